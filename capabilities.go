@@ -0,0 +1,63 @@
+package sms
+
+import "context"
+
+// Capabilities describes what a provider actually supports, operations it
+// lacks are either no-ops or fall back to another operation (e.g. reporting
+// a number cancels it)
+type Capabilities struct {
+	// CountrySelection is false when the country argument of GetPhoneNumber is ignored
+	CountrySelection bool
+	Reuse            bool
+	Cancel           bool
+	Report           bool
+	Balance          bool
+	Prices           bool
+	Rentals          bool
+	// MultipleMessages is true when a single number can receive more than one message
+	MultipleMessages  bool
+	Voice             bool
+	Webhooks          bool
+	AreaCodeSelection bool
+	// ListActive is true when the provider can list the numbers being rented
+	ListActive bool
+	// History is true when the provider can list past orders
+	History bool
+	// Extend is true when rentals can be prolonged, see ExtendableClient
+	Extend bool
+}
+
+type CapableClient interface {
+	Client
+	Capabilities() Capabilities
+}
+
+type BalanceClient interface {
+	GetBalance(ctx context.Context) (float64, error)
+}
+
+// CapabilitiesOf returns the capabilities client declares, or those that can
+// be inferred from the interfaces it implements
+func CapabilitiesOf(client Client) Capabilities {
+	if capable, ok := client.(CapableClient); ok {
+		return capable.Capabilities()
+	}
+
+	_, reusable := client.(ReusableClient)
+	_, balance := client.(BalanceClient)
+	_, active := client.(ActiveClient)
+	_, history := client.(HistoryClient)
+	_, extendable := client.(ExtendableClient)
+
+	return Capabilities{
+		CountrySelection: true,
+		Reuse:            reusable,
+		Cancel:           true,
+		Report:           true,
+		Balance:          balance,
+		MultipleMessages: reusable,
+		ListActive:       active,
+		History:          history,
+		Extend:           extendable,
+	}
+}
@@ -0,0 +1,174 @@
+package sms
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Service is one of a provider's services, providers with a generated catalog
+// list theirs in a Services variable
+type Service struct {
+	// ID is what the provider's client expects
+	ID   string
+	Name string
+	// NormalizedName is Name as NormalizeName returns it
+	NormalizedName string
+}
+
+type Services []Service
+
+// NormalizeName lowercases name and drops everything but letters and digits,
+// so "Burger King" and "burger_king" compare equal
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return b.String()
+}
+
+func (s Services) ByID(id string) (Service, bool) {
+	for _, service := range s {
+		if service.ID == id {
+			return service, true
+		}
+	}
+
+	return Service{}, false
+}
+
+// ByName returns the first service whose normalized name is name's
+func (s Services) ByName(name string) (Service, bool) {
+	normalized := NormalizeName(name)
+	for _, service := range s {
+		if service.NormalizedName == normalized {
+			return service, true
+		}
+	}
+
+	return Service{}, false
+}
+
+// Search returns the services whose normalized names match query's, best
+// matches first: equal names, then names starting with, containing and
+// finally holding query's letters in order
+func (s Services) Search(query string) []Service {
+	normalized := NormalizeName(query)
+	if normalized == "" {
+		return nil
+	}
+
+	type match struct {
+		service Service
+		rank    int
+	}
+
+	var matches []match
+	for _, service := range s {
+		rank := -1
+		switch name := service.NormalizedName; {
+		case name == normalized:
+			rank = 0
+		case strings.HasPrefix(name, normalized):
+			rank = 1
+		case strings.Contains(name, normalized):
+			rank = 2
+		case subsequence(normalized, name):
+			rank = 3
+		}
+
+		if rank >= 0 {
+			matches = append(matches, match{service: service, rank: rank})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return len(matches[i].service.NormalizedName) < len(matches[j].service.NormalizedName)
+	})
+
+	services := make([]Service, len(matches))
+	for i, m := range matches {
+		services[i] = m.service
+	}
+
+	return services
+}
+
+// subsequence reports whether s's runes appear in t in order
+func subsequence(s string, t string) bool {
+	runes := []rune(s)
+	i := 0
+	for _, r := range t {
+		if i < len(runes) && r == runes[i] {
+			i++
+		}
+	}
+
+	return i == len(runes)
+}
+
+// Country is one of a provider's countries, providers with a generated catalog
+// list theirs in a Countries variable
+type Country struct {
+	// ID is what the provider's client expects
+	ID string
+	// Alpha2 is the ISO 3166-1 alpha-2 code, empty when unknown
+	Alpha2 string
+	Name   string
+	// DialCode is the country calling code, 0 when unknown
+	DialCode int
+}
+
+type Countries []Country
+
+func (c Countries) ByID(id string) (Country, bool) {
+	for _, country := range c {
+		if country.ID == id {
+			return country, true
+		}
+	}
+
+	return Country{}, false
+}
+
+// ByAlpha2 returns the first country with the alpha-2 code, in any case
+func (c Countries) ByAlpha2(alpha2 string) (Country, bool) {
+	for _, country := range c {
+		if country.Alpha2 != "" && strings.EqualFold(country.Alpha2, alpha2) {
+			return country, true
+		}
+	}
+
+	return Country{}, false
+}
+
+// ByName returns the first country whose normalized name is name's
+func (c Countries) ByName(name string) (Country, bool) {
+	normalized := NormalizeName(name)
+	for _, country := range c {
+		if NormalizeName(country.Name) == normalized {
+			return country, true
+		}
+	}
+
+	return Country{}, false
+}
+
+// ByDialCode returns the countries sharing the calling code
+func (c Countries) ByDialCode(code int) []Country {
+	var countries []Country
+	for _, country := range c {
+		if country.DialCode == code {
+			countries = append(countries, country)
+		}
+	}
+
+	return countries
+}
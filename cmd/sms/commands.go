@@ -0,0 +1,621 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/internal/providers"
+	"github.com/saucesteals/sms/smsstore"
+)
+
+// common are the flags shared by every command
+type common struct {
+	fs *flag.FlagSet
+
+	provider string
+	apiKey   string
+	state    string
+	json     bool
+}
+
+func newCommon(name string) *common {
+	c := &common{fs: flag.NewFlagSet("sms "+name, flag.ContinueOnError)}
+	c.fs.StringVar(&c.provider, "provider", os.Getenv("SMS_PROVIDER"), "phone number provider ("+strings.Join(providers.Names(), ", ")+"), the path of a generic provider spec or plugin:<executable>")
+	c.fs.StringVar(&c.apiKey, "apikey", "", "api key for provider (default $SMS_<PROVIDER>_APIKEY or $SMS_APIKEY)")
+	c.fs.StringVar(&c.state, "state", defaultStatePath(), "JSON-lines file remembering rented phone numbers, or a bbolt database when it ends in .db")
+	c.fs.BoolVar(&c.json, "json", false, "print JSON instead of text")
+	return c
+}
+
+func (c *common) parse(args []string, nargs int) error {
+	if err := c.fs.Parse(args); err != nil {
+		return err
+	}
+
+	if c.fs.NArg() != nargs {
+		c.fs.Usage()
+		return flag.ErrHelp
+	}
+
+	return nil
+}
+
+func (c *common) client(ctx context.Context) (sms.Client, providers.Provider, error) {
+	provider, err := providers.Get(c.provider)
+	if err != nil {
+		return nil, providers.Provider{}, err
+	}
+
+	// generic providers are given as a spec path but named after the spec
+	c.provider = provider.Name
+
+	apiKey := c.apiKey
+	if apiKey == "" {
+		apiKey = os.Getenv("SMS_" + strings.ToUpper(provider.Name) + "_APIKEY")
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("SMS_APIKEY")
+	}
+	if apiKey == "" {
+		return nil, providers.Provider{}, fmt.Errorf("no api key for %s", provider.Name)
+	}
+
+	client, err := provider.New(ctx, apiKey)
+	if err != nil {
+		return nil, providers.Provider{}, err
+	}
+
+	return client, provider, nil
+}
+
+// restore rebuilds the rented phone number identified by the command's
+// argument, along with its record
+func (c *common) restore(ctx context.Context, client sms.Client, store sms.Store) (*sms.PhoneNumber, sms.Record, error) {
+	restorable, ok := client.(sms.RestorableClient)
+	if !ok {
+		return nil, sms.Record{}, fmt.Errorf("%s cannot restore rented phone numbers", c.provider)
+	}
+
+	record, err := store.Load(ctx, c.provider, c.fs.Arg(0))
+	if errors.Is(err, sms.ErrNotFound) {
+		return nil, sms.Record{}, fmt.Errorf("no %s rental %q in %s", c.provider, c.fs.Arg(0), c.state)
+	}
+	if err != nil {
+		return nil, sms.Record{}, err
+	}
+
+	phoneNumber, err := restorable.RestorePhoneNumber(ctx, record.Rental)
+	if err != nil {
+		return nil, sms.Record{}, err
+	}
+
+	return phoneNumber, record, nil
+}
+
+func (c *common) print(v any, text func(w *tabwriter.Writer)) error {
+	if c.json {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	text(w)
+	return w.Flush()
+}
+
+func (c *common) printRentals(rentals ...sms.Rental) error {
+	return c.print(rentals, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "PROVIDER\tID\tNUMBER\tSERVICE\tCOUNTRY\tCOST\tRENTED\tEXPIRES\tSTATE")
+		for _, r := range rentals {
+			number := r.Number
+			if parsed, err := phonenumbers.Parse(r.Number, ""); err == nil {
+				number = phonenumbers.Format(parsed, phonenumbers.INTERNATIONAL)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.2f\t%s\t%s\t%s\n",
+				r.Provider, r.ID, number, r.Service, r.Country, r.Cost,
+				formatTime(r.RentedAt), formatTime(r.ExpiresAt), rentalState(r))
+		}
+	})
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+func rentalState(r sms.Rental) string {
+	switch {
+	case r.Used && r.Cancelled:
+		return "finished"
+	case r.Cancelled:
+		return "cancelled"
+	case !r.ExpiresAt.IsZero() && time.Now().After(r.ExpiresAt):
+		return "expired"
+	case r.Used:
+		return "used"
+	default:
+		return "active"
+	}
+}
+
+func runRent(ctx context.Context, args []string) error {
+	c := newCommon("rent")
+	service := c.fs.String("service", "", "provider's service identifier, or its name when the provider has a catalog")
+	country := c.fs.String("country", "", "provider's country identifier, or its alpha-2 code or name when the provider has a country catalog")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	phoneNumber, err := client.GetPhoneNumber(ctx, provider.ServiceID(*service), provider.CountryID(*country))
+	if err != nil {
+		return err
+	}
+
+	rental := phoneNumber.Rental()
+	if err := store.Save(ctx, sms.NewRecord(rental, nil)); err != nil {
+		return fmt.Errorf("saving rental %s: %w", rental.Number, err)
+	}
+
+	return c.printRentals(rental)
+}
+
+// newMatcherFn matches whole messages unless digits or pattern is set, a
+// pattern with a capture group matches the first group
+func newMatcherFn(digits int, pattern string) (sms.MatcherFn, error) {
+	if digits > 0 {
+		pattern = fmt.Sprintf(`\b\d{%d}\b`, digits)
+	}
+
+	if pattern == "" {
+		return func(message string) string { return message }, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(message string) string {
+		match := re.FindStringSubmatch(message)
+		switch len(match) {
+		case 0:
+			return ""
+		case 1:
+			return match[0]
+		default:
+			return match[1]
+		}
+	}, nil
+}
+
+type waitResult struct {
+	sms.Rental
+	Message string `json:"message"`
+}
+
+func runWait(ctx context.Context, args []string) error {
+	c := newCommon("wait")
+	digits := c.fs.Int("digits", 0, "match the first code of this many digits")
+	pattern := c.fs.String("regex", "", "match this regular expression, or its first capture group")
+	delay := c.fs.Duration("delay", 2*time.Second, "delay between checking for messages")
+	timeout := c.fs.Duration("timeout", 5*time.Minute, "maximum time to wait, cut short if the rental expires")
+	if err := c.parse(args, 1); err != nil {
+		return err
+	}
+
+	matcherFn, err := newMatcherFn(*digits, *pattern)
+	if err != nil {
+		return err
+	}
+
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	client, _, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	phoneNumber, record, err := c.restore(ctx, client, store)
+	if err != nil {
+		return err
+	}
+
+	// remember the whole message the match was found in
+	var received string
+	matcher := sms.NewMatcher(func(message string) string {
+		match := matcherFn(message)
+		if match != "" {
+			received = message
+		}
+		return match
+	}, *delay, *timeout)
+
+	message, err := matcher.WaitForMessage(ctx, client, phoneNumber)
+	messages := record.Messages
+	if received != "" {
+		messages = append(messages, received)
+	}
+	next := sms.NewRecord(phoneNumber.Rental(), messages)
+	next.Labels = record.Labels
+	if saveErr := store.Save(ctx, next); saveErr != nil && err == nil {
+		err = saveErr
+	}
+	if err != nil {
+		return err
+	}
+
+	result := waitResult{Rental: phoneNumber.Rental(), Message: message}
+	return c.print(result, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, message)
+	})
+}
+
+func runCancel(ctx context.Context, args []string) error {
+	return closeRental(ctx, "cancel", args, sms.Client.CancelPhoneNumber)
+}
+
+func runReport(ctx context.Context, args []string) error {
+	return closeRental(ctx, "report", args, sms.Client.ReportPhoneNumber)
+}
+
+func closeRental(ctx context.Context, name string, args []string, close func(sms.Client, context.Context, *sms.PhoneNumber) error) error {
+	c := newCommon(name)
+	if err := c.parse(args, 1); err != nil {
+		return err
+	}
+
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	client, _, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	phoneNumber, record, err := c.restore(ctx, client, store)
+	if err != nil {
+		return err
+	}
+
+	if err := close(client, ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	// providers leave used numbers and numbers they cannot cancel open
+	rental := phoneNumber.Rental()
+	next := sms.NewRecord(rental, record.Messages)
+	switch {
+	case rental.Used:
+		next.Status = sms.StatusFinished
+	case rental.Cancelled && name == "report":
+		next.Status = sms.StatusReported
+	case rental.Cancelled:
+		next.Status = sms.StatusCancelled
+	default:
+		fmt.Fprintf(os.Stderr, "%s left %s open\n", c.provider, rental.Number)
+	}
+	next.Labels = record.Labels
+
+	if err := store.Save(ctx, next); err != nil {
+		return err
+	}
+
+	return c.printRentals(rental)
+}
+
+func runReuse(ctx context.Context, args []string) error {
+	c := newCommon("reuse")
+	if err := c.parse(args, 1); err != nil {
+		return err
+	}
+
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	client, _, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	reusable, ok := client.(sms.ReusableClient)
+	if !ok {
+		return fmt.Errorf("%s does not support reusing phone numbers", c.provider)
+	}
+
+	phoneNumber, record, err := c.restore(ctx, client, store)
+	if err != nil {
+		return err
+	}
+
+	reused, err := reusable.ReusePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	// some providers rent a new order for the same number, which starts
+	// without messages
+	rental := reused.Rental()
+	reusedRecord := sms.Record{Rental: rental, Status: sms.StatusWaiting, Labels: record.Labels}
+	if reusedRecord.Key() == record.Key() {
+		reusedRecord.Messages = record.Messages
+	}
+	if err := store.Save(ctx, reusedRecord); err != nil {
+		return err
+	}
+
+	return c.printRentals(rental)
+}
+
+type balanceResult struct {
+	Provider string  `json:"provider"`
+	Balance  float64 `json:"balance"`
+}
+
+func runBalance(ctx context.Context, args []string) error {
+	c := newCommon("balance")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	balanceClient, ok := client.(sms.BalanceClient)
+	if !ok {
+		return fmt.Errorf("%s does not report balances", provider.Name)
+	}
+
+	balance, err := balanceClient.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.print(balanceResult{Provider: provider.Name, Balance: balance}, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "%.2f\n", balance)
+	})
+}
+
+func runServices(ctx context.Context, args []string) error {
+	c := newCommon("services")
+	search := c.fs.String("search", "", "only list the service with this ID and those whose name matches this, best matches first")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	services, err := listServices(ctx, client, provider)
+	if err != nil {
+		return err
+	}
+
+	matches := services
+	if *search != "" {
+		matches = searchServices(services, *search)
+	}
+
+	return c.print(matches, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "ID\tNAME")
+		for _, s := range matches {
+			fmt.Fprintf(w, "%s\t%s\n", s.ID, s.Name)
+		}
+	})
+}
+
+// searchServices returns the service with query's ID, if any, followed by the
+// services whose names match query as sms.Services.Search ranks them
+func searchServices(services []providers.Service, query string) []providers.Service {
+	catalog := make(sms.Services, len(services))
+	for i, s := range services {
+		catalog[i] = sms.Service{ID: s.ID, Name: s.Name, NormalizedName: sms.NormalizeName(s.Name)}
+	}
+
+	matches := []providers.Service{}
+	if s, ok := catalog.ByID(query); ok {
+		matches = append(matches, providers.Service{ID: s.ID, Name: s.Name})
+	}
+
+	for _, s := range catalog.Search(query) {
+		if s.ID != query {
+			matches = append(matches, providers.Service{ID: s.ID, Name: s.Name})
+		}
+	}
+
+	return matches
+}
+
+// listServices lists the provider's services, from its generated catalog when
+// it cannot list them itself
+func listServices(ctx context.Context, client sms.Client, provider providers.Provider) ([]providers.Service, error) {
+	if provider.Services != nil {
+		return provider.Services(ctx, client)
+	}
+
+	if len(provider.Catalog) == 0 {
+		return nil, fmt.Errorf("%s cannot list services", provider.Name)
+	}
+
+	services := make([]providers.Service, len(provider.Catalog))
+	for i, s := range provider.Catalog {
+		services[i] = providers.Service{ID: s.ID, Name: s.Name}
+	}
+
+	return services, nil
+}
+
+func runPrices(ctx context.Context, args []string) error {
+	c := newCommon("prices")
+	service := c.fs.String("service", "", "only list the price of this service, by ID or by name when the provider has a catalog")
+	country := c.fs.String("country", "", "only list prices in this country, by ID or by alpha-2 code or name when the provider has a country catalog")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	if provider.Prices == nil {
+		return fmt.Errorf("%s cannot list prices", provider.Name)
+	}
+
+	serviceID := ""
+	if *service != "" {
+		serviceID = provider.ServiceID(*service)
+	}
+
+	prices, err := provider.Prices(ctx, client, serviceID, provider.CountryID(*country))
+	if err != nil {
+		return err
+	}
+
+	matches := []providers.Price{}
+	for _, p := range prices {
+		if serviceID == "" || p.Service == serviceID {
+			matches = append(matches, p)
+		}
+	}
+
+	return c.print(matches, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "SERVICE\tNAME\tCOST\tSTOCK")
+		for _, p := range matches {
+			stock := "-"
+			if p.Stock >= 0 {
+				stock = fmt.Sprint(p.Stock)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%.2f\t%s\n", p.Service, p.Name, p.Cost, stock)
+		}
+	})
+}
+
+func runActive(ctx context.Context, args []string) error {
+	c := newCommon("active")
+	all := c.fs.Bool("all", false, "include cancelled and expired rentals")
+	remote := c.fs.Bool("remote", false, "list the provider's open orders and remember those missing from the state file")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if *remote {
+		return c.listRemote(ctx, store)
+	}
+
+	records, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	active := []sms.Rental{}
+	for _, record := range records {
+		rental := record.Rental
+		if c.provider != "" && rental.Provider != c.provider {
+			continue
+		}
+
+		// the stored status knows of rentals finished elsewhere, such as
+		// through the gateway
+		s := rentalState(rental)
+		if *all || (!record.Status.Done() && s != "cancelled" && s != "expired" && s != "finished") {
+			active = append(active, rental)
+		}
+	}
+
+	if len(active) == 0 && !c.json {
+		fmt.Fprintln(os.Stderr, "no active rentals")
+		return nil
+	}
+
+	return c.printRentals(active...)
+}
+
+// listRemote lists the provider's open orders, saving those the store does not
+// know yet so they can be waited on, cancelled or reported
+func (c *common) listRemote(ctx context.Context, store sms.Store) error {
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return err
+	}
+
+	activeClient, ok := client.(sms.ActiveClient)
+	if !ok {
+		return fmt.Errorf("%s cannot list open orders, run active without -remote to list those in the state file", provider.Name)
+	}
+
+	phoneNumbers, err := activeClient.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	active := make([]sms.Rental, 0, len(phoneNumbers))
+	for _, phoneNumber := range phoneNumbers {
+		rental := phoneNumber.Rental()
+		active = append(active, rental)
+
+		_, err := store.Load(ctx, rental.Provider, rental.ID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, sms.ErrNotFound) {
+			return err
+		}
+
+		if err := store.Save(ctx, sms.NewRecord(rental, nil)); err != nil {
+			return fmt.Errorf("saving rental %s: %w", rental.Number, err)
+		}
+	}
+
+	if len(active) == 0 && !c.json {
+		fmt.Fprintf(os.Stderr, "no open %s orders\n", provider.Name)
+		return nil
+	}
+
+	return c.printRentals(active...)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/smsstore"
+)
+
+func runHistory(ctx context.Context, args []string) error {
+	c := newCommon("history")
+	from := c.fs.String("from", "", "only list orders rented on or after this date (2006-01-02 or RFC 3339)")
+	to := c.fs.String("to", "", "only list orders rented before this date (2006-01-02 or RFC 3339)")
+	local := c.fs.Bool("local", false, "list the rentals in the state file instead of asking the provider")
+	csv := c.fs.Bool("csv", false, "print CSV instead of text")
+	summary := c.fs.Bool("summary", false, "print the number of orders and their cost per provider and service")
+	if err := c.parse(args, 0); err != nil {
+		return err
+	}
+
+	query, err := historyQuery(*from, *to)
+	if err != nil {
+		return err
+	}
+
+	var records []sms.HistoryRecord
+	if *local || c.provider == "" {
+		records, err = c.localHistory(ctx, query)
+	} else {
+		records, err = c.remoteHistory(ctx, query)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *summary {
+		return c.printSummary(summarize(records), *csv)
+	}
+
+	return c.printHistory(records, *csv)
+}
+
+func historyQuery(from string, to string) (sms.HistoryQuery, error) {
+	var query sms.HistoryQuery
+	var err error
+
+	if query.From, err = parseDate(from); err != nil {
+		return query, fmt.Errorf("parsing -from: %w", err)
+	}
+	if query.To, err = parseDate(to); err != nil {
+		return query, fmt.Errorf("parsing -to: %w", err)
+	}
+
+	return query, nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func (c *common) remoteHistory(ctx context.Context, query sms.HistoryQuery) ([]sms.HistoryRecord, error) {
+	client, provider, err := c.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	historyClient, ok := client.(sms.HistoryClient)
+	if !ok {
+		return nil, fmt.Errorf("%s does not list past orders, use -local for the rentals in %s", provider.Name, c.state)
+	}
+
+	return sms.AllHistory(ctx, historyClient, query.From, query.To)
+}
+
+// localHistory lists the rentals in the state file, newest first like
+// providers list them
+func (c *common) localHistory(ctx context.Context, query sms.HistoryQuery) ([]sms.HistoryRecord, error) {
+	store, err := smsstore.Open(c.state)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	stored, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []sms.HistoryRecord{}
+	for i := len(stored) - 1; i >= 0; i-- {
+		record := stored[i]
+		if c.provider != "" && record.Provider != c.provider {
+			continue
+		}
+		if !query.Contains(record.RentedAt) {
+			continue
+		}
+
+		h := sms.HistoryRecord{Order: record.Order, Number: record.Number, Status: record.Status}
+		if n := len(record.Messages); n > 0 {
+			h.Code = record.Messages[n-1]
+		}
+
+		records = append(records, h)
+	}
+
+	return records, nil
+}
+
+func (c *common) printHistory(records []sms.HistoryRecord, asCSV bool) error {
+	if asCSV {
+		rows := [][]string{{"provider", "id", "service", "country", "number", "cost", "status", "code", "rented_at"}}
+		for _, r := range records {
+			rows = append(rows, []string{
+				r.Provider, r.ID, r.Service, r.Country, r.Number,
+				strconv.FormatFloat(r.Cost, 'f', -1, 64), r.Status.String(), r.Code,
+				r.RentedAt.UTC().Format(time.RFC3339),
+			})
+		}
+
+		return writeCSV(rows)
+	}
+
+	return c.print(records, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "PROVIDER\tID\tNUMBER\tSERVICE\tCOUNTRY\tCOST\tRENTED\tSTATUS\tCODE")
+		for _, r := range records {
+			code := r.Code
+			if code == "" {
+				code = "-"
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.2f\t%s\t%s\t%s\n",
+				r.Provider, r.ID, r.Number, r.Service, r.Country, r.Cost,
+				formatTime(r.RentedAt), r.Status, code)
+		}
+	})
+}
+
+// spend is the orders of one provider's service, orders that ended before
+// they received a code are counted but cost nothing since providers refund them
+type spend struct {
+	Provider string  `json:"provider"`
+	Service  string  `json:"service"`
+	Orders   int     `json:"orders"`
+	Refunded int     `json:"refunded"`
+	Cost     float64 `json:"cost"`
+}
+
+// refunded reports whether the order ended before it received a code, which
+// providers refund
+func refunded(r sms.HistoryRecord) bool {
+	if r.Code != "" {
+		return false
+	}
+
+	switch r.Status {
+	case sms.StatusCancelled, sms.StatusReported, sms.StatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+func summarize(records []sms.HistoryRecord) []spend {
+	type key struct{ provider, service string }
+
+	byKey := map[key]*spend{}
+	for _, r := range records {
+		k := key{r.Provider, r.Service}
+		s, ok := byKey[k]
+		if !ok {
+			s = &spend{Provider: r.Provider, Service: r.Service}
+			byKey[k] = s
+		}
+
+		s.Orders++
+		if refunded(r) {
+			s.Refunded++
+			continue
+		}
+		s.Cost += r.Cost
+	}
+
+	spends := make([]spend, 0, len(byKey))
+	for _, s := range byKey {
+		spends = append(spends, *s)
+	}
+
+	sort.Slice(spends, func(i, j int) bool {
+		if spends[i].Provider != spends[j].Provider {
+			return spends[i].Provider < spends[j].Provider
+		}
+		return spends[i].Service < spends[j].Service
+	})
+
+	return spends
+}
+
+func (c *common) printSummary(spends []spend, asCSV bool) error {
+	if asCSV {
+		rows := [][]string{{"provider", "service", "orders", "refunded", "cost"}}
+		for _, s := range spends {
+			rows = append(rows, []string{
+				s.Provider, s.Service, strconv.Itoa(s.Orders), strconv.Itoa(s.Refunded),
+				strconv.FormatFloat(s.Cost, 'f', -1, 64),
+			})
+		}
+
+		return writeCSV(rows)
+	}
+
+	return c.print(spends, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "PROVIDER\tSERVICE\tORDERS\tREFUNDED\tCOST")
+		for _, s := range spends {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.2f\n", s.Provider, s.Service, s.Orders, s.Refunded, s.Cost)
+		}
+	})
+}
+
+func writeCSV(rows [][]string) error {
+	w := csv.NewWriter(os.Stdout)
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return w.Error()
+}
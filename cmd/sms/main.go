@@ -0,0 +1,81 @@
+// Command sms rents and manages phone numbers on any supported provider.
+//
+//	sms rent -provider smspool -service 1106 -country US
+//	sms wait -provider smspool -digits 6 <order id>
+//	sms cancel -provider smspool <order id>
+//
+// API keys are read from -apikey, SMS_<PROVIDER>_APIKEY or SMS_APIKEY, and
+// rented numbers are remembered in a local state file so that later commands
+// can refer to them by order ID or phone number.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+)
+
+type command struct {
+	usage string
+	run   func(ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{
+	"rent":     {"rent a phone number", runRent},
+	"wait":     {"wait for a message matching the matcher flags", runWait},
+	"cancel":   {"cancel a rented phone number", runCancel},
+	"report":   {"report a rented phone number", runReport},
+	"reuse":    {"reuse a phone number for another message", runReuse},
+	"balance":  {"print the account balance", runBalance},
+	"services": {"list the provider's services", runServices},
+	"prices":   {"list the provider's prices", runPrices},
+	"active":   {"list rented phone numbers that are still active", runActive},
+	"history":  {"list or export past orders and their cost", runHistory},
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("usage: sms <command> [flags] [args]\n\ncommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %-9s %s\n", name, commands[name].usage)
+	}
+	b.WriteString("\nrun sms <command> -h for the command's flags\n")
+
+	fmt.Fprint(os.Stderr, b.String())
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := cmd.run(ctx, os.Args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+
+		fmt.Fprintf(os.Stderr, "sms %s: %s\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
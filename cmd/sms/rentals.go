@@ -0,0 +1,15 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func defaultStatePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "sms-rentals.jsonl"
+	}
+
+	return filepath.Join(dir, "sms", "rentals.jsonl")
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// failover is an sms.Client renting from the first configured provider that
+// has a number, every other call goes to the provider that rented the number.
+// Services and countries are resolved in each provider's catalogs, like rent
+// requests
+type failover struct {
+	providers []provider
+
+	mu     sync.RWMutex
+	rented map[*sms.PhoneNumber]provider
+}
+
+var (
+	_ sms.ReusableClient = &failover{}
+	_ sms.BalanceClient  = &failover{}
+)
+
+func newFailover(configured []provider) *failover {
+	return &failover{providers: configured, rented: map[*sms.PhoneNumber]provider{}}
+}
+
+func (f *failover) provider(phoneNumber *sms.PhoneNumber) (provider, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	p, ok := f.rented[phoneNumber]
+	if !ok {
+		return provider{}, sms.ErrInvalidMetadata
+	}
+
+	return p, nil
+}
+
+// track records the provider that rented phoneNumber, forgetting numbers that
+// expired or were cancelled
+func (f *failover) track(phoneNumber *sms.PhoneNumber, p provider) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	for tracked := range f.rented {
+		if tracked.Cancelled() || tracked.Expired() ||
+			tracked.ExpiresAt().IsZero() && now.Sub(tracked.RentedAt()) > maxAge {
+			delete(f.rented, tracked)
+		}
+	}
+
+	f.rented[phoneNumber] = p
+}
+
+func (f *failover) forget(phoneNumber *sms.PhoneNumber) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.rented, phoneNumber)
+}
+
+func (f *failover) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	var errs []string
+	for _, p := range f.providers {
+		phoneNumber, err := p.client.GetPhoneNumber(ctx, p.adapter.ServiceID(service), p.adapter.CountryID(country))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", p.name, err))
+			continue
+		}
+
+		f.track(phoneNumber, p)
+		return phoneNumber, nil
+	}
+
+	return nil, fmt.Errorf("no provider could rent a number: %s", strings.Join(errs, "; "))
+}
+
+func (f *failover) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	p, err := f.provider(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.client.GetMessages(ctx, phoneNumber)
+}
+
+func (f *failover) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	p, err := f.provider(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	if err := p.client.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	f.forget(phoneNumber)
+	return nil
+}
+
+func (f *failover) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	p, err := f.provider(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	if err := p.client.ReportPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	f.forget(phoneNumber)
+	return nil
+}
+
+func (f *failover) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	p, err := f.provider(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	reusable, ok := p.client.(sms.ReusableClient)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support reusing numbers", p.name)
+	}
+
+	reused, err := reusable.ReusePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// some providers rent a new order for the same number
+	if reused != phoneNumber {
+		f.forget(phoneNumber)
+	}
+
+	f.track(reused, p)
+	return reused, nil
+}
+
+// GetBalance returns the balance of the first provider that reports one, the
+// one rentals go to first. Providers bill in different currencies, so their
+// balances are not summed, Balances lists each of them
+func (f *failover) GetBalance(ctx context.Context) (float64, error) {
+	for _, p := range f.providers {
+		balanceClient, ok := p.client.(sms.BalanceClient)
+		if !ok {
+			continue
+		}
+
+		balance, err := balanceClient.GetBalance(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", p.name, err)
+		}
+
+		return balance, nil
+	}
+
+	return 0, errors.New("no provider reports a balance")
+}
+
+type balanceResponse struct {
+	Provider string  `json:"provider"`
+	Balance  float64 `json:"balance"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// Balances lists the balance of every provider that reports one, or only
+// name's when it is set. Providers failing to report theirs are listed with
+// the error
+func (f *failover) Balances(ctx context.Context, name string) []balanceResponse {
+	balances := []balanceResponse{}
+	for _, p := range f.providers {
+		if name != "" && p.name != name {
+			continue
+		}
+
+		balanceClient, ok := p.client.(sms.BalanceClient)
+		if !ok {
+			continue
+		}
+
+		balance := balanceResponse{Provider: p.name}
+		if bal, err := balanceClient.GetBalance(ctx); err != nil {
+			balance.Error = err.Error()
+		} else {
+			balance.Balance = bal
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances
+}
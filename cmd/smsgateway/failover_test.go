@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/internal/providers"
+)
+
+// fake rents numbers receiving messages, reusing a number keeps its order
+type fake struct {
+	name string
+	// err fails GetPhoneNumber and GetBalance when set
+	err     error
+	balance float64
+
+	mu        sync.Mutex
+	rented    int
+	messages  []string
+	cancelled []*sms.PhoneNumber
+}
+
+func (f *fake) GetPhoneNumber(_ context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.mu.Lock()
+	f.rented++
+	id := f.rented
+	f.mu.Unlock()
+
+	number, err := phonenumbers.Parse(fmt.Sprintf("+120255501%02d", id), "US")
+	if err != nil {
+		return nil, err
+	}
+
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: f.name,
+		ID:       fmt.Sprint(id),
+		Service:  service,
+		Country:  country,
+	}, nil), nil
+}
+
+// receive delivers message to every number
+func (f *fake) receive(message string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.messages = append(f.messages, message)
+}
+
+func (f *fake) GetMessages(context.Context, *sms.PhoneNumber) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string{}, f.messages...), nil
+}
+
+func (f *fake) CancelPhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	phoneNumber.MarkCancelled()
+	f.cancelled = append(f.cancelled, phoneNumber)
+	return nil
+}
+
+func (f *fake) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return f.CancelPhoneNumber(ctx, phoneNumber)
+}
+
+func (f *fake) ReusePhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.messages = nil
+	return phoneNumber, nil
+}
+
+func (f *fake) GetBalance(context.Context) (float64, error) {
+	return f.balance, f.err
+}
+
+func newTestProviders(fakes ...*fake) []provider {
+	configured := make([]provider, len(fakes))
+	for i, f := range fakes {
+		configured[i] = provider{name: f.name, client: f, adapter: providers.Provider{Name: f.name}}
+	}
+
+	return configured
+}
+
+func TestFailover(t *testing.T) {
+	empty := &fake{name: "empty", err: errors.New("no numbers")}
+	stocked := &fake{name: "stocked"}
+	f := newFailover(newTestProviders(empty, stocked))
+	ctx := context.Background()
+
+	phoneNumber, err := f.GetPhoneNumber(ctx, "service", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phoneNumber.Provider() != "stocked" {
+		t.Fatalf("rented from %s, want stocked", phoneNumber.Provider())
+	}
+
+	stocked.receive("123456")
+	messages, err := f.GetMessages(ctx, phoneNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0] != "123456" {
+		t.Fatalf("messages = %q, want the stocked provider's", messages)
+	}
+
+	if err := f.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if len(stocked.cancelled) != 1 {
+		t.Fatal("the provider that rented the number did not cancel it")
+	}
+
+	// cancelled numbers are forgotten
+	if _, err := f.GetMessages(ctx, phoneNumber); !errors.Is(err, sms.ErrInvalidMetadata) {
+		t.Fatalf("err = %v, want sms.ErrInvalidMetadata", err)
+	}
+
+	empty.err = errors.New("no numbers")
+	stocked.err = errors.New("no numbers")
+	if _, err := f.GetPhoneNumber(ctx, "service", "US"); err == nil {
+		t.Fatal("rented a number without any provider having one")
+	}
+}
+
+func TestFailoverBalances(t *testing.T) {
+	failing := &fake{name: "failing", err: errors.New("unavailable")}
+	usd := &fake{name: "usd", balance: 1}
+	rub := &fake{name: "rub", balance: 100}
+	f := newFailover(newTestProviders(usd, rub, failing))
+	ctx := context.Background()
+
+	// balances in different currencies are not summed
+	balance, err := f.GetBalance(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 1 {
+		t.Fatalf("balance = %v, want the first provider's", balance)
+	}
+
+	balances := f.Balances(ctx, "")
+	want := []balanceResponse{
+		{Provider: "usd", Balance: 1},
+		{Provider: "rub", Balance: 100},
+		{Provider: "failing", Error: "unavailable"},
+	}
+	if fmt.Sprint(balances) != fmt.Sprint(want) {
+		t.Fatalf("balances = %+v, want %+v", balances, want)
+	}
+
+	if balances := f.Balances(ctx, "rub"); len(balances) != 1 || balances[0].Balance != 100 {
+		t.Fatalf("balances = %+v, want rub's", balances)
+	}
+}
@@ -0,0 +1,184 @@
+// Command smsgateway exposes the configured providers behind one REST/JSON API.
+//
+//	POST /numbers                 rent a number, failing over between providers
+//	GET  /numbers/{id}            describe a rented number
+//	GET  /numbers/{id}/messages   list the number's messages, long-polling with ?wait=30s&after=N
+//	GET  /numbers/{id}/events     stream the number's messages as server-sent events
+//	POST /numbers/{id}/cancel     cancel the number
+//	POST /numbers/{id}/report     report the number
+//	POST /numbers/{id}/reuse      reuse the number for another message
+//	GET  /balance                 balances of every provider that reports one
+//
+// It also speaks sms-activate's protocol at /stubs/handler_api.php, with the
+// token as api_key, failing over between providers in the configured order.
+//
+// Providers are configured with a JSON file:
+//
+//	{
+//	  "listen": ":8080",
+//	  "token": "secret",
+//	  "poll_interval": "2s",
+//	  "state": "rentals.jsonl",
+//	  "providers": [
+//	    {"name": "smspool", "api_key": "..."},
+//	    {"name": "textverified", "api_key": "..."}
+//	  ]
+//	}
+//
+// Numbers rented through the REST API are recorded in the state file when one is
+// configured, a bbolt database when it ends in .db and JSON lines otherwise, and
+// numbers still waiting for messages are restored on startup.
+//
+// Providers are tried in the configured order unless a request names its own.
+// A provider's name may also be the path of a generic provider spec, which is
+// then named after the spec, or "plugin:" followed by the path of a plugin
+// executable, named after the executable.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/internal/providers"
+	"github.com/saucesteals/sms/smsstore"
+)
+
+type providerConfig struct {
+	Name   string `json:"name"`
+	APIKey string `json:"api_key"`
+}
+
+type duration struct {
+	time.Duration
+}
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	d.Duration = parsed
+	return nil
+}
+
+type config struct {
+	Listen string `json:"listen"`
+	// Token is required as a bearer token on every request when set
+	Token string `json:"token"`
+	// PollInterval is how often numbers that clients wait on are polled
+	PollInterval duration `json:"poll_interval"`
+	// State is the path of the store recording rented numbers, if any
+	State     string           `json:"state"`
+	Providers []providerConfig `json:"providers"`
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &config{Listen: ":8080", PollInterval: duration{2 * time.Second}}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	if len(cfg.Providers) == 0 {
+		return nil, fmt.Errorf("%s: no providers configured", path)
+	}
+
+	return cfg, nil
+}
+
+// provider is a configured provider's client
+type provider struct {
+	name    string
+	client  sms.Client
+	adapter providers.Provider
+}
+
+func newProviders(ctx context.Context, cfg *config) ([]provider, error) {
+	configured := make([]provider, 0, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		adapter, err := providers.Get(p.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := adapter.New(ctx, p.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.Name, err)
+		}
+
+		configured = append(configured, provider{name: adapter.Name, client: client, adapter: adapter})
+	}
+
+	return configured, nil
+}
+
+func main() {
+	configPath := flag.String("config", "smsgateway.json", "path to the gateway's JSON config")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	configured, err := newProviders(ctx, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	handler := newServer(configured, cfg.Token, cfg.PollInterval.Duration)
+	if cfg.State != "" {
+		store, err := smsstore.Open(cfg.State)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer store.Close()
+
+		restored, err := handler.restore(ctx, store)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("restored %d numbers from %s", restored, cfg.State)
+	}
+
+	srv := &http.Server{
+		Addr:              cfg.Listen,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("listening on %s", cfg.Listen)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
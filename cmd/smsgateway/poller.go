@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// poller polls a number's messages once on behalf of every client waiting on
+// it, polling only runs while at least one client is subscribed
+type poller struct {
+	number   *number
+	interval time.Duration
+	// onMessages is called with every message so far whenever new ones arrive
+	onMessages func(messages []string)
+	// saving is held from recording messages until onMessages returns, so
+	// updates are recorded in order without holding mu during store I/O
+	saving sync.Mutex
+
+	mu          sync.Mutex
+	messages    []string
+	seen        map[string]struct{}
+	err         error
+	subscribers int
+	stop        context.CancelFunc
+	// updated is closed and replaced whenever messages or err change
+	updated chan struct{}
+}
+
+func newPoller(n *number, interval time.Duration, onMessages func(messages []string)) *poller {
+	return &poller{
+		number:     n,
+		interval:   interval,
+		onMessages: onMessages,
+		seen:       map[string]struct{}{},
+		updated:    make(chan struct{}),
+	}
+}
+
+// restore seeds the messages received before a restart
+func (p *poller) restore(messages []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, message := range messages {
+		if _, ok := p.seen[message]; !ok {
+			p.seen[message] = struct{}{}
+			p.messages = append(p.messages, message)
+		}
+	}
+}
+
+// received returns the messages seen so far
+func (p *poller) received() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), p.messages...)
+}
+
+// subscribe starts polling if needed, the returned func must be called once
+// the subscriber stops waiting
+func (p *poller) subscribe() (unsubscribe func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subscribers++
+	if p.stop == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.stop = cancel
+		p.err = nil
+		go p.run(ctx)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+
+			p.subscribers--
+			if p.subscribers == 0 && p.stop != nil {
+				p.stop()
+				p.stop = nil
+			}
+		})
+	}
+}
+
+// reset forgets seen messages, e.g. once the number was reused
+func (p *poller) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = nil
+	p.seen = map[string]struct{}{}
+	p.err = nil
+	p.notify()
+}
+
+// notify must be called with mu held
+func (p *poller) notify() {
+	close(p.updated)
+	p.updated = make(chan struct{})
+}
+
+func (p *poller) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if done := p.poll(ctx); done {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *poller) poll(ctx context.Context) (done bool) {
+	messages, err := p.number.provider.client.GetMessages(ctx, p.number.get())
+	if errors.Is(err, sms.ErrRatelimited) || ctx.Err() != nil {
+		return ctx.Err() != nil
+	}
+
+	p.saving.Lock()
+	defer p.saving.Unlock()
+
+	received, done := p.receive(ctx, messages, err)
+	if received != nil && p.onMessages != nil {
+		p.onMessages(received)
+	}
+
+	return done
+}
+
+// receive records a poll's result, returning every message so far when new
+// ones arrived
+func (p *poller) receive(ctx context.Context, messages []string, err error) (received []string, done bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// the last subscriber may have left while GetMessages was in flight
+	if ctx.Err() != nil {
+		return nil, true
+	}
+
+	if err != nil {
+		p.err = err
+		p.stop()
+		p.stop = nil
+		p.notify()
+		return nil, true
+	}
+
+	var changed bool
+	for _, message := range messages {
+		if _, ok := p.seen[message]; ok {
+			continue
+		}
+
+		p.seen[message] = struct{}{}
+		p.messages = append(p.messages, message)
+		changed = true
+	}
+
+	if !changed {
+		return nil, false
+	}
+
+	p.notify()
+	return append([]string(nil), p.messages...), false
+}
+
+// wait blocks until there are more than after messages, polling fails or ctx is done
+func (p *poller) wait(ctx context.Context, after int) ([]string, error) {
+	for {
+		p.mu.Lock()
+		messages, err, updated := p.messages, p.err, p.updated
+		p.mu.Unlock()
+
+		if after < len(messages) {
+			return messages[after:], nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-updated:
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms/smsstore"
+)
+
+func newTestServer(t *testing.T, f *fake) (*server, *httptest.Server) {
+	t.Helper()
+
+	s := newServer(newTestProviders(f), "", 10*time.Millisecond)
+	if _, err := s.restore(context.Background(), smsstore.NewJSONLines(filepath.Join(t.TempDir(), "rentals.jsonl"))); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	return s, server
+}
+
+func request(t *testing.T, method string, url string, body any, response any) {
+	t.Helper()
+
+	var data bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&data).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req, err := http.NewRequest(method, url, &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		t.Fatalf("%s %s: %s", method, url, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func rent(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	var rented numberResponse
+	request(t, http.MethodPost, server.URL+"/numbers", rentRequest{Service: "service"}, &rented)
+
+	return rented.ID
+}
+
+func TestLongPoll(t *testing.T) {
+	f := &fake{name: "fake"}
+	_, server := newTestServer(t, f)
+	url := server.URL + "/numbers/" + rent(t, server) + "/messages"
+
+	var res messagesResponse
+	request(t, http.MethodGet, url+"?wait=50ms", nil, &res)
+	if len(res.Messages) != 0 || res.Next != 0 {
+		t.Fatalf("got %+v before any message arrived", res)
+	}
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		f.receive("111111")
+	}()
+
+	request(t, http.MethodGet, url+"?wait=5s", nil, &res)
+	if len(res.Messages) != 1 || res.Messages[0] != "111111" || res.Next != 1 {
+		t.Fatalf("got %+v, want the first message", res)
+	}
+
+	f.receive("222222")
+	request(t, http.MethodGet, url+"?wait=5s&after=1", nil, &res)
+	if len(res.Messages) != 1 || res.Messages[0] != "222222" || res.Next != 2 {
+		t.Fatalf("got %+v, want only the second message", res)
+	}
+}
+
+// events reads server-sent events until it read count messages
+func events(t *testing.T, url string, lastEventID string, count int) []messageEvent {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var read []messageEvent
+	scanner := bufio.NewScanner(resp.Body)
+	for len(read) < count && scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		data := strings.TrimPrefix(line, "data: ")
+
+		var event messageEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			t.Fatal(err)
+		}
+		read = append(read, event)
+	}
+
+	if len(read) < count {
+		t.Fatalf("read %d events, want %d: %v", len(read), count, scanner.Err())
+	}
+
+	return read
+}
+
+func TestEvents(t *testing.T) {
+	f := &fake{name: "fake"}
+	_, server := newTestServer(t, f)
+	url := server.URL + "/numbers/" + rent(t, server) + "/events"
+
+	f.receive("111111")
+	f.receive("222222")
+	read := events(t, url, "", 2)
+	if read[0] != (messageEvent{Index: 0, Message: "111111"}) || read[1] != (messageEvent{Index: 1, Message: "222222"}) {
+		t.Fatalf("events = %+v, want both messages", read)
+	}
+
+	// reconnecting clients only receive the messages they have not seen
+	f.receive("333333")
+	read = events(t, url, "1", 1)
+	if read[0] != (messageEvent{Index: 2, Message: "333333"}) {
+		t.Fatalf("event = %+v, want the third message", read[0])
+	}
+}
+
+func TestReuseKeepsMessages(t *testing.T) {
+	f := &fake{name: "fake"}
+	s, server := newTestServer(t, f)
+	id := rent(t, server)
+
+	f.receive("111111")
+	var res messagesResponse
+	request(t, http.MethodGet, server.URL+"/numbers/"+id+"/messages?wait=5s", nil, &res)
+
+	var reused numberResponse
+	request(t, http.MethodPost, server.URL+"/numbers/"+id+"/reuse", nil, &reused)
+
+	record, err := s.store.Load(context.Background(), reused.Rental.Provider, reused.Rental.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Messages) != 1 || record.Messages[0] != "111111" {
+		t.Fatalf("messages = %q after reusing, want the first message", record.Messages)
+	}
+
+	f.receive("222222")
+	request(t, http.MethodGet, server.URL+"/numbers/"+id+"/messages?wait=5s", nil, &res)
+	if len(res.Messages) != 1 || res.Messages[0] != "222222" {
+		t.Fatalf("messages = %q, want the message received after reusing", res.Messages)
+	}
+
+	// the poller saves received messages as they arrive
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		record, err := s.store.Load(context.Background(), reused.Rental.Provider, reused.Rental.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(record.Messages, ",") == "111111,222222" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("messages = %q, want both", record.Messages)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
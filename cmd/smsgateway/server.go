@@ -0,0 +1,601 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/smsactivate"
+)
+
+// number is a phone number rented through the gateway
+type number struct {
+	id       string
+	provider provider
+	poller   *poller
+
+	mu          sync.RWMutex
+	phoneNumber *sms.PhoneNumber
+	// earlier are the messages received before the order was reused, which
+	// the poller forgets
+	earlier []string
+}
+
+func (n *number) get() *sms.PhoneNumber {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	return n.phoneNumber
+}
+
+// reuse replaces the phone number with the one reusing it, the messages
+// received so far are kept when it is the same order
+func (n *number) reuse(phoneNumber *sms.PhoneNumber, received []string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	previous, next := n.phoneNumber.Rental(), phoneNumber.Rental()
+	if previous.Provider == next.Provider && previous.ID == next.ID {
+		n.earlier = append(n.earlier, received...)
+	} else {
+		n.earlier = nil
+	}
+
+	n.phoneNumber = phoneNumber
+}
+
+// messages returns the messages received before the order was reused
+// followed by received
+func (n *number) messages(received []string) []string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	if len(n.earlier) == 0 {
+		return received
+	}
+
+	return append(append([]string(nil), n.earlier...), received...)
+}
+
+// done reports whether n can no longer receive messages, numbers without an
+// expiry are considered done maxAge after they were rented
+func (n *number) done(now time.Time) bool {
+	phoneNumber := n.get()
+	switch {
+	case phoneNumber.Cancelled(), phoneNumber.Expired():
+		return true
+	case phoneNumber.ExpiresAt().IsZero():
+		return now.Sub(phoneNumber.RentedAt()) > maxAge
+	default:
+		return false
+	}
+}
+
+type server struct {
+	providers    []provider
+	token        string
+	pollInterval time.Duration
+	failover     *failover
+	activate     *smsactivate.Server
+	// store records rented numbers when set
+	store sms.Store
+
+	mu      sync.RWMutex
+	numbers map[string]*number
+}
+
+func newServer(configured []provider, token string, pollInterval time.Duration) *server {
+	failover := newFailover(configured)
+	return &server{
+		providers:    configured,
+		token:        token,
+		pollInterval: pollInterval,
+		failover:     failover,
+		activate:     smsactivate.NewServer(failover, token),
+		numbers:      map[string]*number{},
+	}
+}
+
+const (
+	// maxWait bounds long-polls so they finish before common proxy timeouts
+	maxWait           = 2 * time.Minute
+	keepAliveInterval = 15 * time.Second
+	// maxAge is how long numbers without an expiry are tracked
+	maxAge = 24 * time.Hour
+
+	activatePath = "/stubs/handler_api.php"
+)
+
+type httpError struct {
+	status int
+	err    error
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *httpError) Unwrap() error {
+	return e.err
+}
+
+func errorStatus(err error) int {
+	var httpErr *httpError
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr.status
+	case errors.Is(err, sms.ErrRatelimited):
+		return http.StatusTooManyRequests
+	case errors.Is(err, sms.ErrExpired):
+		return http.StatusGone
+	case errors.Is(err, sms.ErrInvalidState):
+		return http.StatusConflict
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("writing response: %s", err)
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, errorStatus(err), errorResponse{Error: err.Error()})
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// sms-activate clients authenticate with the token as their api_key
+	if r.URL.Path == activatePath {
+		s.activate.ServeHTTP(w, r)
+		return
+	}
+
+	if s.token != "" {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			writeError(w, &httpError{http.StatusUnauthorized, errors.New("invalid token")})
+			return
+		}
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "balance":
+		s.method(w, r, http.MethodGet, s.handleBalance)
+	case len(parts) == 1 && parts[0] == "numbers":
+		s.method(w, r, http.MethodPost, s.handleRent)
+	case len(parts) >= 2 && parts[0] == "numbers":
+		n, err := s.number(parts[1])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		s.route(w, r, n, parts[2:])
+	default:
+		writeError(w, &httpError{http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path)})
+	}
+}
+
+func (s *server) route(w http.ResponseWriter, r *http.Request, n *number, parts []string) {
+	if len(parts) == 0 {
+		s.method(w, r, http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, newNumberResponse(n))
+		})
+		return
+	}
+
+	if len(parts) > 1 {
+		writeError(w, &httpError{http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path)})
+		return
+	}
+
+	switch parts[0] {
+	case "messages":
+		s.method(w, r, http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			s.handleMessages(w, r, n)
+		})
+	case "events":
+		s.method(w, r, http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			s.handleEvents(w, r, n)
+		})
+	case "cancel":
+		s.method(w, r, http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+			s.handleClose(w, r, n, n.provider.client.CancelPhoneNumber, sms.StatusCancelled)
+		})
+	case "report":
+		s.method(w, r, http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+			s.handleClose(w, r, n, n.provider.client.ReportPhoneNumber, sms.StatusReported)
+		})
+	case "reuse":
+		s.method(w, r, http.MethodPost, func(w http.ResponseWriter, r *http.Request) {
+			s.handleReuse(w, r, n)
+		})
+	default:
+		writeError(w, &httpError{http.StatusNotFound, fmt.Errorf("no route for %s", r.URL.Path)})
+	}
+}
+
+func (s *server) method(w http.ResponseWriter, r *http.Request, method string, handler http.HandlerFunc) {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, &httpError{http.StatusMethodNotAllowed, fmt.Errorf("%s %s not allowed", r.Method, r.URL.Path)})
+		return
+	}
+
+	handler(w, r)
+}
+
+func (s *server) number(id string) (*number, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n, ok := s.numbers[id]
+	if !ok {
+		return nil, &httpError{http.StatusNotFound, fmt.Errorf("no number %q", id)}
+	}
+
+	return n, nil
+}
+
+// idLabel is the record label holding a number's gateway ID
+const idLabel = "gateway_id"
+
+// restore adds store's numbers that are still waiting for messages and whose
+// provider is configured, every rented number is recorded in store from now on
+func (s *server) restore(ctx context.Context, store sms.Store) (int, error) {
+	s.store = store
+
+	records, err := store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var restored int
+	for _, record := range records {
+		id := record.Labels[idLabel]
+		if id == "" || record.Cancelled || record.Status.Done() {
+			continue
+		}
+
+		var p provider
+		for _, configured := range s.providers {
+			if configured.name == record.Provider {
+				p = configured
+				break
+			}
+		}
+
+		restorable, ok := p.client.(sms.RestorableClient)
+		if !ok {
+			continue
+		}
+
+		phoneNumber, err := restorable.RestorePhoneNumber(ctx, record.Rental)
+		if err != nil {
+			log.Printf("restoring %s (%s): %s", id, record.Number, err)
+			continue
+		}
+
+		n := s.add(id, p, phoneNumber)
+		n.poller.restore(record.Messages)
+		restored++
+	}
+
+	return restored, nil
+}
+
+// add starts tracking a rented number, forgetting numbers that are done
+func (s *server) add(id string, p provider, phoneNumber *sms.PhoneNumber) *number {
+	n := &number{id: id, provider: p, phoneNumber: phoneNumber}
+	n.poller = newPoller(n, s.pollInterval, func(messages []string) {
+		s.save(n, sms.StatusReceived, messages)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, tracked := range s.numbers {
+		if tracked.done(now) {
+			delete(s.numbers, id)
+		}
+	}
+
+	s.numbers[n.id] = n
+	return n
+}
+
+// remove stops tracking n
+func (s *server) remove(n *number) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.numbers[n.id] == n {
+		delete(s.numbers, n.id)
+	}
+}
+
+// save records n in the store, if any, failing to only logs as the number is
+// rented regardless
+func (s *server) save(n *number, status sms.Status, messages []string) {
+	if s.store == nil {
+		return
+	}
+
+	record := sms.Record{
+		Rental:   n.get().Rental(),
+		Status:   status,
+		Messages: n.messages(messages),
+		Labels:   map[string]string{idLabel: n.id},
+	}
+
+	if err := s.store.Save(context.Background(), record); err != nil {
+		log.Printf("recording %s: %s", n.id, err)
+	}
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+type numberResponse struct {
+	ID     string     `json:"id"`
+	Rental sms.Rental `json:"rental"`
+}
+
+func newNumberResponse(n *number) numberResponse {
+	return numberResponse{ID: n.id, Rental: n.get().Rental()}
+}
+
+// rentRequest's Service and Country are resolved in the catalogs of providers
+// that have them, so names and alpha-2 codes work across providers
+type rentRequest struct {
+	Service string `json:"service"`
+	Country string `json:"country"`
+	// Providers overrides the configured preference order
+	Providers []string `json:"providers"`
+	// Services overrides Service for individual providers, whose service IDs differ
+	Services map[string]string `json:"services"`
+}
+
+// candidates are the providers to try for req, in order
+func (s *server) candidates(req rentRequest) ([]provider, error) {
+	if len(req.Providers) == 0 {
+		return s.providers, nil
+	}
+
+	candidates := make([]provider, 0, len(req.Providers))
+	for _, name := range req.Providers {
+		var found bool
+		for _, p := range s.providers {
+			if p.name == name {
+				candidates = append(candidates, p)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, &httpError{http.StatusBadRequest, fmt.Errorf("provider %q is not configured", name)}
+		}
+	}
+
+	return candidates, nil
+}
+
+func (s *server) handleRent(w http.ResponseWriter, r *http.Request) {
+	var req rentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, &httpError{http.StatusBadRequest, fmt.Errorf("decoding request: %w", err)})
+		return
+	}
+
+	candidates, err := s.candidates(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var errs []string
+	for _, p := range candidates {
+		service := req.Service
+		if override, ok := req.Services[p.name]; ok {
+			service = override
+		}
+
+		if service == "" {
+			errs = append(errs, fmt.Sprintf("%s: no service", p.name))
+			continue
+		}
+
+		phoneNumber, err := p.client.GetPhoneNumber(r.Context(), p.adapter.ServiceID(service), p.adapter.CountryID(req.Country))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", p.name, err))
+			continue
+		}
+
+		n := s.add(newID(), p, phoneNumber)
+		s.save(n, sms.StatusWaiting, nil)
+
+		writeJSON(w, http.StatusCreated, newNumberResponse(n))
+		return
+	}
+
+	writeError(w, &httpError{http.StatusServiceUnavailable, fmt.Errorf("no provider could rent a number: %s", strings.Join(errs, "; "))})
+}
+
+type messagesResponse struct {
+	ID       string   `json:"id"`
+	Messages []string `json:"messages"`
+	// Next is the after value for the next long-poll, only set when long-polling
+	Next int `json:"next,omitempty"`
+}
+
+// handleMessages long-polls the shared poller when wait is set, returning the
+// messages after the first after ones, otherwise it queries the provider directly
+func (s *server) handleMessages(w http.ResponseWriter, r *http.Request, n *number) {
+	query := r.URL.Query()
+	if query.Get("wait") == "" {
+		messages, err := n.provider.client.GetMessages(r.Context(), n.get())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, messagesResponse{ID: n.id, Messages: messages})
+		return
+	}
+
+	wait, err := time.ParseDuration(query.Get("wait"))
+	if err != nil || wait <= 0 || wait > maxWait {
+		writeError(w, &httpError{http.StatusBadRequest, fmt.Errorf("wait must be a duration up to %s", maxWait)})
+		return
+	}
+
+	var after int
+	if query.Get("after") != "" {
+		if after, err = strconv.Atoi(query.Get("after")); err != nil || after < 0 {
+			writeError(w, &httpError{http.StatusBadRequest, errors.New("after must be a non-negative integer")})
+			return
+		}
+	}
+
+	unsubscribe := n.poller.subscribe()
+	defer unsubscribe()
+
+	ctx, cancel := context.WithTimeout(r.Context(), wait)
+	defer cancel()
+
+	messages, err := n.poller.wait(ctx, after)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		writeError(w, err)
+		return
+	}
+
+	if messages == nil {
+		messages = []string{}
+	}
+
+	writeJSON(w, http.StatusOK, messagesResponse{ID: n.id, Messages: messages, Next: after + len(messages)})
+}
+
+type messageEvent struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// handleEvents streams messages as server-sent events, clients reconnecting
+// with Last-Event-ID only receive messages they have not seen
+func (s *server) handleEvents(w http.ResponseWriter, r *http.Request, n *number) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, &httpError{http.StatusInternalServerError, errors.New("streaming is not supported")})
+		return
+	}
+
+	var after int
+	if id, err := strconv.Atoi(r.Header.Get("Last-Event-ID")); err == nil && id >= 0 {
+		after = id + 1
+	}
+
+	unsubscribe := n.poller.subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		ctx, cancel := context.WithTimeout(r.Context(), keepAliveInterval)
+		messages, err := n.poller.wait(ctx, after)
+		cancel()
+
+		switch {
+		case r.Context().Err() != nil:
+			return
+		case errors.Is(err, context.DeadlineExceeded):
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case err != nil:
+			data, _ := json.Marshal(errorResponse{Error: err.Error()})
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+			flusher.Flush()
+			return
+		}
+
+		for _, message := range messages {
+			data, _ := json.Marshal(messageEvent{Index: after, Message: message})
+			fmt.Fprintf(w, "id: %d\nevent: message\ndata: %s\n\n", after, data)
+			after++
+		}
+
+		flusher.Flush()
+	}
+}
+
+func (s *server) handleClose(w http.ResponseWriter, r *http.Request, n *number, close func(context.Context, *sms.PhoneNumber) error, status sms.Status) {
+	if err := close(r.Context(), n.get()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.save(n, status, n.poller.received())
+	s.remove(n)
+
+	writeJSON(w, http.StatusOK, newNumberResponse(n))
+}
+
+func (s *server) handleReuse(w http.ResponseWriter, r *http.Request, n *number) {
+	reusable, ok := n.provider.client.(sms.ReusableClient)
+	if !ok {
+		writeError(w, &httpError{http.StatusNotImplemented, fmt.Errorf("%s does not support reusing numbers", n.provider.name)})
+		return
+	}
+
+	phoneNumber, err := reusable.ReusePhoneNumber(r.Context(), n.get())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// some providers rent a new order for the same number, which starts
+	// without messages
+	n.reuse(phoneNumber, n.poller.received())
+	n.poller.reset()
+	s.save(n, sms.StatusWaiting, nil)
+
+	writeJSON(w, http.StatusOK, newNumberResponse(n))
+}
+
+func (s *server) handleBalance(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.failover.Balances(r.Context(), r.URL.Query().Get("provider")))
+}
@@ -0,0 +1,63 @@
+//go:build live
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/saucesteals/sms/internal/providers"
+)
+
+func fetch(ctx context.Context, name string) (*catalog, error) {
+	provider, err := providers.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if provider.Services == nil {
+		return nil, errors.New("cannot list services live, edit catalog.json instead")
+	}
+
+	apiKey := os.Getenv("SMS_" + strings.ToUpper(name) + "_APIKEY")
+	if apiKey == "" {
+		apiKey = os.Getenv("SMS_APIKEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("no api key, set $SMS_%s_APIKEY", strings.ToUpper(name))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := provider.New(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := provider.Services(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &catalog{}
+	for _, s := range services {
+		c.Services = append(c.Services, entry{ID: s.ID, Name: s.Name})
+	}
+
+	if provider.Countries != nil {
+		countries, err := provider.Countries(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, country := range countries {
+			c.Countries = append(c.Countries, entry{ID: country.ID, Name: country.Name, Alpha2: country.Alpha2, DialCode: country.DialCode})
+		}
+	}
+
+	return c, nil
+}
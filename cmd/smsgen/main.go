@@ -0,0 +1,337 @@
+// Command smsgen regenerates the providers' services.go catalogs.
+//
+//	smsgen [-live] [-dir dir] [provider...]
+//
+// By default catalogs are generated from the catalog.json snapshot checked in
+// next to each services.go, so generating works offline and is reproducible.
+// With -live the catalog is fetched from the provider's API first, with the api
+// key in $SMS_<PROVIDER>_APIKEY or $SMS_APIKEY, and the snapshot is updated.
+// Fetching uses the provider packages, which use the catalogs generated from
+// the snapshots, so it is only built with the live tag:
+//
+//	go run -tags live ./cmd/smsgen -live -dir smspool smspool
+//
+// Generating from the snapshots never compiles the packages it generates, so
+// it works even when a services.go is missing or broken.
+//
+// Every identifier generated is recorded in catalog.lock, also next to
+// services.go. Identifiers keep the value they are locked to where possible,
+// and those that would disappear because the provider renamed or removed
+// what they name are still generated as deprecated constants. Added, removed
+// and renamed identifiers are reported after generating.
+//
+// Constants are typed ServiceID<Name> and CountryID<Name>, along with the
+// untyped Service<Name> and Country<Name> they replace, kept as deprecated
+// aliases so code passing them as strings still builds.
+//
+// Without providers every catalog is regenerated, each in the package
+// directory named after its provider under -dir. Providers use
+//
+//	//go:generate go run ../cmd/smsgen -dir . <provider>
+//
+// where -dir is the package directory itself.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/internal/gen"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// target is a provider with a generated catalog, identifier turns a service
+// name into the ServiceID<identifier> constant name
+type target struct {
+	name       string
+	identifier func(name string) string
+}
+
+var title = cases.Title(language.AmericanEnglish)
+
+// upper capitalizes the first letter of each space separated word, keeping
+// acronyms and letters following digits as they are
+func upper(name string) string {
+	words := strings.Split(name, " ")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+var accents = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// fold strips accents, which identifiers cannot keep
+func fold(name string) string {
+	folded, _, err := transform.String(accents, name)
+	if err != nil {
+		return name
+	}
+
+	return folded
+}
+
+var targets = []target{
+	{name: "daisysms", identifier: func(name string) string { return gen.Normalize(upper(name)) }},
+	{name: "fivesim", identifier: func(name string) string { return gen.Normalize(upper(name)) }},
+	{name: "getatext", identifier: func(name string) string { return gen.Normalize(upper(name)) }},
+	{name: "smsman", identifier: func(name string) string { return gen.Normalize(upper(name)) }},
+	{name: "smspool", identifier: gen.Normalize},
+	{name: "smspva", identifier: func(name string) string { return title.String(gen.Normalize(name)) }},
+	{name: "textverified", identifier: gen.Normalize},
+	{name: "truverifi", identifier: func(name string) string { return title.String(strings.ToLower(gen.Normalize(name))) }},
+}
+
+type entry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Alpha2 and DialCode are only set on countries, DialCode is checked
+	// against Alpha2's when both are set
+	Alpha2   string `json:"alpha2,omitempty"`
+	DialCode int    `json:"dial_code,omitempty"`
+}
+
+// catalog is the snapshot format
+type catalog struct {
+	Services  []entry `json:"services"`
+	Countries []entry `json:"countries,omitempty"`
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("smsgen: ")
+
+	live := flag.Bool("live", false, "fetch catalogs from the providers' APIs and update the snapshots")
+	dir := flag.String("dir", ".", "directory holding the provider packages, or the package itself when generating one provider")
+	flag.Parse()
+
+	selected, err := selectTargets(flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for _, t := range selected {
+		pkgDir := *dir
+		if flag.NArg() == 0 {
+			pkgDir = filepath.Join(*dir, t.name)
+		}
+
+		if err := run(ctx, t, pkgDir, *live); err != nil {
+			log.Fatalf("%s: %s", t.name, err)
+		}
+	}
+}
+
+func selectTargets(names []string) ([]target, error) {
+	if len(names) == 0 {
+		return targets, nil
+	}
+
+	var selected []target
+	for _, name := range names {
+		found := false
+		for _, t := range targets {
+			if t.name == name {
+				selected = append(selected, t)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("no catalog for provider %q", name)
+		}
+	}
+
+	return selected, nil
+}
+
+func run(ctx context.Context, t target, dir string, live bool) error {
+	snapshot := filepath.Join(dir, "catalog.json")
+	lockPath := filepath.Join(dir, "catalog.lock")
+
+	var (
+		c   *catalog
+		err error
+	)
+	if live {
+		c, err = fetch(ctx, t.name)
+		if err != nil {
+			return err
+		}
+
+		if err := writeSnapshot(snapshot, c); err != nil {
+			return err
+		}
+	} else {
+		c, err = readSnapshot(snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	data := gen.Data{Package: t.name}
+	for _, s := range c.Services {
+		data.Services = append(data.Services, gen.Service{Name: t.identifier(s.Name), Value: s.ID, DisplayName: s.Name})
+	}
+	regions := regionNames()
+	for _, country := range c.Countries {
+		alpha2 := country.Alpha2
+		if alpha2 == "" {
+			alpha2 = regions[sms.NormalizeName(country.Name)]
+			if alpha2 == "" {
+				log.Printf("%s: no alpha-2 code for country %q (%s), set it in %s", t.name, country.Name, country.ID, snapshot)
+			}
+		}
+
+		data.Countries = append(data.Countries, gen.Country{
+			Entry:    gen.Entry{Name: gen.Normalize(upper(fold(country.Name))), Value: country.ID, DisplayName: country.Name},
+			Alpha2:   alpha2,
+			DialCode: country.DialCode,
+		})
+	}
+
+	data.Lock, err = readLock(lockPath)
+	if err != nil {
+		return err
+	}
+
+	res, err := gen.Generate(filepath.Join(dir, "services.go"), data)
+	if err != nil {
+		return err
+	}
+
+	if err := writeJSON(lockPath, res.Lock); err != nil {
+		return err
+	}
+
+	if data.Lock == nil {
+		log.Printf("%s: locked %d identifiers in %s", t.name, len(res.Lock.Services)+len(res.Lock.Countries), lockPath)
+		return nil
+	}
+
+	report(t.name, res)
+
+	return nil
+}
+
+// report logs what changed since the previous generation
+func report(name string, res *gen.Result) {
+	if len(res.Added)+len(res.Removed)+len(res.Renamed)+len(res.Changed) == 0 {
+		return
+	}
+
+	log.Printf("%s: %d added, %d removed, %d renamed, %d changed", name, len(res.Added), len(res.Removed), len(res.Renamed), len(res.Changed))
+	for _, c := range res.Added {
+		log.Printf("  + %s (%s)", c.Ident, c.Value)
+	}
+	for _, c := range res.Removed {
+		log.Printf("  - %s (%s), deprecated", c.Ident, c.Value)
+	}
+	for _, c := range res.Renamed {
+		log.Printf("  ~ %s (%s) is now %s, deprecated", c.Ident, c.Value, c.To)
+	}
+	for _, c := range res.Changed {
+		log.Printf("  ! %s is now %s", c.Ident, c.Value)
+	}
+}
+
+// regionNames maps the normalized English names of the regions phonenumbers
+// knows to their alpha-2 codes, for countries the provider lists by name only
+func regionNames() map[string]string {
+	names := map[string]string{}
+	for alpha2 := range phonenumbers.GetSupportedRegions() {
+		region, err := language.ParseRegion(alpha2)
+		if err != nil {
+			continue
+		}
+
+		names[sms.NormalizeName(display.English.Regions().Name(region))] = alpha2
+	}
+
+	return names
+}
+
+// readLock returns nil when there is no lock yet
+func readLock(path string) (*gen.Lock, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock gen.Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return &lock, nil
+}
+
+func readSnapshot(path string) (*catalog, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no %s, fetch it with -live", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var c catalog
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	sortEntries(c.Services)
+	sortEntries(c.Countries)
+
+	return &c, nil
+}
+
+func writeSnapshot(path string, c *catalog) error {
+	sortEntries(c.Services)
+	sortEntries(c.Countries)
+
+	return writeJSON(path, c)
+}
+
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// sortEntries orders numeric IDs numerically before the others
+func sortEntries(entries []entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].ID != entries[j].ID {
+			return gen.LessValue(entries[i].ID, entries[j].ID)
+		}
+		return entries[i].Name < entries[j].Name
+	})
+}
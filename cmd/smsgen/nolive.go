@@ -0,0 +1,14 @@
+//go:build !live
+
+package main
+
+import (
+	"context"
+	"errors"
+)
+
+// fetch is only built with the live tag, as the provider packages it uses
+// depend on the catalogs this command generates
+func fetch(context.Context, string) (*catalog, error) {
+	return nil, errors.New("fetching catalogs needs the live build tag, run go run -tags live ./cmd/smsgen -live")
+}
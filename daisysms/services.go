@@ -0,0 +1,171 @@
+// Code generated by saucesteals/sms; DO NOT EDIT.
+
+package daisysms
+
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceIDAirbnb    ServiceID = "uk"
+	ServiceIDAmazon    ServiceID = "am"
+	ServiceIDAOL       ServiceID = "pm"
+	ServiceIDApple     ServiceID = "wx"
+	ServiceIDBlizzard  ServiceID = "bz"
+	ServiceIDCoinbase  ServiceID = "re"
+	ServiceIDDiscord   ServiceID = "ds"
+	ServiceIDEBay      ServiceID = "dh"
+	ServiceIDFacebook  ServiceID = "fb"
+	ServiceIDGoogle    ServiceID = "go"
+	ServiceIDInstagram ServiceID = "ig"
+	ServiceIDKakaoTalk ServiceID = "kt"
+	ServiceIDLine      ServiceID = "me"
+	ServiceIDLinkedIn  ServiceID = "tn"
+	ServiceIDMicrosoft ServiceID = "mm"
+	ServiceIDNetflix   ServiceID = "nf"
+	ServiceIDNike      ServiceID = "ew"
+	ServiceIDOpenAI    ServiceID = "dr"
+	ServiceIDOther     ServiceID = "ot"
+	ServiceIDPayPal    ServiceID = "ts"
+	ServiceIDSnapchat  ServiceID = "fu"
+	ServiceIDSteam     ServiceID = "mt"
+	ServiceIDTelegram  ServiceID = "tg"
+	ServiceIDTikTok    ServiceID = "lf"
+	ServiceIDTinder    ServiceID = "oi"
+	ServiceIDTwitter   ServiceID = "tw"
+	ServiceIDUber      ServiceID = "ub"
+	ServiceIDViber     ServiceID = "vi"
+	ServiceIDWeChat    ServiceID = "wb"
+	ServiceIDWhatsApp  ServiceID = "wa"
+	ServiceIDYahoo     ServiceID = "mb"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceIDAirbnb instead.
+	ServiceAirbnb = "uk"
+	// Deprecated: use ServiceIDAmazon instead.
+	ServiceAmazon = "am"
+	// Deprecated: use ServiceIDAOL instead.
+	ServiceAOL = "pm"
+	// Deprecated: use ServiceIDApple instead.
+	ServiceApple = "wx"
+	// Deprecated: use ServiceIDBlizzard instead.
+	ServiceBlizzard = "bz"
+	// Deprecated: use ServiceIDCoinbase instead.
+	ServiceCoinbase = "re"
+	// Deprecated: use ServiceIDDiscord instead.
+	ServiceDiscord = "ds"
+	// Deprecated: use ServiceIDEBay instead.
+	ServiceEBay = "dh"
+	// Deprecated: use ServiceIDFacebook instead.
+	ServiceFacebook = "fb"
+	// Deprecated: use ServiceIDGoogle instead.
+	ServiceGoogle = "go"
+	// Deprecated: use ServiceIDInstagram instead.
+	ServiceInstagram = "ig"
+	// Deprecated: use ServiceIDKakaoTalk instead.
+	ServiceKakaoTalk = "kt"
+	// Deprecated: use ServiceIDLine instead.
+	ServiceLine = "me"
+	// Deprecated: use ServiceIDLinkedIn instead.
+	ServiceLinkedIn = "tn"
+	// Deprecated: use ServiceIDMicrosoft instead.
+	ServiceMicrosoft = "mm"
+	// Deprecated: use ServiceIDNetflix instead.
+	ServiceNetflix = "nf"
+	// Deprecated: use ServiceIDNike instead.
+	ServiceNike = "ew"
+	// Deprecated: use ServiceIDOpenAI instead.
+	ServiceOpenAI = "dr"
+	// Deprecated: use ServiceIDOther instead.
+	ServiceOther = "ot"
+	// Deprecated: use ServiceIDPayPal instead.
+	ServicePayPal = "ts"
+	// Deprecated: use ServiceIDSnapchat instead.
+	ServiceSnapchat = "fu"
+	// Deprecated: use ServiceIDSteam instead.
+	ServiceSteam = "mt"
+	// Deprecated: use ServiceIDTelegram instead.
+	ServiceTelegram = "tg"
+	// Deprecated: use ServiceIDTikTok instead.
+	ServiceTikTok = "lf"
+	// Deprecated: use ServiceIDTinder instead.
+	ServiceTinder = "oi"
+	// Deprecated: use ServiceIDTwitter instead.
+	ServiceTwitter = "tw"
+	// Deprecated: use ServiceIDUber instead.
+	ServiceUber = "ub"
+	// Deprecated: use ServiceIDViber instead.
+	ServiceViber = "vi"
+	// Deprecated: use ServiceIDWeChat instead.
+	ServiceWeChat = "wb"
+	// Deprecated: use ServiceIDWhatsApp instead.
+	ServiceWhatsApp = "wa"
+	// Deprecated: use ServiceIDYahoo instead.
+	ServiceYahoo = "mb"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "am", Name: "Amazon", NormalizedName: "amazon"},
+	{ID: "bz", Name: "Blizzard", NormalizedName: "blizzard"},
+	{ID: "dh", Name: "eBay", NormalizedName: "ebay"},
+	{ID: "dr", Name: "OpenAI", NormalizedName: "openai"},
+	{ID: "ds", Name: "Discord", NormalizedName: "discord"},
+	{ID: "ew", Name: "Nike", NormalizedName: "nike"},
+	{ID: "fb", Name: "Facebook", NormalizedName: "facebook"},
+	{ID: "fu", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "go", Name: "Google", NormalizedName: "google"},
+	{ID: "ig", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "kt", Name: "KakaoTalk", NormalizedName: "kakaotalk"},
+	{ID: "lf", Name: "TikTok", NormalizedName: "tiktok"},
+	{ID: "mb", Name: "Yahoo", NormalizedName: "yahoo"},
+	{ID: "me", Name: "Line", NormalizedName: "line"},
+	{ID: "mm", Name: "Microsoft", NormalizedName: "microsoft"},
+	{ID: "mt", Name: "Steam", NormalizedName: "steam"},
+	{ID: "nf", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "oi", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "ot", Name: "Other", NormalizedName: "other"},
+	{ID: "pm", Name: "AOL", NormalizedName: "aol"},
+	{ID: "re", Name: "Coinbase", NormalizedName: "coinbase"},
+	{ID: "tg", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "tn", Name: "LinkedIn", NormalizedName: "linkedin"},
+	{ID: "ts", Name: "PayPal", NormalizedName: "paypal"},
+	{ID: "tw", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "ub", Name: "Uber", NormalizedName: "uber"},
+	{ID: "uk", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "vi", Name: "Viber", NormalizedName: "viber"},
+	{ID: "wa", Name: "WhatsApp", NormalizedName: "whatsapp"},
+	{ID: "wb", Name: "WeChat", NormalizedName: "wechat"},
+	{ID: "wx", Name: "Apple", NormalizedName: "apple"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDUnitedStates CountryID = "187"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDUnitedStates instead.
+	CountryUnitedStates = "187"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "187", Alpha2: "US", Name: "United States", DialCode: 1},
+}
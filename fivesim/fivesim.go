@@ -0,0 +1,656 @@
+package fivesim
+
+//go:generate go run ../cmd/smsgen -dir . fivesim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+var (
+	ErrVerificationExpired = fmt.Errorf("fivesim: order timed out: %w", sms.ErrExpired)
+	ErrCancelled           = errors.New("fivesim: order was cancelled")
+	ErrBanned              = errors.New("fivesim: number was banned")
+
+	ErrUnauthorized  = errors.New("fivesim: unauthorized")
+	ErrNoNumbers     = errors.New("fivesim: no free phones")
+	ErrNoBalance     = errors.New("fivesim: not enough user balance")
+	ErrOrderNotFound = errors.New("fivesim: order not found")
+)
+
+const (
+	provider = "fivesim"
+	baseURL  = "https://5sim.net/v1/"
+
+	// OperatorAny lets 5sim pick the operator, as does an empty operator
+	OperatorAny = "any"
+	// CountryAny lets 5sim pick the country, as does an empty country
+	CountryAny = "any"
+)
+
+// order statuses
+const (
+	statusPending  = "PENDING"
+	statusReceived = "RECEIVED"
+	statusCanceled = "CANCELED"
+	statusTimeout  = "TIMEOUT"
+	statusFinished = "FINISHED"
+	statusBanned   = "BANNED"
+)
+
+type Client struct {
+	http    *http.Client
+	apiKey  string
+	baseURL string
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.ActiveClient     = &Client{}
+	_ sms.ExtendableClient = &Client{}
+)
+
+type metadata struct {
+	id int64
+}
+
+func NewClient(apiKey string) *Client {
+	return &Client{
+		http:    http.DefaultClient,
+		apiKey:  apiKey,
+		baseURL: baseURL,
+	}
+}
+
+func (c *Client) do(ctx context.Context, path string, query url.Values, response any) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return sms.ErrRatelimited
+	case resp.StatusCode == http.StatusNotFound:
+		if strings.Contains(string(body), "order not found") {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("fivesim: %d %s", resp.StatusCode, resp.Status)
+	case resp.StatusCode > 299:
+		return fmt.Errorf("fivesim: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	// 5sim answers errors with plain text and a 200
+	if len(body) > 0 && body[0] != '{' && body[0] != '[' {
+		return textError(strings.TrimSpace(string(body)))
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	return json.Unmarshal(body, response)
+}
+
+func textError(message string) error {
+	switch message {
+	case "no free phones":
+		return ErrNoNumbers
+	case "not enough user balance":
+		return ErrNoBalance
+	case "order not found":
+		return ErrOrderNotFound
+	default:
+		return fmt.Errorf("fivesim: %s", message)
+	}
+}
+
+// SMS is a message received by an order
+type SMS struct {
+	CreatedAt time.Time `json:"created_at"`
+	Date      time.Time `json:"date"`
+	Sender    string    `json:"sender"`
+	Text      string    `json:"text"`
+	// Code is the code 5sim extracted from Text, if any
+	Code string `json:"code"`
+}
+
+type order struct {
+	ID        int64     `json:"id"`
+	Phone     string    `json:"phone"`
+	Operator  string    `json:"operator"`
+	Product   string    `json:"product"`
+	Price     float64   `json:"price"`
+	Status    string    `json:"status"`
+	Expires   time.Time `json:"expires"`
+	SMS       []SMS     `json:"sms"`
+	CreatedAt time.Time `json:"created_at"`
+	Country   string    `json:"country"`
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Reuse:            true,
+		Cancel:           true,
+		Report:           true,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+		ListActive:       true,
+		Extend:           true,
+	}
+}
+
+func orDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// GetPhoneNumber buys an activation for product from any operator in country
+func (c *Client) GetPhoneNumber(ctx context.Context, product string, country string) (*sms.PhoneNumber, error) {
+	return c.GetPhoneNumberFromOperator(ctx, product, country, OperatorAny)
+}
+
+// GetPhoneNumberFromOperator buys an activation for product from operator
+// (such as "virtual21") in country
+func (c *Client) GetPhoneNumberFromOperator(ctx context.Context, product string, country string, operator string) (*sms.PhoneNumber, error) {
+	path := fmt.Sprintf("user/buy/activation/%s/%s/%s",
+		url.PathEscape(orDefault(country, CountryAny)),
+		url.PathEscape(orDefault(operator, OperatorAny)),
+		url.PathEscape(product),
+	)
+
+	var res order
+	if err := c.do(ctx, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return newPhoneNumber(res)
+}
+
+func newPhoneNumber(res order) (*sms.PhoneNumber, error) {
+	number, err := phonenumbers.Parse(res.Phone, "")
+	if err != nil {
+		return nil, fmt.Errorf("fivesim: parsing phone number (%s): %w", res.Phone, err)
+	}
+
+	phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       strconv.FormatInt(res.ID, 10),
+		Service:  res.Product,
+		Country:  res.Country,
+		Cost:     res.Price,
+		RentedAt: res.CreatedAt,
+	}, metadata{id: res.ID})
+	phoneNumber.SetExpiresAt(res.Expires)
+
+	return phoneNumber, nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	id, err := strconv.ParseInt(rental.ID, 10, 64)
+	if err != nil {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	return rental.PhoneNumber(metadata{id: id})
+}
+
+func (c *Client) check(ctx context.Context, phoneNumber *sms.PhoneNumber) (*order, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	var res order
+	if err := c.do(ctx, fmt.Sprintf("user/check/%d", metadata.id), nil, &res); err != nil {
+		return nil, err
+	}
+
+	if !res.Expires.IsZero() {
+		phoneNumber.SetExpiresAt(res.Expires)
+	}
+
+	return &res, nil
+}
+
+// GetSMS returns every message the number received, oldest first
+func (c *Client) GetSMS(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]SMS, error) {
+	res, err := c.check(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.Status {
+	case statusTimeout:
+		if len(res.SMS) == 0 {
+			return nil, ErrVerificationExpired
+		}
+	case statusCanceled:
+		return nil, ErrCancelled
+	case statusBanned:
+		return nil, ErrBanned
+	}
+
+	messages := res.SMS
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Date.Before(messages[j].Date)
+	})
+
+	if len(messages) > 0 {
+		phoneNumber.MarkUsed()
+	}
+
+	return messages, nil
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	messages, err := c.GetSMS(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, len(messages))
+	for i, message := range messages {
+		texts[i] = message.Text
+	}
+
+	return texts, nil
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	res, err := c.check(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var status sms.Status
+	switch res.Status {
+	case statusPending:
+		status = sms.StatusWaiting
+		if len(res.SMS) > 0 {
+			status = sms.StatusReceived
+		}
+	case statusReceived:
+		status = sms.StatusReceived
+	case statusTimeout:
+		status = sms.StatusExpired
+	case statusCanceled:
+		status = sms.StatusCancelled
+	case statusBanned:
+		status = sms.StatusReported
+	case statusFinished:
+		status = sms.StatusFinished
+	default:
+		return nil, fmt.Errorf("fivesim: unknown status %q", res.Status)
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
+func (c *Client) setOrderStatus(ctx context.Context, phoneNumber *sms.PhoneNumber, action string) error {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	var res order
+	return c.do(ctx, fmt.Sprintf("user/%s/%d", action, metadata.id), nil, &res)
+}
+
+// CancelPhoneNumber finishes the order once it received a message and cancels
+// it otherwise
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if phoneNumber.Cancelled() {
+		return nil
+	}
+
+	action := "cancel"
+	if phoneNumber.Used() {
+		action = "finish"
+	}
+
+	if err := c.setOrderStatus(ctx, phoneNumber, action); err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+// ReportPhoneNumber bans the number, refunding the order
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if err := c.setOrderStatus(ctx, phoneNumber, "ban"); err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+// ExtendPhoneNumber prolongs the order, which 5sim charges for
+func (c *Client) ExtendPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	var res order
+	if err := c.do(ctx, fmt.Sprintf("user/prolong/%d", metadata.id), nil, &res); err != nil {
+		return err
+	}
+
+	if !res.Expires.IsZero() {
+		phoneNumber.SetExpiresAt(res.Expires)
+	}
+
+	return nil
+}
+
+// ReusePhoneNumber buys a new order on the same number, the returned phone
+// number replaces phoneNumber
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	if _, ok := phoneNumber.Metadata().(metadata); !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	if err := phoneNumber.CanReuse(); err != nil {
+		return nil, err
+	}
+
+	number := strings.TrimPrefix(phoneNumber.Format(phonenumbers.E164), "+")
+
+	var res order
+	if err := c.do(ctx, fmt.Sprintf("user/reuse/%s/%s", url.PathEscape(phoneNumber.Service()), number), nil, &res); err != nil {
+		return nil, err
+	}
+
+	return newPhoneNumber(res)
+}
+
+type ordersResponse struct {
+	Data []order `json:"Data"`
+}
+
+// ordersPage is how many orders ListActive asks for at once
+const ordersPage = 100
+
+// ListActive lists the pending activations and those that received messages
+// but were not finished, newest first. Orders are paged through until a page
+// has no active order, as activations expire within minutes
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	var active []*sms.PhoneNumber
+	for offset := 0; ; offset += ordersPage {
+		var res ordersResponse
+		if err := c.do(ctx, "user/orders", url.Values{
+			"category": {"activation"},
+			"limit":    {strconv.Itoa(ordersPage)},
+			"offset":   {strconv.Itoa(offset)},
+			"order":    {"id"},
+			"reverse":  {"true"},
+		}, &res); err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, o := range res.Data {
+			if o.Status != statusPending && o.Status != statusReceived {
+				continue
+			}
+
+			phoneNumber, err := newPhoneNumber(o)
+			if err != nil {
+				return nil, err
+			}
+			if len(o.SMS) > 0 {
+				phoneNumber.MarkUsed()
+			}
+
+			active = append(active, phoneNumber)
+			found = true
+		}
+
+		if !found || len(res.Data) < ordersPage {
+			return active, nil
+		}
+	}
+}
+
+type Profile struct {
+	ID              int64   `json:"id"`
+	Email           string  `json:"email"`
+	Balance         float64 `json:"balance"`
+	Rating          float64 `json:"rating"`
+	DefaultCountry  string  `json:"-"`
+	DefaultOperator string  `json:"-"`
+}
+
+type profileResponse struct {
+	Profile
+	DefaultCountry struct {
+		Name string `json:"name"`
+	} `json:"default_country"`
+	DefaultOperator struct {
+		Name string `json:"name"`
+	} `json:"default_operator"`
+}
+
+func (c *Client) GetProfile(ctx context.Context) (*Profile, error) {
+	var res profileResponse
+	if err := c.do(ctx, "user/profile", nil, &res); err != nil {
+		return nil, err
+	}
+
+	profile := res.Profile
+	profile.DefaultCountry = res.DefaultCountry.Name
+	profile.DefaultOperator = res.DefaultOperator.Name
+
+	return &profile, nil
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	profile, err := c.GetProfile(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return profile.Balance, nil
+}
+
+// Price is what one operator charges for a product in a country
+type Price struct {
+	Country  string  `json:"country"`
+	Product  string  `json:"product"`
+	Operator string  `json:"operator"`
+	Cost     float64 `json:"cost"`
+	Count    int     `json:"count"`
+	// Rate is the operator's delivery rate in percent, 0 when unknown
+	Rate float64 `json:"rate"`
+}
+
+// GetPrices lists prices, narrowed to product and country when they are not
+// empty, sorted by country, product and cost
+func (c *Client) GetPrices(ctx context.Context, product string, country string) ([]Price, error) {
+	query := url.Values{}
+	if country != "" {
+		query.Set("country", country)
+	}
+	if product != "" {
+		query.Set("product", product)
+	}
+
+	var res map[string]map[string]map[string]struct {
+		Cost  float64 `json:"cost"`
+		Count int     `json:"count"`
+		Rate  float64 `json:"rate"`
+	}
+	if err := c.do(ctx, "guest/prices", query, &res); err != nil {
+		return nil, err
+	}
+
+	var prices []Price
+	for country, products := range res {
+		for product, operators := range products {
+			for operator, p := range operators {
+				prices = append(prices, Price{
+					Country:  country,
+					Product:  product,
+					Operator: operator,
+					Cost:     p.Cost,
+					Count:    p.Count,
+					Rate:     p.Rate,
+				})
+			}
+		}
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		a, b := prices[i], prices[j]
+		if a.Country != b.Country {
+			return a.Country < b.Country
+		}
+		if a.Product != b.Product {
+			return a.Product < b.Product
+		}
+		if a.Cost != b.Cost {
+			return a.Cost < b.Cost
+		}
+		return a.Operator < b.Operator
+	})
+
+	return prices, nil
+}
+
+// Product is a service 5sim sells numbers for
+type Product struct {
+	Name     string  `json:"name"`
+	Category string  `json:"category"`
+	Qty      int     `json:"qty"`
+	Price    float64 `json:"price"`
+}
+
+// GetProducts lists the products available from operator in country, sorted
+// by name
+func (c *Client) GetProducts(ctx context.Context, country string, operator string) ([]Product, error) {
+	path := fmt.Sprintf("guest/products/%s/%s",
+		url.PathEscape(orDefault(country, CountryAny)),
+		url.PathEscape(orDefault(operator, OperatorAny)),
+	)
+
+	var res map[string]struct {
+		Category string  `json:"Category"`
+		Qty      int     `json:"Qty"`
+		Price    float64 `json:"Price"`
+	}
+	if err := c.do(ctx, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	products := make([]Product, 0, len(res))
+	for name, p := range res {
+		products = append(products, Product{Name: name, Category: p.Category, Qty: p.Qty, Price: p.Price})
+	}
+
+	sort.Slice(products, func(i, j int) bool { return products[i].Name < products[j].Name })
+
+	return products, nil
+}
+
+// Country is a country 5sim sells numbers in
+type Country struct {
+	// Name is what the API expects, such as "england"
+	Name string `json:"name"`
+	// Text is the English name, such as "United Kingdom"
+	Text      string   `json:"text"`
+	ISO       []string `json:"iso"`
+	Prefixes  []string `json:"prefixes"`
+	Operators []string `json:"operators"`
+}
+
+func keys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// GetCountries lists every country, sorted by name
+func (c *Client) GetCountries(ctx context.Context) ([]Country, error) {
+	var res map[string]map[string]json.RawMessage
+	if err := c.do(ctx, "guest/countries", nil, &res); err != nil {
+		return nil, err
+	}
+
+	countries := make([]Country, 0, len(res))
+	for name, fields := range res {
+		country := Country{Name: name}
+
+		var iso, prefix map[string]any
+		_ = json.Unmarshal(fields["iso"], &iso)
+		_ = json.Unmarshal(fields["prefix"], &prefix)
+		_ = json.Unmarshal(fields["text_en"], &country.Text)
+		country.ISO = keys(iso)
+		country.Prefixes = keys(prefix)
+
+		// operators are the remaining keys, each an object of products
+		for key, value := range fields {
+			switch key {
+			case "iso", "prefix", "text_en", "text_ru":
+				continue
+			}
+			if len(value) > 0 && value[0] == '{' {
+				country.Operators = append(country.Operators, key)
+			}
+		}
+		sort.Strings(country.Operators)
+
+		countries = append(countries, country)
+	}
+
+	sort.Slice(countries, func(i, j int) bool { return countries[i].Name < countries[j].Name })
+
+	return countries, nil
+}
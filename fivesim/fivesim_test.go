@@ -0,0 +1,302 @@
+package fivesim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("key")
+	c.baseURL = server.URL + "/"
+
+	return c
+}
+
+func testOrder(id int64, status string, expires time.Time) order {
+	return order{
+		ID:        id,
+		Phone:     "+12025550100",
+		Product:   "discord",
+		Price:     0.5,
+		Status:    status,
+		Expires:   expires,
+		CreatedAt: expires.Add(-15 * time.Minute),
+		Country:   "usa",
+	}
+}
+
+func TestExtendPhoneNumber(t *testing.T) {
+	expires := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	prolonged := expires.Add(15 * time.Minute)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/buy/activation/usa/any/discord", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(testOrder(1, statusPending, expires))
+	})
+	mux.HandleFunc("/user/prolong/1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(testOrder(1, statusPending, prolonged))
+	})
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	phoneNumber, err := c.GetPhoneNumber(ctx, "discord", "usa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !phoneNumber.ExpiresAt().Equal(expires) {
+		t.Fatalf("expires at %s, want %s", phoneNumber.ExpiresAt(), expires)
+	}
+
+	if err := c.ExtendPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if !phoneNumber.ExpiresAt().Equal(prolonged) {
+		t.Fatalf("expires at %s after extending, want %s", phoneNumber.ExpiresAt(), prolonged)
+	}
+}
+
+// api is an in-memory 5sim serving the order endpoints
+type api struct {
+	mu     sync.Mutex
+	orders map[int64]*order
+	lastID int64
+	// requests counts the requests per endpoint, such as "user/check"
+	requests map[string]int
+}
+
+func newAPI() *api {
+	return &api{orders: map[int64]*order{}, requests: map[string]int{}}
+}
+
+func (a *api) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer key" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 {
+		http.NotFound(w, r)
+		return
+	}
+	a.requests[parts[0]+"/"+parts[1]]++
+
+	switch {
+	case parts[1] == "buy" && len(parts) == 6:
+		a.lastID++
+		o := testOrder(a.lastID, statusPending, time.Now().Add(15*time.Minute))
+		o.Country, o.Operator, o.Product = parts[3], parts[4], parts[5]
+		a.orders[o.ID] = &o
+		json.NewEncoder(w).Encode(o)
+	case len(parts) == 3:
+		id, _ := strconv.ParseInt(parts[2], 10, 64)
+		o, ok := a.orders[id]
+		if !ok {
+			fmt.Fprint(w, "order not found")
+			return
+		}
+
+		switch parts[1] {
+		case "check":
+		case "finish":
+			o.Status = statusFinished
+		case "cancel":
+			o.Status = statusCanceled
+		case "ban":
+			o.Status = statusBanned
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(o)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// receive delivers text to the order with id
+func (a *api) receive(id int64, text string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	o := a.orders[id]
+	o.Status = statusReceived
+	o.SMS = append(o.SMS, SMS{Date: time.Now(), Text: text})
+}
+
+func (a *api) status(id int64) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.orders[id].Status
+}
+
+func TestOrder(t *testing.T) {
+	a := newAPI()
+	c := newTestClient(t, a)
+	ctx := context.Background()
+
+	phoneNumber, err := c.GetPhoneNumber(ctx, "discord", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rental := phoneNumber.Rental(); rental.ID != "1" || rental.Service != "discord" || rental.Country != CountryAny || rental.Cost != 0.5 {
+		t.Fatalf("rental = %+v, want order 1 for discord in any country", rental)
+	}
+
+	// check
+	messages, err := c.GetMessages(ctx, phoneNumber)
+	if err != nil || len(messages) != 0 {
+		t.Fatalf("GetMessages = %q, %v before any message arrived", messages, err)
+	}
+
+	a.receive(1, "code 123456")
+	messages, err = c.GetMessages(ctx, phoneNumber)
+	if err != nil || len(messages) != 1 || messages[0] != "code 123456" {
+		t.Fatalf("GetMessages = %q, %v, want the message", messages, err)
+	}
+	if !phoneNumber.Used() {
+		t.Fatal("number is not used after receiving a message")
+	}
+
+	status, err := c.GetStatus(ctx, phoneNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != sms.StatusReceived {
+		t.Fatalf("status = %s, want received", status.Status)
+	}
+
+	// used orders are finished
+	if err := c.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if a.status(1) != statusFinished || a.requests["user/cancel"] != 0 {
+		t.Fatalf("order is %s after cancelling it used, want it finished", a.status(1))
+	}
+
+	// unused orders are cancelled
+	phoneNumber, err = c.GetPhoneNumber(ctx, "discord", "usa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if a.status(2) != statusCanceled {
+		t.Fatalf("order is %s after cancelling it unused, want it canceled", a.status(2))
+	}
+	if _, err := c.GetMessages(ctx, phoneNumber); !errors.Is(err, ErrCancelled) {
+		t.Fatalf("err = %v, want ErrCancelled", err)
+	}
+}
+
+func TestOrderNotFound(t *testing.T) {
+	c := newTestClient(t, newAPI())
+
+	phoneNumber, err := c.RestorePhoneNumber(context.Background(), sms.Rental{Order: sms.Order{Provider: provider, ID: "404"}, Number: "+12025550100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetMessages(context.Background(), phoneNumber); !errors.Is(err, ErrOrderNotFound) {
+		t.Fatalf("err = %v, want ErrOrderNotFound", err)
+	}
+}
+
+// orders serves count orders newest first, the first active of which are pending
+func orders(count int, active int, offsets *[]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/user/orders" || query.Get("category") != "activation" || query.Get("reverse") != "true" {
+			http.NotFound(w, r)
+			return
+		}
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		*offsets = append(*offsets, offset)
+
+		res := ordersResponse{Data: []order{}}
+		for i := offset; i < offset+limit && i < count; i++ {
+			status := statusFinished
+			if i < active {
+				status = statusPending
+			}
+			res.Data = append(res.Data, testOrder(int64(count-i), status, time.Now().Add(time.Minute)))
+		}
+
+		json.NewEncoder(w).Encode(res)
+	}
+}
+
+func TestListActivePaging(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		count   int
+		active  int
+		offsets []int
+	}{
+		{name: "short page", count: 50, active: 50, offsets: []int{0}},
+		{name: "pages until one has no active order", count: 350, active: 120, offsets: []int{0, 100, 200}},
+		{name: "last full page", count: 200, active: 200, offsets: []int{0, 100, 200}},
+	} {
+		var offsets []int
+		c := newTestClient(t, orders(test.count, test.active, &offsets))
+
+		active, err := c.ListActive(context.Background())
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(active) != test.active {
+			t.Errorf("%s: listed %d active orders, want %d", test.name, len(active), test.active)
+		}
+		if fmt.Sprint(offsets) != fmt.Sprint(test.offsets) {
+			t.Errorf("%s: requested offsets %v, want %v", test.name, offsets, test.offsets)
+		}
+	}
+}
+
+func TestGetPrices(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/guest/prices" || query.Get("product") != "discord" || query.Get("country") != "usa" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `{"usa": {"discord": {"virtual21": {"cost": 0.3, "count": 10, "rate": 90}, "virtual4": {"cost": 0.2, "count": 5}}}}`)
+	}))
+
+	prices, err := c.GetPrices(context.Background(), "discord", "usa")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Price{
+		{Country: "usa", Product: "discord", Operator: "virtual4", Cost: 0.2, Count: 5},
+		{Country: "usa", Product: "discord", Operator: "virtual21", Cost: 0.3, Count: 10, Rate: 90},
+	}
+	if fmt.Sprint(prices) != fmt.Sprint(want) {
+		t.Fatalf("prices = %+v, want %+v", prices, want)
+	}
+}
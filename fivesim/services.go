@@ -0,0 +1,679 @@
+// Code generated by saucesteals/sms; DO NOT EDIT.
+
+package fivesim
+
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceID1688       ServiceID = "1688"
+	ServiceID1xbet      ServiceID = "1xbet"
+	ServiceID23red      ServiceID = "23red"
+	ServiceIDAirbnb     ServiceID = "airbnb"
+	ServiceIDAliexpress ServiceID = "aliexpress"
+	ServiceIDAlipay     ServiceID = "alipay"
+	ServiceIDAmazon     ServiceID = "amazon"
+	ServiceIDAol        ServiceID = "aol"
+	ServiceIDApple      ServiceID = "apple"
+	ServiceIDAvito      ServiceID = "avito"
+	ServiceIDBadoo      ServiceID = "badoo"
+	ServiceIDBigolive   ServiceID = "bigolive"
+	ServiceIDBitclout   ServiceID = "bitclout"
+	ServiceIDBlizzard   ServiceID = "blizzard"
+	ServiceIDBolt       ServiceID = "bolt"
+	ServiceIDCareem     ServiceID = "careem"
+	ServiceIDCathay     ServiceID = "cathay"
+	ServiceIDChispa     ServiceID = "chispa"
+	ServiceIDClaude     ServiceID = "claude"
+	ServiceIDCoinbase   ServiceID = "coinbase"
+	ServiceIDCraigslist ServiceID = "craigslist"
+	ServiceIDDeliveroo  ServiceID = "deliveroo"
+	ServiceIDDidi       ServiceID = "didi"
+	ServiceIDDiscord    ServiceID = "discord"
+	ServiceIDDosi       ServiceID = "dosi"
+	ServiceIDDrom       ServiceID = "drom"
+	ServiceIDEbay       ServiceID = "ebay"
+	ServiceIDFacebook   ServiceID = "facebook"
+	ServiceIDFiverr     ServiceID = "fiverr"
+	ServiceIDFoodpanda  ServiceID = "foodpanda"
+	ServiceIDGameflip   ServiceID = "gameflip"
+	ServiceIDGett       ServiceID = "gett"
+	ServiceIDGmx        ServiceID = "gmx"
+	ServiceIDGoogle     ServiceID = "google"
+	ServiceIDGrab       ServiceID = "grab"
+	ServiceIDHappn      ServiceID = "happn"
+	ServiceIDHinge      ServiceID = "hinge"
+	ServiceIDIcq        ServiceID = "icq"
+	ServiceIDImo        ServiceID = "imo"
+	ServiceIDInstagram  ServiceID = "instagram"
+	ServiceIDKakaotalk  ServiceID = "kakaotalk"
+	ServiceIDLine       ServiceID = "line"
+	ServiceIDLinkedin   ServiceID = "linkedin"
+	ServiceIDLyft       ServiceID = "lyft"
+	ServiceIDMail       ServiceID = "mail"
+	ServiceIDMailru     ServiceID = "mailru"
+	ServiceIDMamba      ServiceID = "mamba"
+	ServiceIDMeetme     ServiceID = "meetme"
+	ServiceIDMicrosoft  ServiceID = "microsoft"
+	ServiceIDNaver      ServiceID = "naver"
+	ServiceIDNetflix    ServiceID = "netflix"
+	ServiceIDNike       ServiceID = "nike"
+	ServiceIDOfferup    ServiceID = "offerup"
+	ServiceIDOkcupid    ServiceID = "okcupid"
+	ServiceIDOlx        ServiceID = "olx"
+	ServiceIDOpenai     ServiceID = "openai"
+	ServiceIDOther      ServiceID = "other"
+	ServiceIDPaypal     ServiceID = "paypal"
+	ServiceIDPof        ServiceID = "pof"
+	ServiceIDProtonmail ServiceID = "protonmail"
+	ServiceIDQiwiwallet ServiceID = "qiwiwallet"
+	ServiceIDQuipp      ServiceID = "quipp"
+	ServiceIDRambler    ServiceID = "rambler"
+	ServiceIDRevolut    ServiceID = "revolut"
+	ServiceIDShopee     ServiceID = "shopee"
+	ServiceIDSignal     ServiceID = "signal"
+	ServiceIDSkype      ServiceID = "skype"
+	ServiceIDSnapchat   ServiceID = "snapchat"
+	ServiceIDSteam      ServiceID = "steam"
+	ServiceIDTelegram   ServiceID = "telegram"
+	ServiceIDTiktok     ServiceID = "tiktok"
+	ServiceIDTinder     ServiceID = "tinder"
+	ServiceIDTwitch     ServiceID = "twitch"
+	ServiceIDTwitter    ServiceID = "twitter"
+	ServiceIDUber       ServiceID = "uber"
+	ServiceIDViber      ServiceID = "viber"
+	ServiceIDVkontakte  ServiceID = "vkontakte"
+	ServiceIDWechat     ServiceID = "wechat"
+	ServiceIDWeibo      ServiceID = "weibo"
+	ServiceIDWhatsapp   ServiceID = "whatsapp"
+	ServiceIDWise       ServiceID = "wise"
+	ServiceIDYahoo      ServiceID = "yahoo"
+	ServiceIDYandex     ServiceID = "yandex"
+	ServiceIDYoula      ServiceID = "youla"
+	ServiceIDZoho       ServiceID = "zoho"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceID1688 instead.
+	Service1688 = "1688"
+	// Deprecated: use ServiceID1xbet instead.
+	Service1xbet = "1xbet"
+	// Deprecated: use ServiceID23red instead.
+	Service23red = "23red"
+	// Deprecated: use ServiceIDAirbnb instead.
+	ServiceAirbnb = "airbnb"
+	// Deprecated: use ServiceIDAliexpress instead.
+	ServiceAliexpress = "aliexpress"
+	// Deprecated: use ServiceIDAlipay instead.
+	ServiceAlipay = "alipay"
+	// Deprecated: use ServiceIDAmazon instead.
+	ServiceAmazon = "amazon"
+	// Deprecated: use ServiceIDAol instead.
+	ServiceAol = "aol"
+	// Deprecated: use ServiceIDApple instead.
+	ServiceApple = "apple"
+	// Deprecated: use ServiceIDAvito instead.
+	ServiceAvito = "avito"
+	// Deprecated: use ServiceIDBadoo instead.
+	ServiceBadoo = "badoo"
+	// Deprecated: use ServiceIDBigolive instead.
+	ServiceBigolive = "bigolive"
+	// Deprecated: use ServiceIDBitclout instead.
+	ServiceBitclout = "bitclout"
+	// Deprecated: use ServiceIDBlizzard instead.
+	ServiceBlizzard = "blizzard"
+	// Deprecated: use ServiceIDBolt instead.
+	ServiceBolt = "bolt"
+	// Deprecated: use ServiceIDCareem instead.
+	ServiceCareem = "careem"
+	// Deprecated: use ServiceIDCathay instead.
+	ServiceCathay = "cathay"
+	// Deprecated: use ServiceIDChispa instead.
+	ServiceChispa = "chispa"
+	// Deprecated: use ServiceIDClaude instead.
+	ServiceClaude = "claude"
+	// Deprecated: use ServiceIDCoinbase instead.
+	ServiceCoinbase = "coinbase"
+	// Deprecated: use ServiceIDCraigslist instead.
+	ServiceCraigslist = "craigslist"
+	// Deprecated: use ServiceIDDeliveroo instead.
+	ServiceDeliveroo = "deliveroo"
+	// Deprecated: use ServiceIDDidi instead.
+	ServiceDidi = "didi"
+	// Deprecated: use ServiceIDDiscord instead.
+	ServiceDiscord = "discord"
+	// Deprecated: use ServiceIDDosi instead.
+	ServiceDosi = "dosi"
+	// Deprecated: use ServiceIDDrom instead.
+	ServiceDrom = "drom"
+	// Deprecated: use ServiceIDEbay instead.
+	ServiceEbay = "ebay"
+	// Deprecated: use ServiceIDFacebook instead.
+	ServiceFacebook = "facebook"
+	// Deprecated: use ServiceIDFiverr instead.
+	ServiceFiverr = "fiverr"
+	// Deprecated: use ServiceIDFoodpanda instead.
+	ServiceFoodpanda = "foodpanda"
+	// Deprecated: use ServiceIDGameflip instead.
+	ServiceGameflip = "gameflip"
+	// Deprecated: use ServiceIDGett instead.
+	ServiceGett = "gett"
+	// Deprecated: use ServiceIDGmx instead.
+	ServiceGmx = "gmx"
+	// Deprecated: use ServiceIDGoogle instead.
+	ServiceGoogle = "google"
+	// Deprecated: use ServiceIDGrab instead.
+	ServiceGrab = "grab"
+	// Deprecated: use ServiceIDHappn instead.
+	ServiceHappn = "happn"
+	// Deprecated: use ServiceIDHinge instead.
+	ServiceHinge = "hinge"
+	// Deprecated: use ServiceIDIcq instead.
+	ServiceIcq = "icq"
+	// Deprecated: use ServiceIDImo instead.
+	ServiceImo = "imo"
+	// Deprecated: use ServiceIDInstagram instead.
+	ServiceInstagram = "instagram"
+	// Deprecated: use ServiceIDKakaotalk instead.
+	ServiceKakaotalk = "kakaotalk"
+	// Deprecated: use ServiceIDLine instead.
+	ServiceLine = "line"
+	// Deprecated: use ServiceIDLinkedin instead.
+	ServiceLinkedin = "linkedin"
+	// Deprecated: use ServiceIDLyft instead.
+	ServiceLyft = "lyft"
+	// Deprecated: use ServiceIDMail instead.
+	ServiceMail = "mail"
+	// Deprecated: use ServiceIDMailru instead.
+	ServiceMailru = "mailru"
+	// Deprecated: use ServiceIDMamba instead.
+	ServiceMamba = "mamba"
+	// Deprecated: use ServiceIDMeetme instead.
+	ServiceMeetme = "meetme"
+	// Deprecated: use ServiceIDMicrosoft instead.
+	ServiceMicrosoft = "microsoft"
+	// Deprecated: use ServiceIDNaver instead.
+	ServiceNaver = "naver"
+	// Deprecated: use ServiceIDNetflix instead.
+	ServiceNetflix = "netflix"
+	// Deprecated: use ServiceIDNike instead.
+	ServiceNike = "nike"
+	// Deprecated: use ServiceIDOfferup instead.
+	ServiceOfferup = "offerup"
+	// Deprecated: use ServiceIDOkcupid instead.
+	ServiceOkcupid = "okcupid"
+	// Deprecated: use ServiceIDOlx instead.
+	ServiceOlx = "olx"
+	// Deprecated: use ServiceIDOpenai instead.
+	ServiceOpenai = "openai"
+	// Deprecated: use ServiceIDOther instead.
+	ServiceOther = "other"
+	// Deprecated: use ServiceIDPaypal instead.
+	ServicePaypal = "paypal"
+	// Deprecated: use ServiceIDPof instead.
+	ServicePof = "pof"
+	// Deprecated: use ServiceIDProtonmail instead.
+	ServiceProtonmail = "protonmail"
+	// Deprecated: use ServiceIDQiwiwallet instead.
+	ServiceQiwiwallet = "qiwiwallet"
+	// Deprecated: use ServiceIDQuipp instead.
+	ServiceQuipp = "quipp"
+	// Deprecated: use ServiceIDRambler instead.
+	ServiceRambler = "rambler"
+	// Deprecated: use ServiceIDRevolut instead.
+	ServiceRevolut = "revolut"
+	// Deprecated: use ServiceIDShopee instead.
+	ServiceShopee = "shopee"
+	// Deprecated: use ServiceIDSignal instead.
+	ServiceSignal = "signal"
+	// Deprecated: use ServiceIDSkype instead.
+	ServiceSkype = "skype"
+	// Deprecated: use ServiceIDSnapchat instead.
+	ServiceSnapchat = "snapchat"
+	// Deprecated: use ServiceIDSteam instead.
+	ServiceSteam = "steam"
+	// Deprecated: use ServiceIDTelegram instead.
+	ServiceTelegram = "telegram"
+	// Deprecated: use ServiceIDTiktok instead.
+	ServiceTiktok = "tiktok"
+	// Deprecated: use ServiceIDTinder instead.
+	ServiceTinder = "tinder"
+	// Deprecated: use ServiceIDTwitch instead.
+	ServiceTwitch = "twitch"
+	// Deprecated: use ServiceIDTwitter instead.
+	ServiceTwitter = "twitter"
+	// Deprecated: use ServiceIDUber instead.
+	ServiceUber = "uber"
+	// Deprecated: use ServiceIDViber instead.
+	ServiceViber = "viber"
+	// Deprecated: use ServiceIDVkontakte instead.
+	ServiceVkontakte = "vkontakte"
+	// Deprecated: use ServiceIDWechat instead.
+	ServiceWechat = "wechat"
+	// Deprecated: use ServiceIDWeibo instead.
+	ServiceWeibo = "weibo"
+	// Deprecated: use ServiceIDWhatsapp instead.
+	ServiceWhatsapp = "whatsapp"
+	// Deprecated: use ServiceIDWise instead.
+	ServiceWise = "wise"
+	// Deprecated: use ServiceIDYahoo instead.
+	ServiceYahoo = "yahoo"
+	// Deprecated: use ServiceIDYandex instead.
+	ServiceYandex = "yandex"
+	// Deprecated: use ServiceIDYoula instead.
+	ServiceYoula = "youla"
+	// Deprecated: use ServiceIDZoho instead.
+	ServiceZoho = "zoho"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "1688", Name: "1688", NormalizedName: "1688"},
+	{ID: "1xbet", Name: "1xbet", NormalizedName: "1xbet"},
+	{ID: "23red", Name: "23red", NormalizedName: "23red"},
+	{ID: "airbnb", Name: "airbnb", NormalizedName: "airbnb"},
+	{ID: "aliexpress", Name: "aliexpress", NormalizedName: "aliexpress"},
+	{ID: "alipay", Name: "alipay", NormalizedName: "alipay"},
+	{ID: "amazon", Name: "amazon", NormalizedName: "amazon"},
+	{ID: "aol", Name: "aol", NormalizedName: "aol"},
+	{ID: "apple", Name: "apple", NormalizedName: "apple"},
+	{ID: "avito", Name: "avito", NormalizedName: "avito"},
+	{ID: "badoo", Name: "badoo", NormalizedName: "badoo"},
+	{ID: "bigolive", Name: "bigolive", NormalizedName: "bigolive"},
+	{ID: "bitclout", Name: "bitclout", NormalizedName: "bitclout"},
+	{ID: "blizzard", Name: "blizzard", NormalizedName: "blizzard"},
+	{ID: "bolt", Name: "bolt", NormalizedName: "bolt"},
+	{ID: "careem", Name: "careem", NormalizedName: "careem"},
+	{ID: "cathay", Name: "cathay", NormalizedName: "cathay"},
+	{ID: "chispa", Name: "chispa", NormalizedName: "chispa"},
+	{ID: "claude", Name: "claude", NormalizedName: "claude"},
+	{ID: "coinbase", Name: "coinbase", NormalizedName: "coinbase"},
+	{ID: "craigslist", Name: "craigslist", NormalizedName: "craigslist"},
+	{ID: "deliveroo", Name: "deliveroo", NormalizedName: "deliveroo"},
+	{ID: "didi", Name: "didi", NormalizedName: "didi"},
+	{ID: "discord", Name: "discord", NormalizedName: "discord"},
+	{ID: "dosi", Name: "dosi", NormalizedName: "dosi"},
+	{ID: "drom", Name: "drom", NormalizedName: "drom"},
+	{ID: "ebay", Name: "ebay", NormalizedName: "ebay"},
+	{ID: "facebook", Name: "facebook", NormalizedName: "facebook"},
+	{ID: "fiverr", Name: "fiverr", NormalizedName: "fiverr"},
+	{ID: "foodpanda", Name: "foodpanda", NormalizedName: "foodpanda"},
+	{ID: "gameflip", Name: "gameflip", NormalizedName: "gameflip"},
+	{ID: "gett", Name: "gett", NormalizedName: "gett"},
+	{ID: "gmx", Name: "gmx", NormalizedName: "gmx"},
+	{ID: "google", Name: "google", NormalizedName: "google"},
+	{ID: "grab", Name: "grab", NormalizedName: "grab"},
+	{ID: "happn", Name: "happn", NormalizedName: "happn"},
+	{ID: "hinge", Name: "hinge", NormalizedName: "hinge"},
+	{ID: "icq", Name: "icq", NormalizedName: "icq"},
+	{ID: "imo", Name: "imo", NormalizedName: "imo"},
+	{ID: "instagram", Name: "instagram", NormalizedName: "instagram"},
+	{ID: "kakaotalk", Name: "kakaotalk", NormalizedName: "kakaotalk"},
+	{ID: "line", Name: "line", NormalizedName: "line"},
+	{ID: "linkedin", Name: "linkedin", NormalizedName: "linkedin"},
+	{ID: "lyft", Name: "lyft", NormalizedName: "lyft"},
+	{ID: "mail", Name: "mail", NormalizedName: "mail"},
+	{ID: "mailru", Name: "mailru", NormalizedName: "mailru"},
+	{ID: "mamba", Name: "mamba", NormalizedName: "mamba"},
+	{ID: "meetme", Name: "meetme", NormalizedName: "meetme"},
+	{ID: "microsoft", Name: "microsoft", NormalizedName: "microsoft"},
+	{ID: "naver", Name: "naver", NormalizedName: "naver"},
+	{ID: "netflix", Name: "netflix", NormalizedName: "netflix"},
+	{ID: "nike", Name: "nike", NormalizedName: "nike"},
+	{ID: "offerup", Name: "offerup", NormalizedName: "offerup"},
+	{ID: "okcupid", Name: "okcupid", NormalizedName: "okcupid"},
+	{ID: "olx", Name: "olx", NormalizedName: "olx"},
+	{ID: "openai", Name: "openai", NormalizedName: "openai"},
+	{ID: "other", Name: "other", NormalizedName: "other"},
+	{ID: "paypal", Name: "paypal", NormalizedName: "paypal"},
+	{ID: "pof", Name: "pof", NormalizedName: "pof"},
+	{ID: "protonmail", Name: "protonmail", NormalizedName: "protonmail"},
+	{ID: "qiwiwallet", Name: "qiwiwallet", NormalizedName: "qiwiwallet"},
+	{ID: "quipp", Name: "quipp", NormalizedName: "quipp"},
+	{ID: "rambler", Name: "rambler", NormalizedName: "rambler"},
+	{ID: "revolut", Name: "revolut", NormalizedName: "revolut"},
+	{ID: "shopee", Name: "shopee", NormalizedName: "shopee"},
+	{ID: "signal", Name: "signal", NormalizedName: "signal"},
+	{ID: "skype", Name: "skype", NormalizedName: "skype"},
+	{ID: "snapchat", Name: "snapchat", NormalizedName: "snapchat"},
+	{ID: "steam", Name: "steam", NormalizedName: "steam"},
+	{ID: "telegram", Name: "telegram", NormalizedName: "telegram"},
+	{ID: "tiktok", Name: "tiktok", NormalizedName: "tiktok"},
+	{ID: "tinder", Name: "tinder", NormalizedName: "tinder"},
+	{ID: "twitch", Name: "twitch", NormalizedName: "twitch"},
+	{ID: "twitter", Name: "twitter", NormalizedName: "twitter"},
+	{ID: "uber", Name: "uber", NormalizedName: "uber"},
+	{ID: "viber", Name: "viber", NormalizedName: "viber"},
+	{ID: "vkontakte", Name: "vkontakte", NormalizedName: "vkontakte"},
+	{ID: "wechat", Name: "wechat", NormalizedName: "wechat"},
+	{ID: "weibo", Name: "weibo", NormalizedName: "weibo"},
+	{ID: "whatsapp", Name: "whatsapp", NormalizedName: "whatsapp"},
+	{ID: "wise", Name: "wise", NormalizedName: "wise"},
+	{ID: "yahoo", Name: "yahoo", NormalizedName: "yahoo"},
+	{ID: "yandex", Name: "yandex", NormalizedName: "yandex"},
+	{ID: "youla", Name: "youla", NormalizedName: "youla"},
+	{ID: "zoho", Name: "zoho", NormalizedName: "zoho"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDAfghanistan   CountryID = "afghanistan"
+	CountryIDAlbania       CountryID = "albania"
+	CountryIDArgentina     CountryID = "argentina"
+	CountryIDArmenia       CountryID = "armenia"
+	CountryIDAustralia     CountryID = "australia"
+	CountryIDAustria       CountryID = "austria"
+	CountryIDAzerbaijan    CountryID = "azerbaijan"
+	CountryIDBangladesh    CountryID = "bangladesh"
+	CountryIDBelarus       CountryID = "belarus"
+	CountryIDBelgium       CountryID = "belgium"
+	CountryIDBolivia       CountryID = "bolivia"
+	CountryIDBrazil        CountryID = "brazil"
+	CountryIDBulgaria      CountryID = "bulgaria"
+	CountryIDCambodia      CountryID = "cambodia"
+	CountryIDCameroon      CountryID = "cameroon"
+	CountryIDCanada        CountryID = "canada"
+	CountryIDChile         CountryID = "chile"
+	CountryIDChina         CountryID = "china"
+	CountryIDColombia      CountryID = "colombia"
+	CountryIDCroatia       CountryID = "croatia"
+	CountryIDCyprus        CountryID = "cyprus"
+	CountryIDCzechRepublic CountryID = "czech"
+	CountryIDDenmark       CountryID = "denmark"
+	CountryIDEgypt         CountryID = "egypt"
+	CountryIDEstonia       CountryID = "estonia"
+	CountryIDFinland       CountryID = "finland"
+	CountryIDFrance        CountryID = "france"
+	CountryIDGeorgia       CountryID = "georgia"
+	CountryIDGermany       CountryID = "germany"
+	CountryIDGhana         CountryID = "ghana"
+	CountryIDGreece        CountryID = "greece"
+	CountryIDHongKong      CountryID = "hongkong"
+	CountryIDHungary       CountryID = "hungary"
+	CountryIDIndia         CountryID = "india"
+	CountryIDIndonesia     CountryID = "indonesia"
+	CountryIDIreland       CountryID = "ireland"
+	CountryIDIsrael        CountryID = "israel"
+	CountryIDItaly         CountryID = "italy"
+	CountryIDJapan         CountryID = "japan"
+	CountryIDKazakhstan    CountryID = "kazakhstan"
+	CountryIDKenya         CountryID = "kenya"
+	CountryIDKyrgyzstan    CountryID = "kyrgyzstan"
+	CountryIDLatvia        CountryID = "latvia"
+	CountryIDLithuania     CountryID = "lithuania"
+	CountryIDMalaysia      CountryID = "malaysia"
+	CountryIDMexico        CountryID = "mexico"
+	CountryIDMoldova       CountryID = "moldova"
+	CountryIDMorocco       CountryID = "morocco"
+	CountryIDNetherlands   CountryID = "netherlands"
+	CountryIDNewZealand    CountryID = "newzealand"
+	CountryIDNigeria       CountryID = "nigeria"
+	CountryIDNorway        CountryID = "norway"
+	CountryIDPakistan      CountryID = "pakistan"
+	CountryIDPeru          CountryID = "peru"
+	CountryIDPhilippines   CountryID = "philippines"
+	CountryIDPoland        CountryID = "poland"
+	CountryIDPortugal      CountryID = "portugal"
+	CountryIDRomania       CountryID = "romania"
+	CountryIDRussia        CountryID = "russia"
+	CountryIDSaudiArabia   CountryID = "saudiarabia"
+	CountryIDSerbia        CountryID = "serbia"
+	CountryIDSingapore     CountryID = "singapore"
+	CountryIDSlovakia      CountryID = "slovakia"
+	CountryIDSlovenia      CountryID = "slovenia"
+	CountryIDSouthAfrica   CountryID = "southafrica"
+	CountryIDSpain         CountryID = "spain"
+	CountryIDSweden        CountryID = "sweden"
+	CountryIDThailand      CountryID = "thailand"
+	CountryIDTurkey        CountryID = "turkey"
+	CountryIDUkraine       CountryID = "ukraine"
+	CountryIDUnitedKingdom CountryID = "england"
+	CountryIDUSA           CountryID = "usa"
+	CountryIDUzbekistan    CountryID = "uzbekistan"
+	CountryIDVietnam       CountryID = "vietnam"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDAfghanistan instead.
+	CountryAfghanistan = "afghanistan"
+	// Deprecated: use CountryIDAlbania instead.
+	CountryAlbania = "albania"
+	// Deprecated: use CountryIDArgentina instead.
+	CountryArgentina = "argentina"
+	// Deprecated: use CountryIDArmenia instead.
+	CountryArmenia = "armenia"
+	// Deprecated: use CountryIDAustralia instead.
+	CountryAustralia = "australia"
+	// Deprecated: use CountryIDAustria instead.
+	CountryAustria = "austria"
+	// Deprecated: use CountryIDAzerbaijan instead.
+	CountryAzerbaijan = "azerbaijan"
+	// Deprecated: use CountryIDBangladesh instead.
+	CountryBangladesh = "bangladesh"
+	// Deprecated: use CountryIDBelarus instead.
+	CountryBelarus = "belarus"
+	// Deprecated: use CountryIDBelgium instead.
+	CountryBelgium = "belgium"
+	// Deprecated: use CountryIDBolivia instead.
+	CountryBolivia = "bolivia"
+	// Deprecated: use CountryIDBrazil instead.
+	CountryBrazil = "brazil"
+	// Deprecated: use CountryIDBulgaria instead.
+	CountryBulgaria = "bulgaria"
+	// Deprecated: use CountryIDCambodia instead.
+	CountryCambodia = "cambodia"
+	// Deprecated: use CountryIDCameroon instead.
+	CountryCameroon = "cameroon"
+	// Deprecated: use CountryIDCanada instead.
+	CountryCanada = "canada"
+	// Deprecated: use CountryIDChile instead.
+	CountryChile = "chile"
+	// Deprecated: use CountryIDChina instead.
+	CountryChina = "china"
+	// Deprecated: use CountryIDColombia instead.
+	CountryColombia = "colombia"
+	// Deprecated: use CountryIDCroatia instead.
+	CountryCroatia = "croatia"
+	// Deprecated: use CountryIDCyprus instead.
+	CountryCyprus = "cyprus"
+	// Deprecated: use CountryIDCzechRepublic instead.
+	CountryCzechRepublic = "czech"
+	// Deprecated: use CountryIDDenmark instead.
+	CountryDenmark = "denmark"
+	// Deprecated: use CountryIDEgypt instead.
+	CountryEgypt = "egypt"
+	// Deprecated: use CountryIDEstonia instead.
+	CountryEstonia = "estonia"
+	// Deprecated: use CountryIDFinland instead.
+	CountryFinland = "finland"
+	// Deprecated: use CountryIDFrance instead.
+	CountryFrance = "france"
+	// Deprecated: use CountryIDGeorgia instead.
+	CountryGeorgia = "georgia"
+	// Deprecated: use CountryIDGermany instead.
+	CountryGermany = "germany"
+	// Deprecated: use CountryIDGhana instead.
+	CountryGhana = "ghana"
+	// Deprecated: use CountryIDGreece instead.
+	CountryGreece = "greece"
+	// Deprecated: use CountryIDHongKong instead.
+	CountryHongKong = "hongkong"
+	// Deprecated: use CountryIDHungary instead.
+	CountryHungary = "hungary"
+	// Deprecated: use CountryIDIndia instead.
+	CountryIndia = "india"
+	// Deprecated: use CountryIDIndonesia instead.
+	CountryIndonesia = "indonesia"
+	// Deprecated: use CountryIDIreland instead.
+	CountryIreland = "ireland"
+	// Deprecated: use CountryIDIsrael instead.
+	CountryIsrael = "israel"
+	// Deprecated: use CountryIDItaly instead.
+	CountryItaly = "italy"
+	// Deprecated: use CountryIDJapan instead.
+	CountryJapan = "japan"
+	// Deprecated: use CountryIDKazakhstan instead.
+	CountryKazakhstan = "kazakhstan"
+	// Deprecated: use CountryIDKenya instead.
+	CountryKenya = "kenya"
+	// Deprecated: use CountryIDKyrgyzstan instead.
+	CountryKyrgyzstan = "kyrgyzstan"
+	// Deprecated: use CountryIDLatvia instead.
+	CountryLatvia = "latvia"
+	// Deprecated: use CountryIDLithuania instead.
+	CountryLithuania = "lithuania"
+	// Deprecated: use CountryIDMalaysia instead.
+	CountryMalaysia = "malaysia"
+	// Deprecated: use CountryIDMexico instead.
+	CountryMexico = "mexico"
+	// Deprecated: use CountryIDMoldova instead.
+	CountryMoldova = "moldova"
+	// Deprecated: use CountryIDMorocco instead.
+	CountryMorocco = "morocco"
+	// Deprecated: use CountryIDNetherlands instead.
+	CountryNetherlands = "netherlands"
+	// Deprecated: use CountryIDNewZealand instead.
+	CountryNewZealand = "newzealand"
+	// Deprecated: use CountryIDNigeria instead.
+	CountryNigeria = "nigeria"
+	// Deprecated: use CountryIDNorway instead.
+	CountryNorway = "norway"
+	// Deprecated: use CountryIDPakistan instead.
+	CountryPakistan = "pakistan"
+	// Deprecated: use CountryIDPeru instead.
+	CountryPeru = "peru"
+	// Deprecated: use CountryIDPhilippines instead.
+	CountryPhilippines = "philippines"
+	// Deprecated: use CountryIDPoland instead.
+	CountryPoland = "poland"
+	// Deprecated: use CountryIDPortugal instead.
+	CountryPortugal = "portugal"
+	// Deprecated: use CountryIDRomania instead.
+	CountryRomania = "romania"
+	// Deprecated: use CountryIDRussia instead.
+	CountryRussia = "russia"
+	// Deprecated: use CountryIDSaudiArabia instead.
+	CountrySaudiArabia = "saudiarabia"
+	// Deprecated: use CountryIDSerbia instead.
+	CountrySerbia = "serbia"
+	// Deprecated: use CountryIDSingapore instead.
+	CountrySingapore = "singapore"
+	// Deprecated: use CountryIDSlovakia instead.
+	CountrySlovakia = "slovakia"
+	// Deprecated: use CountryIDSlovenia instead.
+	CountrySlovenia = "slovenia"
+	// Deprecated: use CountryIDSouthAfrica instead.
+	CountrySouthAfrica = "southafrica"
+	// Deprecated: use CountryIDSpain instead.
+	CountrySpain = "spain"
+	// Deprecated: use CountryIDSweden instead.
+	CountrySweden = "sweden"
+	// Deprecated: use CountryIDThailand instead.
+	CountryThailand = "thailand"
+	// Deprecated: use CountryIDTurkey instead.
+	CountryTurkey = "turkey"
+	// Deprecated: use CountryIDUkraine instead.
+	CountryUkraine = "ukraine"
+	// Deprecated: use CountryIDUnitedKingdom instead.
+	CountryUnitedKingdom = "england"
+	// Deprecated: use CountryIDUSA instead.
+	CountryUSA = "usa"
+	// Deprecated: use CountryIDUzbekistan instead.
+	CountryUzbekistan = "uzbekistan"
+	// Deprecated: use CountryIDVietnam instead.
+	CountryVietnam = "vietnam"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "afghanistan", Alpha2: "AF", Name: "Afghanistan", DialCode: 93},
+	{ID: "albania", Alpha2: "AL", Name: "Albania", DialCode: 355},
+	{ID: "argentina", Alpha2: "AR", Name: "Argentina", DialCode: 54},
+	{ID: "armenia", Alpha2: "AM", Name: "Armenia", DialCode: 374},
+	{ID: "australia", Alpha2: "AU", Name: "Australia", DialCode: 61},
+	{ID: "austria", Alpha2: "AT", Name: "Austria", DialCode: 43},
+	{ID: "azerbaijan", Alpha2: "AZ", Name: "Azerbaijan", DialCode: 994},
+	{ID: "bangladesh", Alpha2: "BD", Name: "Bangladesh", DialCode: 880},
+	{ID: "belarus", Alpha2: "BY", Name: "Belarus", DialCode: 375},
+	{ID: "belgium", Alpha2: "BE", Name: "Belgium", DialCode: 32},
+	{ID: "bolivia", Alpha2: "BO", Name: "Bolivia", DialCode: 591},
+	{ID: "brazil", Alpha2: "BR", Name: "Brazil", DialCode: 55},
+	{ID: "bulgaria", Alpha2: "BG", Name: "Bulgaria", DialCode: 359},
+	{ID: "cambodia", Alpha2: "KH", Name: "Cambodia", DialCode: 855},
+	{ID: "cameroon", Alpha2: "CM", Name: "Cameroon", DialCode: 237},
+	{ID: "canada", Alpha2: "CA", Name: "Canada", DialCode: 1},
+	{ID: "chile", Alpha2: "CL", Name: "Chile", DialCode: 56},
+	{ID: "china", Alpha2: "CN", Name: "China", DialCode: 86},
+	{ID: "colombia", Alpha2: "CO", Name: "Colombia", DialCode: 57},
+	{ID: "croatia", Alpha2: "HR", Name: "Croatia", DialCode: 385},
+	{ID: "cyprus", Alpha2: "CY", Name: "Cyprus", DialCode: 357},
+	{ID: "czech", Alpha2: "CZ", Name: "Czech Republic", DialCode: 420},
+	{ID: "denmark", Alpha2: "DK", Name: "Denmark", DialCode: 45},
+	{ID: "egypt", Alpha2: "EG", Name: "Egypt", DialCode: 20},
+	{ID: "england", Alpha2: "GB", Name: "United Kingdom", DialCode: 44},
+	{ID: "estonia", Alpha2: "EE", Name: "Estonia", DialCode: 372},
+	{ID: "finland", Alpha2: "FI", Name: "Finland", DialCode: 358},
+	{ID: "france", Alpha2: "FR", Name: "France", DialCode: 33},
+	{ID: "georgia", Alpha2: "GE", Name: "Georgia", DialCode: 995},
+	{ID: "germany", Alpha2: "DE", Name: "Germany", DialCode: 49},
+	{ID: "ghana", Alpha2: "GH", Name: "Ghana", DialCode: 233},
+	{ID: "greece", Alpha2: "GR", Name: "Greece", DialCode: 30},
+	{ID: "hongkong", Alpha2: "HK", Name: "Hong Kong", DialCode: 852},
+	{ID: "hungary", Alpha2: "HU", Name: "Hungary", DialCode: 36},
+	{ID: "india", Alpha2: "IN", Name: "India", DialCode: 91},
+	{ID: "indonesia", Alpha2: "ID", Name: "Indonesia", DialCode: 62},
+	{ID: "ireland", Alpha2: "IE", Name: "Ireland", DialCode: 353},
+	{ID: "israel", Alpha2: "IL", Name: "Israel", DialCode: 972},
+	{ID: "italy", Alpha2: "IT", Name: "Italy", DialCode: 39},
+	{ID: "japan", Alpha2: "JP", Name: "Japan", DialCode: 81},
+	{ID: "kazakhstan", Alpha2: "KZ", Name: "Kazakhstan", DialCode: 7},
+	{ID: "kenya", Alpha2: "KE", Name: "Kenya", DialCode: 254},
+	{ID: "kyrgyzstan", Alpha2: "KG", Name: "Kyrgyzstan", DialCode: 996},
+	{ID: "latvia", Alpha2: "LV", Name: "Latvia", DialCode: 371},
+	{ID: "lithuania", Alpha2: "LT", Name: "Lithuania", DialCode: 370},
+	{ID: "malaysia", Alpha2: "MY", Name: "Malaysia", DialCode: 60},
+	{ID: "mexico", Alpha2: "MX", Name: "Mexico", DialCode: 52},
+	{ID: "moldova", Alpha2: "MD", Name: "Moldova", DialCode: 373},
+	{ID: "morocco", Alpha2: "MA", Name: "Morocco", DialCode: 212},
+	{ID: "netherlands", Alpha2: "NL", Name: "Netherlands", DialCode: 31},
+	{ID: "newzealand", Alpha2: "NZ", Name: "New Zealand", DialCode: 64},
+	{ID: "nigeria", Alpha2: "NG", Name: "Nigeria", DialCode: 234},
+	{ID: "norway", Alpha2: "NO", Name: "Norway", DialCode: 47},
+	{ID: "pakistan", Alpha2: "PK", Name: "Pakistan", DialCode: 92},
+	{ID: "peru", Alpha2: "PE", Name: "Peru", DialCode: 51},
+	{ID: "philippines", Alpha2: "PH", Name: "Philippines", DialCode: 63},
+	{ID: "poland", Alpha2: "PL", Name: "Poland", DialCode: 48},
+	{ID: "portugal", Alpha2: "PT", Name: "Portugal", DialCode: 351},
+	{ID: "romania", Alpha2: "RO", Name: "Romania", DialCode: 40},
+	{ID: "russia", Alpha2: "RU", Name: "Russia", DialCode: 7},
+	{ID: "saudiarabia", Alpha2: "SA", Name: "Saudi Arabia", DialCode: 966},
+	{ID: "serbia", Alpha2: "RS", Name: "Serbia", DialCode: 381},
+	{ID: "singapore", Alpha2: "SG", Name: "Singapore", DialCode: 65},
+	{ID: "slovakia", Alpha2: "SK", Name: "Slovakia", DialCode: 421},
+	{ID: "slovenia", Alpha2: "SI", Name: "Slovenia", DialCode: 386},
+	{ID: "southafrica", Alpha2: "ZA", Name: "South Africa", DialCode: 27},
+	{ID: "spain", Alpha2: "ES", Name: "Spain", DialCode: 34},
+	{ID: "sweden", Alpha2: "SE", Name: "Sweden", DialCode: 46},
+	{ID: "thailand", Alpha2: "TH", Name: "Thailand", DialCode: 66},
+	{ID: "turkey", Alpha2: "TR", Name: "Turkey", DialCode: 90},
+	{ID: "ukraine", Alpha2: "UA", Name: "Ukraine", DialCode: 380},
+	{ID: "usa", Alpha2: "US", Name: "USA", DialCode: 1},
+	{ID: "uzbekistan", Alpha2: "UZ", Name: "Uzbekistan", DialCode: 998},
+	{ID: "vietnam", Alpha2: "VN", Name: "Vietnam", DialCode: 84},
+}
@@ -0,0 +1,438 @@
+package generic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+var (
+	ErrNoNumbers     = errors.New("generic: no numbers available")
+	ErrNoBalance     = errors.New("generic: not enough balance")
+	ErrCancelled     = errors.New("generic: rental was cancelled")
+	ErrReported      = errors.New("generic: rental was reported")
+	ErrUnsupported   = errors.New("generic: endpoint not configured")
+	ErrUnauthorized  = errors.New("generic: unauthorized")
+	ErrFieldNotFound = errors.New("generic: field not found")
+)
+
+// Client is an sms.Client for the vendor its Spec describes, calls whose
+// endpoint the spec leaves out fail with ErrUnsupported
+type Client struct {
+	http   *http.Client
+	apiKey string
+	spec   *Spec
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+)
+
+type metadata struct {
+	id      string
+	service string
+	country string
+}
+
+func NewClient(spec *Spec, apiKey string) (*Client, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		http:   http.DefaultClient,
+		apiKey: apiKey,
+		spec:   spec,
+	}, nil
+}
+
+func (c *Client) Name() string {
+	return c.spec.Name
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	getNumber := c.spec.Endpoints.GetNumber
+	countrySelection := strings.Contains(getNumber.Path, "{country}")
+	for _, value := range getNumber.Query {
+		countrySelection = countrySelection || strings.Contains(value, "{country}")
+	}
+
+	return sms.Capabilities{
+		CountrySelection: countrySelection,
+		Reuse:            c.spec.Endpoints.Reuse != nil,
+		Cancel:           c.spec.Endpoints.Cancel != nil,
+		Report:           c.spec.Endpoints.Report != nil,
+		Balance:          c.spec.Endpoints.Balance != nil,
+	}
+}
+
+type values map[string]string
+
+var placeholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// expand replaces s' placeholders in a single pass, so values are never
+// expanded again, escaping each value when escape is set. Unknown placeholders
+// are kept
+func (v values) expand(s string, escape func(string) string) string {
+	return placeholder.ReplaceAllStringFunc(s, func(match string) string {
+		value, ok := v[match[1:len(match)-1]]
+		if !ok {
+			return match
+		}
+
+		if escape != nil {
+			value = escape(value)
+		}
+		return value
+	})
+}
+
+func (c *Client) values(m metadata, phoneNumber *sms.PhoneNumber) values {
+	v := values{
+		"api_key": c.apiKey,
+		"service": m.service,
+		"country": m.country,
+		"id":      m.id,
+	}
+
+	if phoneNumber != nil {
+		v["number"] = strings.TrimPrefix(phoneNumber.Format(phonenumbers.E164), "+")
+	}
+
+	return v
+}
+
+// do calls endpoint and decodes its JSON response, checking it against the
+// spec's error detection
+func (c *Client) do(ctx context.Context, endpoint Endpoint, v values) (any, error) {
+	query := url.Values{}
+	// query values are escaped when encoded
+	for key, value := range endpoint.Query {
+		query.Set(key, v.expand(value, nil))
+	}
+
+	if c.spec.Auth.In == "query" {
+		query.Set(c.spec.Auth.Name, c.spec.Auth.Prefix+c.apiKey)
+	}
+
+	u := strings.TrimSuffix(c.spec.BaseURL, "/") + v.expand(endpoint.Path, url.PathEscape)
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	method := endpoint.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if c.spec.Auth.In == "header" {
+		req.Header.Set(c.spec.Auth.Name, c.spec.Auth.Prefix+c.apiKey)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, ErrUnauthorized
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, sms.ErrRatelimited
+	case resp.StatusCode > 299:
+		return nil, fmt.Errorf("%s: %d %s", c.spec.Name, resp.StatusCode, resp.Status)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		// plain text answers are errors such as NO_NUMBERS
+		return nil, c.failure(strings.TrimSpace(string(body)), "")
+	}
+
+	if c.spec.Error.Path != "" {
+		if value, ok := lookupString(data, c.spec.Error.Path); ok && !c.succeeded(value) {
+			message, _ := lookupString(data, c.spec.Error.Message)
+			return nil, c.failure(value, message)
+		}
+	}
+
+	return data, nil
+}
+
+func (c *Client) succeeded(value string) bool {
+	for _, success := range c.spec.Error.Success {
+		if value == success {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Client) failure(value string, message string) error {
+	if message == "" {
+		message = value
+	}
+
+	for _, key := range []string{message, value} {
+		if err, ok := errorCodes[c.spec.Error.Codes[key]]; ok {
+			return fmt.Errorf("%s: %s: %w", c.spec.Name, message, err)
+		}
+	}
+
+	return fmt.Errorf("%s: %s", c.spec.Name, message)
+}
+
+func (c *Client) field(data any, path string) (string, error) {
+	value, ok := lookupString(data, path)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrFieldNotFound, path)
+	}
+
+	return value, nil
+}
+
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	endpoint := c.spec.Endpoints.GetNumber
+	m := metadata{service: service, country: country}
+
+	data, err := c.do(ctx, endpoint.Endpoint, c.values(m, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	rawNumber, err := c.field(data, endpoint.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	m.id, err = c.field(data, endpoint.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	region := c.spec.Region
+	if !strings.HasPrefix(rawNumber, "+") && region == "" {
+		// numbers without a plus usually start with the calling code
+		rawNumber = "+" + rawNumber
+	}
+
+	number, err := phonenumbers.Parse(rawNumber, region)
+	if err != nil {
+		return nil, fmt.Errorf("%s: parsing phone number (%s): %w", c.spec.Name, rawNumber, err)
+	}
+
+	// the number is already paid for, so an unparseable cost only loses the cost
+	var cost float64
+	if value, ok := lookupString(data, endpoint.Cost); ok && endpoint.Cost != "" {
+		cost, _ = strconv.ParseFloat(value, 64)
+	}
+
+	phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+		Provider: c.spec.Name,
+		ID:       m.id,
+		Service:  service,
+		Country:  country,
+		Cost:     cost,
+	}, m)
+
+	if value, ok := lookupString(data, endpoint.ExpiresIn); ok && endpoint.ExpiresIn != "" {
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+			phoneNumber.SetExpiresAt(time.Now().Add(time.Duration(seconds * float64(time.Second))))
+		}
+	}
+
+	return phoneNumber, nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{id: rental.ID, service: rental.Service, country: rental.Country})
+}
+
+// check fetches the rental's messages and status, status is false when the
+// spec does not map the vendor's status
+func (c *Client) check(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, sms.Status, bool, error) {
+	m, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, 0, false, sms.ErrInvalidMetadata
+	}
+
+	endpoint := c.spec.Endpoints.GetMessages
+
+	data, err := c.do(ctx, endpoint.Endpoint, c.values(m, phoneNumber))
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	messages := []string{}
+	if raw, ok := lookup(data, endpoint.Messages); ok && endpoint.Messages != "" {
+		list, isList := raw.([]any)
+		if !isList {
+			list = []any{raw}
+		}
+
+		for _, item := range list {
+			text, ok := lookupString(item, endpoint.Text)
+			if ok && text != "" {
+				messages = append(messages, text)
+			}
+		}
+	}
+
+	var (
+		status sms.Status
+		mapped bool
+	)
+	if value, ok := lookupString(data, endpoint.Status); ok && endpoint.Status != "" {
+		status, mapped = parseStatus(endpoint.Statuses[value])
+	}
+
+	if len(messages) > 0 {
+		phoneNumber.MarkUsed()
+	}
+
+	return messages, status, mapped, nil
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	messages, status, mapped, err := c.check(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapped && len(messages) == 0 {
+		switch status {
+		case sms.StatusExpired:
+			return nil, fmt.Errorf("%s: rental expired: %w", c.spec.Name, sms.ErrExpired)
+		case sms.StatusCancelled:
+			return nil, ErrCancelled
+		case sms.StatusReported:
+			return nil, ErrReported
+		}
+	}
+
+	return messages, nil
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	messages, status, mapped, err := c.check(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if !mapped {
+		status = sms.StatusWaiting
+		if len(messages) > 0 {
+			status = sms.StatusReceived
+		}
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
+func (c *Client) call(ctx context.Context, endpoint *Endpoint, phoneNumber *sms.PhoneNumber) error {
+	if endpoint == nil {
+		return ErrUnsupported
+	}
+
+	m, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	_, err := c.do(ctx, *endpoint, c.values(m, phoneNumber))
+	return err
+}
+
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if phoneNumber.Cancelled() {
+		return nil
+	}
+
+	if err := c.call(ctx, c.spec.Endpoints.Cancel, phoneNumber); err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if err := c.call(ctx, c.spec.Endpoints.Report, phoneNumber); err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	if err := phoneNumber.CanReuse(); err != nil {
+		return nil, err
+	}
+
+	if err := c.call(ctx, c.spec.Endpoints.Reuse, phoneNumber); err != nil {
+		return nil, err
+	}
+
+	if err := phoneNumber.Reuse(); err != nil {
+		return nil, err
+	}
+
+	return phoneNumber, nil
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	endpoint := c.spec.Endpoints.Balance
+	if endpoint == nil {
+		return 0, ErrUnsupported
+	}
+
+	data, err := c.do(ctx, endpoint.Endpoint, c.values(metadata{}, nil))
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := c.field(data, endpoint.Balance)
+	if err != nil {
+		return 0, err
+	}
+
+	balance, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: parsing balance %q: %w", c.spec.Name, value, err)
+	}
+
+	return balance, nil
+}
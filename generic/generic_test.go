@@ -0,0 +1,67 @@
+package generic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	v := values{"service": "{id}", "country": "a/b c", "id": "7"}
+
+	// values are not expanded again, so the result does not depend on order
+	if got := v.expand("/{service}/{country}/{id}/{unknown}", url.PathEscape); got != "/%7Bid%7D/a%2Fb%20c/7/{unknown}" {
+		t.Fatalf("path = %q", got)
+	}
+	if got := v.expand("{service}&{country}", nil); got != "{id}&a/b c" {
+		t.Fatalf("query value = %q", got)
+	}
+}
+
+func TestRequestEscaping(t *testing.T) {
+	var requests []*url.URL
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL)
+		w.Write([]byte(`{"status": "ok", "data": {"number": "12025550123", "id": "a/1 b", "price": "0.5"}}`))
+	}))
+	defer server.Close()
+
+	spec, err := ParseSpec([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.BaseURL = server.URL
+	spec.Region = ""
+
+	c, err := NewClient(spec, "key&x=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	phoneNumber, err := c.GetPhoneNumber(context.Background(), "tele gram&", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phoneNumber.OrderID() != "a/1 b" || phoneNumber.Cost() != 0.5 {
+		t.Fatalf("order = %+v", phoneNumber.Order())
+	}
+
+	if err := c.CancelPhoneNumber(context.Background(), phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("made %d requests, want 2", len(requests))
+	}
+
+	rent := requests[0].Query()
+	if rent.Get("service") != "tele gram&" || rent.Get("country") != "US" || rent.Get("api_key") != "key&x=1" {
+		t.Fatalf("rent query = %v", rent)
+	}
+
+	if got := requests[1].EscapedPath(); got != "/numbers/a%2F1%20b/cancel" {
+		t.Fatalf("cancel path = %q", got)
+	}
+}
@@ -0,0 +1,277 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/saucesteals/sms"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec describes a simple REST/JSON vendor. Paths and query values may contain
+// the placeholders {api_key}, {service}, {country}, {id} and {number} (the
+// rented number in E.164 without the leading +), which are escaped for the
+// part of the URL they land in, for example:
+//
+//	name: examplesms
+//	base_url: https://api.example.com/v1
+//	region: US
+//	auth: {in: query, name: api_key}
+//	error: {path: status, success: ["ok"], message: error}
+//	endpoints:
+//	  get_number:
+//	    path: /numbers
+//	    query: {service: "{service}", country: "{country}"}
+//	    number: data.number
+//	    id: data.id
+//	    cost: data.price
+//	  get_messages:
+//	    path: /numbers/{id}
+//	    messages: data.sms
+//	    text: text
+//	    status: data.status
+//	    statuses: {pending: waiting, done: received, timeout: expired}
+//	  cancel:
+//	    path: /numbers/{id}/cancel
+//	  balance:
+//	    path: /balance
+//	    balance: data.balance
+type Spec struct {
+	// Name is recorded as the provider of rented numbers
+	Name    string `json:"name" yaml:"name"`
+	BaseURL string `json:"base_url" yaml:"base_url"`
+	// Region parses numbers returned without a country calling code, as an
+	// ISO 3166-1 alpha-2 code
+	Region    string    `json:"region" yaml:"region"`
+	Auth      Auth      `json:"auth" yaml:"auth"`
+	Error     Error     `json:"error" yaml:"error"`
+	Endpoints Endpoints `json:"endpoints" yaml:"endpoints"`
+}
+
+// Auth places the api key on every request
+type Auth struct {
+	// In is "query" or "header"
+	In   string `json:"in" yaml:"in"`
+	Name string `json:"name" yaml:"name"`
+	// Prefix is prepended to the api key, such as "Bearer "
+	Prefix string `json:"prefix" yaml:"prefix"`
+}
+
+// Error detects failed calls answered with a successful HTTP status
+type Error struct {
+	// Path is the field telling whether the call succeeded, the call failed
+	// when it is present and not one of Success
+	Path    string   `json:"path" yaml:"path"`
+	Success []string `json:"success" yaml:"success"`
+	// Message is the field holding the failure's description, Path's value is
+	// used when it is empty or absent
+	Message string `json:"message" yaml:"message"`
+	// Codes maps failure values or messages to "ratelimited", "expired",
+	// "no_numbers" or "no_balance"
+	Codes map[string]string `json:"codes" yaml:"codes"`
+}
+
+type Endpoint struct {
+	// Method defaults to GET
+	Method string            `json:"method" yaml:"method"`
+	Path   string            `json:"path" yaml:"path"`
+	Query  map[string]string `json:"query" yaml:"query"`
+}
+
+type GetNumberEndpoint struct {
+	Endpoint `yaml:",inline"`
+	Number   string `json:"number" yaml:"number"`
+	ID       string `json:"id" yaml:"id"`
+	Cost     string `json:"cost" yaml:"cost"`
+	// ExpiresIn is the field holding the seconds left on the rental
+	ExpiresIn string `json:"expires_in" yaml:"expires_in"`
+}
+
+type GetMessagesEndpoint struct {
+	Endpoint `yaml:",inline"`
+	// Messages is the field holding a message or a list of messages
+	Messages string `json:"messages" yaml:"messages"`
+	// Text is the field of each message holding its text when messages are
+	// objects
+	Text string `json:"text" yaml:"text"`
+	// Status is the field holding the rental's status, mapped through Statuses
+	// to one of sms.Status' names (waiting, received, expired, cancelled,
+	// reported or finished)
+	Status   string            `json:"status" yaml:"status"`
+	Statuses map[string]string `json:"statuses" yaml:"statuses"`
+}
+
+type BalanceEndpoint struct {
+	Endpoint `yaml:",inline"`
+	Balance  string `json:"balance" yaml:"balance"`
+}
+
+// Endpoints are the vendor's calls, only GetNumber and GetMessages are
+// required
+type Endpoints struct {
+	GetNumber   GetNumberEndpoint   `json:"get_number" yaml:"get_number"`
+	GetMessages GetMessagesEndpoint `json:"get_messages" yaml:"get_messages"`
+	Cancel      *Endpoint           `json:"cancel" yaml:"cancel"`
+	Report      *Endpoint           `json:"report" yaml:"report"`
+	Reuse       *Endpoint           `json:"reuse" yaml:"reuse"`
+	Balance     *BalanceEndpoint    `json:"balance" yaml:"balance"`
+}
+
+// ParseSpec parses a YAML or JSON spec
+func ParseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("generic: parsing spec: %w", err)
+	}
+
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// LoadSpec reads and parses the YAML or JSON spec at path
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("generic: reading spec: %w", err)
+	}
+
+	return ParseSpec(data)
+}
+
+var errorCodes = map[string]error{
+	"ratelimited": sms.ErrRatelimited,
+	"expired":     sms.ErrExpired,
+	"no_numbers":  ErrNoNumbers,
+	"no_balance":  ErrNoBalance,
+}
+
+func parseStatus(name string) (sms.Status, bool) {
+	for status := sms.StatusWaiting; status <= sms.StatusFinished; status++ {
+		if status.String() == name {
+			return status, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *Spec) Validate() error {
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if s.Name == "" {
+		problem("name is required")
+	}
+
+	if !strings.HasPrefix(s.BaseURL, "http://") && !strings.HasPrefix(s.BaseURL, "https://") {
+		problem("base_url must be an http or https URL")
+	}
+
+	switch s.Auth.In {
+	case "query", "header":
+		if s.Auth.Name == "" {
+			problem("auth.name is required")
+		}
+	case "":
+	default:
+		problem("auth.in must be query or header, not %q", s.Auth.In)
+	}
+
+	for value, code := range s.Error.Codes {
+		if _, ok := errorCodes[code]; !ok {
+			problem("error.codes.%s: unknown code %q", value, code)
+		}
+	}
+
+	if s.Endpoints.GetNumber.Path == "" && s.Endpoints.GetNumber.Query == nil {
+		problem("endpoints.get_number is required")
+	}
+	if s.Endpoints.GetNumber.Number == "" {
+		problem("endpoints.get_number.number is required")
+	}
+	if s.Endpoints.GetNumber.ID == "" {
+		problem("endpoints.get_number.id is required")
+	}
+
+	messages := s.Endpoints.GetMessages
+	if messages.Path == "" && messages.Query == nil {
+		problem("endpoints.get_messages is required")
+	}
+	if messages.Messages == "" && messages.Status == "" {
+		problem("endpoints.get_messages needs messages or status")
+	}
+	if messages.Status != "" && len(messages.Statuses) == 0 {
+		problem("endpoints.get_messages.statuses is required with status")
+	}
+	for value, name := range messages.Statuses {
+		if _, ok := parseStatus(name); !ok {
+			problem("endpoints.get_messages.statuses.%s: unknown status %q", value, name)
+		}
+	}
+
+	if s.Endpoints.Balance != nil && s.Endpoints.Balance.Balance == "" {
+		problem("endpoints.balance.balance is required")
+	}
+
+	if len(problems) > 0 {
+		return errors.New("generic: invalid spec: " + strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// lookup follows a dot separated path of object keys and list indexes
+func lookup(value any, path string) (any, bool) {
+	if path == "" || path == "." {
+		return value, true
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			next, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			value = next
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return value, value != nil
+}
+
+// lookupString formats scalars found at path, objects and lists are not found
+func lookupString(value any, path string) (string, bool) {
+	v, ok := lookup(value, path)
+	if !ok {
+		return "", false
+	}
+
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case fmt.Stringer:
+		// json.Number
+		return v.String(), true
+	default:
+		return "", false
+	}
+}
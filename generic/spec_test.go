@@ -0,0 +1,139 @@
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/saucesteals/sms"
+)
+
+const testSpec = `
+name: examplesms
+base_url: https://api.example.com/v1
+region: US
+auth: {in: query, name: api_key}
+error: {path: status, success: ["ok"], message: error, codes: {NO_NUMBERS: no_numbers}}
+endpoints:
+  get_number:
+    path: /numbers
+    query: {service: "{service}", country: "{country}"}
+    number: data.number
+    id: data.id
+    cost: data.price
+  get_messages:
+    path: /numbers/{id}
+    messages: data.sms
+    text: text
+    status: data.status
+    statuses: {pending: waiting, done: received, timeout: expired}
+  cancel:
+    path: /numbers/{id}/cancel
+  balance:
+    path: /balance
+    balance: data.balance
+`
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.Name != "examplesms" || spec.Auth.In != "query" || spec.Auth.Name != "api_key" {
+		t.Fatalf("spec = %+v", spec)
+	}
+	if got := spec.Endpoints.GetNumber.Query["service"]; got != "{service}" {
+		t.Fatalf("get_number.query.service = %q", got)
+	}
+	if spec.Endpoints.GetNumber.Number != "data.number" || spec.Endpoints.GetNumber.ID != "data.id" {
+		t.Fatalf("get_number = %+v", spec.Endpoints.GetNumber)
+	}
+	if got := spec.Endpoints.GetMessages.Path; got != "/numbers/{id}" {
+		t.Fatalf("get_messages.path = %q", got)
+	}
+	if spec.Endpoints.Cancel == nil || spec.Endpoints.Report != nil || spec.Endpoints.Reuse != nil {
+		t.Fatalf("endpoints = %+v", spec.Endpoints)
+	}
+	if spec.Endpoints.Balance == nil || spec.Endpoints.Balance.Balance != "data.balance" {
+		t.Fatalf("balance = %+v", spec.Endpoints.Balance)
+	}
+}
+
+func TestParseSpecJSON(t *testing.T) {
+	spec, err := ParseSpec([]byte(`{
+		"name": "jsonsms",
+		"base_url": "http://localhost",
+		"endpoints": {
+			"get_number": {"path": "/rent", "number": "number", "id": "id"},
+			"get_messages": {"path": "/sms/{id}", "messages": "sms"}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.Name != "jsonsms" || spec.Endpoints.GetMessages.Messages != "sms" {
+		t.Fatalf("spec = %+v", spec)
+	}
+}
+
+func TestParseSpecInvalid(t *testing.T) {
+	_, err := ParseSpec([]byte(`
+base_url: ftp://example.com
+auth: {in: cookie}
+error: {codes: {X: unknown}}
+endpoints:
+  get_messages:
+    path: /sms
+    status: state
+    statuses: {a: lost}
+`))
+	if err == nil {
+		t.Fatal("invalid spec parsed")
+	}
+
+	for _, problem := range []string{
+		"name is required",
+		"base_url must be an http or https URL",
+		`auth.in must be query or header, not "cookie"`,
+		`error.codes.X: unknown code "unknown"`,
+		"endpoints.get_number is required",
+		"endpoints.get_number.number is required",
+		"endpoints.get_number.id is required",
+		`endpoints.get_messages.statuses.a: unknown status "lost"`,
+	} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("error %q does not report %q", err, problem)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	data := map[string]any{
+		"data": map[string]any{
+			"sms": []any{map[string]any{"text": "123456"}},
+			"ok":  true,
+		},
+	}
+
+	if got, ok := lookupString(data, "data.sms.0.text"); !ok || got != "123456" {
+		t.Fatalf("data.sms.0.text = %q, %v", got, ok)
+	}
+	if got, ok := lookupString(data, "data.ok"); !ok || got != "true" {
+		t.Fatalf("data.ok = %q, %v", got, ok)
+	}
+	for _, path := range []string{"data.sms", "data.sms.1.text", "data.missing", "data.ok.x"} {
+		if got, ok := lookupString(data, path); ok {
+			t.Errorf("%s = %q, want not found", path, got)
+		}
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	if status, ok := parseStatus("expired"); !ok || status != sms.StatusExpired {
+		t.Fatalf("parseStatus(expired) = %v, %v", status, ok)
+	}
+	if _, ok := parseStatus("lost"); ok {
+		t.Fatal("parseStatus(lost) succeeded")
+	}
+}
@@ -1,34 +1,50 @@
 package getatext
 
+//go:generate go run ../cmd/smsgen -dir . getatext
+
 import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/saucesteals/sms"
 )
 
-const baseURL = "https://getatext.com/api/v1"
+const (
+	provider = "getatext"
+	baseURL  = "https://getatext.com/api/v1"
+)
+
+// ErrUnsupported is returned by OrderHistory, getatext's API only answers about
+// a rental ID the caller already has so past rentals can't be listed
+var ErrUnsupported = errors.New("getatext: listing past rentals is not supported")
 
 type Client struct {
 	http   *http.Client
 	apiKey string
 }
 
-var _ sms.ReusableClient = &Client{}
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.HistoryClient    = &Client{}
+)
 
 type metadata struct {
-	id             int
-	lastCode       string
-	ignoreLastCode bool
+	id int
 }
 
-
 func NewClient(apiKey string) *Client {
 	return &Client{
 		http:   http.DefaultClient,
@@ -88,13 +104,27 @@ type rentRequest struct {
 }
 
 type rentResponse struct {
-	ID          int            `json:"id"`
-	Status      string         `json:"status"`
-	Number      string         `json:"number"`
-	ServiceName string         `json:"service_name"`
-	Price       json.Number    `json:"price"`
-	NewBalance  json.Number    `json:"new_balance"`
-	EndTime     string         `json:"end_time"`
+	ID          int         `json:"id"`
+	Status      string      `json:"status"`
+	Number      string      `json:"number"`
+	ServiceName string      `json:"service_name"`
+	Price       json.Number `json:"price"`
+	NewBalance  json.Number `json:"new_balance"`
+	EndTime     string      `json:"end_time"`
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		// getatext only rents US numbers
+		CountrySelection: false,
+		Reuse:            true,
+		Cancel:           true,
+		// reporting cancels the number
+		Report:           false,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+	}
 }
 
 func (c *Client) GetPhoneNumber(ctx context.Context, service string, _ string) (*sms.PhoneNumber, error) {
@@ -110,7 +140,44 @@ func (c *Client) GetPhoneNumber(ctx context.Context, service string, _ string) (
 		return nil, fmt.Errorf("getatext: parsing phone number (%s): %w", resp.Number, err)
 	}
 
-	return &sms.PhoneNumber{PhoneNumber: number, Metadata: metadata{id: resp.ID}}, nil
+	// the number is already paid for, so an unparseable end_time only loses the expiry
+	endTime, _ := parseTime(resp.EndTime)
+
+	// the number is already paid for, so an unparseable price only loses the cost
+	cost, _ := resp.Price.Float64()
+
+	phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       strconv.Itoa(resp.ID),
+		Service:  service,
+		Country:  "US",
+		Cost:     cost,
+	}, metadata{id: resp.ID})
+	phoneNumber.SetExpiresAt(endTime)
+	return phoneNumber, nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	id, err := strconv.Atoi(rental.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getatext: invalid rental id %q: %w", rental.ID, err)
+	}
+
+	return rental.PhoneNumber(metadata{id: id})
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unknown time format %q", value)
 }
 
 type statusRequest struct {
@@ -127,7 +194,7 @@ type statusResponse struct {
 }
 
 func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
-	meta, ok := phoneNumber.Metadata.(metadata)
+	meta, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
@@ -142,17 +209,46 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	}
 
 	code := *resp.Code
-	if meta.ignoreLastCode && meta.lastCode == code {
+	if !phoneNumber.ReceiveCode(code) {
 		return []string{}, nil
 	}
 
-	meta.lastCode = code
-	phoneNumber.Metadata = meta
-
 	phoneNumber.MarkUsed()
 	return []string{code}, nil
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	meta, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	var resp statusResponse
+	if err := c.do(ctx, http.MethodPost, "/rental-status", statusRequest{ID: meta.id}, &resp); err != nil {
+		return nil, err
+	}
+
+	var status sms.Status
+	switch strings.ToLower(resp.Status) {
+	case "cancelled", "canceled", "refunded":
+		status = sms.StatusCancelled
+	case "expired", "timed out":
+		status = sms.StatusExpired
+	case "completed", "finished":
+		status = sms.StatusFinished
+	default:
+		if resp.Code != nil && !phoneNumber.StaleCode(*resp.Code) {
+			status = sms.StatusReceived
+		} else if phoneNumber.Expired() {
+			status = sms.StatusExpired
+		} else {
+			status = sms.StatusWaiting
+		}
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
 type cancelRequest struct {
 	ID int `json:"id"`
 }
@@ -162,7 +258,7 @@ func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 		return nil
 	}
 
-	meta, ok := phoneNumber.Metadata.(metadata)
+	meta, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -180,14 +276,15 @@ func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 }
 
 func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
-	meta, ok := phoneNumber.Metadata.(metadata)
-	if !ok {
+	if _, ok := phoneNumber.Metadata().(metadata); !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
 
-	meta.ignoreLastCode = true
-	phoneNumber.Metadata = meta
-	phoneNumber.Reuse()
+	// the code received so far is stale until another one arrives
+	if err := phoneNumber.Reuse(); err != nil {
+		return nil, err
+	}
+
 	return phoneNumber, nil
 }
 
@@ -230,3 +327,9 @@ func (c *Client) GetServices(ctx context.Context) ([]Service, error) {
 
 	return resp.Prices, nil
 }
+
+// OrderHistory fails with ErrUnsupported, keep rentals in an sms.Store to list
+// them
+func (c *Client) OrderHistory(context.Context, sms.HistoryQuery) (*sms.HistoryPage, error) {
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,151 @@
+// Code generated by saucesteals/sms; DO NOT EDIT.
+
+package getatext
+
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceIDAirbnb    ServiceID = "airbnb"
+	ServiceIDAmazon    ServiceID = "amazon"
+	ServiceIDApple     ServiceID = "apple"
+	ServiceIDCashApp   ServiceID = "cashapp"
+	ServiceIDCoinbase  ServiceID = "coinbase"
+	ServiceIDDiscord   ServiceID = "discord"
+	ServiceIDDoorDash  ServiceID = "doordash"
+	ServiceIDEBay      ServiceID = "ebay"
+	ServiceIDFacebook  ServiceID = "facebook"
+	ServiceIDGoogle    ServiceID = "google"
+	ServiceIDInstagram ServiceID = "instagram"
+	ServiceIDLyft      ServiceID = "lyft"
+	ServiceIDMicrosoft ServiceID = "microsoft"
+	ServiceIDNetflix   ServiceID = "netflix"
+	ServiceIDOpenAI    ServiceID = "openai"
+	ServiceIDOther     ServiceID = "other"
+	ServiceIDPayPal    ServiceID = "paypal"
+	ServiceIDSnapchat  ServiceID = "snapchat"
+	ServiceIDTelegram  ServiceID = "telegram"
+	ServiceIDTikTok    ServiceID = "tiktok"
+	ServiceIDTinder    ServiceID = "tinder"
+	ServiceIDTwitter   ServiceID = "twitter"
+	ServiceIDUber      ServiceID = "uber"
+	ServiceIDVenmo     ServiceID = "venmo"
+	ServiceIDWhatsApp  ServiceID = "whatsapp"
+	ServiceIDYahoo     ServiceID = "yahoo"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceIDAirbnb instead.
+	ServiceAirbnb = "airbnb"
+	// Deprecated: use ServiceIDAmazon instead.
+	ServiceAmazon = "amazon"
+	// Deprecated: use ServiceIDApple instead.
+	ServiceApple = "apple"
+	// Deprecated: use ServiceIDCashApp instead.
+	ServiceCashApp = "cashapp"
+	// Deprecated: use ServiceIDCoinbase instead.
+	ServiceCoinbase = "coinbase"
+	// Deprecated: use ServiceIDDiscord instead.
+	ServiceDiscord = "discord"
+	// Deprecated: use ServiceIDDoorDash instead.
+	ServiceDoorDash = "doordash"
+	// Deprecated: use ServiceIDEBay instead.
+	ServiceEBay = "ebay"
+	// Deprecated: use ServiceIDFacebook instead.
+	ServiceFacebook = "facebook"
+	// Deprecated: use ServiceIDGoogle instead.
+	ServiceGoogle = "google"
+	// Deprecated: use ServiceIDInstagram instead.
+	ServiceInstagram = "instagram"
+	// Deprecated: use ServiceIDLyft instead.
+	ServiceLyft = "lyft"
+	// Deprecated: use ServiceIDMicrosoft instead.
+	ServiceMicrosoft = "microsoft"
+	// Deprecated: use ServiceIDNetflix instead.
+	ServiceNetflix = "netflix"
+	// Deprecated: use ServiceIDOpenAI instead.
+	ServiceOpenAI = "openai"
+	// Deprecated: use ServiceIDOther instead.
+	ServiceOther = "other"
+	// Deprecated: use ServiceIDPayPal instead.
+	ServicePayPal = "paypal"
+	// Deprecated: use ServiceIDSnapchat instead.
+	ServiceSnapchat = "snapchat"
+	// Deprecated: use ServiceIDTelegram instead.
+	ServiceTelegram = "telegram"
+	// Deprecated: use ServiceIDTikTok instead.
+	ServiceTikTok = "tiktok"
+	// Deprecated: use ServiceIDTinder instead.
+	ServiceTinder = "tinder"
+	// Deprecated: use ServiceIDTwitter instead.
+	ServiceTwitter = "twitter"
+	// Deprecated: use ServiceIDUber instead.
+	ServiceUber = "uber"
+	// Deprecated: use ServiceIDVenmo instead.
+	ServiceVenmo = "venmo"
+	// Deprecated: use ServiceIDWhatsApp instead.
+	ServiceWhatsApp = "whatsapp"
+	// Deprecated: use ServiceIDYahoo instead.
+	ServiceYahoo = "yahoo"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "airbnb", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "amazon", Name: "Amazon", NormalizedName: "amazon"},
+	{ID: "apple", Name: "Apple", NormalizedName: "apple"},
+	{ID: "cashapp", Name: "Cash App", NormalizedName: "cashapp"},
+	{ID: "coinbase", Name: "Coinbase", NormalizedName: "coinbase"},
+	{ID: "discord", Name: "Discord", NormalizedName: "discord"},
+	{ID: "doordash", Name: "DoorDash", NormalizedName: "doordash"},
+	{ID: "ebay", Name: "eBay", NormalizedName: "ebay"},
+	{ID: "facebook", Name: "Facebook", NormalizedName: "facebook"},
+	{ID: "google", Name: "Google", NormalizedName: "google"},
+	{ID: "instagram", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "lyft", Name: "Lyft", NormalizedName: "lyft"},
+	{ID: "microsoft", Name: "Microsoft", NormalizedName: "microsoft"},
+	{ID: "netflix", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "openai", Name: "OpenAI", NormalizedName: "openai"},
+	{ID: "other", Name: "Other", NormalizedName: "other"},
+	{ID: "paypal", Name: "PayPal", NormalizedName: "paypal"},
+	{ID: "snapchat", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "telegram", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "tiktok", Name: "TikTok", NormalizedName: "tiktok"},
+	{ID: "tinder", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "twitter", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "uber", Name: "Uber", NormalizedName: "uber"},
+	{ID: "venmo", Name: "Venmo", NormalizedName: "venmo"},
+	{ID: "whatsapp", Name: "WhatsApp", NormalizedName: "whatsapp"},
+	{ID: "yahoo", Name: "Yahoo", NormalizedName: "yahoo"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDUnitedStates CountryID = "US"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDUnitedStates instead.
+	CountryUnitedStates = "US"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "US", Alpha2: "US", Name: "United States", DialCode: 1},
+}
@@ -4,7 +4,10 @@ go 1.19
 
 require (
 	github.com/nyaruka/phonenumbers v1.1.4
+	go.etcd.io/bbolt v1.3.7
+	golang.org/x/sys v0.10.0
 	golang.org/x/text v0.3.7
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require github.com/golang/protobuf v1.3.2 // indirect
@@ -0,0 +1,65 @@
+package sms
+
+import (
+	"context"
+	"time"
+)
+
+// HistoryQuery selects a page of past orders, a zero From or To leaves that
+// end of the range open
+type HistoryQuery struct {
+	From time.Time
+	To   time.Time
+	// Page counts from zero
+	Page int
+	// Limit is the page size, zero leaves it to the provider
+	Limit int
+}
+
+// Contains reports whether an order rented at t falls in the query's range
+func (q HistoryQuery) Contains(t time.Time) bool {
+	if !q.From.IsZero() && t.Before(q.From) {
+		return false
+	}
+
+	return q.To.IsZero() || t.Before(q.To)
+}
+
+// HistoryRecord is a past order as every provider reports it
+type HistoryRecord struct {
+	Order
+	// Number is in E.164 format
+	Number string `json:"number"`
+	Status Status `json:"status"`
+	// Code is the last message received, if any
+	Code string `json:"code,omitempty"`
+}
+
+// HistoryPage is one page of a provider's order history
+type HistoryPage struct {
+	// Records are the page's orders in the query's range, newest first
+	Records []HistoryRecord
+	// More is true while later pages may hold orders in the query's range
+	More bool
+}
+
+type HistoryClient interface {
+	Client
+	OrderHistory(ctx context.Context, query HistoryQuery) (*HistoryPage, error)
+}
+
+// AllHistory pages through client's orders rented between from and to
+func AllHistory(ctx context.Context, client HistoryClient, from time.Time, to time.Time) ([]HistoryRecord, error) {
+	records := []HistoryRecord{}
+	for page := 0; ; page++ {
+		res, err := client.OrderHistory(ctx, HistoryQuery{From: from, To: to, Page: page})
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, res.Records...)
+		if !res.More {
+			return records, nil
+		}
+	}
+}
@@ -1,46 +1,574 @@
 package gen
 
 import (
-	"html/template"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
 	"os"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
 )
 
 var (
 	t = template.Must(template.New("gen").Parse(`// Code generated by saucesteals/sms; DO NOT EDIT.
+
 package {{ .Package }}
 
-var (
-	{{ range .Services }}Service{{ .Name }} = "{{ .Value }}"
-	{{ end }}
-)`))
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+{{- if .Services }}
+
+const (
+{{- range .Services }}
+	{{ .Typed }} ServiceID = {{ printf "%q" .Value }}
+{{- end }}
+)
+{{- end }}
+{{- if .DeprecatedServices }}
+
+// Services that were renamed or are no longer listed, kept so code using them
+// still builds
+const (
+{{- range .DeprecatedServices }}
+	{{ template "deprecated" . }}
+{{- end }}
+)
+{{- end }}
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+{{- range .Services }}
+	{{ template "untyped" . }}
+{{- end }}
+{{- range .DeprecatedServices }}
+	{{ template "untyped" . }}
+{{- end }}
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+{{- range .Table }}
+	{ID: {{ printf "%q" .ID }}, Name: {{ printf "%q" .Name }}, NormalizedName: {{ printf "%q" .NormalizedName }}},
+{{- end }}
+}
+{{- if .Countries }}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+{{- range .Countries }}
+	{{ .Typed }} CountryID = {{ printf "%q" .Value }}
+{{- end }}
+)
+{{- if .DeprecatedCountries }}
+
+// Countries that were renamed or are no longer listed, kept so code using
+// them still builds
+const (
+{{- range .DeprecatedCountries }}
+	{{ template "deprecated" . }}
+{{- end }}
+)
+{{- end }}
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+{{- range .Countries }}
+	{{ template "untyped" . }}
+{{- end }}
+{{- range .DeprecatedCountries }}
+	{{ template "untyped" . }}
+{{- end }}
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+{{- range .CountryTable }}
+	{ID: {{ printf "%q" .ID }}, Alpha2: {{ printf "%q" .Alpha2 }}, Name: {{ printf "%q" .Name }}, DialCode: {{ .DialCode }}},
+{{- end }}
+}
+{{- end }}
+
+{{- define "deprecated" }}
+{{- if .To -}}
+	// Deprecated: use {{ .TypedTo }} instead.
+	{{ .Typed }} = {{ .TypedTo }}
+{{- else -}}
+	// Deprecated: no longer listed by the provider.
+	{{ .Typed }} {{ .Type }} = {{ printf "%q" .Value }}
+{{- end }}
+{{- end }}
+
+{{- define "untyped" -}}
+	// Deprecated: use {{ if .To }}{{ .TypedTo }}{{ else }}{{ .Typed }}{{ end }} instead.
+	{{ .Ident }} = {{ printf "%q" .Value }}
+{{- end }}
+`))
 
 	reNormalize = regexp.MustCompile(`(?i)(\W|_|service)`)
+	reUnsafe    = regexp.MustCompile(`[^A-Za-z0-9_]`)
 )
 
-type Service struct {
-	Name  string
-	Value string
+// Entry is a generated constant, Name is appended to the constant's prefix
+// and DisplayName is the name listed in the service table
+type Entry struct {
+	Name        string
+	Value       string
+	DisplayName string
+}
+
+type Service = Entry
+
+// Country is an Entry along with the country's alpha-2 code and calling code,
+// both checked against the phonenumbers region data. DialCode may be left out
+// when Alpha2 is known
+type Country struct {
+	Entry
+	Alpha2   string
+	DialCode int
+}
+
+// Lock maps every identifier emitted so far to its value, so identifiers
+// outlive the provider renaming or removing what they name. Identifiers are
+// locked by their untyped Service<Name> and Country<Name> form, the typed
+// ServiceID<Name> and CountryID<Name> constants follow them
+type Lock struct {
+	Services  map[string]string `json:"services"`
+	Countries map[string]string `json:"countries,omitempty"`
+	// Deprecated lists the identifiers already generated as deprecated, which
+	// are no longer reported as changes
+	Deprecated []string `json:"deprecated,omitempty"`
 }
 
 type Data struct {
-	Services []Service
-	Package  string
+	Services  []Service
+	Countries []Country
+	Package   string
+	// Lock is the previous generation's lock, if any
+	Lock *Lock
+}
+
+// Change is a typed identifier that was added, removed or renamed since the
+// lock. To is the identifier a renamed one now aliases
+type Change struct {
+	Ident string
+	Value string
+	To    string
+}
+
+// Result is a rendered catalog along with the lock to keep for the next
+// generation and what changed since the previous one
+type Result struct {
+	Source  []byte
+	Lock    Lock
+	Added   []Change
+	Removed []Change
+	Renamed []Change
+	// Changed are locked identifiers whose value went away and that now name
+	// another value
+	Changed []Change
+}
+
+// constant is named Ident when untyped and Typed when typed
+type constant struct {
+	Ident string
+	Typed string
+	Type  string
+	Value string
+	// To and TypedTo are only set on deprecated constants that were renamed
+	To      string
+	TypedTo string
 }
 
 func Normalize(name string) string {
 	return reNormalize.ReplaceAllString(name, "")
 }
 
-func Generate(path string, data Data) error {
-	out, err := os.Create(path)
-	if err != nil {
-		return err
+// LessValue orders numeric values numerically before the others
+func LessValue(a string, b string) bool {
+	an, aErr := strconv.ParseInt(a, 10, 64)
+	bn, bErr := strconv.ParseInt(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil && an != bn:
+		return an < bn
+	case aErr == nil && bErr != nil:
+		return true
+	case aErr != nil && bErr == nil:
+		return false
+	}
+
+	return a < b
+}
+
+// constants names entries prefix+Name. Entries sharing a name are told apart by
+// their value: the one the name is locked to, or else the lowest value, keeps
+// the name and the others are suffixed with _<value>, so the result does not
+// depend on the order of entries
+func constants(prefix string, entries []Entry, locked map[string]string) ([]constant, error) {
+	sorted := append([]Entry(nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		li := locked[prefix+sorted[i].Name] == sorted[i].Value
+		lj := locked[prefix+sorted[j].Name] == sorted[j].Value
+		if li != lj {
+			return li
+		}
+		if sorted[i].Value != sorted[j].Value {
+			return LessValue(sorted[i].Value, sorted[j].Value)
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	taken := map[string]string{}
+	var consts []constant
+	for _, e := range sorted {
+		suffix := "_" + reUnsafe.ReplaceAllString(e.Value, "_")
+
+		ident := prefix + e.Name
+		if e.Name == "" {
+			// nothing was left of the name
+			ident = prefix + suffix
+		}
+
+		if value, ok := taken[ident]; ok {
+			if value == e.Value {
+				// listed twice
+				continue
+			}
+			ident += suffix
+		}
+
+		if value, ok := taken[ident]; ok {
+			return nil, fmt.Errorf("gen: %s is both %q and %q", ident, value, e.Value)
+		}
+
+		if !token.IsIdentifier(ident) || !token.IsExported(ident) {
+			return nil, fmt.Errorf("gen: %q (%q) is not a valid exported identifier", ident, e.Value)
+		}
+
+		taken[ident] = e.Value
+		consts = append(consts, constant{Ident: ident, Value: e.Value})
+	}
+
+	sortConstants(consts)
+
+	return consts, nil
+}
+
+func sortConstants(consts []constant) {
+	sort.Slice(consts, func(i, j int) bool {
+		a, b := consts[i].Ident, consts[j].Ident
+		if la, lb := strings.ToLower(a), strings.ToLower(b); la != lb {
+			return la < lb
+		}
+		return a < b
+	})
+}
+
+// lockChanges compares consts to the locked identifiers, returning the
+// deprecated constants to keep emitting along with what changed. locked is
+// updated to hold every identifier emitted
+func lockChanges(consts []constant, locked map[string]string, res *Result) []constant {
+	current := map[string]string{}
+	byValue := map[string]string{}
+	for _, c := range consts {
+		current[c.Ident] = c.Value
+		if _, ok := byValue[c.Value]; !ok {
+			byValue[c.Value] = c.Ident
+		}
+	}
+
+	wasDeprecated := map[string]bool{}
+	for _, ident := range res.Lock.Deprecated {
+		wasDeprecated[ident] = true
+	}
+
+	lockedValues := map[string]bool{}
+	for _, value := range locked {
+		lockedValues[value] = true
+	}
+
+	var deprecated []constant
+	for ident, value := range locked {
+		if v, ok := current[ident]; ok {
+			if v != value {
+				res.Changed = append(res.Changed, Change{Ident: ident, Value: v})
+			}
+			continue
+		}
+
+		c := constant{Ident: ident, Value: value}
+		c.To = byValue[value]
+		deprecated = append(deprecated, c)
+		if wasDeprecated[ident] {
+			continue
+		}
+
+		if c.To != "" {
+			res.Renamed = append(res.Renamed, Change{Ident: ident, Value: value, To: c.To})
+		} else {
+			res.Removed = append(res.Removed, Change{Ident: ident, Value: value})
+		}
 	}
 
-	if err := t.Execute(out, data); err != nil {
-		return err
+	for _, c := range consts {
+		if wasDeprecated[c.Ident] {
+			// listed again
+			continue
+		}
+		if _, ok := locked[c.Ident]; !ok && !lockedValues[c.Value] {
+			res.Added = append(res.Added, Change{Ident: c.Ident, Value: c.Value})
+		}
+		locked[c.Ident] = c.Value
+	}
+
+	sortConstants(deprecated)
+
+	return deprecated
+}
+
+// typed names the typed constants after the untyped ones, typ replacing their
+// prefix
+func typed(consts []constant, prefix string, typ string) {
+	for i := range consts {
+		consts[i].Type = typ
+		consts[i].Typed = typ + strings.TrimPrefix(consts[i].Ident, prefix)
+		if consts[i].To != "" {
+			consts[i].TypedTo = typ + strings.TrimPrefix(consts[i].To, prefix)
+		}
+	}
+}
+
+// typedChange renames a change's untyped identifiers to their typed form
+func typedChange(c Change) Change {
+	rename := func(ident string) string {
+		for _, prefix := range []string{"Service", "Country"} {
+			if strings.HasPrefix(ident, prefix) {
+				return prefix + "ID" + strings.TrimPrefix(ident, prefix)
+			}
+		}
+		return ident
+	}
+
+	c.Ident = rename(c.Ident)
+	if c.To != "" {
+		c.To = rename(c.To)
+	}
+	return c
+}
+
+// collisions fails when two declarations of the generated file share a name,
+// such as ServiceIDMail typed from Mail and untyped from a service named IDMail
+func collisions(names []string, groups ...[]constant) error {
+	declared := map[string]bool{}
+	declare := func(name string) error {
+		if declared[name] {
+			return fmt.Errorf("gen: %s is declared twice", name)
+		}
+		declared[name] = true
+		return nil
+	}
+
+	for _, name := range names {
+		if err := declare(name); err != nil {
+			return err
+		}
+	}
+	for _, group := range groups {
+		for _, c := range group {
+			if err := declare(c.Ident); err != nil {
+				return err
+			}
+			if err := declare(c.Typed); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
+
+// table lists entries once per value, sorted by value
+func table(entries []Entry) []sms.Service {
+	seen := map[string]bool{}
+	var services []sms.Service
+	for _, e := range entries {
+		if seen[e.Value] {
+			continue
+		}
+		seen[e.Value] = true
+
+		name := e.DisplayName
+		if name == "" {
+			name = e.Name
+		}
+
+		services = append(services, sms.Service{ID: e.Value, Name: name, NormalizedName: sms.NormalizeName(name)})
+	}
+
+	sort.SliceStable(services, func(i, j int) bool {
+		return LessValue(services[i].ID, services[j].ID)
+	})
+
+	return services
+}
+
+// countryTable lists countries once per value, sorted by value. Alpha-2 codes
+// must be regions phonenumbers knows and calling codes must match theirs
+func countryTable(countries []Country) ([]sms.Country, error) {
+	seen := map[string]bool{}
+	var table []sms.Country
+	for _, c := range countries {
+		if seen[c.Value] {
+			continue
+		}
+		seen[c.Value] = true
+
+		name := c.DisplayName
+		if name == "" {
+			name = c.Name
+		}
+
+		country := sms.Country{ID: c.Value, Alpha2: strings.ToUpper(c.Alpha2), Name: name, DialCode: c.DialCode}
+		if country.Alpha2 != "" {
+			code := phonenumbers.GetCountryCodeForRegion(country.Alpha2)
+			if code == 0 {
+				return nil, fmt.Errorf("gen: country %q (%q) has unknown region %q", name, c.Value, country.Alpha2)
+			}
+
+			if country.DialCode != 0 && country.DialCode != code {
+				return nil, fmt.Errorf("gen: country %q (%q) has calling code %d, %s's is %d", name, c.Value, country.DialCode, country.Alpha2, code)
+			}
+			country.DialCode = code
+		}
+
+		table = append(table, country)
+	}
+
+	sort.SliceStable(table, func(i, j int) bool {
+		return LessValue(table[i].ID, table[j].ID)
+	})
+
+	return table, nil
+}
+
+// Render returns the gofmt'd source of data's catalog. Identifiers in
+// data.Lock that are no longer generated are kept as deprecated constants
+func Render(data Data) (*Result, error) {
+	lock := Lock{Services: map[string]string{}, Countries: map[string]string{}}
+	if data.Lock != nil {
+		lock.Deprecated = data.Lock.Deprecated
+		for ident, value := range data.Lock.Services {
+			lock.Services[ident] = value
+		}
+		for ident, value := range data.Lock.Countries {
+			lock.Countries[ident] = value
+		}
+	}
+
+	services, err := constants("Service", data.Services, lock.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	countryEntries := make([]Entry, len(data.Countries))
+	for i, c := range data.Countries {
+		countryEntries[i] = c.Entry
+	}
+
+	countries, err := constants("Country", countryEntries, lock.Countries)
+	if err != nil {
+		return nil, err
+	}
+
+	countryTable, err := countryTable(data.Countries)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Lock: lock}
+	deprecatedServices := lockChanges(services, lock.Services, res)
+	deprecatedCountries := lockChanges(countries, lock.Countries, res)
+
+	res.Lock.Deprecated = nil
+	for _, deprecated := range [][]constant{deprecatedServices, deprecatedCountries} {
+		for _, c := range deprecated {
+			res.Lock.Deprecated = append(res.Lock.Deprecated, c.Ident)
+		}
+	}
+	sort.Strings(res.Lock.Deprecated)
+	if len(countries) == 0 && len(deprecatedCountries) > 0 {
+		return nil, errors.New("gen: cannot keep deprecated countries without any country")
+	}
+
+	typed(services, "Service", "ServiceID")
+	typed(deprecatedServices, "Service", "ServiceID")
+	typed(countries, "Country", "CountryID")
+	typed(deprecatedCountries, "Country", "CountryID")
+
+	names := []string{"ServiceID", "Services"}
+	if len(countries) > 0 {
+		names = append(names, "CountryID", "Countries")
+	}
+	if err := collisions(names, services, deprecatedServices, countries, deprecatedCountries); err != nil {
+		return nil, err
+	}
+
+	for _, changes := range [][]Change{res.Added, res.Removed, res.Renamed, res.Changed} {
+		for i := range changes {
+			changes[i] = typedChange(changes[i])
+		}
+		sort.Slice(changes, func(i, j int) bool { return changes[i].Ident < changes[j].Ident })
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, struct {
+		Package             string
+		Services            []constant
+		DeprecatedServices  []constant
+		Countries           []constant
+		DeprecatedCountries []constant
+		Table               []sms.Service
+		CountryTable        []sms.Country
+	}{data.Package, services, deprecatedServices, countries, deprecatedCountries, table(data.Services), countryTable}); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gen: generated invalid Go: %w", err)
+	}
+	res.Source = src
+
+	return res, nil
+}
+
+func Generate(path string, data Data) (*Result, error) {
+	res, err := Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, os.WriteFile(path, res.Source, 0o644)
+}
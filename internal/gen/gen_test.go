@@ -0,0 +1,154 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+)
+
+func render(t *testing.T, data Data) *Result {
+	t.Helper()
+
+	res, err := Render(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res
+}
+
+// mustContain ignores differences in spacing, which gofmt aligns
+func mustContain(t *testing.T, src []byte, lines ...string) {
+	t.Helper()
+
+	collapsed := strings.Join(strings.Fields(string(src)), " ")
+	for _, line := range lines {
+		if !strings.Contains(collapsed, strings.Join(strings.Fields(line), " ")) {
+			t.Errorf("generated source is missing %q:\n%s", line, src)
+		}
+	}
+}
+
+func TestRenderConstants(t *testing.T) {
+	res := render(t, Data{
+		Package:  "example",
+		Services: []Service{{Name: "Telegram", Value: "2"}, {Name: "Google", Value: "1"}},
+		Countries: []Country{
+			{Entry: Entry{Name: "UnitedStates", Value: "1", DisplayName: "United States"}, Alpha2: "us"},
+		},
+	})
+
+	mustContain(t, res.Source,
+		"package example",
+		"type ServiceID string",
+		"\tServiceIDGoogle   ServiceID = \"1\"\n",
+		"\tServiceIDTelegram ServiceID = \"2\"\n",
+		"type CountryID string",
+		"\tCountryIDUnitedStates CountryID = \"1\"\n",
+		// the untyped constants are kept for code passing them as strings
+		"// Deprecated: use ServiceIDGoogle instead.\n\tServiceGoogle = \"1\"\n",
+		"// Deprecated: use CountryIDUnitedStates instead.\n\tCountryUnitedStates = \"1\"\n",
+		`{ID: "1", Name: "Google", NormalizedName: "google"},`,
+		`{ID: "1", Alpha2: "US", Name: "United States", DialCode: 1},`,
+	)
+}
+
+func TestRenderWithoutCountries(t *testing.T) {
+	res := render(t, Data{Package: "example", Services: []Service{{Name: "Google", Value: "1"}}})
+
+	if strings.Contains(string(res.Source), "CountryID") {
+		t.Errorf("generated countries without any:\n%s", res.Source)
+	}
+}
+
+func TestRenderRejectsCollisions(t *testing.T) {
+	for _, services := range [][]Service{
+		// ServiceIDMail, typed from Mail and untyped from IDMail
+		{{Name: "Mail", Value: "1"}, {Name: "IDMail", Value: "2"}},
+		// the type itself
+		{{Name: "ID", Value: "1"}},
+		// the table
+		{{Name: "s", Value: "1"}},
+	} {
+		if _, err := Render(Data{Package: "example", Services: services}); err == nil {
+			t.Errorf("%+v rendered", services)
+		}
+	}
+}
+
+func TestRenderCollisionsDoNotDependOnOrder(t *testing.T) {
+	services := []Service{{Name: "Mail", Value: "9"}, {Name: "Mail", Value: "3"}, {Name: "Mail", Value: "3"}}
+
+	first := render(t, Data{Package: "example", Services: services})
+	reversed := render(t, Data{Package: "example", Services: []Service{services[2], services[1], services[0]}})
+
+	if string(first.Source) != string(reversed.Source) {
+		t.Fatalf("output depends on the order of services:\n%s\n%s", first.Source, reversed.Source)
+	}
+
+	// the lowest value keeps the name
+	mustContain(t, first.Source, "\tServiceIDMail   ServiceID = \"3\"\n", "\tServiceIDMail_9 ServiceID = \"9\"\n")
+}
+
+func TestRenderKeepsLockedIdentifiers(t *testing.T) {
+	lock := &Lock{Services: map[string]string{
+		"ServiceMail":    "9",
+		"ServiceOldName": "1",
+		"ServiceGone":    "5",
+	}}
+
+	res := render(t, Data{
+		Package:  "example",
+		Services: []Service{{Name: "Mail", Value: "3"}, {Name: "Mail", Value: "9"}, {Name: "NewName", Value: "1"}},
+		Lock:     lock,
+	})
+
+	mustContain(t, res.Source,
+		// the locked value keeps the name
+		"\tServiceIDMail   ServiceID = \"9\"\n",
+		"\tServiceIDMail_3 ServiceID = \"3\"\n",
+		"// Deprecated: use ServiceIDNewName instead.\n\tServiceIDOldName = ServiceIDNewName\n",
+		"// Deprecated: no longer listed by the provider.\n\tServiceIDGone ServiceID = \"5\"\n",
+		"// Deprecated: use ServiceIDNewName instead.\n\tServiceOldName = \"1\"\n",
+		"// Deprecated: use ServiceIDGone instead.\n\tServiceGone = \"5\"\n",
+	)
+
+	if len(res.Renamed) != 1 || res.Renamed[0].Ident != "ServiceIDOldName" || res.Renamed[0].To != "ServiceIDNewName" {
+		t.Errorf("renamed = %+v", res.Renamed)
+	}
+	if len(res.Removed) != 1 || res.Removed[0].Ident != "ServiceIDGone" {
+		t.Errorf("removed = %+v", res.Removed)
+	}
+	if len(res.Added) != 1 || res.Added[0].Ident != "ServiceIDMail_3" {
+		t.Errorf("added = %+v", res.Added)
+	}
+
+	// deprecations are only reported once
+	again := render(t, Data{
+		Package:  "example",
+		Services: []Service{{Name: "Mail", Value: "3"}, {Name: "Mail", Value: "9"}, {Name: "NewName", Value: "1"}},
+		Lock:     &res.Lock,
+	})
+	if len(again.Added)+len(again.Removed)+len(again.Renamed)+len(again.Changed) != 0 {
+		t.Errorf("regenerating reported changes: %+v", again)
+	}
+	if string(again.Source) != string(res.Source) {
+		t.Errorf("regenerating changed the source")
+	}
+}
+
+func TestRenderChecksCountries(t *testing.T) {
+	for _, country := range []Country{
+		{Entry: Entry{Name: "Nowhere", Value: "1"}, Alpha2: "XX"},
+		{Entry: Entry{Name: "UnitedKingdom", Value: "2"}, Alpha2: "GB", DialCode: 1},
+	} {
+		if _, err := Render(Data{Package: "example", Countries: []Country{country}}); err == nil {
+			t.Errorf("%s rendered", country.Name)
+		}
+	}
+}
+
+func TestRenderRejectsInvalidIdentifiers(t *testing.T) {
+	if _, err := Render(Data{Package: "example", Services: []Service{{Name: "a-b", Value: "1"}}}); err == nil {
+		t.Fatal("invalid identifier rendered")
+	}
+}
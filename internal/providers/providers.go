@@ -0,0 +1,570 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/daisysms"
+	"github.com/saucesteals/sms/fivesim"
+	"github.com/saucesteals/sms/generic"
+	"github.com/saucesteals/sms/getatext"
+	"github.com/saucesteals/sms/onlinesim"
+	"github.com/saucesteals/sms/smsman"
+	"github.com/saucesteals/sms/smsplugin"
+	"github.com/saucesteals/sms/smspool"
+	"github.com/saucesteals/sms/smspva"
+	"github.com/saucesteals/sms/smsstore"
+	"github.com/saucesteals/sms/textverified"
+	"github.com/saucesteals/sms/truverifi"
+	"github.com/saucesteals/sms/twilio"
+)
+
+type Service struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Price struct {
+	Service string  `json:"service"`
+	Name    string  `json:"name"`
+	Cost    float64 `json:"cost"`
+	// Stock is -1 when the provider does not report it
+	Stock int `json:"stock"`
+}
+
+type Country struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Alpha2 is the ISO 3166-1 alpha-2 code and DialCode the calling code,
+	// when the provider reports them
+	Alpha2   string `json:"alpha2,omitempty"`
+	DialCode int    `json:"dial_code,omitempty"`
+}
+
+// Provider adapts a provider package to the commands, Services, Prices and
+// Countries are nil when the provider cannot list them. Prices lists the
+// prices of service in country, either left empty lists all of them when the
+// provider can.
+type Provider struct {
+	Name string
+	// New returns a ready to use client, background work such as keeping
+	// authentication alive stops with ctx
+	New       func(ctx context.Context, apiKey string) (sms.Client, error)
+	Services  func(ctx context.Context, client sms.Client) ([]Service, error)
+	Prices    func(ctx context.Context, client sms.Client, service string, country string) ([]Price, error)
+	Countries func(ctx context.Context, client sms.Client) ([]Country, error)
+	// Catalog and CountryCatalog are the provider's generated service and
+	// country tables, if any
+	Catalog        sms.Services
+	CountryCatalog sms.Countries
+}
+
+// ServiceID resolves a service given by name in the provider's catalog,
+// anything else is returned as is
+func (p Provider) ServiceID(service string) string {
+	if _, ok := p.Catalog.ByID(service); ok {
+		return service
+	}
+
+	if s, ok := p.Catalog.ByName(service); ok {
+		return s.ID
+	}
+
+	return service
+}
+
+// CountryID resolves a country given by alpha-2 code or name in the provider's
+// country catalog, anything else is returned as is
+func (p Provider) CountryID(country string) string {
+	if _, ok := p.CountryCatalog.ByID(country); ok {
+		return country
+	}
+
+	if c, ok := p.CountryCatalog.ByAlpha2(country); ok {
+		return c.ID
+	}
+
+	if c, ok := p.CountryCatalog.ByName(country); ok {
+		return c.ID
+	}
+
+	return country
+}
+
+// catalogName returns the name of service in catalog, or service when it is
+// not listed
+func catalogName(catalog sms.Services, service string) string {
+	if s, ok := catalog.ByID(service); ok {
+		return s.Name
+	}
+
+	return service
+}
+
+var providers = map[string]Provider{
+	"daisysms": {
+		Name:           "daisysms",
+		Catalog:        daisysms.Services,
+		CountryCatalog: daisysms.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return daisysms.NewClient(apiKey), nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			daisysmsPrices, err := client.(*daisysms.Client).GetPrices(ctx, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			seen := map[string]bool{}
+			var services []Service
+			for _, p := range daisysmsPrices {
+				if seen[p.Service] {
+					continue
+				}
+				seen[p.Service] = true
+
+				name := p.Name
+				if name == "" {
+					name = p.Service
+				}
+				services = append(services, Service{ID: p.Service, Name: name})
+			}
+
+			return services, nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, service string, country string) ([]Price, error) {
+			daisysmsPrices, err := client.(*daisysms.Client).GetPrices(ctx, service, country)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(daisysmsPrices))
+			for i, p := range daisysmsPrices {
+				prices[i] = Price{Service: p.Service, Name: p.Service, Cost: p.Cost, Stock: p.Count}
+				if p.Name != "" {
+					prices[i].Name = p.Name
+				}
+			}
+
+			return prices, nil
+		},
+		// daisysms only rents US numbers, 187 in sms-activate's country IDs
+		Countries: func(context.Context, sms.Client) ([]Country, error) {
+			return []Country{{ID: "187", Name: "United States", Alpha2: "US", DialCode: 1}}, nil
+		},
+	},
+	"fivesim": {
+		Name:           "fivesim",
+		Catalog:        fivesim.Services,
+		CountryCatalog: fivesim.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return fivesim.NewClient(apiKey), nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			products, err := client.(*fivesim.Client).GetProducts(ctx, fivesim.CountryAny, fivesim.OperatorAny)
+			if err != nil {
+				return nil, err
+			}
+
+			services := make([]Service, len(products))
+			for i, p := range products {
+				services[i] = Service{ID: p.Name, Name: p.Name}
+			}
+
+			return services, nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, _ string, country string) ([]Price, error) {
+			if country == "" {
+				country = fivesim.CountryAny
+			}
+
+			products, err := client.(*fivesim.Client).GetProducts(ctx, country, fivesim.OperatorAny)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(products))
+			for i, p := range products {
+				prices[i] = Price{Service: p.Name, Name: p.Name, Cost: p.Price, Stock: p.Qty}
+			}
+
+			return prices, nil
+		},
+		Countries: func(ctx context.Context, client sms.Client) ([]Country, error) {
+			fivesimCountries, err := client.(*fivesim.Client).GetCountries(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			countries := make([]Country, len(fivesimCountries))
+			for i, c := range fivesimCountries {
+				countries[i] = Country{ID: c.Name, Name: c.Text}
+				if len(c.ISO) > 0 {
+					countries[i].Alpha2 = strings.ToUpper(c.ISO[0])
+				}
+			}
+
+			return countries, nil
+		},
+	},
+	"getatext": {
+		Name:           "getatext",
+		Catalog:        getatext.Services,
+		CountryCatalog: getatext.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return getatext.NewClient(apiKey), nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			getatextServices, err := client.(*getatext.Client).GetServices(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			services := make([]Service, len(getatextServices))
+			for i, s := range getatextServices {
+				services[i] = Service{ID: s.APIName, Name: s.ServiceName}
+			}
+
+			return services, nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, _ string, _ string) ([]Price, error) {
+			getatextServices, err := client.(*getatext.Client).GetServices(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(getatextServices))
+			for i, s := range getatextServices {
+				prices[i] = Price{Service: s.APIName, Name: s.ServiceName, Cost: s.Price, Stock: s.Stock}
+			}
+
+			return prices, nil
+		},
+		// getatext only rents US numbers and takes no country
+		Countries: func(context.Context, sms.Client) ([]Country, error) {
+			return []Country{{ID: "US", Name: "United States", Alpha2: "US", DialCode: 1}}, nil
+		},
+	},
+	"onlinesim": {
+		Name: "onlinesim",
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return onlinesim.NewClient(apiKey), nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, _ string, country string) ([]Price, error) {
+			tariffs, err := client.(*onlinesim.Client).GetTariffs(ctx, country)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(tariffs))
+			for i, t := range tariffs {
+				prices[i] = Price{Service: t.Service, Name: fmt.Sprintf("%s (%s)", t.Name, t.CountryName), Cost: t.Price, Stock: t.Count}
+			}
+
+			return prices, nil
+		},
+		Countries: func(ctx context.Context, client sms.Client) ([]Country, error) {
+			onlinesimCountries, err := client.(*onlinesim.Client).GetCountries(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			countries := make([]Country, len(onlinesimCountries))
+			for i, c := range onlinesimCountries {
+				countries[i] = Country{ID: strconv.Itoa(c.Code), Name: c.Name, DialCode: c.Code}
+			}
+
+			return countries, nil
+		},
+	},
+	"smsman": {
+		Name:           "smsman",
+		Catalog:        smsman.Services,
+		CountryCatalog: smsman.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return smsman.NewClient(apiKey), nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, _ string, country string) ([]Price, error) {
+			if country == "" {
+				return nil, errors.New("smsman lists prices per country")
+			}
+
+			smsmanPrices, err := client.(*smsman.Client).GetPrices(ctx, country)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(smsmanPrices))
+			for i, p := range smsmanPrices {
+				prices[i] = Price{Service: p.ApplicationID, Name: catalogName(smsman.Services, p.ApplicationID), Cost: p.Cost, Stock: p.Count}
+			}
+
+			return prices, nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			applications, err := client.(*smsman.Client).GetApplications(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			services := make([]Service, len(applications))
+			for i, a := range applications {
+				services[i] = Service{ID: a.ID, Name: a.Title}
+			}
+
+			return services, nil
+		},
+		Countries: func(ctx context.Context, client sms.Client) ([]Country, error) {
+			smsmanCountries, err := client.(*smsman.Client).GetCountries(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			countries := make([]Country, len(smsmanCountries))
+			for i, c := range smsmanCountries {
+				countries[i] = Country{ID: c.ID, Name: c.Title, Alpha2: strings.ToUpper(c.Code)}
+			}
+
+			return countries, nil
+		},
+	},
+	"smspool": {
+		Name:           "smspool",
+		Catalog:        smspool.Services,
+		CountryCatalog: smspool.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return smspool.NewClient(apiKey), nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, service string, country string) ([]Price, error) {
+			smspoolPrices, err := client.(*smspool.Client).GetPrices(ctx, service, country)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(smspoolPrices))
+			for i, p := range smspoolPrices {
+				prices[i] = Price{Service: p.Service, Name: fmt.Sprintf("%s (%s)", p.ServiceName, p.CountryName), Cost: p.Cost, Stock: -1}
+			}
+
+			return prices, nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			smspoolServices, err := client.(*smspool.Client).GetServices(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			services := make([]Service, len(smspoolServices))
+			for i, s := range smspoolServices {
+				services[i] = Service{ID: strconv.Itoa(s.Id), Name: s.Name}
+			}
+
+			return services, nil
+		},
+		Countries: func(ctx context.Context, client sms.Client) ([]Country, error) {
+			smspoolCountries, err := client.(*smspool.Client).GetCountries(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			// smspool takes short names wherever it takes a country, which
+			// keeps IDs readable and matches the snapshot
+			countries := make([]Country, len(smspoolCountries))
+			for i, c := range smspoolCountries {
+				countries[i] = Country{ID: strings.ToUpper(c.ShortName), Name: c.Name, Alpha2: strings.ToUpper(c.ShortName)}
+				if c.ShortName == "" {
+					countries[i].ID = strconv.Itoa(c.Id)
+				}
+			}
+
+			return countries, nil
+		},
+	},
+	"smspva": {
+		Name:           "smspva",
+		Catalog:        smspva.Services,
+		CountryCatalog: smspva.Countries,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return smspva.NewClient(apiKey), nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, service string, country string) ([]Price, error) {
+			if service == "" || country == "" {
+				return nil, errors.New("smspva prices one service in one country at a time")
+			}
+
+			cost, err := client.(*smspva.Client).GetPrice(ctx, service, country)
+			if err != nil {
+				return nil, err
+			}
+
+			return []Price{{Service: service, Name: catalogName(smspva.Services, service), Cost: cost, Stock: -1}}, nil
+		},
+	},
+	"textverified": {
+		Name:    "textverified",
+		Catalog: textverified.Services,
+		// the api key is "<api key>", or "<username>:<api key>" to list past
+		// verifications
+		New: func(ctx context.Context, apiKey string) (sms.Client, error) {
+			var username string
+			if i := strings.LastIndex(apiKey, ":"); i >= 0 {
+				username, apiKey = apiKey[:i], apiKey[i+1:]
+			}
+
+			client := textverified.NewClient(apiKey)
+			client.SetUsername(username)
+
+			// authenticate up front to avoid racing KeepAuthAlive
+			if err := client.Authenticate(ctx); err != nil {
+				return nil, fmt.Errorf("textverified: authenticating: %w", err)
+			}
+
+			go client.KeepAuthAlive(ctx)
+
+			return client, nil
+		},
+		Services: func(ctx context.Context, client sms.Client) ([]Service, error) {
+			targets, err := client.(*textverified.Client).GetTargets(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			services := make([]Service, len(targets))
+			for i, t := range targets {
+				services[i] = Service{ID: strconv.Itoa(t.TargetID), Name: t.Name}
+			}
+
+			return services, nil
+		},
+		Prices: func(ctx context.Context, client sms.Client, _ string, _ string) ([]Price, error) {
+			targets, err := client.(*textverified.Client).GetTargets(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			prices := make([]Price, len(targets))
+			for i, t := range targets {
+				prices[i] = Price{Service: strconv.Itoa(t.TargetID), Name: t.Name, Cost: t.Cost, Stock: -1}
+			}
+
+			return prices, nil
+		},
+	},
+	"truverifi": {
+		Name:    "truverifi",
+		Catalog: truverifi.Services,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return truverifi.NewClient(apiKey), nil
+		},
+		// truverifi bills its line as a subscription, services cost nothing more
+		Prices: func(_ context.Context, _ sms.Client, service string, _ string) ([]Price, error) {
+			var prices []Price
+			for _, s := range truverifi.Services {
+				if service == "" || s.ID == service {
+					prices = append(prices, Price{Service: s.ID, Name: s.Name, Stock: -1})
+				}
+			}
+
+			return prices, nil
+		},
+	},
+	"twilio": {
+		Name: "twilio",
+		// the api key is "<account sid>:<auth token>:<number>,<number>..."
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			parts := strings.SplitN(apiKey, ":", 3)
+			if len(parts) != 3 {
+				return nil, errors.New("twilio: api key must be <account sid>:<auth token>:<numbers>")
+			}
+
+			// every process leases from the same pool, so leases are
+			// shared through a store
+			return twilio.NewClient(twilio.Config{
+				AccountSID: parts[0],
+				AuthToken:  parts[1],
+				Numbers:    strings.Split(parts[2], ","),
+				Store:      smsstore.NewJSONLines(twilioLeasesPath()),
+			})
+		},
+	},
+}
+
+func twilioLeasesPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "sms-twilio-leases.jsonl"
+	}
+
+	return filepath.Join(dir, "sms", "twilio-leases.jsonl")
+}
+
+// Get also accepts the path of a generic provider spec ending in .yaml, .yml
+// or .json, the provider is then named after the spec, and "plugin:" followed
+// by the path of a plugin executable, named after the executable
+func Get(name string) (Provider, error) {
+	provider, ok := providers[name]
+	if ok {
+		return provider, nil
+	}
+
+	if path, ok := cutPrefix(name, "plugin:"); ok {
+		return pluginProvider(path), nil
+	}
+
+	switch filepath.Ext(name) {
+	case ".yaml", ".yml", ".json":
+		return genericProvider(name)
+	}
+
+	return Provider{}, fmt.Errorf("unsupported provider %q", name)
+}
+
+func cutPrefix(s string, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
+
+func pluginProvider(path string) Provider {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	return Provider{
+		Name: name,
+		New: func(ctx context.Context, apiKey string) (sms.Client, error) {
+			return smsplugin.Start(ctx, path, apiKey)
+		},
+	}
+}
+
+func genericProvider(path string) (Provider, error) {
+	spec, err := generic.LoadSpec(path)
+	if err != nil {
+		return Provider{}, err
+	}
+
+	return Provider{
+		Name: spec.Name,
+		New: func(_ context.Context, apiKey string) (sms.Client, error) {
+			return generic.NewClient(spec, apiKey)
+		},
+	}, nil
+}
+
+func Names() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -12,7 +12,11 @@ type MatcherFn func(message string) (match string)
 type Matcher struct {
 	MatcherFn MatcherFn
 	Delay     time.Duration
-	Timeout   time.Duration
+	// Timeout bounds the wait, it is cut short if the phone number expires first
+	Timeout time.Duration
+	// AutoExtend prolongs the rental one Delay before it expires when the
+	// client is an ExtendableClient, which providers may charge for
+	AutoExtend bool
 }
 
 func NewMatcher(matcher MatcherFn, delay time.Duration, timeout time.Duration) *Matcher {
@@ -38,6 +42,10 @@ func (m *Matcher) getMatch(ctx context.Context, client Client, phoneNumber *Phon
 	return "", nil
 }
 
+// WaitForMessage polls every Delay until a message matches, Timeout passes or
+// the phone number expires, in which case the messages are polled once more
+// before returning ErrExpired. With AutoExtend the rental is extended instead
+// for as long as the provider prolongs it
 func (m *Matcher) WaitForMessage(ctx context.Context, client Client, phoneNumber *PhoneNumber) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, m.Timeout)
 	defer cancel()
@@ -49,10 +57,66 @@ func (m *Matcher) WaitForMessage(ctx context.Context, client Client, phoneNumber
 	ticker := time.NewTicker(m.Delay)
 	defer ticker.Stop()
 
+	extendable, ok := client.(ExtendableClient)
+	if !m.AutoExtend || !ok {
+		extendable = nil
+	}
+
+	// a nil channel never fires for phone numbers without an expiry
+	var (
+		timer   *time.Timer
+		expired <-chan time.Time
+	)
+	schedule := func() {
+		expiresAt := phoneNumber.ExpiresAt()
+		if expiresAt.IsZero() {
+			expired = nil
+			return
+		}
+
+		wait := time.Until(expiresAt)
+		if extendable != nil {
+			wait -= m.Delay
+		}
+
+		if timer == nil {
+			timer = time.NewTimer(wait)
+		} else {
+			timer.Reset(wait)
+		}
+		expired = timer.C
+	}
+	schedule()
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return "", fmt.Errorf("sms: waiting for messages: %w", ctx.Err())
+		case <-expired:
+			// a message may have arrived since the last poll
+			if match, err := m.getMatch(ctx, client, phoneNumber); err != nil || match != "" {
+				return match, err
+			}
+
+			if extendable == nil {
+				return "", ErrExpired
+			}
+
+			expiresAt := phoneNumber.ExpiresAt()
+			if err := extendable.ExtendPhoneNumber(ctx, phoneNumber); err != nil {
+				return "", fmt.Errorf("sms: extending phone number: %w", err)
+			}
+
+			// wait out the rental once it is no longer prolonged
+			if !phoneNumber.ExpiresAt().After(expiresAt) {
+				extendable = nil
+			}
+			schedule()
 		case <-ticker.C:
 			if match, err := m.getMatch(ctx, client, phoneNumber); err != nil || match != "" {
 				return match, err
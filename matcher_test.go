@@ -0,0 +1,164 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// pollClient delivers messages once its deliver time has passed
+type pollClient struct {
+	mu       sync.Mutex
+	deliver  time.Time
+	messages []string
+	polls    int
+}
+
+func (c *pollClient) GetPhoneNumber(context.Context, string, string) (*PhoneNumber, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *pollClient) GetMessages(context.Context, *PhoneNumber) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.polls++
+	if time.Now().Before(c.deliver) {
+		return []string{}, nil
+	}
+
+	return c.messages, nil
+}
+
+func (c *pollClient) CancelPhoneNumber(context.Context, *PhoneNumber) error { return nil }
+func (c *pollClient) ReportPhoneNumber(context.Context, *PhoneNumber) error { return nil }
+
+func newTestPhoneNumber(t *testing.T, expiresIn time.Duration) *PhoneNumber {
+	t.Helper()
+
+	number, err := phonenumbers.Parse("+12025550123", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	phoneNumber := NewPhoneNumber(number, Order{Provider: "test", ID: "1"}, nil)
+	if expiresIn > 0 {
+		phoneNumber.SetExpiresAt(time.Now().Add(expiresIn))
+	}
+
+	return phoneNumber
+}
+
+func matchAll(message string) string {
+	return message
+}
+
+func TestWaitForMessagePollsOnceMoreAtExpiry(t *testing.T) {
+	// the message arrives after the last tick but before the expiry
+	client := &pollClient{deliver: time.Now().Add(120 * time.Millisecond), messages: []string{"123456"}}
+	phoneNumber := newTestPhoneNumber(t, 150*time.Millisecond)
+
+	matcher := NewMatcher(matchAll, 100*time.Millisecond, time.Second)
+	match, err := matcher.WaitForMessage(context.Background(), client, phoneNumber)
+	if err != nil {
+		t.Fatalf("WaitForMessage: %v", err)
+	}
+	if match != "123456" {
+		t.Fatalf("match = %q, want 123456", match)
+	}
+}
+
+func TestWaitForMessageExpired(t *testing.T) {
+	client := &pollClient{deliver: time.Now().Add(time.Hour)}
+	phoneNumber := newTestPhoneNumber(t, 50*time.Millisecond)
+
+	matcher := NewMatcher(matchAll, 20*time.Millisecond, time.Second)
+	_, err := matcher.WaitForMessage(context.Background(), client, phoneNumber)
+	if !errors.Is(err, ErrExpired) {
+		t.Fatalf("err = %v, want ErrExpired", err)
+	}
+}
+
+func TestWaitForMessageTimeout(t *testing.T) {
+	client := &pollClient{deliver: time.Now().Add(time.Hour)}
+	phoneNumber := newTestPhoneNumber(t, 0)
+
+	matcher := NewMatcher(matchAll, 10*time.Millisecond, 50*time.Millisecond)
+	_, err := matcher.WaitForMessage(context.Background(), client, phoneNumber)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if client.polls < 2 {
+		t.Fatalf("polled %d times, want at least 2", client.polls)
+	}
+}
+
+// extendClient prolongs rentals by extension, extensions times at most
+type extendClient struct {
+	pollClient
+	extension  time.Duration
+	extensions int
+	extended   int
+}
+
+func (c *extendClient) ExtendPhoneNumber(_ context.Context, phoneNumber *PhoneNumber) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.extended < c.extensions {
+		c.extended++
+		phoneNumber.SetExpiresAt(phoneNumber.ExpiresAt().Add(c.extension))
+	}
+
+	return nil
+}
+
+func TestWaitForMessageAutoExtend(t *testing.T) {
+	newClient := func() *extendClient {
+		return &extendClient{
+			pollClient: pollClient{deliver: time.Now().Add(150 * time.Millisecond), messages: []string{"123456"}},
+			extension:  100 * time.Millisecond,
+			extensions: 1,
+		}
+	}
+
+	// off by default
+	client := newClient()
+	matcher := NewMatcher(matchAll, 20*time.Millisecond, time.Second)
+	if _, err := matcher.WaitForMessage(context.Background(), client, newTestPhoneNumber(t, 100*time.Millisecond)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("err = %v, want ErrExpired without AutoExtend", err)
+	}
+	if client.extended != 0 {
+		t.Fatalf("extended %d times without AutoExtend", client.extended)
+	}
+
+	client = newClient()
+	matcher.AutoExtend = true
+	match, err := matcher.WaitForMessage(context.Background(), client, newTestPhoneNumber(t, 100*time.Millisecond))
+	if err != nil {
+		t.Fatalf("WaitForMessage: %v", err)
+	}
+	if match != "123456" || client.extended != 1 {
+		t.Fatalf("match = %q after %d extensions, want 123456 after 1", match, client.extended)
+	}
+}
+
+func TestWaitForMessageAutoExtendRefused(t *testing.T) {
+	// the provider stops prolonging the rental, which then runs out
+	client := &extendClient{pollClient: pollClient{deliver: time.Now().Add(time.Hour)}}
+	phoneNumber := newTestPhoneNumber(t, 100*time.Millisecond)
+	expiresAt := phoneNumber.ExpiresAt()
+
+	matcher := NewMatcher(matchAll, 20*time.Millisecond, time.Second)
+	matcher.AutoExtend = true
+	if _, err := matcher.WaitForMessage(context.Background(), client, phoneNumber); !errors.Is(err, ErrExpired) {
+		t.Fatalf("err = %v, want ErrExpired", err)
+	}
+	if time.Now().Before(expiresAt) {
+		t.Fatal("gave up before the rental expired")
+	}
+}
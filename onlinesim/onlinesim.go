@@ -0,0 +1,548 @@
+package onlinesim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+var (
+	ErrOperationExpired = fmt.Errorf("onlinesim: operation expired: %w", sms.ErrExpired)
+	ErrNoOperation      = errors.New("onlinesim: operation not found")
+	// ErrUnsupported is returned by ReportPhoneNumber, onlinesim cannot
+	// report numbers so they can only be cancelled
+	ErrUnsupported = errors.New("onlinesim: reporting numbers is not supported")
+
+	ErrBadKey    = errors.New("onlinesim: wrong api key")
+	ErrNoNumbers = errors.New("onlinesim: no numbers available")
+	ErrNoBalance = errors.New("onlinesim: not enough balance")
+)
+
+const (
+	provider = "onlinesim"
+	baseURL  = "https://onlinesim.io/api/"
+)
+
+// operation states
+const (
+	stateWaiting   = "TZ_NUM_WAIT"
+	stateAnswered  = "TZ_NUM_ANSWER"
+	stateOverEmpty = "TZ_OVER_EMPTY"
+	stateOverOK    = "TZ_OVER_OK"
+	stateDeleted   = "TZ_DELETED"
+)
+
+type Client struct {
+	http    *http.Client
+	apiKey  string
+	baseURL string
+}
+
+var (
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.ActiveClient     = &Client{}
+)
+
+type metadata struct {
+	tzid int64
+}
+
+func NewClient(apiKey string) *Client {
+	return &Client{
+		http:    http.DefaultClient,
+		apiKey:  apiKey,
+		baseURL: baseURL,
+	}
+}
+
+func (c *Client) do(ctx context.Context, path string, query url.Values, response any) error {
+	if query == nil {
+		query = url.Values{}
+	}
+
+	query.Set("apikey", c.apiKey)
+
+	url := c.baseURL + path + "?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return sms.ErrRatelimited
+	}
+
+	if resp.StatusCode > 299 {
+		return fmt.Errorf("onlinesim: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// apiResponse is embedded by every response, response is 1 (as a number or
+// string) on success and an error code otherwise
+type apiResponse struct {
+	Response json.RawMessage `json:"response"`
+}
+
+func (r apiResponse) err() error {
+	var code string
+	if err := json.Unmarshal(r.Response, &code); err != nil {
+		// numeric responses are successes
+		return nil
+	}
+
+	switch code {
+	case "1", "":
+		return nil
+	case "ERROR_WRONG_KEY":
+		return ErrBadKey
+	case "NO_NUMBER", "NO_NUMBER_FOR_FORWARD":
+		return ErrNoNumbers
+	case "WARNING_LOW_BALANCE", "NO_BALANCE":
+		return ErrNoBalance
+	case "ERROR_NO_OPERATIONS", "NO_COMPLETE_TZID":
+		return ErrNoOperation
+	case "TRY_AGAIN_LATER", "INTERVAL_CONCURRENT_REQUESTS_ERROR", "LIMIT_EXCEEDED":
+		return sms.ErrRatelimited
+	default:
+		return fmt.Errorf("onlinesim: %s", code)
+	}
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Cancel:           true,
+		Report:           false,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+		ListActive:       true,
+	}
+}
+
+type getNumResponse struct {
+	apiResponse
+	TZID   int64  `json:"tzid"`
+	Number string `json:"number"`
+}
+
+// GetPhoneNumber rents a number for service, country is the country's dialing
+// code such as "7" or "44"
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	query := url.Values{
+		"service": {service},
+		"number":  {"true"},
+	}
+	if country != "" {
+		query.Set("country", country)
+	}
+
+	var res getNumResponse
+	if err := c.do(ctx, "getNum.php", query, &res); err != nil {
+		return nil, err
+	}
+
+	if err := res.err(); err != nil {
+		return nil, err
+	}
+
+	number, err := phonenumbers.Parse(res.Number, "")
+	if err != nil {
+		return nil, fmt.Errorf("onlinesim: parsing phone number (%s): %w", res.Number, err)
+	}
+
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       strconv.FormatInt(res.TZID, 10),
+		Service:  service,
+		Country:  country,
+	}, metadata{tzid: res.TZID}), nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	tzid, err := strconv.ParseInt(rental.ID, 10, 64)
+	if err != nil {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	return rental.PhoneNumber(metadata{tzid: tzid})
+}
+
+// Message is one message received by an operation
+type Message struct {
+	Service string `json:"service"`
+	Text    string `json:"msg"`
+}
+
+// Operation is the state of a rented number
+type Operation struct {
+	TZID     int64     `json:"tzid"`
+	Number   string    `json:"number"`
+	Service  string    `json:"service"`
+	Country  int       `json:"country"`
+	Sum      float64   `json:"sum"`
+	State    string    `json:"response"`
+	Time     int       `json:"time"`
+	Messages []Message `json:"msg"`
+}
+
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	type operation Operation
+	var raw struct {
+		operation
+		Messages json.RawMessage `json:"msg"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*o = Operation(raw.operation)
+	o.Messages = nil
+
+	// msg is absent until a message arrives, then a list
+	if len(raw.Messages) > 0 && raw.Messages[0] == '[' {
+		return json.Unmarshal(raw.Messages, &o.Messages)
+	}
+
+	return nil
+}
+
+// getOperations lists the open operations, or only tzid's when it is set
+func (c *Client) getOperations(ctx context.Context, tzid int64) ([]Operation, error) {
+	query := url.Values{
+		"message_to_code": {"0"},
+		"msg_list":        {"1"},
+		"clean":           {"0"},
+	}
+	if tzid != 0 {
+		query.Set("tzid", strconv.FormatInt(tzid, 10))
+	}
+
+	var raw json.RawMessage
+	if err := c.do(ctx, "getState.php", query, &raw); err != nil {
+		return nil, err
+	}
+
+	// errors are an object, operations a list
+	if len(raw) > 0 && raw[0] == '{' {
+		var res apiResponse
+		if err := json.Unmarshal(raw, &res); err != nil {
+			return nil, err
+		}
+
+		if err := res.err(); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrNoOperation
+	}
+
+	var operations []Operation
+	if err := json.Unmarshal(raw, &operations); err != nil {
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+// GetOperation returns the state of phoneNumber with every message received
+// so far
+func (c *Client) GetOperation(ctx context.Context, phoneNumber *sms.PhoneNumber) (*Operation, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	operations, err := c.getOperations(ctx, metadata.tzid)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operation := range operations {
+		if operation.TZID == metadata.tzid {
+			if operation.Time > 0 {
+				phoneNumber.SetExpiresAt(time.Now().Add(time.Duration(operation.Time) * time.Second))
+			}
+
+			if len(operation.Messages) > 0 {
+				phoneNumber.MarkUsed()
+			}
+
+			return &operation, nil
+		}
+	}
+
+	return nil, ErrNoOperation
+}
+
+// ListActive lists the operations that are still open, the country of each is
+// its dialing code as GetPhoneNumber takes it
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	operations, err := c.getOperations(ctx, 0)
+	if errors.Is(err, ErrNoOperation) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	phoneNumbers := make([]*sms.PhoneNumber, 0, len(operations))
+	for _, operation := range operations {
+		if operation.State != stateWaiting && operation.State != stateAnswered {
+			continue
+		}
+
+		number, err := phonenumbers.Parse(operation.Number, "")
+		if err != nil {
+			return nil, fmt.Errorf("onlinesim: parsing phone number (%s): %w", operation.Number, err)
+		}
+
+		phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+			Provider: provider,
+			ID:       strconv.FormatInt(operation.TZID, 10),
+			Service:  operation.Service,
+			Country:  strconv.Itoa(operation.Country),
+			Cost:     operation.Sum,
+		}, metadata{tzid: operation.TZID})
+
+		if operation.Time > 0 {
+			phoneNumber.SetExpiresAt(time.Now().Add(time.Duration(operation.Time) * time.Second))
+		}
+		if len(operation.Messages) > 0 {
+			phoneNumber.MarkUsed()
+		}
+
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+	}
+
+	return phoneNumbers, nil
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	operation, err := c.GetOperation(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation.State == stateOverEmpty {
+		return nil, ErrOperationExpired
+	}
+
+	messages := make([]string, len(operation.Messages))
+	for i, message := range operation.Messages {
+		messages[i] = message.Text
+	}
+
+	return messages, nil
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	operation, err := c.GetOperation(ctx, phoneNumber)
+	if err != nil {
+		if errors.Is(err, ErrNoOperation) && phoneNumber.Cancelled() {
+			status := sms.StatusCancelled
+			if phoneNumber.Used() {
+				status = sms.StatusFinished
+			}
+			return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+		}
+		return nil, err
+	}
+
+	var status sms.Status
+	switch operation.State {
+	case stateWaiting:
+		status = sms.StatusWaiting
+	case stateAnswered:
+		status = sms.StatusReceived
+	case stateOverEmpty:
+		status = sms.StatusExpired
+	case stateOverOK:
+		status = sms.StatusFinished
+	case stateDeleted:
+		status = sms.StatusCancelled
+	default:
+		return nil, fmt.Errorf("onlinesim: unknown state %q", operation.State)
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
+// CancelPhoneNumber closes the operation, which is refunded when no message
+// was received
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if phoneNumber.Cancelled() {
+		return nil
+	}
+
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	var res apiResponse
+	if err := c.do(ctx, "setOperationOk.php", url.Values{
+		"tzid": {strconv.FormatInt(metadata.tzid, 10)},
+	}, &res); err != nil {
+		return err
+	}
+
+	if err := res.err(); err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+// ReportPhoneNumber fails with ErrUnsupported, use CancelPhoneNumber instead
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return ErrUnsupported
+}
+
+type balanceResponse struct {
+	apiResponse
+	Balance json.Number `json:"balance"`
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	var res balanceResponse
+	if err := c.do(ctx, "getBalance.php", nil, &res); err != nil {
+		return 0, err
+	}
+
+	if err := res.err(); err != nil {
+		return 0, err
+	}
+
+	bal, err := res.Balance.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("onlinesim: parsing balance %q: %w", res.Balance, err)
+	}
+
+	return bal, nil
+}
+
+// Tariff is what a service costs in a country
+type Tariff struct {
+	// Country is the country's dialing code
+	Country     int     `json:"country"`
+	CountryName string  `json:"country_name"`
+	Service     string  `json:"service"`
+	Name        string  `json:"name"`
+	Price       float64 `json:"price"`
+	Count       int     `json:"count"`
+}
+
+type tariffsCountry struct {
+	Name     string `json:"name"`
+	Code     int    `json:"code"`
+	Enabled  bool   `json:"enabled"`
+	Services map[string]struct {
+		Count   int         `json:"count"`
+		Price   json.Number `json:"price"`
+		Service string      `json:"service"`
+		Slug    string      `json:"slug"`
+	} `json:"services"`
+}
+
+// GetTariffs lists the price of every service in every enabled country,
+// sorted by country and service, country narrows the list to one dialing
+// code when it is not empty
+func (c *Client) GetTariffs(ctx context.Context, country string) ([]Tariff, error) {
+	query := url.Values{}
+	if country != "" {
+		query.Set("country", country)
+	}
+
+	// countries are keyed by "_" and their dialing code
+	var res map[string]json.RawMessage
+	if err := c.do(ctx, "getTariffs.php", query, &res); err != nil {
+		return nil, err
+	}
+
+	var tariffs []Tariff
+	for key, value := range res {
+		if !strings.HasPrefix(key, "_") {
+			continue
+		}
+
+		var country tariffsCountry
+		if err := json.Unmarshal(value, &country); err != nil {
+			return nil, fmt.Errorf("onlinesim: parsing tariffs of %s: %w", key, err)
+		}
+
+		if !country.Enabled {
+			continue
+		}
+
+		for _, service := range country.Services {
+			// an unparseable price only loses the price
+			price, _ := service.Price.Float64()
+
+			tariffs = append(tariffs, Tariff{
+				Country:     country.Code,
+				CountryName: country.Name,
+				Service:     service.Slug,
+				Name:        service.Service,
+				Price:       price,
+				Count:       service.Count,
+			})
+		}
+	}
+
+	sort.Slice(tariffs, func(i, j int) bool {
+		if tariffs[i].Country != tariffs[j].Country {
+			return tariffs[i].Country < tariffs[j].Country
+		}
+		return tariffs[i].Service < tariffs[j].Service
+	})
+
+	return tariffs, nil
+}
+
+// Country is a country onlinesim rents numbers in
+type Country struct {
+	// Code is the country's dialing code, which GetPhoneNumber expects
+	Code int    `json:"code"`
+	Name string `json:"name"`
+}
+
+// GetCountries lists the enabled countries, sorted by dialing code
+func (c *Client) GetCountries(ctx context.Context) ([]Country, error) {
+	tariffs, err := c.GetTariffs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var countries []Country
+	for _, tariff := range tariffs {
+		if len(countries) == 0 || countries[len(countries)-1].Code != tariff.Country {
+			countries = append(countries, Country{Code: tariff.Country, Name: tariff.CountryName})
+		}
+	}
+
+	return countries, nil
+}
@@ -0,0 +1,118 @@
+package onlinesim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/saucesteals/sms"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("key")
+	c.baseURL = server.URL + "/"
+
+	return c
+}
+
+func TestOperationUnmarshal(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		data     string
+		messages []Message
+	}{
+		{name: "absent", data: `{"tzid": 1, "response": "TZ_NUM_WAIT"}`},
+		{name: "not a list", data: `{"tzid": 1, "response": "TZ_NUM_WAIT", "msg": "no messages"}`},
+		{name: "null", data: `{"tzid": 1, "response": "TZ_NUM_WAIT", "msg": null}`},
+		{
+			name:     "list",
+			data:     `{"tzid": 1, "response": "TZ_NUM_ANSWER", "msg": [{"service": "discord", "msg": "123456"}]}`,
+			messages: []Message{{Service: "discord", Text: "123456"}},
+		},
+	} {
+		var operation Operation
+		if err := json.Unmarshal([]byte(test.data), &operation); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+
+		if operation.TZID != 1 {
+			t.Errorf("%s: tzid = %d, want 1", test.name, operation.TZID)
+		}
+		if fmt.Sprint(operation.Messages) != fmt.Sprint(test.messages) {
+			t.Errorf("%s: messages = %+v, want %+v", test.name, operation.Messages, test.messages)
+		}
+	}
+}
+
+// getState answers getState.php with body
+func getState(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/getState.php" || r.URL.Query().Get("apikey") != "key" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, body)
+	}
+}
+
+func TestGetOperations(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		body  string
+		err   error
+		count int
+	}{
+		{name: "list", body: `[{"tzid": 1, "response": "TZ_NUM_WAIT"}, {"tzid": 2, "response": "TZ_NUM_ANSWER", "msg": [{"msg": "123456"}]}]`, count: 2},
+		{name: "no operations", body: `{"response": "ERROR_NO_OPERATIONS"}`, err: ErrNoOperation},
+		{name: "error", body: `{"response": "ERROR_WRONG_KEY"}`, err: ErrBadKey},
+		{name: "object without error", body: `{"response": 1}`, err: ErrNoOperation},
+	} {
+		c := newTestClient(t, getState(test.body))
+
+		operations, err := c.getOperations(context.Background(), 0)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: err = %v, want %v", test.name, err, test.err)
+		}
+		if len(operations) != test.count {
+			t.Errorf("%s: listed %d operations, want %d", test.name, len(operations), test.count)
+		}
+	}
+}
+
+func TestListActiveWithoutOperations(t *testing.T) {
+	c := newTestClient(t, getState(`{"response": "ERROR_NO_OPERATIONS"}`))
+
+	active, err := c.ListActive(context.Background())
+	if err != nil || len(active) != 0 {
+		t.Fatalf("ListActive = %v, %v, want no operations", active, err)
+	}
+}
+
+func TestReportUnsupported(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("reporting requested %s", r.URL.Path)
+	}))
+
+	phoneNumber, err := c.RestorePhoneNumber(context.Background(), sms.Rental{Order: sms.Order{Provider: provider, ID: "1"}, Number: "+12025550100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.ReportPhoneNumber(context.Background(), phoneNumber); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("err = %v, want ErrUnsupported", err)
+	}
+	if phoneNumber.Cancelled() {
+		t.Fatal("reporting cancelled the number")
+	}
+}
@@ -0,0 +1,62 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Rental is a serializable snapshot of a PhoneNumber, provider metadata is
+// rebuilt from it by a RestorableClient
+type Rental struct {
+	Order
+	// Number is in E.164 format
+	Number    string    `json:"number"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Used      bool      `json:"used"`
+	Cancelled bool      `json:"cancelled"`
+	// LastCode and StaleCode are the state of ReceiveCode
+	LastCode  string `json:"last_code,omitempty"`
+	StaleCode bool   `json:"stale_code,omitempty"`
+}
+
+func (p *PhoneNumber) Rental() Rental {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return Rental{
+		Order:     p.order,
+		Number:    phonenumbers.Format(p.PhoneNumber, phonenumbers.E164),
+		ExpiresAt: p.expiresAt,
+		Used:      p.used,
+		Cancelled: p.cancelled,
+		LastCode:  p.lastCode,
+		StaleCode: p.staleCode,
+	}
+}
+
+// PhoneNumber rebuilds the phone number the rental was taken from
+func (r Rental) PhoneNumber(metadata any) (*PhoneNumber, error) {
+	number, err := phonenumbers.Parse(r.Number, "US")
+	if err != nil {
+		return nil, fmt.Errorf("sms: parsing phone number (%s): %w", r.Number, err)
+	}
+
+	return &PhoneNumber{
+		PhoneNumber: number,
+		order:       r.Order,
+		metadata:    metadata,
+		expiresAt:   r.ExpiresAt,
+		used:        r.Used,
+		cancelled:   r.Cancelled,
+		lastCode:    r.LastCode,
+		staleCode:   r.StaleCode,
+	}, nil
+}
+
+type RestorableClient interface {
+	Client
+	RestorePhoneNumber(ctx context.Context, rental Rental) (*PhoneNumber, error)
+}
@@ -3,6 +3,9 @@ package sms
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
 )
@@ -10,37 +13,202 @@ import (
 var (
 	ErrInvalidMetadata = errors.New("sms: invalid metadata type")
 	ErrRatelimited     = errors.New("sms: ratelimited")
+	ErrExpired         = errors.New("sms: phone number expired")
+	ErrInvalidState    = errors.New("sms: invalid phone number state")
+	ErrBudgetExceeded  = errors.New("sms: budget exceeded")
 )
 
+// PhoneNumber is safe for concurrent use, e.g. polling messages from one
+// goroutine while cancelling from another
 type PhoneNumber struct {
 	*phonenumbers.PhoneNumber
-	Metadata any
 
+	order Order
+
+	mu        sync.RWMutex
+	metadata  any
+	expiresAt time.Time
 	used      bool
 	cancelled bool
+	// lastCode is the latest code of providers that only report one, and
+	// staleCode is true while it predates the last reuse
+	lastCode  string
+	staleCode bool
+}
+
+// Order describes a rental as the provider billed it
+type Order struct {
+	Provider string `json:"provider"`
+	ID       string `json:"id,omitempty"`
+	Service  string `json:"service"`
+	Country  string `json:"country"`
+	// Cost is in the provider's account currency, zero when the provider does not report it
+	Cost     float64   `json:"cost"`
+	RentedAt time.Time `json:"rented_at"`
+}
+
+// NewPhoneNumber defaults order.RentedAt to now
+func NewPhoneNumber(number *phonenumbers.PhoneNumber, order Order, metadata any) *PhoneNumber {
+	if order.RentedAt.IsZero() {
+		order.RentedAt = time.Now()
+	}
+
+	return &PhoneNumber{PhoneNumber: number, metadata: metadata, order: order}
+}
+
+func (p *PhoneNumber) Provider() string {
+	return p.order.Provider
+}
+
+func (p *PhoneNumber) OrderID() string {
+	return p.order.ID
+}
+
+func (p *PhoneNumber) Service() string {
+	return p.order.Service
+}
+
+func (p *PhoneNumber) Country() string {
+	return p.order.Country
+}
+
+func (p *PhoneNumber) Cost() float64 {
+	return p.order.Cost
+}
+
+func (p *PhoneNumber) RentedAt() time.Time {
+	return p.order.RentedAt
+}
+
+func (p *PhoneNumber) Order() Order {
+	return p.order
+}
+
+// Metadata is the provider specific state of the rental
+func (p *PhoneNumber) Metadata() any {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.metadata
+}
+
+func (p *PhoneNumber) SetMetadata(metadata any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.metadata = metadata
+}
+
+// UpdateMetadata replaces the metadata with what update returns for it, with
+// no other change to the metadata in between
+func (p *PhoneNumber) UpdateMetadata(update func(metadata any) any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.metadata = update(p.metadata)
+}
+
+// ReceiveCode records code as the latest one of a provider that only reports
+// its latest code, it returns false when code was received before the last
+// reuse and is not a new message
+func (p *PhoneNumber) ReceiveCode(code string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.staleCode && p.lastCode == code {
+		return false
+	}
+
+	p.lastCode = code
+	p.staleCode = false
+	return true
+}
+
+// StaleCode reports whether code was received before the last reuse
+func (p *PhoneNumber) StaleCode(code string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.staleCode && p.lastCode == code
 }
 
 func (p *PhoneNumber) MarkUsed() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.used = true
 }
 
-func (p *PhoneNumber) Reuse() {
+// CanReuse returns the error Reuse would, without changing any state
+func (p *PhoneNumber) CanReuse() error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.canReuse()
+}
+
+func (p *PhoneNumber) canReuse() error {
+	if p.cancelled {
+		return fmt.Errorf("%w: cannot reuse a cancelled phone number", ErrInvalidState)
+	}
+
+	return nil
+}
+
+func (p *PhoneNumber) Reuse() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.canReuse(); err != nil {
+		return err
+	}
+
 	p.used = false
-	p.cancelled = false
+	p.staleCode = p.lastCode != ""
+	return nil
 }
 
 func (p *PhoneNumber) Used() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.used
 }
 
 func (p *PhoneNumber) MarkCancelled() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.cancelled = true
 }
 
 func (p *PhoneNumber) Cancelled() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	return p.cancelled
 }
 
+// ExpiresAt is zero when the provider does not report when the rental expires
+func (p *PhoneNumber) ExpiresAt() time.Time {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.expiresAt
+}
+
+func (p *PhoneNumber) SetExpiresAt(expiresAt time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.expiresAt = expiresAt
+}
+
+func (p *PhoneNumber) Expired() bool {
+	expiresAt := p.ExpiresAt()
+	return !expiresAt.IsZero() && !time.Now().Before(expiresAt)
+}
+
 func (p *PhoneNumber) Format(format phonenumbers.PhoneNumberFormat) string {
 	return phonenumbers.Format(p.PhoneNumber, format)
 }
@@ -56,3 +224,18 @@ type ReusableClient interface {
 	Client
 	ReusePhoneNumber(ctx context.Context, phoneNumber *PhoneNumber) (*PhoneNumber, error)
 }
+
+// ExtendableClient is implemented by providers that can prolong a rental
+// before it expires, ExtendPhoneNumber must update the phone number's
+// ExpiresAt
+type ExtendableClient interface {
+	Client
+	ExtendPhoneNumber(ctx context.Context, phoneNumber *PhoneNumber) error
+}
+
+// ActiveClient lists the numbers the account is still paying for from the
+// provider itself, so they can be polled or cancelled without local state
+type ActiveClient interface {
+	Client
+	ListActive(ctx context.Context) ([]*PhoneNumber, error)
+}
@@ -0,0 +1,86 @@
+package sms
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestReceiveCodeSurvivesRestore(t *testing.T) {
+	phoneNumber := newTestPhoneNumber(t, 0)
+
+	if !phoneNumber.ReceiveCode("111111") {
+		t.Fatal("first code was not received")
+	}
+	if err := phoneNumber.Reuse(); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := phoneNumber.Rental().PhoneNumber(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !restored.StaleCode("111111") {
+		t.Fatal("code received before the reuse is not stale after a restore")
+	}
+	if restored.ReceiveCode("111111") {
+		t.Fatal("code received before the reuse was received again")
+	}
+	if !restored.ReceiveCode("222222") {
+		t.Fatal("code received after the reuse was not received")
+	}
+	if restored.StaleCode("222222") {
+		t.Fatal("code received after the reuse is stale")
+	}
+}
+
+func TestUpdateMetadataIsAtomic(t *testing.T) {
+	phoneNumber := newTestPhoneNumber(t, 0)
+	phoneNumber.SetMetadata(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			phoneNumber.UpdateMetadata(func(metadata any) any {
+				return metadata.(int) + 1
+			})
+		}()
+	}
+	wg.Wait()
+
+	if n := phoneNumber.Metadata().(int); n != 100 {
+		t.Fatalf("metadata = %d, want 100", n)
+	}
+}
+
+func TestNewRecordRefunds(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		rental   Rental
+		messages []string
+		status   Status
+		refunded bool
+	}{
+		{name: "waiting", status: StatusWaiting},
+		{name: "cancelled", rental: Rental{Cancelled: true}, status: StatusCancelled, refunded: true},
+		{name: "used", rental: Rental{Used: true}, status: StatusReceived},
+		{name: "finished", rental: Rental{Used: true, Cancelled: true}, status: StatusFinished},
+		{name: "finished with messages", rental: Rental{Cancelled: true}, messages: []string{"123456"}, status: StatusFinished},
+	} {
+		record := NewRecord(test.rental, test.messages)
+		if record.Status != test.status {
+			t.Errorf("%s: status = %s, want %s", test.name, record.Status, test.status)
+		}
+		if record.Refunded() != test.refunded {
+			t.Errorf("%s: refunded = %t, want %t", test.name, record.Refunded(), test.refunded)
+		}
+	}
+
+	reported := NewRecord(Rental{}, nil)
+	reported.Status = StatusReported
+	if !reported.Refunded() {
+		t.Error("reported rental that was never used is not refunded")
+	}
+}
@@ -0,0 +1,544 @@
+package smsactivate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+var (
+	ErrBadKey       = errors.New("smsactivate: bad api key")
+	ErrBadService   = errors.New("smsactivate: bad service")
+	ErrNoNumbers    = errors.New("smsactivate: no numbers available")
+	ErrNoBalance    = errors.New("smsactivate: insufficient balance")
+	ErrNoActivation = errors.New("smsactivate: no such activation")
+	ErrCancelled    = errors.New("smsactivate: activation was cancelled")
+)
+
+// Quirks are the ways a vendor deviates from sms-activate's protocol
+type Quirks struct {
+	// Region is the country of every number when the vendor only rents from
+	// one, the country passed to GetPhoneNumber is then ignored
+	Region string
+	// NoRetry is set when the vendor does not accept setStatus 3, reusing a
+	// number then only waits for a code different from the last one
+	NoRetry bool
+}
+
+type Config struct {
+	// Provider names the vendor on rented numbers and in errors
+	Provider string
+	// BaseURL is the vendor's handler_api.php endpoint
+	BaseURL    string
+	APIKey     string
+	Quirks     Quirks
+	HTTPClient *http.Client
+}
+
+// Client speaks sms-activate's handler_api.php protocol, which many vendors
+// implement
+type Client struct {
+	http   *http.Client
+	config Config
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.ActiveClient     = &Client{}
+)
+
+type metadata struct {
+	id string
+}
+
+func NewClient(config Config) *Client {
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{http: httpClient, config: config}
+}
+
+// responseError maps the protocol's error responses, unknown responses are
+// reported as is
+func (c *Client) responseError(res string) error {
+	switch res {
+	case badKey:
+		return ErrBadKey
+	case badService:
+		return ErrBadService
+	case noNumbers:
+		return ErrNoNumbers
+	case noBalance:
+		return ErrNoBalance
+	case noActivation, noActivations, wrongActivationID:
+		return ErrNoActivation
+	case tooManyRequests:
+		return sms.ErrRatelimited
+	default:
+		return fmt.Errorf("%s: unexpected response %q", c.config.Provider, res)
+	}
+}
+
+func (c *Client) do(ctx context.Context, query url.Values) (string, error) {
+	query.Set("api_key", c.config.APIKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.config.BaseURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	content := strings.TrimSpace(string(data))
+
+	if content == tooManyRequests {
+		return "", sms.ErrRatelimited
+	}
+
+	return content, nil
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: c.config.Quirks.Region == "",
+		Reuse:            true,
+		Cancel:           true,
+		// the protocol has no way to report a number, reporting cancels it
+		Report:           false,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+		ListActive:       true,
+	}
+}
+
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	query := url.Values{
+		"action":  {"getNumber"},
+		"service": {service},
+	}
+
+	region := c.config.Quirks.Region
+	if region == "" {
+		query.Set("country", country)
+	} else {
+		country = region
+	}
+
+	res, err := c.do(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(res, accessNumber) {
+		return nil, c.responseError(res)
+	}
+
+	numCols := 3
+	parts := strings.SplitN(res, ":", numCols)
+	if len(parts) != numCols {
+		return nil, fmt.Errorf("%s: invalid phone format %q", c.config.Provider, res)
+	}
+
+	id := parts[1]
+	number, err := phonenumbers.Parse("+"+parts[2], "US")
+	if err != nil {
+		return nil, fmt.Errorf("%s: parsing phone number for %q", c.config.Provider, res)
+	}
+
+	// getNumber does not answer the cost, the number is already paid for so
+	// an unknown cost only loses the cost
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: c.config.Provider,
+		ID:       id,
+		Service:  service,
+		Country:  country,
+		Cost:     c.price(ctx, service, country),
+	}, metadata{id: id}), nil
+}
+
+// price returns what renting service in country costs, or zero when it
+// cannot be listed
+func (c *Client) price(ctx context.Context, service string, country string) float64 {
+	prices, err := c.GetPrices(ctx, service, country)
+	if err != nil {
+		return 0
+	}
+
+	for _, p := range prices {
+		if p.Service == service {
+			return p.Cost
+		}
+	}
+
+	return 0
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{id: rental.ID})
+}
+
+func (c *Client) setStatus(ctx context.Context, id string, status string, success string) error {
+	res, err := c.do(ctx, url.Values{
+		"action": {"setStatus"},
+		"status": {status},
+		"id":     {id},
+	})
+	if err != nil {
+		return err
+	}
+
+	if res != success {
+		return c.responseError(res)
+	}
+
+	return nil
+}
+
+// CancelPhoneNumber completes used activations and cancels unused ones
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if phoneNumber.Cancelled() {
+		return nil
+	}
+
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	var err error
+	if phoneNumber.Used() {
+		err = c.setStatus(ctx, metadata.id, setStatusComplete, accessActivation)
+	} else {
+		err = c.setStatus(ctx, metadata.id, setStatusCancel, accessCancel)
+	}
+	if err != nil {
+		return err
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return c.CancelPhoneNumber(ctx, phoneNumber)
+}
+
+// ReusePhoneNumber requests another code on the same activation
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	if err := phoneNumber.CanReuse(); err != nil {
+		return nil, err
+	}
+
+	if !c.config.Quirks.NoRetry {
+		if err := c.setStatus(ctx, metadata.id, setStatusRetry, accessRetryGet); err != nil {
+			return nil, err
+		}
+	}
+
+	// the code received so far is stale until another one arrives
+	if err := phoneNumber.Reuse(); err != nil {
+		return nil, err
+	}
+
+	return phoneNumber, nil
+}
+
+// getStatus returns the activation's status and its last code, if any
+func (c *Client) getStatus(ctx context.Context, phoneNumber *sms.PhoneNumber, metadata metadata) (string, string, error) {
+	res, err := c.do(ctx, url.Values{
+		"action": {"getStatus"},
+		"id":     {metadata.id},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	status, code, _ := strings.Cut(res, ":")
+	switch status {
+	case statusWaitCode, statusWaitResend, statusWaitRetry, statusCancel:
+		return status, code, nil
+	case statusOK:
+		if phoneNumber.StaleCode(code) {
+			return statusWaitRetry, code, nil
+		}
+		return status, code, nil
+	default:
+		return "", "", c.responseError(res)
+	}
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	status, code, err := c.getStatus(ctx, phoneNumber, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case statusCancel:
+		return nil, ErrCancelled
+	case statusOK:
+		if !phoneNumber.ReceiveCode(code) {
+			return []string{}, nil
+		}
+
+		phoneNumber.MarkUsed()
+		return []string{code}, nil
+	default:
+		return []string{}, nil
+	}
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	status, _, err := c.getStatus(ctx, phoneNumber, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// the protocol does not report when an activation expires
+	switch status {
+	case statusCancel:
+		return sms.NewPhoneNumberStatus(sms.StatusCancelled, time.Time{}), nil
+	case statusOK:
+		if phoneNumber.Cancelled() {
+			return sms.NewPhoneNumberStatus(sms.StatusFinished, time.Time{}), nil
+		}
+		return sms.NewPhoneNumberStatus(sms.StatusReceived, time.Time{}), nil
+	default:
+		return sms.NewPhoneNumberStatus(sms.StatusWaiting, time.Time{}), nil
+	}
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	res, err := c.do(ctx, url.Values{
+		"action": {"getBalance"},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if !strings.HasPrefix(res, accessBalance) {
+		return 0, c.responseError(res)
+	}
+
+	parts := strings.SplitN(res, ":", 2)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("%s: invalid balance format %q", c.config.Provider, res)
+	}
+
+	bal, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: parsing balance %q: %w", c.config.Provider, res, err)
+	}
+
+	return bal, nil
+}
+
+// doJSON is do for actions answering with JSON, or an error response
+func (c *Client) doJSON(ctx context.Context, query url.Values, response any) error {
+	res, err := c.do(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(res, "{") && !strings.HasPrefix(res, "[") {
+		return c.responseError(res)
+	}
+
+	if err := json.Unmarshal([]byte(res), response); err != nil {
+		return fmt.Errorf("%s: decoding %s: %w", c.config.Provider, query.Get("action"), err)
+	}
+
+	return nil
+}
+
+type activeActivation struct {
+	ActivationID   string      `json:"activationId"`
+	ServiceCode    string      `json:"serviceCode"`
+	PhoneNumber    string      `json:"phoneNumber"`
+	ActivationCost json.Number `json:"activationCost"`
+	CountryCode    string      `json:"countryCode"`
+	SMSCode        []string    `json:"smsCode"`
+}
+
+type activeActivationsResponse struct {
+	Status            string             `json:"status"`
+	Error             string             `json:"error"`
+	ActiveActivations []activeActivation `json:"activeActivations"`
+}
+
+// ListActive lists the activations that were neither completed nor cancelled
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	var res activeActivationsResponse
+	if err := c.doJSON(ctx, url.Values{"action": {"getActiveActivations"}}, &res); err != nil {
+		if errors.Is(err, ErrNoActivation) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if res.Status != "success" {
+		if res.Error == noActivations {
+			return nil, nil
+		}
+		return nil, c.responseError(res.Error)
+	}
+
+	phoneNumbers := make([]*sms.PhoneNumber, 0, len(res.ActiveActivations))
+	for _, a := range res.ActiveActivations {
+		number, err := phonenumbers.Parse("+"+a.PhoneNumber, "US")
+		if err != nil {
+			return nil, fmt.Errorf("%s: parsing phone number (%s): %w", c.config.Provider, a.PhoneNumber, err)
+		}
+
+		country := a.CountryCode
+		if c.config.Quirks.Region != "" {
+			country = c.config.Quirks.Region
+		}
+
+		// the activation is already paid for, so an unparseable cost only loses the cost
+		cost, _ := a.ActivationCost.Float64()
+
+		phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+			Provider: c.config.Provider,
+			ID:       a.ActivationID,
+			Service:  a.ServiceCode,
+			Country:  country,
+			Cost:     cost,
+		}, metadata{id: a.ActivationID})
+		if len(a.SMSCode) > 0 {
+			phoneNumber.MarkUsed()
+		}
+
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+	}
+
+	return phoneNumbers, nil
+}
+
+// GetNumbersStatus returns how many numbers are available per service in
+// country, numbers with call forwarding are not counted
+func (c *Client) GetNumbersStatus(ctx context.Context, country string) (map[string]int, error) {
+	query := url.Values{"action": {"getNumbersStatus"}}
+	if country != "" {
+		query.Set("country", country)
+	}
+
+	var res map[string]json.Number
+	if err := c.doJSON(ctx, query, &res); err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]int, len(res))
+	for key, count := range res {
+		service, forward, _ := strings.Cut(key, "_")
+		if forward == "1" {
+			continue
+		}
+
+		n, err := count.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("%s: parsing count of %s %q: %w", c.config.Provider, key, count, err)
+		}
+
+		available[service] = int(n)
+	}
+
+	return available, nil
+}
+
+type Price struct {
+	Country string `json:"country"`
+	Service string `json:"service"`
+	// Name is the service's name, on vendors that report it
+	Name  string  `json:"name,omitempty"`
+	Cost  float64 `json:"cost"`
+	Count int     `json:"count"`
+}
+
+type priceResponse struct {
+	Cost  json.Number `json:"cost"`
+	Count json.Number `json:"count"`
+	Name  string      `json:"name"`
+}
+
+// GetPrices lists prices, service and country may be empty to list all of them
+func (c *Client) GetPrices(ctx context.Context, service string, country string) ([]Price, error) {
+	query := url.Values{"action": {"getPrices"}}
+	if service != "" {
+		query.Set("service", service)
+	}
+	if country != "" && c.config.Quirks.Region == "" {
+		query.Set("country", country)
+	}
+
+	var res map[string]map[string]priceResponse
+	if err := c.doJSON(ctx, query, &res); err != nil {
+		return nil, err
+	}
+
+	var prices []Price
+	for country, services := range res {
+		for service, price := range services {
+			cost, err := price.Cost.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("%s: parsing cost of %s %q: %w", c.config.Provider, service, price.Cost, err)
+			}
+
+			// some vendors omit the count
+			count, _ := price.Count.Int64()
+
+			prices = append(prices, Price{Country: country, Service: service, Name: price.Name, Cost: cost, Count: int(count)})
+		}
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].Country != prices[j].Country {
+			return prices[i].Country < prices[j].Country
+		}
+		return prices[i].Service < prices[j].Service
+	})
+
+	return prices, nil
+}
@@ -0,0 +1,51 @@
+package smsactivate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, quirks Quirks, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(Config{
+		Provider: "test",
+		BaseURL:  server.URL,
+		APIKey:   "key",
+		Quirks:   quirks,
+	})
+}
+
+func TestGetPhoneNumberCost(t *testing.T) {
+	for _, quirks := range []Quirks{{}, {Region: "US"}} {
+		c := newTestClient(t, quirks, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			switch query.Get("action") {
+			case "getNumber":
+				fmt.Fprint(w, "ACCESS_NUMBER:1:12025550100")
+			case "getPrices":
+				country := query.Get("country")
+				if country == "" {
+					country = "187"
+				}
+				fmt.Fprintf(w, `{%q: {%q: {"cost": 0.25, "count": 10}}}`, country, query.Get("service"))
+			default:
+				fmt.Fprint(w, badAction)
+			}
+		}))
+
+		phoneNumber, err := c.GetPhoneNumber(context.Background(), "ds", "12")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost := phoneNumber.Rental().Cost; cost != 0.25 {
+			t.Fatalf("cost = %v with %+v, want 0.25", cost, quirks)
+		}
+	}
+}
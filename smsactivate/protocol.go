@@ -0,0 +1,39 @@
+package smsactivate
+
+// responses of sms-activate's handler_api.php protocol
+const (
+	accessNumber     = "ACCESS_NUMBER"
+	accessBalance    = "ACCESS_BALANCE"
+	accessReady      = "ACCESS_READY"
+	accessRetryGet   = "ACCESS_RETRY_GET"
+	accessActivation = "ACCESS_ACTIVATION"
+	accessCancel     = "ACCESS_CANCEL"
+
+	statusWaitCode   = "STATUS_WAIT_CODE"
+	statusWaitRetry  = "STATUS_WAIT_RETRY"
+	statusWaitResend = "STATUS_WAIT_RESEND"
+	statusOK         = "STATUS_OK"
+	statusCancel     = "STATUS_CANCEL"
+
+	badKey       = "BAD_KEY"
+	badAction    = "BAD_ACTION"
+	badService   = "BAD_SERVICE"
+	badStatus    = "BAD_STATUS"
+	noActivation = "NO_ACTIVATION"
+	// getActiveActivations' error when there are none
+	noActivations = "NO_ACTIVATIONS"
+	noNumbers     = "NO_NUMBERS"
+	noBalance     = "NO_BALANCE"
+	// some vendors answer this instead of NO_ACTIVATION
+	wrongActivationID = "WRONG_ACTIVATION_ID"
+	errorSQL          = "ERROR_SQL"
+	tooManyRequests   = "TOO_MANY_REQUESTS"
+)
+
+// statuses accepted by setStatus
+const (
+	setStatusReady    = "1"
+	setStatusRetry    = "3"
+	setStatusComplete = "6"
+	setStatusCancel   = "8"
+)
@@ -0,0 +1,316 @@
+package smsactivate
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+// Server implements sms-activate's handler_api.php protocol (getNumber,
+// getStatus, setStatus and getBalance) on top of any sms.Client, so tools
+// that only speak sms-activate can use it unmodified. Activations are kept in
+// memory under random IDs, so IDs from before a restart are answered with
+// NO_ACTIVATION instead of reaching another activation
+type Server struct {
+	client sms.Client
+	apiKey string
+
+	mu          sync.Mutex
+	activations map[string]*activation
+}
+
+type activation struct {
+	phoneNumber *sms.PhoneNumber
+	// users counts the requests using the activation, which is not pruned
+	// while in use, it is guarded by the server's mu
+	users int
+
+	mu sync.Mutex
+	// codes are the messages received so far, retrying waits for a new one
+	codes    []string
+	retrying bool
+	// finished is set once the activation was completed
+	finished bool
+}
+
+// done reports whether a can no longer receive messages, activations without
+// an expiry are considered done maxAge after they were rented
+func (a *activation) done(now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch {
+	case a.finished, a.phoneNumber.Cancelled(), a.phoneNumber.Expired():
+		return true
+	case a.phoneNumber.ExpiresAt().IsZero():
+		return now.Sub(a.phoneNumber.RentedAt()) > maxAge
+	default:
+		return false
+	}
+}
+
+// maxAge is how long activations without an expiry are kept
+const maxAge = 24 * time.Hour
+
+// NewServer accepts any api_key when apiKey is empty
+func NewServer(client sms.Client, apiKey string) *Server {
+	return &Server{
+		client:      client,
+		apiKey:      apiKey,
+		activations: map[string]*activation{},
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprint(w, s.handle(r.Context(), r.Form))
+}
+
+func (s *Server) handle(ctx context.Context, form map[string][]string) string {
+	get := func(key string) string {
+		if values := form[key]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	if s.apiKey != "" && subtle.ConstantTimeCompare([]byte(get("api_key")), []byte(s.apiKey)) != 1 {
+		return badKey
+	}
+
+	switch get("action") {
+	case "getNumber":
+		return s.getNumber(ctx, get("service"), get("country"))
+	case "getStatus":
+		return s.getStatus(ctx, get("id"))
+	case "setStatus":
+		return s.setStatus(ctx, get("id"), get("status"))
+	case "getBalance":
+		return s.getBalance(ctx)
+	default:
+		return badAction
+	}
+}
+
+// errorResponse maps client errors to the closest protocol error
+func errorResponse(err error, fallback string) string {
+	switch {
+	case errors.Is(err, sms.ErrRatelimited):
+		return tooManyRequests
+	case errors.Is(err, sms.ErrInvalidMetadata):
+		return noActivation
+	default:
+		return fallback
+	}
+}
+
+func (s *Server) getNumber(ctx context.Context, service string, country string) string {
+	if service == "" {
+		return badService
+	}
+
+	phoneNumber, err := s.client.GetPhoneNumber(ctx, service, country)
+	if err != nil {
+		return errorResponse(err, noNumbers)
+	}
+
+	s.mu.Lock()
+	s.prune(time.Now())
+	id, err := s.newID()
+	if err == nil {
+		s.activations[id] = &activation{phoneNumber: phoneNumber}
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		// the number is rented but cannot be handed out
+		s.client.CancelPhoneNumber(ctx, phoneNumber)
+		return errorSQL
+	}
+
+	number := strings.TrimPrefix(phoneNumber.Format(phonenumbers.E164), "+")
+	return fmt.Sprintf("%s:%s:%s", accessNumber, id, number)
+}
+
+// maxID bounds activation IDs so that clients parsing them as JavaScript
+// numbers read them exactly
+var maxID = big.NewInt(1 << 53)
+
+// newID returns a random activation ID that is not in use, it must be called
+// with mu held
+func (s *Server) newID() (string, error) {
+	for {
+		n, err := rand.Int(rand.Reader, maxID)
+		if err != nil {
+			return "", err
+		}
+
+		id := n.Add(n, big.NewInt(1)).String()
+		if _, ok := s.activations[id]; !ok {
+			return id, nil
+		}
+	}
+}
+
+// prune forgets activations that are done and not in use, it must be called
+// with mu held
+func (s *Server) prune(now time.Time) {
+	for id, a := range s.activations {
+		if a.users == 0 && a.done(now) {
+			delete(s.activations, id)
+		}
+	}
+}
+
+// activation marks the activation with id in use until release is called
+func (s *Server) activation(id string) (a *activation, release func(), ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok = s.activations[id]
+	if !ok {
+		return nil, nil, false
+	}
+
+	a.users++
+	return a, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		a.users--
+	}, true
+}
+
+func (s *Server) getStatus(ctx context.Context, id string) string {
+	a, release, ok := s.activation(id)
+	if !ok {
+		return noActivation
+	}
+	defer release()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.finished || a.phoneNumber.Cancelled() {
+		if len(a.codes) > 0 {
+			return statusOK + ":" + a.codes[len(a.codes)-1]
+		}
+		return statusCancel
+	}
+
+	messages, err := s.client.GetMessages(ctx, a.phoneNumber)
+	if err != nil {
+		if errors.Is(err, sms.ErrExpired) {
+			return statusCancel
+		}
+		return errorResponse(err, errorSQL)
+	}
+
+	for _, message := range messages {
+		if !a.seen(message) {
+			a.codes = append(a.codes, message)
+			a.retrying = false
+		}
+	}
+
+	switch {
+	case a.retrying:
+		return statusWaitRetry + ":" + a.codes[len(a.codes)-1]
+	case len(a.codes) > 0:
+		return statusOK + ":" + a.codes[len(a.codes)-1]
+	default:
+		return statusWaitCode
+	}
+}
+
+func (a *activation) seen(message string) bool {
+	for _, code := range a.codes {
+		if code == message {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) setStatus(ctx context.Context, id string, status string) string {
+	a, release, ok := s.activation(id)
+	if !ok {
+		return noActivation
+	}
+	defer release()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch status {
+	case setStatusReady:
+		return accessReady
+	case setStatusRetry:
+		reusable, ok := s.client.(sms.ReusableClient)
+		if !ok || len(a.codes) == 0 {
+			return badStatus
+		}
+
+		phoneNumber, err := reusable.ReusePhoneNumber(ctx, a.phoneNumber)
+		if err != nil {
+			return errorResponse(err, badStatus)
+		}
+
+		a.phoneNumber = phoneNumber
+		a.retrying = true
+		return accessRetryGet
+	case setStatusComplete:
+		if len(a.codes) == 0 {
+			return badStatus
+		}
+
+		// cancelling a used number finishes it with providers that can, the
+		// rest leave it to run out as it is already paid for
+		a.phoneNumber.MarkUsed()
+		if err := s.client.CancelPhoneNumber(ctx, a.phoneNumber); err != nil {
+			return errorResponse(err, errorSQL)
+		}
+
+		a.finished = true
+		return accessActivation
+	case setStatusCancel:
+		if err := s.client.CancelPhoneNumber(ctx, a.phoneNumber); err != nil {
+			return errorResponse(err, errorSQL)
+		}
+
+		a.phoneNumber.MarkCancelled()
+		return accessCancel
+	default:
+		return badStatus
+	}
+}
+
+func (s *Server) getBalance(ctx context.Context) string {
+	balanceClient, ok := s.client.(sms.BalanceClient)
+	if !ok {
+		return badAction
+	}
+
+	balance, err := balanceClient.GetBalance(ctx)
+	if err != nil {
+		return errorResponse(err, errorSQL)
+	}
+
+	return fmt.Sprintf("%s:%.2f", accessBalance, balance)
+}
@@ -0,0 +1,206 @@
+package smsactivate
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+// fake rents one number whose messages are delivered by receive
+type fake struct {
+	mu        sync.Mutex
+	messages  []string
+	cancelled int
+	// finished counts cancellations of used numbers
+	finished int
+}
+
+func (f *fake) GetPhoneNumber(_ context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	number, err := phonenumbers.Parse("+12025550100", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return sms.NewPhoneNumber(number, sms.Order{Provider: "fake", ID: "1", Service: service, Country: country}, nil), nil
+}
+
+func (f *fake) receive(message string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.messages = append(f.messages, message)
+}
+
+func (f *fake) GetMessages(context.Context, *sms.PhoneNumber) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string{}, f.messages...), nil
+}
+
+func (f *fake) CancelPhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if phoneNumber.Used() {
+		f.finished++
+	} else {
+		f.cancelled++
+	}
+
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (f *fake) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return f.CancelPhoneNumber(ctx, phoneNumber)
+}
+
+func (f *fake) ReusePhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	return phoneNumber, nil
+}
+
+func (f *fake) GetBalance(context.Context) (float64, error) {
+	return 12.5, nil
+}
+
+// newTestServer serves f through a Server, returning a client of it
+func newTestServer(t *testing.T, f *fake) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(NewServer(f, "key"))
+	t.Cleanup(server.Close)
+
+	return NewClient(Config{Provider: "gateway", BaseURL: server.URL, APIKey: "key"})
+}
+
+func TestServerActivation(t *testing.T) {
+	f := &fake{}
+	c := newTestServer(t, f)
+	ctx := context.Background()
+
+	phoneNumber, err := c.GetPhoneNumber(ctx, "ds", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if phoneNumber.Format(phonenumbers.E164) != "+12025550100" {
+		t.Fatalf("number = %s, want the fake's", phoneNumber.Format(phonenumbers.E164))
+	}
+	if _, err := strconv.ParseUint(phoneNumber.Rental().ID, 10, 64); err != nil {
+		t.Fatalf("activation ID %q is not numeric", phoneNumber.Rental().ID)
+	}
+
+	// getStatus
+	if messages, err := c.GetMessages(ctx, phoneNumber); err != nil || len(messages) != 0 {
+		t.Fatalf("GetMessages = %q, %v before any message arrived", messages, err)
+	}
+
+	f.receive("111111")
+	if messages, err := c.GetMessages(ctx, phoneNumber); err != nil || len(messages) != 1 || messages[0] != "111111" {
+		t.Fatalf("GetMessages = %q, %v, want the first code", messages, err)
+	}
+
+	// setStatus 3 waits for another code
+	if _, err := c.ReusePhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	status, err := c.GetStatus(ctx, phoneNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != sms.StatusWaiting {
+		t.Fatalf("status = %s after retrying, want waiting", status.Status)
+	}
+
+	f.receive("222222")
+	if messages, err := c.GetMessages(ctx, phoneNumber); err != nil || len(messages) != 1 || messages[0] != "222222" {
+		t.Fatalf("GetMessages = %q, %v, want the second code", messages, err)
+	}
+
+	// setStatus 6 finishes the used number
+	if err := c.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if f.finished != 1 || f.cancelled != 0 {
+		t.Fatalf("finished %d and cancelled %d numbers, want 1 finished", f.finished, f.cancelled)
+	}
+}
+
+func TestServerCancel(t *testing.T) {
+	f := &fake{}
+	c := newTestServer(t, f)
+	ctx := context.Background()
+
+	phoneNumber, err := c.GetPhoneNumber(ctx, "ds", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// setStatus 8
+	if err := c.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+	if f.cancelled != 1 {
+		t.Fatalf("cancelled %d numbers, want 1", f.cancelled)
+	}
+
+	if _, err := c.GetMessages(ctx, phoneNumber); !errors.Is(err, ErrCancelled) {
+		t.Fatalf("err = %v, want ErrCancelled", err)
+	}
+}
+
+func TestServerIDs(t *testing.T) {
+	c := newTestServer(t, &fake{})
+	ctx := context.Background()
+
+	// IDs are random so they are not reused after a restart
+	ids := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		phoneNumber, err := c.GetPhoneNumber(ctx, "ds", "0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids[phoneNumber.Rental().ID] {
+			t.Fatalf("activation ID %s handed out twice", phoneNumber.Rental().ID)
+		}
+		ids[phoneNumber.Rental().ID] = true
+	}
+	if ids["1"] && ids["2"] {
+		t.Fatal("activation IDs are sequential")
+	}
+
+	// another server knows none of them
+	other := newTestServer(t, &fake{})
+	for id := range ids {
+		phoneNumber, err := other.RestorePhoneNumber(ctx, sms.Rental{Order: sms.Order{Provider: "gateway", ID: id}, Number: "+12025550100"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := other.GetMessages(ctx, phoneNumber); !errors.Is(err, ErrNoActivation) {
+			t.Fatalf("err = %v, want ErrNoActivation", err)
+		}
+	}
+}
+
+func TestServerBalance(t *testing.T) {
+	c := newTestServer(t, &fake{})
+
+	balance, err := c.GetBalance(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != 12.5 {
+		t.Fatalf("balance = %v, want 12.5", balance)
+	}
+
+	c.config.APIKey = "wrong"
+	if _, err := c.GetBalance(context.Background()); !errors.Is(err, ErrBadKey) {
+		t.Fatalf("err = %v, want ErrBadKey", err)
+	}
+}
@@ -0,0 +1,152 @@
+package smsbudget
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// tagLabel is the record label StoreLedger keeps an entry's tag in
+const tagLabel = "budget_tag"
+
+// Entry is what one rental cost
+type Entry struct {
+	sms.Order
+	// Number is in E.164 format
+	Number string `json:"number"`
+	Tag    string `json:"tag,omitempty"`
+	// Refunded is true once the rental was cancelled or reported before it
+	// was used, which providers refund
+	Refunded bool `json:"refunded"`
+}
+
+func (e Entry) key() string {
+	return e.Provider + "/" + e.ID + "/" + e.Number
+}
+
+// Ledger keeps the entries budgets are checked against
+type Ledger interface {
+	// Record saves the entry, or replaces the one of the same rental
+	Record(ctx context.Context, entry Entry) error
+	// Entries returns the entries of rentals rented at or after since
+	Entries(ctx context.Context, since time.Time) ([]Entry, error)
+}
+
+// MemoryLedger is a Ledger that forgets its entries when the process exits
+type MemoryLedger struct {
+	mu      sync.Mutex
+	keys    map[string]int
+	entries []Entry
+}
+
+var (
+	_ Ledger = &MemoryLedger{}
+	_ Ledger = &StoreLedger{}
+)
+
+func NewMemoryLedger() *MemoryLedger {
+	return &MemoryLedger{keys: map[string]int{}}
+}
+
+func (l *MemoryLedger) Record(_ context.Context, entry Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if i, ok := l.keys[entry.key()]; ok {
+		l.entries[i] = entry
+		return nil
+	}
+
+	l.keys[entry.key()] = len(l.entries)
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *MemoryLedger) Entries(_ context.Context, since time.Time) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := []Entry{}
+	for _, entry := range l.entries {
+		if !entry.RentedAt.Before(since) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// StoreLedger keeps entries as the records of an sms.Store, so that budgets
+// hold across restarts and the rentals the ledger records can be restored
+type StoreLedger struct {
+	store sms.Store
+}
+
+func NewStoreLedger(store sms.Store) *StoreLedger {
+	return &StoreLedger{store: store}
+}
+
+// Record saves the entry's rental, or updates the store's record of it
+func (l *StoreLedger) Record(ctx context.Context, entry Entry) error {
+	id := entry.ID
+	if id == "" {
+		id = entry.Number
+	}
+
+	record, err := l.store.Load(ctx, entry.Provider, id)
+	if err != nil && !errors.Is(err, sms.ErrNotFound) {
+		return err
+	}
+	if err != nil || record.Key() != entry.key() {
+		record = sms.NewRecord(sms.Rental{Order: entry.Order, Number: entry.Number}, nil)
+	}
+
+	// the store may have recorded the rental before its cost was known
+	if record.Cost == 0 {
+		record.Cost = entry.Cost
+	}
+
+	if entry.Tag != "" {
+		if record.Labels == nil {
+			record.Labels = map[string]string{}
+		}
+		record.Labels[tagLabel] = entry.Tag
+	}
+
+	if entry.Refunded {
+		record.Cancelled = true
+		record.Status = sms.StatusCancelled
+	}
+
+	record.UpdatedAt = time.Time{}
+	return l.store.Save(ctx, record)
+}
+
+// Entries returns the store's records rented at or after since, whether or
+// not the ledger recorded them. Records cancelled after they were used were
+// paid for and are not refunded
+func (l *StoreLedger) Entries(ctx context.Context, since time.Time) ([]Entry, error) {
+	records, err := l.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for _, record := range records {
+		if record.RentedAt.Before(since) {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Order:    record.Order,
+			Number:   record.Number,
+			Tag:      record.Labels[tagLabel],
+			Refunded: record.Refunded(),
+		})
+	}
+
+	return entries, nil
+}
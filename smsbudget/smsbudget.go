@@ -0,0 +1,367 @@
+// Package smsbudget wraps an sms.Client to record what every rental cost in a
+// ledger and to refuse rentals that would exceed a budget before any money is
+// spent.
+//
+//	client := smsbudget.NewClient(smspool.NewClient(apiKey), smsbudget.Config{
+//		Ledger:   smsbudget.NewStoreLedger(store),
+//		Daily:    20,
+//		Services: map[string]float64{"1106": 5},
+//	})
+//
+//	phoneNumber, err := client.GetPhoneNumber(smsbudget.WithTag(ctx, "signups"), "1106", "US")
+//	if errors.Is(err, sms.ErrBudgetExceeded) {
+//		// stop renting until tomorrow
+//	}
+package smsbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+var ErrUnsupported = errors.New("smsbudget: not supported by the wrapped client")
+
+// ExceededError is the budget a rental was refused for, it matches
+// sms.ErrBudgetExceeded
+type ExceededError struct {
+	// Budget is "daily", "service" or "tag"
+	Budget string
+	// Key is the service or tag of the budget, empty for the daily budget
+	Key   string
+	Limit float64
+	Spent float64
+	// Cost is what the rental was estimated to cost
+	Cost float64
+}
+
+func (e *ExceededError) Error() string {
+	name := e.Budget
+	if e.Key != "" {
+		name += " " + e.Key
+	}
+
+	return fmt.Sprintf("smsbudget: %s budget of %.2f exceeded, %.2f spent and the rental costs %.2f", name, e.Limit, e.Spent, e.Cost)
+}
+
+func (e *ExceededError) Unwrap() error {
+	return sms.ErrBudgetExceeded
+}
+
+type tagKey struct{}
+
+// WithTag returns a context whose rentals are recorded and budgeted under the
+// caller's tag, such as the team or job renting
+func WithTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, tagKey{}, tag)
+}
+
+// Tag returns the tag WithTag set on ctx, if any
+func Tag(ctx context.Context) string {
+	tag, _ := ctx.Value(tagKey{}).(string)
+	return tag
+}
+
+// Config holds the budgets, each is what may be spent per day and zero or
+// absent is no budget. Rentals cancelled or reported before receiving a
+// message are refunded and not counted.
+type Config struct {
+	// Ledger defaults to a MemoryLedger
+	Ledger Ledger
+
+	Daily    float64
+	Services map[string]float64
+	// Tags are budgets per caller tag, see WithTag
+	Tags map[string]float64
+
+	// Price estimates a rental's cost before it is rented, such as from the
+	// provider's price list. Without it rentals are only refused once a budget
+	// is spent, and one rental may overshoot it.
+	Price func(ctx context.Context, service string, country string) (float64, error)
+
+	// Location is where days start, it defaults to time.Local
+	Location *time.Location
+}
+
+// Client rents through the wrapped client within the budgets of its Config,
+// calls the wrapped client does not support fail with ErrUnsupported
+type Client struct {
+	client sms.Client
+	config Config
+
+	mu sync.Mutex
+	// pending are the estimated costs of rentals in flight
+	pending []Entry
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.ActiveClient     = &Client{}
+)
+
+func NewClient(client sms.Client, config Config) *Client {
+	if config.Ledger == nil {
+		config.Ledger = NewMemoryLedger()
+	}
+
+	if config.Location == nil {
+		config.Location = time.Local
+	}
+
+	return &Client{client: client, config: config}
+}
+
+// Unwrap returns the wrapped client
+func (c *Client) Unwrap() sms.Client {
+	return c.client
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.CapabilitiesOf(c.client)
+}
+
+// Spend is what was spent so far today
+type Spend struct {
+	Total    float64
+	Services map[string]float64
+	Tags     map[string]float64
+}
+
+func (c *Client) today() time.Time {
+	now := time.Now().In(c.config.Location)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, c.config.Location)
+}
+
+// Spent returns what was spent so far today according to the ledger
+func (c *Client) Spent(ctx context.Context) (*Spend, error) {
+	entries, err := c.config.Ledger.Entries(ctx, c.today())
+	if err != nil {
+		return nil, err
+	}
+
+	return spent(entries), nil
+}
+
+func spent(entries []Entry) *Spend {
+	spend := &Spend{Services: map[string]float64{}, Tags: map[string]float64{}}
+	for _, entry := range entries {
+		if entry.Refunded {
+			continue
+		}
+
+		spend.Total += entry.Cost
+		spend.Services[entry.Service] += entry.Cost
+		if entry.Tag != "" {
+			spend.Tags[entry.Tag] += entry.Cost
+		}
+	}
+
+	return spend
+}
+
+// check returns the first budget that entry would exceed, if any
+func (c *Client) check(spend *Spend, entry Entry) error {
+	exceeds := func(limit float64, spent float64) bool {
+		return limit > 0 && (spent >= limit || spent+entry.Cost > limit)
+	}
+
+	if exceeds(c.config.Daily, spend.Total) {
+		return &ExceededError{Budget: "daily", Limit: c.config.Daily, Spent: spend.Total, Cost: entry.Cost}
+	}
+
+	if limit := c.config.Services[entry.Service]; exceeds(limit, spend.Services[entry.Service]) {
+		return &ExceededError{Budget: "service", Key: entry.Service, Limit: limit, Spent: spend.Services[entry.Service], Cost: entry.Cost}
+	}
+
+	if entry.Tag != "" {
+		if limit := c.config.Tags[entry.Tag]; exceeds(limit, spend.Tags[entry.Tag]) {
+			return &ExceededError{Budget: "tag", Key: entry.Tag, Limit: limit, Spent: spend.Tags[entry.Tag], Cost: entry.Cost}
+		}
+	}
+
+	return nil
+}
+
+// reserve checks a rental of service in country against the budgets and holds
+// its estimated cost until release, so concurrent rentals cannot overshoot
+// them together
+func (c *Client) reserve(ctx context.Context, service string, country string) (Entry, error) {
+	entry := Entry{Order: sms.Order{Service: service, Country: country}, Tag: Tag(ctx)}
+	if c.config.Price != nil {
+		cost, err := c.config.Price(ctx, service, country)
+		if err != nil {
+			return Entry{}, fmt.Errorf("smsbudget: estimating cost: %w", err)
+		}
+		entry.Cost = cost
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.config.Ledger.Entries(ctx, c.today())
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if err := c.check(spent(append(entries, c.pending...)), entry); err != nil {
+		return Entry{}, err
+	}
+
+	c.pending = append(c.pending, entry)
+	return entry, nil
+}
+
+func (c *Client) release(reserved Entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, entry := range c.pending {
+		if entry == reserved {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// record saves what phoneNumber cost, its estimate when the provider did not
+// report it
+func (c *Client) record(ctx context.Context, phoneNumber *sms.PhoneNumber, reserved Entry) error {
+	entry := Entry{
+		Order:  phoneNumber.Order(),
+		Number: phoneNumber.Rental().Number,
+		Tag:    reserved.Tag,
+	}
+	if entry.Cost == 0 {
+		entry.Cost = reserved.Cost
+	}
+
+	if err := c.config.Ledger.Record(ctx, entry); err != nil {
+		return fmt.Errorf("smsbudget: recording rental: %w", err)
+	}
+
+	return nil
+}
+
+// refund marks phoneNumber refunded once the wrapped client cancelled it
+// unused. Several providers mark used numbers cancelled when finishing them,
+// which is not refunded as the number was paid for
+func (c *Client) refund(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if !phoneNumber.Cancelled() || phoneNumber.Used() {
+		return nil
+	}
+
+	rental := phoneNumber.Rental()
+	if err := c.config.Ledger.Record(ctx, Entry{Order: rental.Order, Number: rental.Number, Refunded: true}); err != nil {
+		return fmt.Errorf("smsbudget: recording refund: %w", err)
+	}
+
+	return nil
+}
+
+// GetPhoneNumber fails with an *ExceededError when the rental would exceed a
+// budget, and otherwise records what it cost. The phone number is returned
+// along with the error when it was rented but could not be recorded.
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	reserved, err := c.reserve(ctx, service, country)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(reserved)
+
+	phoneNumber, err := c.client.GetPhoneNumber(ctx, service, country)
+	if err != nil {
+		return nil, err
+	}
+
+	return phoneNumber, c.record(ctx, phoneNumber, reserved)
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	return c.client.GetMessages(ctx, phoneNumber)
+}
+
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if err := c.client.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	return c.refund(ctx, phoneNumber)
+}
+
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if err := c.client.ReportPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	return c.refund(ctx, phoneNumber)
+}
+
+// ReusePhoneNumber is budgeted like GetPhoneNumber, since some providers rent
+// a new order to reuse a number
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	reusable, ok := c.client.(sms.ReusableClient)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	reserved, err := c.reserve(ctx, phoneNumber.Service(), phoneNumber.Country())
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(reserved)
+
+	reused, err := reusable.ReusePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if reused.OrderID() == phoneNumber.OrderID() && reused.Rental().Number == phoneNumber.Rental().Number {
+		return reused, nil
+	}
+
+	return reused, c.record(ctx, reused, reserved)
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	statusClient, ok := c.client.(sms.StatusClient)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return statusClient.GetStatus(ctx, phoneNumber)
+}
+
+func (c *Client) RestorePhoneNumber(ctx context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	restorable, ok := c.client.(sms.RestorableClient)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return restorable.RestorePhoneNumber(ctx, rental)
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	balanceClient, ok := c.client.(sms.BalanceClient)
+	if !ok {
+		return 0, ErrUnsupported
+	}
+
+	return balanceClient.GetBalance(ctx)
+}
+
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	activeClient, ok := c.client.(sms.ActiveClient)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return activeClient.ListActive(ctx)
+}
@@ -0,0 +1,283 @@
+package smsbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+	"github.com/saucesteals/sms/smsstore"
+)
+
+// fake rents numbers costing one each and, like several providers, marks
+// numbers cancelled when cancelling or reporting them whether or not they
+// were used
+type fake struct {
+	mu     sync.Mutex
+	rented int
+	// block holds GetPhoneNumber until it is closed, when set
+	block chan struct{}
+}
+
+func (f *fake) GetPhoneNumber(_ context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	if f.block != nil {
+		<-f.block
+	}
+
+	f.mu.Lock()
+	f.rented++
+	id := f.rented
+	f.mu.Unlock()
+
+	number, err := phonenumbers.Parse(fmt.Sprintf("+120255501%02d", id), "US")
+	if err != nil {
+		return nil, err
+	}
+
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: "fake",
+		ID:       fmt.Sprint(id),
+		Service:  service,
+		Country:  country,
+		Cost:     1,
+	}, nil), nil
+}
+
+func (f *fake) GetMessages(context.Context, *sms.PhoneNumber) ([]string, error) {
+	return []string{}, nil
+}
+
+func (f *fake) CancelPhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) error {
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (f *fake) ReportPhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) error {
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func price(context.Context, string, string) (float64, error) {
+	return 1, nil
+}
+
+func ledgers(t *testing.T) map[string]Ledger {
+	return map[string]Ledger{
+		"memory": NewMemoryLedger(),
+		"store":  NewStoreLedger(smsstore.NewJSONLines(filepath.Join(t.TempDir(), "rentals.jsonl"))),
+	}
+}
+
+func mustSpend(t *testing.T, c *Client, want float64) {
+	t.Helper()
+
+	spend, err := c.Spent(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spend.Total != want {
+		t.Fatalf("spent %.2f, want %.2f", spend.Total, want)
+	}
+}
+
+func TestRefunds(t *testing.T) {
+	for name, ledger := range ledgers(t) {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(&fake{}, Config{Ledger: ledger, Price: price})
+			ctx := context.Background()
+
+			rent := func(t *testing.T) *sms.PhoneNumber {
+				t.Helper()
+
+				phoneNumber, err := c.GetPhoneNumber(ctx, "service", "US")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return phoneNumber
+			}
+
+			for _, tt := range []struct {
+				name  string
+				used  bool
+				close func(context.Context, *sms.PhoneNumber) error
+				spent float64
+			}{
+				{"cancelled unused", false, c.CancelPhoneNumber, 0},
+				{"reported unused", false, c.ReportPhoneNumber, 0},
+				{"finished used", true, c.CancelPhoneNumber, 1},
+				{"reported used", true, c.ReportPhoneNumber, 2},
+			} {
+				t.Run(tt.name, func(t *testing.T) {
+					phoneNumber := rent(t)
+					if tt.used {
+						phoneNumber.MarkUsed()
+					}
+
+					if err := tt.close(ctx, phoneNumber); err != nil {
+						t.Fatal(err)
+					}
+
+					mustSpend(t, c, tt.spent)
+				})
+			}
+		})
+	}
+}
+
+func TestStoreLedgerUsedRecords(t *testing.T) {
+	store := smsstore.NewJSONLines(filepath.Join(t.TempDir(), "rentals.jsonl"))
+	c := NewClient(&fake{}, Config{Ledger: NewStoreLedger(store), Daily: 1, Price: price})
+	ctx := context.Background()
+
+	phoneNumber, err := c.GetPhoneNumber(ctx, "service", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the rental is finished and saved by another caller of the same store
+	phoneNumber.MarkUsed()
+	phoneNumber.MarkCancelled()
+	if err := store.Save(ctx, sms.NewRecord(phoneNumber.Rental(), []string{"123456"})); err != nil {
+		t.Fatal(err)
+	}
+
+	mustSpend(t, c, 1)
+	if _, err := c.GetPhoneNumber(ctx, "service", "US"); !errors.Is(err, sms.ErrBudgetExceeded) {
+		t.Fatalf("err = %v, want the daily budget spent on the used rental", err)
+	}
+}
+
+func TestStoreLedgerRecordsUnknownCosts(t *testing.T) {
+	store := smsstore.NewJSONLines(filepath.Join(t.TempDir(), "rentals.jsonl"))
+	ledger := NewStoreLedger(store)
+	ctx := context.Background()
+
+	// the rental was saved before its cost was known
+	rental := sms.Rental{Order: sms.Order{Provider: "fake", ID: "1"}, Number: "+12025550101"}
+	if err := store.Save(ctx, sms.NewRecord(rental, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	entry := Entry{Order: rental.Order, Number: rental.Number}
+	entry.Cost = 1
+	if err := ledger.Record(ctx, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ledger.Entries(ctx, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Cost != 1 {
+		t.Fatalf("entries = %+v, want one costing 1", entries)
+	}
+}
+
+func TestReserve(t *testing.T) {
+	f := &fake{block: make(chan struct{})}
+	c := NewClient(f, Config{Daily: 2, Price: price})
+	ctx := context.Background()
+
+	// two rentals in flight hold the whole budget
+	rented := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := c.GetPhoneNumber(ctx, "service", "US")
+			rented <- err
+		}()
+	}
+
+	for {
+		c.mu.Lock()
+		pending := len(c.pending)
+		c.mu.Unlock()
+
+		if pending == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err := c.GetPhoneNumber(ctx, "service", "US")
+	var exceeded *ExceededError
+	if !errors.As(err, &exceeded) || !errors.Is(err, sms.ErrBudgetExceeded) {
+		t.Fatalf("err = %v, want an *ExceededError", err)
+	}
+	if exceeded.Budget != "daily" || exceeded.Spent != 2 || exceeded.Cost != 1 {
+		t.Fatalf("exceeded %+v, want the daily budget with 2 reserved", exceeded)
+	}
+
+	close(f.block)
+	for i := 0; i < 2; i++ {
+		if err := <-rented; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the reservations are released and the rentals recorded in their place
+	if len(c.pending) != 0 {
+		t.Fatalf("%d reservations left", len(c.pending))
+	}
+	mustSpend(t, c, 2)
+}
+
+func TestReserveReleasesFailedRentals(t *testing.T) {
+	c := NewClient(&failing{}, Config{Daily: 1, Price: price})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetPhoneNumber(ctx, "service", "US"); !errors.Is(err, errNoNumbers) {
+			t.Fatalf("err = %v, want errNoNumbers", err)
+		}
+	}
+
+	mustSpend(t, c, 0)
+}
+
+func TestBudgets(t *testing.T) {
+	c := NewClient(&fake{}, Config{
+		Services: map[string]float64{"limited": 1},
+		Tags:     map[string]float64{"team": 1},
+		Price:    price,
+	})
+	ctx := context.Background()
+
+	if _, err := c.GetPhoneNumber(ctx, "limited", "US"); err != nil {
+		t.Fatal(err)
+	}
+
+	var exceeded *ExceededError
+	if _, err := c.GetPhoneNumber(ctx, "limited", "US"); !errors.As(err, &exceeded) || exceeded.Budget != "service" || exceeded.Key != "limited" {
+		t.Fatalf("err = %v, want the limited service budget exceeded", err)
+	}
+
+	tagged := WithTag(ctx, "team")
+	if _, err := c.GetPhoneNumber(tagged, "other", "US"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetPhoneNumber(tagged, "other", "US"); !errors.As(err, &exceeded) || exceeded.Budget != "tag" || exceeded.Key != "team" {
+		t.Fatalf("err = %v, want the team tag budget exceeded", err)
+	}
+
+	// untagged rentals of other services have no budget
+	if _, err := c.GetPhoneNumber(ctx, "other", "US"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+var errNoNumbers = errors.New("no numbers")
+
+// failing rents nothing
+type failing struct {
+	fake
+}
+
+func (f *failing) GetPhoneNumber(context.Context, string, string) (*sms.PhoneNumber, error) {
+	return nil, errNoNumbers
+}
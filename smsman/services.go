@@ -0,0 +1,923 @@
+// Code generated by saucesteals/sms; DO NOT EDIT.
+
+package smsman
+
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceIDAirbnb    ServiceID = "21"
+	ServiceIDAlibaba   ServiceID = "28"
+	ServiceIDAmazon    ServiceID = "13"
+	ServiceIDAOL       ServiceID = "12"
+	ServiceIDApple     ServiceID = "25"
+	ServiceIDAvito     ServiceID = "29"
+	ServiceIDDiscord   ServiceID = "16"
+	ServiceIDFacebook  ServiceID = "7"
+	ServiceIDGoogle    ServiceID = "6"
+	ServiceIDInstagram ServiceID = "8"
+	ServiceIDKakaoTalk ServiceID = "27"
+	ServiceIDLine      ServiceID = "26"
+	ServiceIDLinkedIn  ServiceID = "22"
+	ServiceIDMailru    ServiceID = "31"
+	ServiceIDMicrosoft ServiceID = "10"
+	ServiceIDNetflix   ServiceID = "18"
+	ServiceIDOKru      ServiceID = "32"
+	ServiceIDOpenAI    ServiceID = "24"
+	ServiceIDOther     ServiceID = "33"
+	ServiceIDPayPal    ServiceID = "20"
+	ServiceIDSnapchat  ServiceID = "23"
+	ServiceIDSteam     ServiceID = "19"
+	ServiceIDTelegram  ServiceID = "3"
+	ServiceIDTikTok    ServiceID = "17"
+	ServiceIDTinder    ServiceID = "15"
+	ServiceIDTwitter   ServiceID = "9"
+	ServiceIDUber      ServiceID = "14"
+	ServiceIDViber     ServiceID = "4"
+	ServiceIDVKontakte ServiceID = "1"
+	ServiceIDWeChat    ServiceID = "5"
+	ServiceIDWhatsApp  ServiceID = "2"
+	ServiceIDYahoo     ServiceID = "11"
+	ServiceIDYandex    ServiceID = "30"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceIDAirbnb instead.
+	ServiceAirbnb = "21"
+	// Deprecated: use ServiceIDAlibaba instead.
+	ServiceAlibaba = "28"
+	// Deprecated: use ServiceIDAmazon instead.
+	ServiceAmazon = "13"
+	// Deprecated: use ServiceIDAOL instead.
+	ServiceAOL = "12"
+	// Deprecated: use ServiceIDApple instead.
+	ServiceApple = "25"
+	// Deprecated: use ServiceIDAvito instead.
+	ServiceAvito = "29"
+	// Deprecated: use ServiceIDDiscord instead.
+	ServiceDiscord = "16"
+	// Deprecated: use ServiceIDFacebook instead.
+	ServiceFacebook = "7"
+	// Deprecated: use ServiceIDGoogle instead.
+	ServiceGoogle = "6"
+	// Deprecated: use ServiceIDInstagram instead.
+	ServiceInstagram = "8"
+	// Deprecated: use ServiceIDKakaoTalk instead.
+	ServiceKakaoTalk = "27"
+	// Deprecated: use ServiceIDLine instead.
+	ServiceLine = "26"
+	// Deprecated: use ServiceIDLinkedIn instead.
+	ServiceLinkedIn = "22"
+	// Deprecated: use ServiceIDMailru instead.
+	ServiceMailru = "31"
+	// Deprecated: use ServiceIDMicrosoft instead.
+	ServiceMicrosoft = "10"
+	// Deprecated: use ServiceIDNetflix instead.
+	ServiceNetflix = "18"
+	// Deprecated: use ServiceIDOKru instead.
+	ServiceOKru = "32"
+	// Deprecated: use ServiceIDOpenAI instead.
+	ServiceOpenAI = "24"
+	// Deprecated: use ServiceIDOther instead.
+	ServiceOther = "33"
+	// Deprecated: use ServiceIDPayPal instead.
+	ServicePayPal = "20"
+	// Deprecated: use ServiceIDSnapchat instead.
+	ServiceSnapchat = "23"
+	// Deprecated: use ServiceIDSteam instead.
+	ServiceSteam = "19"
+	// Deprecated: use ServiceIDTelegram instead.
+	ServiceTelegram = "3"
+	// Deprecated: use ServiceIDTikTok instead.
+	ServiceTikTok = "17"
+	// Deprecated: use ServiceIDTinder instead.
+	ServiceTinder = "15"
+	// Deprecated: use ServiceIDTwitter instead.
+	ServiceTwitter = "9"
+	// Deprecated: use ServiceIDUber instead.
+	ServiceUber = "14"
+	// Deprecated: use ServiceIDViber instead.
+	ServiceViber = "4"
+	// Deprecated: use ServiceIDVKontakte instead.
+	ServiceVKontakte = "1"
+	// Deprecated: use ServiceIDWeChat instead.
+	ServiceWeChat = "5"
+	// Deprecated: use ServiceIDWhatsApp instead.
+	ServiceWhatsApp = "2"
+	// Deprecated: use ServiceIDYahoo instead.
+	ServiceYahoo = "11"
+	// Deprecated: use ServiceIDYandex instead.
+	ServiceYandex = "30"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "1", Name: "VKontakte", NormalizedName: "vkontakte"},
+	{ID: "2", Name: "WhatsApp", NormalizedName: "whatsapp"},
+	{ID: "3", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "4", Name: "Viber", NormalizedName: "viber"},
+	{ID: "5", Name: "WeChat", NormalizedName: "wechat"},
+	{ID: "6", Name: "Google", NormalizedName: "google"},
+	{ID: "7", Name: "Facebook", NormalizedName: "facebook"},
+	{ID: "8", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "9", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "10", Name: "Microsoft", NormalizedName: "microsoft"},
+	{ID: "11", Name: "Yahoo", NormalizedName: "yahoo"},
+	{ID: "12", Name: "AOL", NormalizedName: "aol"},
+	{ID: "13", Name: "Amazon", NormalizedName: "amazon"},
+	{ID: "14", Name: "Uber", NormalizedName: "uber"},
+	{ID: "15", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "16", Name: "Discord", NormalizedName: "discord"},
+	{ID: "17", Name: "TikTok", NormalizedName: "tiktok"},
+	{ID: "18", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "19", Name: "Steam", NormalizedName: "steam"},
+	{ID: "20", Name: "PayPal", NormalizedName: "paypal"},
+	{ID: "21", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "22", Name: "LinkedIn", NormalizedName: "linkedin"},
+	{ID: "23", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "24", Name: "OpenAI", NormalizedName: "openai"},
+	{ID: "25", Name: "Apple", NormalizedName: "apple"},
+	{ID: "26", Name: "Line", NormalizedName: "line"},
+	{ID: "27", Name: "KakaoTalk", NormalizedName: "kakaotalk"},
+	{ID: "28", Name: "Alibaba", NormalizedName: "alibaba"},
+	{ID: "29", Name: "Avito", NormalizedName: "avito"},
+	{ID: "30", Name: "Yandex", NormalizedName: "yandex"},
+	{ID: "31", Name: "Mail.ru", NormalizedName: "mailru"},
+	{ID: "32", Name: "OK.ru", NormalizedName: "okru"},
+	{ID: "33", Name: "Other", NormalizedName: "other"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDAfghanistan                  CountryID = "74"
+	CountryIDAlbania                      CountryID = "155"
+	CountryIDAlgeria                      CountryID = "58"
+	CountryIDAngola                       CountryID = "76"
+	CountryIDAnguilla                     CountryID = "181"
+	CountryIDAntiguaAndBarbuda            CountryID = "169"
+	CountryIDArgentina                    CountryID = "39"
+	CountryIDArmenia                      CountryID = "148"
+	CountryIDAruba                        CountryID = "179"
+	CountryIDAustralia                    CountryID = "175"
+	CountryIDAustria                      CountryID = "50"
+	CountryIDAzerbaijan                   CountryID = "35"
+	CountryIDBahamas                      CountryID = "122"
+	CountryIDBahrain                      CountryID = "145"
+	CountryIDBangladesh                   CountryID = "60"
+	CountryIDBarbados                     CountryID = "118"
+	CountryIDBelarus                      CountryID = "51"
+	CountryIDBelgium                      CountryID = "82"
+	CountryIDBelize                       CountryID = "124"
+	CountryIDBenin                        CountryID = "120"
+	CountryIDBhutan                       CountryID = "158"
+	CountryIDBolivia                      CountryID = "92"
+	CountryIDBosniaAndHerzegovina         CountryID = "108"
+	CountryIDBotswana                     CountryID = "123"
+	CountryIDBrazil                       CountryID = "73"
+	CountryIDBrunei                       CountryID = "121"
+	CountryIDBulgaria                     CountryID = "83"
+	CountryIDBurkinaFaso                  CountryID = "152"
+	CountryIDBurundi                      CountryID = "119"
+	CountryIDCambodia                     CountryID = "24"
+	CountryIDCameroon                     CountryID = "41"
+	CountryIDCanada                       CountryID = "36"
+	CountryIDCapeVerde                    CountryID = "186"
+	CountryIDCaymanIslands                CountryID = "170"
+	CountryIDCentralAfricanRepublic       CountryID = "125"
+	CountryIDChad                         CountryID = "42"
+	CountryIDChile                        CountryID = "151"
+	CountryIDChina                        CountryID = "4"
+	CountryIDColombia                     CountryID = "33"
+	CountryIDComoros                      CountryID = "133"
+	CountryIDCongo                        CountryID = "150"
+	CountryIDCostaRica                    CountryID = "93"
+	CountryIDCroatia                      CountryID = "45"
+	CountryIDCuba                         CountryID = "113"
+	CountryIDCyprus                       CountryID = "77"
+	CountryIDCzechRepublic                CountryID = "63"
+	CountryIDDenmark                      CountryID = "172"
+	CountryIDDjibouti                     CountryID = "168"
+	CountryIDDominica                     CountryID = "126"
+	CountryIDDominicanRepublic            CountryID = "109"
+	CountryIDEcuador                      CountryID = "105"
+	CountryIDEgypt                        CountryID = "21"
+	CountryIDElSalvador                   CountryID = "101"
+	CountryIDEquatorialGuinea             CountryID = "167"
+	CountryIDEritrea                      CountryID = "176"
+	CountryIDEstonia                      CountryID = "34"
+	CountryIDEswatini                     CountryID = "106"
+	CountryIDEthiopia                     CountryID = "71"
+	CountryIDFinland                      CountryID = "163"
+	CountryIDFrance                       CountryID = "78"
+	CountryIDFrenchGuiana                 CountryID = "162"
+	CountryIDGabon                        CountryID = "154"
+	CountryIDGambia                       CountryID = "28"
+	CountryIDGeorgia                      CountryID = "128"
+	CountryIDGermany                      CountryID = "43"
+	CountryIDGhana                        CountryID = "38"
+	CountryIDGreece                       CountryID = "129"
+	CountryIDGrenada                      CountryID = "127"
+	CountryIDGuadeloupe                   CountryID = "160"
+	CountryIDGuatemala                    CountryID = "94"
+	CountryIDGuinea                       CountryID = "68"
+	CountryIDGuineaBissau                 CountryID = "130"
+	CountryIDGuyana                       CountryID = "131"
+	CountryIDHaiti                        CountryID = "26"
+	CountryIDHonduras                     CountryID = "88"
+	CountryIDHongKong                     CountryID = "15"
+	CountryIDHungary                      CountryID = "84"
+	CountryIDIceland                      CountryID = "132"
+	CountryIDIndia                        CountryID = "22"
+	CountryIDIndonesia                    CountryID = "7"
+	CountryIDIran                         CountryID = "57"
+	CountryIDIraq                         CountryID = "47"
+	CountryIDIreland                      CountryID = "23"
+	CountryIDIsrael                       CountryID = "14"
+	CountryIDItaly                        CountryID = "86"
+	CountryIDIvoryCoast                   CountryID = "27"
+	CountryIDJamaica                      CountryID = "103"
+	CountryIDJapan                        CountryID = "182"
+	CountryIDJordan                       CountryID = "116"
+	CountryIDKazakhstan                   CountryID = "3"
+	CountryIDKenya                        CountryID = "9"
+	CountryIDKuwait                       CountryID = "100"
+	CountryIDKyrgyzstan                   CountryID = "12"
+	CountryIDLaos                         CountryID = "25"
+	CountryIDLatvia                       CountryID = "49"
+	CountryIDLebanon                      CountryID = "153"
+	CountryIDLesotho                      CountryID = "136"
+	CountryIDLiberia                      CountryID = "135"
+	CountryIDLibya                        CountryID = "102"
+	CountryIDLithuania                    CountryID = "44"
+	CountryIDLuxembourg                   CountryID = "165"
+	CountryIDMacao                        CountryID = "20"
+	CountryIDMadagascar                   CountryID = "18"
+	CountryIDMalawi                       CountryID = "137"
+	CountryIDMalaysia                     CountryID = "8"
+	CountryIDMaldives                     CountryID = "159"
+	CountryIDMali                         CountryID = "69"
+	CountryIDMauritania                   CountryID = "114"
+	CountryIDMauritius                    CountryID = "157"
+	CountryIDMexico                       CountryID = "54"
+	CountryIDMoldova                      CountryID = "85"
+	CountryIDMonaco                       CountryID = "144"
+	CountryIDMongolia                     CountryID = "72"
+	CountryIDMontenegro                   CountryID = "171"
+	CountryIDMontserrat                   CountryID = "180"
+	CountryIDMorocco                      CountryID = "37"
+	CountryIDMozambique                   CountryID = "80"
+	CountryIDMyanmar                      CountryID = "6"
+	CountryIDNamibia                      CountryID = "138"
+	CountryIDNepal                        CountryID = "81"
+	CountryIDNetherlands                  CountryID = "48"
+	CountryIDNewCaledonia                 CountryID = "185"
+	CountryIDNewZealand                   CountryID = "67"
+	CountryIDNicaragua                    CountryID = "90"
+	CountryIDNiger                        CountryID = "139"
+	CountryIDNigeria                      CountryID = "19"
+	CountryIDNorthMacedonia               CountryID = "183"
+	CountryIDNorway                       CountryID = "174"
+	CountryIDOman                         CountryID = "107"
+	CountryIDPakistan                     CountryID = "66"
+	CountryIDPanama                       CountryID = "112"
+	CountryIDPapuaNewGuinea               CountryID = "79"
+	CountryIDParaguay                     CountryID = "87"
+	CountryIDPeru                         CountryID = "65"
+	CountryIDPhilippines                  CountryID = "5"
+	CountryIDPoland                       CountryID = "16"
+	CountryIDPortugal                     CountryID = "117"
+	CountryIDPuertoRico                   CountryID = "97"
+	CountryIDQatar                        CountryID = "111"
+	CountryIDReunion                      CountryID = "146"
+	CountryIDRomania                      CountryID = "32"
+	CountryIDRussia                       CountryID = "1"
+	CountryIDRwanda                       CountryID = "140"
+	CountryIDSaintKittsAndNevis           CountryID = "134"
+	CountryIDSaintLucia                   CountryID = "164"
+	CountryIDSaintVincentAndTheGrenadines CountryID = "166"
+	CountryIDSaoTomeAndPrincipe           CountryID = "178"
+	CountryIDSaudiArabia                  CountryID = "53"
+	CountryIDSenegal                      CountryID = "61"
+	CountryIDSerbia                       CountryID = "29"
+	CountryIDSeychelles                   CountryID = "184"
+	CountryIDSierraLeone                  CountryID = "115"
+	CountryIDSlovakia                     CountryID = "141"
+	CountryIDSlovenia                     CountryID = "59"
+	CountryIDSomalia                      CountryID = "149"
+	CountryIDSouthAfrica                  CountryID = "31"
+	CountryIDSouthKorea                   CountryID = "187"
+	CountryIDSouthSudan                   CountryID = "177"
+	CountryIDSpain                        CountryID = "56"
+	CountryIDSriLanka                     CountryID = "64"
+	CountryIDSudan                        CountryID = "98"
+	CountryIDSuriname                     CountryID = "142"
+	CountryIDSweden                       CountryID = "46"
+	CountryIDSwitzerland                  CountryID = "173"
+	CountryIDSyria                        CountryID = "110"
+	CountryIDTaiwan                       CountryID = "55"
+	CountryIDTajikistan                   CountryID = "143"
+	CountryIDTanzania                     CountryID = "10"
+	CountryIDThailand                     CountryID = "52"
+	CountryIDTimorLeste                   CountryID = "91"
+	CountryIDTogo                         CountryID = "99"
+	CountryIDTrinidadAndTobago            CountryID = "104"
+	CountryIDTunisia                      CountryID = "89"
+	CountryIDTurkey                       CountryID = "62"
+	CountryIDTurkmenistan                 CountryID = "161"
+	CountryIDUganda                       CountryID = "75"
+	CountryIDUkraine                      CountryID = "2"
+	CountryIDUnitedArabEmirates           CountryID = "95"
+	CountryIDUnitedKingdom                CountryID = "17"
+	CountryIDUnitedStates                 CountryID = "13"
+	CountryIDUruguay                      CountryID = "156"
+	CountryIDUzbekistan                   CountryID = "40"
+	CountryIDVenezuela                    CountryID = "70"
+	CountryIDVietnam                      CountryID = "11"
+	CountryIDYemen                        CountryID = "30"
+	CountryIDZambia                       CountryID = "147"
+	CountryIDZimbabwe                     CountryID = "96"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDAfghanistan instead.
+	CountryAfghanistan = "74"
+	// Deprecated: use CountryIDAlbania instead.
+	CountryAlbania = "155"
+	// Deprecated: use CountryIDAlgeria instead.
+	CountryAlgeria = "58"
+	// Deprecated: use CountryIDAngola instead.
+	CountryAngola = "76"
+	// Deprecated: use CountryIDAnguilla instead.
+	CountryAnguilla = "181"
+	// Deprecated: use CountryIDAntiguaAndBarbuda instead.
+	CountryAntiguaAndBarbuda = "169"
+	// Deprecated: use CountryIDArgentina instead.
+	CountryArgentina = "39"
+	// Deprecated: use CountryIDArmenia instead.
+	CountryArmenia = "148"
+	// Deprecated: use CountryIDAruba instead.
+	CountryAruba = "179"
+	// Deprecated: use CountryIDAustralia instead.
+	CountryAustralia = "175"
+	// Deprecated: use CountryIDAustria instead.
+	CountryAustria = "50"
+	// Deprecated: use CountryIDAzerbaijan instead.
+	CountryAzerbaijan = "35"
+	// Deprecated: use CountryIDBahamas instead.
+	CountryBahamas = "122"
+	// Deprecated: use CountryIDBahrain instead.
+	CountryBahrain = "145"
+	// Deprecated: use CountryIDBangladesh instead.
+	CountryBangladesh = "60"
+	// Deprecated: use CountryIDBarbados instead.
+	CountryBarbados = "118"
+	// Deprecated: use CountryIDBelarus instead.
+	CountryBelarus = "51"
+	// Deprecated: use CountryIDBelgium instead.
+	CountryBelgium = "82"
+	// Deprecated: use CountryIDBelize instead.
+	CountryBelize = "124"
+	// Deprecated: use CountryIDBenin instead.
+	CountryBenin = "120"
+	// Deprecated: use CountryIDBhutan instead.
+	CountryBhutan = "158"
+	// Deprecated: use CountryIDBolivia instead.
+	CountryBolivia = "92"
+	// Deprecated: use CountryIDBosniaAndHerzegovina instead.
+	CountryBosniaAndHerzegovina = "108"
+	// Deprecated: use CountryIDBotswana instead.
+	CountryBotswana = "123"
+	// Deprecated: use CountryIDBrazil instead.
+	CountryBrazil = "73"
+	// Deprecated: use CountryIDBrunei instead.
+	CountryBrunei = "121"
+	// Deprecated: use CountryIDBulgaria instead.
+	CountryBulgaria = "83"
+	// Deprecated: use CountryIDBurkinaFaso instead.
+	CountryBurkinaFaso = "152"
+	// Deprecated: use CountryIDBurundi instead.
+	CountryBurundi = "119"
+	// Deprecated: use CountryIDCambodia instead.
+	CountryCambodia = "24"
+	// Deprecated: use CountryIDCameroon instead.
+	CountryCameroon = "41"
+	// Deprecated: use CountryIDCanada instead.
+	CountryCanada = "36"
+	// Deprecated: use CountryIDCapeVerde instead.
+	CountryCapeVerde = "186"
+	// Deprecated: use CountryIDCaymanIslands instead.
+	CountryCaymanIslands = "170"
+	// Deprecated: use CountryIDCentralAfricanRepublic instead.
+	CountryCentralAfricanRepublic = "125"
+	// Deprecated: use CountryIDChad instead.
+	CountryChad = "42"
+	// Deprecated: use CountryIDChile instead.
+	CountryChile = "151"
+	// Deprecated: use CountryIDChina instead.
+	CountryChina = "4"
+	// Deprecated: use CountryIDColombia instead.
+	CountryColombia = "33"
+	// Deprecated: use CountryIDComoros instead.
+	CountryComoros = "133"
+	// Deprecated: use CountryIDCongo instead.
+	CountryCongo = "150"
+	// Deprecated: use CountryIDCostaRica instead.
+	CountryCostaRica = "93"
+	// Deprecated: use CountryIDCroatia instead.
+	CountryCroatia = "45"
+	// Deprecated: use CountryIDCuba instead.
+	CountryCuba = "113"
+	// Deprecated: use CountryIDCyprus instead.
+	CountryCyprus = "77"
+	// Deprecated: use CountryIDCzechRepublic instead.
+	CountryCzechRepublic = "63"
+	// Deprecated: use CountryIDDenmark instead.
+	CountryDenmark = "172"
+	// Deprecated: use CountryIDDjibouti instead.
+	CountryDjibouti = "168"
+	// Deprecated: use CountryIDDominica instead.
+	CountryDominica = "126"
+	// Deprecated: use CountryIDDominicanRepublic instead.
+	CountryDominicanRepublic = "109"
+	// Deprecated: use CountryIDEcuador instead.
+	CountryEcuador = "105"
+	// Deprecated: use CountryIDEgypt instead.
+	CountryEgypt = "21"
+	// Deprecated: use CountryIDElSalvador instead.
+	CountryElSalvador = "101"
+	// Deprecated: use CountryIDEquatorialGuinea instead.
+	CountryEquatorialGuinea = "167"
+	// Deprecated: use CountryIDEritrea instead.
+	CountryEritrea = "176"
+	// Deprecated: use CountryIDEstonia instead.
+	CountryEstonia = "34"
+	// Deprecated: use CountryIDEswatini instead.
+	CountryEswatini = "106"
+	// Deprecated: use CountryIDEthiopia instead.
+	CountryEthiopia = "71"
+	// Deprecated: use CountryIDFinland instead.
+	CountryFinland = "163"
+	// Deprecated: use CountryIDFrance instead.
+	CountryFrance = "78"
+	// Deprecated: use CountryIDFrenchGuiana instead.
+	CountryFrenchGuiana = "162"
+	// Deprecated: use CountryIDGabon instead.
+	CountryGabon = "154"
+	// Deprecated: use CountryIDGambia instead.
+	CountryGambia = "28"
+	// Deprecated: use CountryIDGeorgia instead.
+	CountryGeorgia = "128"
+	// Deprecated: use CountryIDGermany instead.
+	CountryGermany = "43"
+	// Deprecated: use CountryIDGhana instead.
+	CountryGhana = "38"
+	// Deprecated: use CountryIDGreece instead.
+	CountryGreece = "129"
+	// Deprecated: use CountryIDGrenada instead.
+	CountryGrenada = "127"
+	// Deprecated: use CountryIDGuadeloupe instead.
+	CountryGuadeloupe = "160"
+	// Deprecated: use CountryIDGuatemala instead.
+	CountryGuatemala = "94"
+	// Deprecated: use CountryIDGuinea instead.
+	CountryGuinea = "68"
+	// Deprecated: use CountryIDGuineaBissau instead.
+	CountryGuineaBissau = "130"
+	// Deprecated: use CountryIDGuyana instead.
+	CountryGuyana = "131"
+	// Deprecated: use CountryIDHaiti instead.
+	CountryHaiti = "26"
+	// Deprecated: use CountryIDHonduras instead.
+	CountryHonduras = "88"
+	// Deprecated: use CountryIDHongKong instead.
+	CountryHongKong = "15"
+	// Deprecated: use CountryIDHungary instead.
+	CountryHungary = "84"
+	// Deprecated: use CountryIDIceland instead.
+	CountryIceland = "132"
+	// Deprecated: use CountryIDIndia instead.
+	CountryIndia = "22"
+	// Deprecated: use CountryIDIndonesia instead.
+	CountryIndonesia = "7"
+	// Deprecated: use CountryIDIran instead.
+	CountryIran = "57"
+	// Deprecated: use CountryIDIraq instead.
+	CountryIraq = "47"
+	// Deprecated: use CountryIDIreland instead.
+	CountryIreland = "23"
+	// Deprecated: use CountryIDIsrael instead.
+	CountryIsrael = "14"
+	// Deprecated: use CountryIDItaly instead.
+	CountryItaly = "86"
+	// Deprecated: use CountryIDIvoryCoast instead.
+	CountryIvoryCoast = "27"
+	// Deprecated: use CountryIDJamaica instead.
+	CountryJamaica = "103"
+	// Deprecated: use CountryIDJapan instead.
+	CountryJapan = "182"
+	// Deprecated: use CountryIDJordan instead.
+	CountryJordan = "116"
+	// Deprecated: use CountryIDKazakhstan instead.
+	CountryKazakhstan = "3"
+	// Deprecated: use CountryIDKenya instead.
+	CountryKenya = "9"
+	// Deprecated: use CountryIDKuwait instead.
+	CountryKuwait = "100"
+	// Deprecated: use CountryIDKyrgyzstan instead.
+	CountryKyrgyzstan = "12"
+	// Deprecated: use CountryIDLaos instead.
+	CountryLaos = "25"
+	// Deprecated: use CountryIDLatvia instead.
+	CountryLatvia = "49"
+	// Deprecated: use CountryIDLebanon instead.
+	CountryLebanon = "153"
+	// Deprecated: use CountryIDLesotho instead.
+	CountryLesotho = "136"
+	// Deprecated: use CountryIDLiberia instead.
+	CountryLiberia = "135"
+	// Deprecated: use CountryIDLibya instead.
+	CountryLibya = "102"
+	// Deprecated: use CountryIDLithuania instead.
+	CountryLithuania = "44"
+	// Deprecated: use CountryIDLuxembourg instead.
+	CountryLuxembourg = "165"
+	// Deprecated: use CountryIDMacao instead.
+	CountryMacao = "20"
+	// Deprecated: use CountryIDMadagascar instead.
+	CountryMadagascar = "18"
+	// Deprecated: use CountryIDMalawi instead.
+	CountryMalawi = "137"
+	// Deprecated: use CountryIDMalaysia instead.
+	CountryMalaysia = "8"
+	// Deprecated: use CountryIDMaldives instead.
+	CountryMaldives = "159"
+	// Deprecated: use CountryIDMali instead.
+	CountryMali = "69"
+	// Deprecated: use CountryIDMauritania instead.
+	CountryMauritania = "114"
+	// Deprecated: use CountryIDMauritius instead.
+	CountryMauritius = "157"
+	// Deprecated: use CountryIDMexico instead.
+	CountryMexico = "54"
+	// Deprecated: use CountryIDMoldova instead.
+	CountryMoldova = "85"
+	// Deprecated: use CountryIDMonaco instead.
+	CountryMonaco = "144"
+	// Deprecated: use CountryIDMongolia instead.
+	CountryMongolia = "72"
+	// Deprecated: use CountryIDMontenegro instead.
+	CountryMontenegro = "171"
+	// Deprecated: use CountryIDMontserrat instead.
+	CountryMontserrat = "180"
+	// Deprecated: use CountryIDMorocco instead.
+	CountryMorocco = "37"
+	// Deprecated: use CountryIDMozambique instead.
+	CountryMozambique = "80"
+	// Deprecated: use CountryIDMyanmar instead.
+	CountryMyanmar = "6"
+	// Deprecated: use CountryIDNamibia instead.
+	CountryNamibia = "138"
+	// Deprecated: use CountryIDNepal instead.
+	CountryNepal = "81"
+	// Deprecated: use CountryIDNetherlands instead.
+	CountryNetherlands = "48"
+	// Deprecated: use CountryIDNewCaledonia instead.
+	CountryNewCaledonia = "185"
+	// Deprecated: use CountryIDNewZealand instead.
+	CountryNewZealand = "67"
+	// Deprecated: use CountryIDNicaragua instead.
+	CountryNicaragua = "90"
+	// Deprecated: use CountryIDNiger instead.
+	CountryNiger = "139"
+	// Deprecated: use CountryIDNigeria instead.
+	CountryNigeria = "19"
+	// Deprecated: use CountryIDNorthMacedonia instead.
+	CountryNorthMacedonia = "183"
+	// Deprecated: use CountryIDNorway instead.
+	CountryNorway = "174"
+	// Deprecated: use CountryIDOman instead.
+	CountryOman = "107"
+	// Deprecated: use CountryIDPakistan instead.
+	CountryPakistan = "66"
+	// Deprecated: use CountryIDPanama instead.
+	CountryPanama = "112"
+	// Deprecated: use CountryIDPapuaNewGuinea instead.
+	CountryPapuaNewGuinea = "79"
+	// Deprecated: use CountryIDParaguay instead.
+	CountryParaguay = "87"
+	// Deprecated: use CountryIDPeru instead.
+	CountryPeru = "65"
+	// Deprecated: use CountryIDPhilippines instead.
+	CountryPhilippines = "5"
+	// Deprecated: use CountryIDPoland instead.
+	CountryPoland = "16"
+	// Deprecated: use CountryIDPortugal instead.
+	CountryPortugal = "117"
+	// Deprecated: use CountryIDPuertoRico instead.
+	CountryPuertoRico = "97"
+	// Deprecated: use CountryIDQatar instead.
+	CountryQatar = "111"
+	// Deprecated: use CountryIDReunion instead.
+	CountryReunion = "146"
+	// Deprecated: use CountryIDRomania instead.
+	CountryRomania = "32"
+	// Deprecated: use CountryIDRussia instead.
+	CountryRussia = "1"
+	// Deprecated: use CountryIDRwanda instead.
+	CountryRwanda = "140"
+	// Deprecated: use CountryIDSaintKittsAndNevis instead.
+	CountrySaintKittsAndNevis = "134"
+	// Deprecated: use CountryIDSaintLucia instead.
+	CountrySaintLucia = "164"
+	// Deprecated: use CountryIDSaintVincentAndTheGrenadines instead.
+	CountrySaintVincentAndTheGrenadines = "166"
+	// Deprecated: use CountryIDSaoTomeAndPrincipe instead.
+	CountrySaoTomeAndPrincipe = "178"
+	// Deprecated: use CountryIDSaudiArabia instead.
+	CountrySaudiArabia = "53"
+	// Deprecated: use CountryIDSenegal instead.
+	CountrySenegal = "61"
+	// Deprecated: use CountryIDSerbia instead.
+	CountrySerbia = "29"
+	// Deprecated: use CountryIDSeychelles instead.
+	CountrySeychelles = "184"
+	// Deprecated: use CountryIDSierraLeone instead.
+	CountrySierraLeone = "115"
+	// Deprecated: use CountryIDSlovakia instead.
+	CountrySlovakia = "141"
+	// Deprecated: use CountryIDSlovenia instead.
+	CountrySlovenia = "59"
+	// Deprecated: use CountryIDSomalia instead.
+	CountrySomalia = "149"
+	// Deprecated: use CountryIDSouthAfrica instead.
+	CountrySouthAfrica = "31"
+	// Deprecated: use CountryIDSouthKorea instead.
+	CountrySouthKorea = "187"
+	// Deprecated: use CountryIDSouthSudan instead.
+	CountrySouthSudan = "177"
+	// Deprecated: use CountryIDSpain instead.
+	CountrySpain = "56"
+	// Deprecated: use CountryIDSriLanka instead.
+	CountrySriLanka = "64"
+	// Deprecated: use CountryIDSudan instead.
+	CountrySudan = "98"
+	// Deprecated: use CountryIDSuriname instead.
+	CountrySuriname = "142"
+	// Deprecated: use CountryIDSweden instead.
+	CountrySweden = "46"
+	// Deprecated: use CountryIDSwitzerland instead.
+	CountrySwitzerland = "173"
+	// Deprecated: use CountryIDSyria instead.
+	CountrySyria = "110"
+	// Deprecated: use CountryIDTaiwan instead.
+	CountryTaiwan = "55"
+	// Deprecated: use CountryIDTajikistan instead.
+	CountryTajikistan = "143"
+	// Deprecated: use CountryIDTanzania instead.
+	CountryTanzania = "10"
+	// Deprecated: use CountryIDThailand instead.
+	CountryThailand = "52"
+	// Deprecated: use CountryIDTimorLeste instead.
+	CountryTimorLeste = "91"
+	// Deprecated: use CountryIDTogo instead.
+	CountryTogo = "99"
+	// Deprecated: use CountryIDTrinidadAndTobago instead.
+	CountryTrinidadAndTobago = "104"
+	// Deprecated: use CountryIDTunisia instead.
+	CountryTunisia = "89"
+	// Deprecated: use CountryIDTurkey instead.
+	CountryTurkey = "62"
+	// Deprecated: use CountryIDTurkmenistan instead.
+	CountryTurkmenistan = "161"
+	// Deprecated: use CountryIDUganda instead.
+	CountryUganda = "75"
+	// Deprecated: use CountryIDUkraine instead.
+	CountryUkraine = "2"
+	// Deprecated: use CountryIDUnitedArabEmirates instead.
+	CountryUnitedArabEmirates = "95"
+	// Deprecated: use CountryIDUnitedKingdom instead.
+	CountryUnitedKingdom = "17"
+	// Deprecated: use CountryIDUnitedStates instead.
+	CountryUnitedStates = "13"
+	// Deprecated: use CountryIDUruguay instead.
+	CountryUruguay = "156"
+	// Deprecated: use CountryIDUzbekistan instead.
+	CountryUzbekistan = "40"
+	// Deprecated: use CountryIDVenezuela instead.
+	CountryVenezuela = "70"
+	// Deprecated: use CountryIDVietnam instead.
+	CountryVietnam = "11"
+	// Deprecated: use CountryIDYemen instead.
+	CountryYemen = "30"
+	// Deprecated: use CountryIDZambia instead.
+	CountryZambia = "147"
+	// Deprecated: use CountryIDZimbabwe instead.
+	CountryZimbabwe = "96"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "1", Alpha2: "RU", Name: "Russia", DialCode: 7},
+	{ID: "2", Alpha2: "UA", Name: "Ukraine", DialCode: 380},
+	{ID: "3", Alpha2: "KZ", Name: "Kazakhstan", DialCode: 7},
+	{ID: "4", Alpha2: "CN", Name: "China", DialCode: 86},
+	{ID: "5", Alpha2: "PH", Name: "Philippines", DialCode: 63},
+	{ID: "6", Alpha2: "MM", Name: "Myanmar", DialCode: 95},
+	{ID: "7", Alpha2: "ID", Name: "Indonesia", DialCode: 62},
+	{ID: "8", Alpha2: "MY", Name: "Malaysia", DialCode: 60},
+	{ID: "9", Alpha2: "KE", Name: "Kenya", DialCode: 254},
+	{ID: "10", Alpha2: "TZ", Name: "Tanzania", DialCode: 255},
+	{ID: "11", Alpha2: "VN", Name: "Vietnam", DialCode: 84},
+	{ID: "12", Alpha2: "KG", Name: "Kyrgyzstan", DialCode: 996},
+	{ID: "13", Alpha2: "US", Name: "United States", DialCode: 1},
+	{ID: "14", Alpha2: "IL", Name: "Israel", DialCode: 972},
+	{ID: "15", Alpha2: "HK", Name: "Hong Kong", DialCode: 852},
+	{ID: "16", Alpha2: "PL", Name: "Poland", DialCode: 48},
+	{ID: "17", Alpha2: "GB", Name: "United Kingdom", DialCode: 44},
+	{ID: "18", Alpha2: "MG", Name: "Madagascar", DialCode: 261},
+	{ID: "19", Alpha2: "NG", Name: "Nigeria", DialCode: 234},
+	{ID: "20", Alpha2: "MO", Name: "Macao", DialCode: 853},
+	{ID: "21", Alpha2: "EG", Name: "Egypt", DialCode: 20},
+	{ID: "22", Alpha2: "IN", Name: "India", DialCode: 91},
+	{ID: "23", Alpha2: "IE", Name: "Ireland", DialCode: 353},
+	{ID: "24", Alpha2: "KH", Name: "Cambodia", DialCode: 855},
+	{ID: "25", Alpha2: "LA", Name: "Laos", DialCode: 856},
+	{ID: "26", Alpha2: "HT", Name: "Haiti", DialCode: 509},
+	{ID: "27", Alpha2: "CI", Name: "Ivory Coast", DialCode: 225},
+	{ID: "28", Alpha2: "GM", Name: "Gambia", DialCode: 220},
+	{ID: "29", Alpha2: "RS", Name: "Serbia", DialCode: 381},
+	{ID: "30", Alpha2: "YE", Name: "Yemen", DialCode: 967},
+	{ID: "31", Alpha2: "ZA", Name: "South Africa", DialCode: 27},
+	{ID: "32", Alpha2: "RO", Name: "Romania", DialCode: 40},
+	{ID: "33", Alpha2: "CO", Name: "Colombia", DialCode: 57},
+	{ID: "34", Alpha2: "EE", Name: "Estonia", DialCode: 372},
+	{ID: "35", Alpha2: "AZ", Name: "Azerbaijan", DialCode: 994},
+	{ID: "36", Alpha2: "CA", Name: "Canada", DialCode: 1},
+	{ID: "37", Alpha2: "MA", Name: "Morocco", DialCode: 212},
+	{ID: "38", Alpha2: "GH", Name: "Ghana", DialCode: 233},
+	{ID: "39", Alpha2: "AR", Name: "Argentina", DialCode: 54},
+	{ID: "40", Alpha2: "UZ", Name: "Uzbekistan", DialCode: 998},
+	{ID: "41", Alpha2: "CM", Name: "Cameroon", DialCode: 237},
+	{ID: "42", Alpha2: "TD", Name: "Chad", DialCode: 235},
+	{ID: "43", Alpha2: "DE", Name: "Germany", DialCode: 49},
+	{ID: "44", Alpha2: "LT", Name: "Lithuania", DialCode: 370},
+	{ID: "45", Alpha2: "HR", Name: "Croatia", DialCode: 385},
+	{ID: "46", Alpha2: "SE", Name: "Sweden", DialCode: 46},
+	{ID: "47", Alpha2: "IQ", Name: "Iraq", DialCode: 964},
+	{ID: "48", Alpha2: "NL", Name: "Netherlands", DialCode: 31},
+	{ID: "49", Alpha2: "LV", Name: "Latvia", DialCode: 371},
+	{ID: "50", Alpha2: "AT", Name: "Austria", DialCode: 43},
+	{ID: "51", Alpha2: "BY", Name: "Belarus", DialCode: 375},
+	{ID: "52", Alpha2: "TH", Name: "Thailand", DialCode: 66},
+	{ID: "53", Alpha2: "SA", Name: "Saudi Arabia", DialCode: 966},
+	{ID: "54", Alpha2: "MX", Name: "Mexico", DialCode: 52},
+	{ID: "55", Alpha2: "TW", Name: "Taiwan", DialCode: 886},
+	{ID: "56", Alpha2: "ES", Name: "Spain", DialCode: 34},
+	{ID: "57", Alpha2: "IR", Name: "Iran", DialCode: 98},
+	{ID: "58", Alpha2: "DZ", Name: "Algeria", DialCode: 213},
+	{ID: "59", Alpha2: "SI", Name: "Slovenia", DialCode: 386},
+	{ID: "60", Alpha2: "BD", Name: "Bangladesh", DialCode: 880},
+	{ID: "61", Alpha2: "SN", Name: "Senegal", DialCode: 221},
+	{ID: "62", Alpha2: "TR", Name: "Turkey", DialCode: 90},
+	{ID: "63", Alpha2: "CZ", Name: "Czech Republic", DialCode: 420},
+	{ID: "64", Alpha2: "LK", Name: "Sri Lanka", DialCode: 94},
+	{ID: "65", Alpha2: "PE", Name: "Peru", DialCode: 51},
+	{ID: "66", Alpha2: "PK", Name: "Pakistan", DialCode: 92},
+	{ID: "67", Alpha2: "NZ", Name: "New Zealand", DialCode: 64},
+	{ID: "68", Alpha2: "GN", Name: "Guinea", DialCode: 224},
+	{ID: "69", Alpha2: "ML", Name: "Mali", DialCode: 223},
+	{ID: "70", Alpha2: "VE", Name: "Venezuela", DialCode: 58},
+	{ID: "71", Alpha2: "ET", Name: "Ethiopia", DialCode: 251},
+	{ID: "72", Alpha2: "MN", Name: "Mongolia", DialCode: 976},
+	{ID: "73", Alpha2: "BR", Name: "Brazil", DialCode: 55},
+	{ID: "74", Alpha2: "AF", Name: "Afghanistan", DialCode: 93},
+	{ID: "75", Alpha2: "UG", Name: "Uganda", DialCode: 256},
+	{ID: "76", Alpha2: "AO", Name: "Angola", DialCode: 244},
+	{ID: "77", Alpha2: "CY", Name: "Cyprus", DialCode: 357},
+	{ID: "78", Alpha2: "FR", Name: "France", DialCode: 33},
+	{ID: "79", Alpha2: "PG", Name: "Papua New Guinea", DialCode: 675},
+	{ID: "80", Alpha2: "MZ", Name: "Mozambique", DialCode: 258},
+	{ID: "81", Alpha2: "NP", Name: "Nepal", DialCode: 977},
+	{ID: "82", Alpha2: "BE", Name: "Belgium", DialCode: 32},
+	{ID: "83", Alpha2: "BG", Name: "Bulgaria", DialCode: 359},
+	{ID: "84", Alpha2: "HU", Name: "Hungary", DialCode: 36},
+	{ID: "85", Alpha2: "MD", Name: "Moldova", DialCode: 373},
+	{ID: "86", Alpha2: "IT", Name: "Italy", DialCode: 39},
+	{ID: "87", Alpha2: "PY", Name: "Paraguay", DialCode: 595},
+	{ID: "88", Alpha2: "HN", Name: "Honduras", DialCode: 504},
+	{ID: "89", Alpha2: "TN", Name: "Tunisia", DialCode: 216},
+	{ID: "90", Alpha2: "NI", Name: "Nicaragua", DialCode: 505},
+	{ID: "91", Alpha2: "TL", Name: "Timor-Leste", DialCode: 670},
+	{ID: "92", Alpha2: "BO", Name: "Bolivia", DialCode: 591},
+	{ID: "93", Alpha2: "CR", Name: "Costa Rica", DialCode: 506},
+	{ID: "94", Alpha2: "GT", Name: "Guatemala", DialCode: 502},
+	{ID: "95", Alpha2: "AE", Name: "United Arab Emirates", DialCode: 971},
+	{ID: "96", Alpha2: "ZW", Name: "Zimbabwe", DialCode: 263},
+	{ID: "97", Alpha2: "PR", Name: "Puerto Rico", DialCode: 1},
+	{ID: "98", Alpha2: "SD", Name: "Sudan", DialCode: 249},
+	{ID: "99", Alpha2: "TG", Name: "Togo", DialCode: 228},
+	{ID: "100", Alpha2: "KW", Name: "Kuwait", DialCode: 965},
+	{ID: "101", Alpha2: "SV", Name: "El Salvador", DialCode: 503},
+	{ID: "102", Alpha2: "LY", Name: "Libya", DialCode: 218},
+	{ID: "103", Alpha2: "JM", Name: "Jamaica", DialCode: 1},
+	{ID: "104", Alpha2: "TT", Name: "Trinidad and Tobago", DialCode: 1},
+	{ID: "105", Alpha2: "EC", Name: "Ecuador", DialCode: 593},
+	{ID: "106", Alpha2: "SZ", Name: "Eswatini", DialCode: 268},
+	{ID: "107", Alpha2: "OM", Name: "Oman", DialCode: 968},
+	{ID: "108", Alpha2: "BA", Name: "Bosnia and Herzegovina", DialCode: 387},
+	{ID: "109", Alpha2: "DO", Name: "Dominican Republic", DialCode: 1},
+	{ID: "110", Alpha2: "SY", Name: "Syria", DialCode: 963},
+	{ID: "111", Alpha2: "QA", Name: "Qatar", DialCode: 974},
+	{ID: "112", Alpha2: "PA", Name: "Panama", DialCode: 507},
+	{ID: "113", Alpha2: "CU", Name: "Cuba", DialCode: 53},
+	{ID: "114", Alpha2: "MR", Name: "Mauritania", DialCode: 222},
+	{ID: "115", Alpha2: "SL", Name: "Sierra Leone", DialCode: 232},
+	{ID: "116", Alpha2: "JO", Name: "Jordan", DialCode: 962},
+	{ID: "117", Alpha2: "PT", Name: "Portugal", DialCode: 351},
+	{ID: "118", Alpha2: "BB", Name: "Barbados", DialCode: 1},
+	{ID: "119", Alpha2: "BI", Name: "Burundi", DialCode: 257},
+	{ID: "120", Alpha2: "BJ", Name: "Benin", DialCode: 229},
+	{ID: "121", Alpha2: "BN", Name: "Brunei", DialCode: 673},
+	{ID: "122", Alpha2: "BS", Name: "Bahamas", DialCode: 1},
+	{ID: "123", Alpha2: "BW", Name: "Botswana", DialCode: 267},
+	{ID: "124", Alpha2: "BZ", Name: "Belize", DialCode: 501},
+	{ID: "125", Alpha2: "CF", Name: "Central African Republic", DialCode: 236},
+	{ID: "126", Alpha2: "DM", Name: "Dominica", DialCode: 1},
+	{ID: "127", Alpha2: "GD", Name: "Grenada", DialCode: 1},
+	{ID: "128", Alpha2: "GE", Name: "Georgia", DialCode: 995},
+	{ID: "129", Alpha2: "GR", Name: "Greece", DialCode: 30},
+	{ID: "130", Alpha2: "GW", Name: "Guinea-Bissau", DialCode: 245},
+	{ID: "131", Alpha2: "GY", Name: "Guyana", DialCode: 592},
+	{ID: "132", Alpha2: "IS", Name: "Iceland", DialCode: 354},
+	{ID: "133", Alpha2: "KM", Name: "Comoros", DialCode: 269},
+	{ID: "134", Alpha2: "KN", Name: "Saint Kitts and Nevis", DialCode: 1},
+	{ID: "135", Alpha2: "LR", Name: "Liberia", DialCode: 231},
+	{ID: "136", Alpha2: "LS", Name: "Lesotho", DialCode: 266},
+	{ID: "137", Alpha2: "MW", Name: "Malawi", DialCode: 265},
+	{ID: "138", Alpha2: "NA", Name: "Namibia", DialCode: 264},
+	{ID: "139", Alpha2: "NE", Name: "Niger", DialCode: 227},
+	{ID: "140", Alpha2: "RW", Name: "Rwanda", DialCode: 250},
+	{ID: "141", Alpha2: "SK", Name: "Slovakia", DialCode: 421},
+	{ID: "142", Alpha2: "SR", Name: "Suriname", DialCode: 597},
+	{ID: "143", Alpha2: "TJ", Name: "Tajikistan", DialCode: 992},
+	{ID: "144", Alpha2: "MC", Name: "Monaco", DialCode: 377},
+	{ID: "145", Alpha2: "BH", Name: "Bahrain", DialCode: 973},
+	{ID: "146", Alpha2: "RE", Name: "Reunion", DialCode: 262},
+	{ID: "147", Alpha2: "ZM", Name: "Zambia", DialCode: 260},
+	{ID: "148", Alpha2: "AM", Name: "Armenia", DialCode: 374},
+	{ID: "149", Alpha2: "SO", Name: "Somalia", DialCode: 252},
+	{ID: "150", Alpha2: "CG", Name: "Congo", DialCode: 242},
+	{ID: "151", Alpha2: "CL", Name: "Chile", DialCode: 56},
+	{ID: "152", Alpha2: "BF", Name: "Burkina Faso", DialCode: 226},
+	{ID: "153", Alpha2: "LB", Name: "Lebanon", DialCode: 961},
+	{ID: "154", Alpha2: "GA", Name: "Gabon", DialCode: 241},
+	{ID: "155", Alpha2: "AL", Name: "Albania", DialCode: 355},
+	{ID: "156", Alpha2: "UY", Name: "Uruguay", DialCode: 598},
+	{ID: "157", Alpha2: "MU", Name: "Mauritius", DialCode: 230},
+	{ID: "158", Alpha2: "BT", Name: "Bhutan", DialCode: 975},
+	{ID: "159", Alpha2: "MV", Name: "Maldives", DialCode: 960},
+	{ID: "160", Alpha2: "GP", Name: "Guadeloupe", DialCode: 590},
+	{ID: "161", Alpha2: "TM", Name: "Turkmenistan", DialCode: 993},
+	{ID: "162", Alpha2: "GF", Name: "French Guiana", DialCode: 594},
+	{ID: "163", Alpha2: "FI", Name: "Finland", DialCode: 358},
+	{ID: "164", Alpha2: "LC", Name: "Saint Lucia", DialCode: 1},
+	{ID: "165", Alpha2: "LU", Name: "Luxembourg", DialCode: 352},
+	{ID: "166", Alpha2: "VC", Name: "Saint Vincent and the Grenadines", DialCode: 1},
+	{ID: "167", Alpha2: "GQ", Name: "Equatorial Guinea", DialCode: 240},
+	{ID: "168", Alpha2: "DJ", Name: "Djibouti", DialCode: 253},
+	{ID: "169", Alpha2: "AG", Name: "Antigua and Barbuda", DialCode: 1},
+	{ID: "170", Alpha2: "KY", Name: "Cayman Islands", DialCode: 1},
+	{ID: "171", Alpha2: "ME", Name: "Montenegro", DialCode: 382},
+	{ID: "172", Alpha2: "DK", Name: "Denmark", DialCode: 45},
+	{ID: "173", Alpha2: "CH", Name: "Switzerland", DialCode: 41},
+	{ID: "174", Alpha2: "NO", Name: "Norway", DialCode: 47},
+	{ID: "175", Alpha2: "AU", Name: "Australia", DialCode: 61},
+	{ID: "176", Alpha2: "ER", Name: "Eritrea", DialCode: 291},
+	{ID: "177", Alpha2: "SS", Name: "South Sudan", DialCode: 211},
+	{ID: "178", Alpha2: "ST", Name: "Sao Tome and Principe", DialCode: 239},
+	{ID: "179", Alpha2: "AW", Name: "Aruba", DialCode: 297},
+	{ID: "180", Alpha2: "MS", Name: "Montserrat", DialCode: 1},
+	{ID: "181", Alpha2: "AI", Name: "Anguilla", DialCode: 1},
+	{ID: "182", Alpha2: "JP", Name: "Japan", DialCode: 81},
+	{ID: "183", Alpha2: "MK", Name: "North Macedonia", DialCode: 389},
+	{ID: "184", Alpha2: "SC", Name: "Seychelles", DialCode: 248},
+	{ID: "185", Alpha2: "NC", Name: "New Caledonia", DialCode: 687},
+	{ID: "186", Alpha2: "CV", Name: "Cape Verde", DialCode: 238},
+	{ID: "187", Alpha2: "KR", Name: "South Korea", DialCode: 82},
+}
@@ -1,24 +1,43 @@
 package smsman
 
+//go:generate go run ../cmd/smsgen -dir . smsman
+
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/saucesteals/sms"
 )
 
+const provider = "smsman"
+
+// ErrUnsupported is returned by OrderHistory, sms-man's API only answers about
+// a request ID the caller already has so past orders can't be listed
+var ErrUnsupported = errors.New("smsman: listing past orders is not supported")
+
+// Client does not implement sms.ActiveClient: sms-man's API has no call
+// listing open requests, get-sms and set-status only take a request ID the
+// caller already has. Keep rentals in an sms.Store to find them again
 type Client struct {
 	http   *http.Client
 	apiKey string
 }
 
 var (
-	_ sms.Client = &Client{}
+	_ sms.Client           = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.HistoryClient    = &Client{}
 )
 
 func NewClient(apiKey string) *Client {
@@ -54,6 +73,8 @@ type getPhoneNumberResponse struct {
 	errorResponse
 	RequestID int    `json:"request_id"`
 	Number    string `json:"number"`
+	// Cost is only sent by some API versions
+	Cost json.Number `json:"cost"`
 }
 
 func (c *Client) do(ctx context.Context, action string, query url.Values, response smsManResponse) error {
@@ -90,6 +111,16 @@ func (c *Client) do(ctx context.Context, action string, query url.Values, respon
 	return nil
 }
 
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Cancel:           true,
+		Report:           true,
+		Balance:          true,
+		Prices:           true,
+	}
+}
+
 func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
 	var data getPhoneNumberResponse
 	if err := c.do(ctx, "get-number", url.Values{
@@ -104,10 +135,41 @@ func (c *Client) GetPhoneNumber(ctx context.Context, service string, country str
 		return nil, fmt.Errorf("smsman: parsing phone number (%s): %w", data.Number, err)
 	}
 
-	return &sms.PhoneNumber{
-		PhoneNumber: number,
-		Metadata:    metadata{requestID: strconv.Itoa(data.RequestID)},
-	}, nil
+	// the number is already paid for, so an unknown cost only loses the cost
+	cost, err := data.Cost.Float64()
+	if err != nil {
+		cost = c.price(ctx, service, country)
+	}
+
+	requestID := strconv.Itoa(data.RequestID)
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       requestID,
+		Service:  service,
+		Country:  country,
+		Cost:     cost,
+	}, metadata{requestID: requestID}), nil
+}
+
+// price returns what renting service in country costs, or zero when it
+// cannot be listed
+func (c *Client) price(ctx context.Context, service string, country string) float64 {
+	prices, err := c.GetPrices(ctx, country)
+	if err != nil {
+		return 0
+	}
+
+	for _, p := range prices {
+		if p.ApplicationID == service {
+			return p.Cost
+		}
+	}
+
+	return 0
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{requestID: rental.ID})
 }
 
 type getSmsResponse struct {
@@ -116,7 +178,7 @@ type getSmsResponse struct {
 }
 
 func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
@@ -137,12 +199,37 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	return []string{data.SmsCode}, nil
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	// sms-man rejects get-sms for closed requests, so rely on our own state for those
+	if phoneNumber.Cancelled() {
+		return sms.NewPhoneNumberStatus(sms.StatusCancelled, time.Time{}), nil
+	}
+
+	var data getSmsResponse
+	if err := c.do(ctx, "get-sms", url.Values{
+		"request_id": {metadata.requestID},
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	if data.SmsCode == "" {
+		return sms.NewPhoneNumberStatus(sms.StatusWaiting, time.Time{}), nil
+	}
+
+	return sms.NewPhoneNumberStatus(sms.StatusReceived, time.Time{}), nil
+}
+
 func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	if phoneNumber.Used() || phoneNumber.Cancelled() {
 		return nil
 	}
 
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -160,7 +247,7 @@ func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 }
 
 func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -176,3 +263,141 @@ func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 
 	return nil
 }
+
+type Country struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Code is the lowercase alpha-2 code
+	Code string `json:"code"`
+}
+
+// Application is a service, its ID is the application_id numbers are rented
+// for
+type Application struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Code  string `json:"code"`
+}
+
+// listResponse is either an error or items keyed by ID
+type listResponse[T any] struct {
+	errorResponse
+	Items map[string]T
+}
+
+func (r *listResponse[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.errorResponse); err != nil {
+		return err
+	}
+
+	if r.ErrorCode != "" {
+		return nil
+	}
+
+	return json.Unmarshal(data, &r.Items)
+}
+
+// list returns the items of action's listResponse sorted by key
+func list[T any](ctx context.Context, c *Client, action string, query url.Values) ([]T, error) {
+	items, _, err := listKeys[T](ctx, c, action, query)
+	return items, err
+}
+
+// listKeys is list returning the items' keys as well
+func listKeys[T any](ctx context.Context, c *Client, action string, query url.Values) ([]T, []string, error) {
+	var data listResponse[T]
+	if err := c.do(ctx, action, query, &data); err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]string, 0, len(data.Items))
+	for key := range data.Items {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, aErr := strconv.Atoi(keys[i])
+		b, bErr := strconv.Atoi(keys[j])
+		if aErr == nil && bErr == nil {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+
+	items := make([]T, len(keys))
+	for i, key := range keys {
+		items[i] = data.Items[key]
+	}
+
+	return items, keys, nil
+}
+
+func (c *Client) GetCountries(ctx context.Context) ([]Country, error) {
+	return list[Country](ctx, c, "countries", nil)
+}
+
+func (c *Client) GetApplications(ctx context.Context) ([]Application, error) {
+	return list[Application](ctx, c, "applications", nil)
+}
+
+// Price is what renting an application's number costs in a country
+type Price struct {
+	ApplicationID string
+	Cost          float64
+	Count         int
+}
+
+// price is a Price as get-prices lists it, with numbers as JSON numbers or
+// strings
+type price struct {
+	Cost  json.Number `json:"cost"`
+	Count json.Number `json:"count"`
+}
+
+// GetPrices lists the prices of every application in country, a country_id
+func (c *Client) GetPrices(ctx context.Context, country string) ([]Price, error) {
+	listed, keys, err := listKeys[price](ctx, c, "get-prices", url.Values{"country_id": {country}})
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]Price, len(listed))
+	for i, p := range listed {
+		cost, err := p.Cost.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("smsman: parsing cost %q of application %s: %w", p.Cost, keys[i], err)
+		}
+
+		// the stock is informative, an unparseable one is left at zero
+		count, _ := p.Count.Int64()
+
+		prices[i] = Price{ApplicationID: keys[i], Cost: cost, Count: int(count)}
+	}
+
+	return prices, nil
+}
+
+type getBalanceResponse struct {
+	errorResponse
+	Balance json.Number `json:"balance"`
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	var data getBalanceResponse
+	if err := c.do(ctx, "get-balance", nil, &data); err != nil {
+		return 0, err
+	}
+
+	bal, err := data.Balance.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("smsman: parsing balance %q: %w", data.Balance, err)
+	}
+
+	return bal, nil
+}
+
+// OrderHistory fails with ErrUnsupported, keep rentals in an sms.Store to list
+// them
+func (c *Client) OrderHistory(context.Context, sms.HistoryQuery) (*sms.HistoryPage, error) {
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,289 @@
+package smsplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"sync/atomic"
+
+	"github.com/saucesteals/sms"
+)
+
+// Client is an sms.Client backed by a plugin, calls the plugin's client does
+// not support fail with ErrUnsupported
+type Client struct {
+	rpc          *rpc.Client
+	cmd          *exec.Cmd
+	capabilities sms.Capabilities
+	lastCall     atomic.Uint64
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+)
+
+// metadata marks phone numbers rented through a plugin, the plugin holds the
+// provider's own metadata
+type metadata struct {
+	client *Client
+}
+
+// Start runs the plugin at path and initializes its client with apiKey, the
+// plugin is killed when ctx is done
+func Start(ctx context.Context, path string, apiKey string, args ...string) (*Client, error) {
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("smsplugin: starting %s: %w", path, err)
+	}
+
+	c, err := NewClient(ctx, pipe{ReadCloser: stdout, WriteCloser: stdin}, apiKey)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return nil, fmt.Errorf("smsplugin: %s: %w", path, err)
+	}
+
+	c.cmd = cmd
+	return c, nil
+}
+
+// NewClient speaks to a plugin already connected to conn
+func NewClient(ctx context.Context, conn io.ReadWriteCloser, apiKey string) (*Client, error) {
+	c := &Client{rpc: jsonrpc.NewClient(conn)}
+
+	var reply InitReply
+	if err := c.call(ctx, "Init", &InitArgs{APIKey: apiKey}, &reply); err != nil {
+		c.rpc.Close()
+		return nil, err
+	}
+
+	c.capabilities = reply.Capabilities
+	return c, nil
+}
+
+type pipe struct {
+	io.ReadCloser
+	io.WriteCloser
+}
+
+func (p pipe) Close() error {
+	err := p.WriteCloser.Close()
+	if readErr := p.ReadCloser.Close(); err == nil {
+		err = readErr
+	}
+
+	return err
+}
+
+// Close disconnects from the plugin and waits for it to exit
+func (c *Client) Close() error {
+	err := c.rpc.Close()
+	if c.cmd != nil {
+		if waitErr := c.cmd.Wait(); err == nil {
+			err = waitErr
+		}
+	}
+
+	return err
+}
+
+// args carry the call's ID and ctx's deadline
+type args interface {
+	setCall(call Call)
+}
+
+// call asks the plugin to cancel the call once ctx is cancelled
+func (c *Client) call(ctx context.Context, method string, args args, reply any) error {
+	id := c.lastCall.Add(1)
+	deadline, _ := ctx.Deadline()
+	args.setCall(Call{ID: id, Deadline: deadline})
+
+	call := c.rpc.Go(service+"."+method, args, reply, make(chan *rpc.Call, 1))
+
+	select {
+	case <-ctx.Done():
+		// the plugin ends calls past their deadline itself and may still be
+		// sending the reply, which is dropped
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			c.rpc.Go(service+".Cancel", &CancelArgs{ID: id}, &CancelReply{}, make(chan *rpc.Call, 1))
+		}
+		return ctx.Err()
+	case <-call.Done:
+	}
+
+	var serverError rpc.ServerError
+	if errors.As(call.Error, &serverError) {
+		return fromRemote(string(serverError))
+	}
+
+	return call.Error
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return c.capabilities
+}
+
+func (c *Client) rental(phoneNumber *sms.PhoneNumber) (sms.Rental, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok || metadata.client != c {
+		return sms.Rental{}, sms.ErrInvalidMetadata
+	}
+
+	return phoneNumber.Rental(), nil
+}
+
+// apply updates phoneNumber with the state the plugin reported
+func apply(phoneNumber *sms.PhoneNumber, rental sms.Rental) {
+	if rental.Used {
+		phoneNumber.MarkUsed()
+	}
+
+	if rental.Cancelled {
+		phoneNumber.MarkCancelled()
+	}
+
+	if !rental.ExpiresAt.IsZero() {
+		phoneNumber.SetExpiresAt(rental.ExpiresAt)
+	}
+}
+
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	var reply RentalReply
+	if err := c.call(ctx, "GetPhoneNumber", &GetPhoneNumberArgs{Service: service, Country: country}, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Rental.PhoneNumber(metadata{client: c})
+}
+
+func (c *Client) RestorePhoneNumber(ctx context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	var reply RentalReply
+	if err := c.call(ctx, "RestorePhoneNumber", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Rental.PhoneNumber(metadata{client: c})
+}
+
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	rental, err := c.rental(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply GetMessagesReply
+	if err := c.call(ctx, "GetMessages", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return nil, err
+	}
+
+	apply(phoneNumber, reply.Rental)
+
+	if reply.Messages == nil {
+		return []string{}, nil
+	}
+
+	return reply.Messages, nil
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	rental, err := c.rental(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply GetStatusReply
+	if err := c.call(ctx, "GetStatus", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return nil, err
+	}
+
+	apply(phoneNumber, reply.Rental)
+	return sms.NewPhoneNumberStatus(reply.Status, phoneNumber.ExpiresAt()), nil
+}
+
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	rental, err := c.rental(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	var reply RentalReply
+	if err := c.call(ctx, "CancelPhoneNumber", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return err
+	}
+
+	apply(phoneNumber, reply.Rental)
+	return nil
+}
+
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	rental, err := c.rental(phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	var reply RentalReply
+	if err := c.call(ctx, "ReportPhoneNumber", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return err
+	}
+
+	apply(phoneNumber, reply.Rental)
+	return nil
+}
+
+// ReusePhoneNumber returns phoneNumber unless the plugin rented a new order
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	rental, err := c.rental(phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := phoneNumber.CanReuse(); err != nil {
+		return nil, err
+	}
+
+	var reply RentalReply
+	if err := c.call(ctx, "ReusePhoneNumber", &NumberArgs{Rental: rental}, &reply); err != nil {
+		return nil, err
+	}
+
+	if reply.Rental.ID != rental.ID || reply.Rental.Number != rental.Number {
+		return reply.Rental.PhoneNumber(metadata{client: c})
+	}
+
+	if err := phoneNumber.Reuse(); err != nil {
+		return nil, err
+	}
+
+	apply(phoneNumber, reply.Rental)
+	return phoneNumber, nil
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	var reply GetBalanceReply
+	if err := c.call(ctx, "GetBalance", &GetBalanceArgs{}, &reply); err != nil {
+		return 0, err
+	}
+
+	return reply.Balance, nil
+}
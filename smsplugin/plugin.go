@@ -0,0 +1,362 @@
+package smsplugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sync"
+
+	"github.com/saucesteals/sms"
+)
+
+// NewClientFunc builds the plugin's client once the host sends its api key, ctx
+// is cancelled when the host disconnects
+type NewClientFunc func(ctx context.Context, apiKey string) (sms.Client, error)
+
+// Serve serves the client built by newClient on stdin and stdout until the
+// host disconnects
+func Serve(newClient NewClientFunc) error {
+	return ServeConn(stdio{}, newClient)
+}
+
+// ServeConn serves the client built by newClient on conn until it is closed
+func ServeConn(conn io.ReadWriteCloser, newClient NewClientFunc) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := rpc.NewServer()
+	if err := server.RegisterName(service, &plugin{
+		ctx:       ctx,
+		newClient: newClient,
+		numbers:   map[string]*sms.PhoneNumber{},
+		calls:     map[uint64]context.CancelFunc{},
+		cancelled: map[uint64]struct{}{},
+	}); err != nil {
+		return err
+	}
+
+	server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	return nil
+}
+
+type stdio struct{}
+
+func (stdio) Read(p []byte) (int, error)  { return os.Stdin.Read(p) }
+func (stdio) Write(p []byte) (int, error) { return os.Stdout.Write(p) }
+func (stdio) Close() error                { return os.Stdin.Close() }
+
+// plugin is the RPC receiver, its exported methods are the protocol
+type plugin struct {
+	ctx       context.Context
+	newClient NewClientFunc
+
+	mu      sync.Mutex
+	client  sms.Client
+	numbers map[string]*sms.PhoneNumber
+	// calls cancel the calls in flight by ID, cancelled are the IDs the host
+	// cancelled before their call started or after it returned
+	calls     map[uint64]context.CancelFunc
+	cancelled map[uint64]struct{}
+}
+
+// maxCancelled bounds cancelled, whose IDs are mostly of calls that returned
+const maxCancelled = 1024
+
+var errNotInitialized = errors.New("smsplugin: Init was not called")
+
+func key(rental sms.Rental) string {
+	return rental.Provider + "\x00" + rental.ID + "\x00" + rental.Number
+}
+
+func (p *plugin) getClient() (sms.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.client == nil {
+		return nil, errNotInitialized
+	}
+
+	return p.client, nil
+}
+
+// begin returns the context of call, end must be called once it returns
+func (p *plugin) begin(call Call) (ctx context.Context, end func()) {
+	var cancel context.CancelFunc
+	if call.Deadline.IsZero() {
+		ctx, cancel = context.WithCancel(p.ctx)
+	} else {
+		ctx, cancel = context.WithDeadline(p.ctx, call.Deadline)
+	}
+
+	// hosts predating call IDs cannot cancel calls
+	if call.ID == 0 {
+		return ctx, cancel
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.cancelled[call.ID]; ok {
+		delete(p.cancelled, call.ID)
+		cancel()
+	} else {
+		p.calls[call.ID] = cancel
+	}
+
+	return ctx, func() {
+		p.mu.Lock()
+		delete(p.calls, call.ID)
+		p.mu.Unlock()
+
+		cancel()
+	}
+}
+
+// Cancel cancels the context of the call with args' ID
+func (p *plugin) Cancel(args CancelArgs, _ *CancelReply) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cancel, ok := p.calls[args.ID]; ok {
+		cancel()
+		return nil
+	}
+
+	if len(p.cancelled) >= maxCancelled {
+		p.cancelled = map[uint64]struct{}{}
+	}
+	p.cancelled[args.ID] = struct{}{}
+	return nil
+}
+
+// track keeps phoneNumber, forgetting numbers that expired or were cancelled
+func (p *plugin) track(phoneNumber *sms.PhoneNumber) sms.Rental {
+	rental := phoneNumber.Rental()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for k, tracked := range p.numbers {
+		if tracked.Cancelled() || tracked.Expired() {
+			delete(p.numbers, k)
+		}
+	}
+
+	p.numbers[key(rental)] = phoneNumber
+	return rental
+}
+
+func (p *plugin) forget(rental sms.Rental) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.numbers, key(rental))
+}
+
+// phoneNumber finds the number the host refers to, restoring it when the
+// plugin did not rent it
+func (p *plugin) phoneNumber(ctx context.Context, rental sms.Rental) (sms.Client, *sms.PhoneNumber, error) {
+	client, err := p.getClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.mu.Lock()
+	phoneNumber, ok := p.numbers[key(rental)]
+	p.mu.Unlock()
+
+	if ok {
+		return client, phoneNumber, nil
+	}
+
+	restorable, ok := client.(sms.RestorableClient)
+	if !ok {
+		return nil, nil, sms.ErrInvalidMetadata
+	}
+
+	phoneNumber, err = restorable.RestorePhoneNumber(ctx, rental)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.track(phoneNumber)
+	return client, phoneNumber, nil
+}
+
+func (p *plugin) Init(args InitArgs, reply *InitReply) error {
+	client, err := p.newClient(p.ctx, args.APIKey)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.client = client
+	p.mu.Unlock()
+
+	reply.Capabilities = sms.CapabilitiesOf(client)
+	return nil
+}
+
+func (p *plugin) GetPhoneNumber(args GetPhoneNumberArgs, reply *RentalReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, err := p.getClient()
+	if err != nil {
+		return err
+	}
+
+	phoneNumber, err := client.GetPhoneNumber(ctx, args.Service, args.Country)
+	if err != nil {
+		return err
+	}
+
+	reply.Rental = p.track(phoneNumber)
+	return nil
+}
+
+func (p *plugin) RestorePhoneNumber(args NumberArgs, reply *RentalReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	_, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	reply.Rental = phoneNumber.Rental()
+	return nil
+}
+
+func (p *plugin) GetMessages(args NumberArgs, reply *GetMessagesReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	messages, err := client.GetMessages(ctx, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	reply.Rental = phoneNumber.Rental()
+	reply.Messages = messages
+	return nil
+}
+
+func (p *plugin) GetStatus(args NumberArgs, reply *GetStatusReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	statusClient, ok := client.(sms.StatusClient)
+	if !ok {
+		return ErrUnsupported
+	}
+
+	status, err := statusClient.GetStatus(ctx, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	reply.Rental = phoneNumber.Rental()
+	reply.Status = status.Status
+	return nil
+}
+
+func (p *plugin) CancelPhoneNumber(args NumberArgs, reply *RentalReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	p.forget(args.Rental)
+	reply.Rental = phoneNumber.Rental()
+	return nil
+}
+
+func (p *plugin) ReportPhoneNumber(args NumberArgs, reply *RentalReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ReportPhoneNumber(ctx, phoneNumber); err != nil {
+		return err
+	}
+
+	p.forget(args.Rental)
+	reply.Rental = phoneNumber.Rental()
+	return nil
+}
+
+func (p *plugin) ReusePhoneNumber(args NumberArgs, reply *RentalReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, phoneNumber, err := p.phoneNumber(ctx, args.Rental)
+	if err != nil {
+		return err
+	}
+
+	reusable, ok := client.(sms.ReusableClient)
+	if !ok {
+		return ErrUnsupported
+	}
+
+	reused, err := reusable.ReusePhoneNumber(ctx, phoneNumber)
+	if err != nil {
+		return err
+	}
+
+	// some providers rent a new order for the same number
+	if reused != phoneNumber {
+		p.forget(args.Rental)
+	}
+
+	reply.Rental = p.track(reused)
+	return nil
+}
+
+func (p *plugin) GetBalance(args GetBalanceArgs, reply *GetBalanceReply) error {
+	ctx, end := p.begin(args.Call)
+	defer end()
+
+	client, err := p.getClient()
+	if err != nil {
+		return err
+	}
+
+	balanceClient, ok := client.(sms.BalanceClient)
+	if !ok {
+		return ErrUnsupported
+	}
+
+	balance, err := balanceClient.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	reply.Balance = balance
+	return nil
+}
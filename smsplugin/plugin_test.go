@@ -0,0 +1,142 @@
+package smsplugin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+// blockingClient's GetMessages blocks until its context is done
+type blockingClient struct {
+	done chan error
+}
+
+func (c *blockingClient) GetPhoneNumber(context.Context, string, string) (*sms.PhoneNumber, error) {
+	number, err := phonenumbers.Parse("+12025550123", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return sms.NewPhoneNumber(number, sms.Order{Provider: "test", ID: "1"}, nil), nil
+}
+
+func (c *blockingClient) GetMessages(ctx context.Context, _ *sms.PhoneNumber) ([]string, error) {
+	<-ctx.Done()
+	c.done <- ctx.Err()
+	return nil, ctx.Err()
+}
+
+func (c *blockingClient) CancelPhoneNumber(_ context.Context, phoneNumber *sms.PhoneNumber) error {
+	phoneNumber.MarkCancelled()
+	return nil
+}
+
+func (c *blockingClient) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return c.CancelPhoneNumber(ctx, phoneNumber)
+}
+
+func startTest(t *testing.T) (*Client, *blockingClient) {
+	t.Helper()
+
+	host, conn := net.Pipe()
+	blocking := &blockingClient{done: make(chan error, 1)}
+
+	go ServeConn(conn, func(context.Context, string) (sms.Client, error) {
+		return blocking, nil
+	})
+
+	c, err := NewClient(context.Background(), host, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c, blocking
+}
+
+func TestCancellationReachesThePlugin(t *testing.T) {
+	c, blocking := startTest(t)
+
+	phoneNumber, err := c.GetPhoneNumber(context.Background(), "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	if _, err := c.GetMessages(ctx, phoneNumber); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+
+	select {
+	case err := <-blocking.done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("plugin call ended with %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the plugin's call was not cancelled")
+	}
+}
+
+func TestDeadlineReachesThePlugin(t *testing.T) {
+	c, blocking := startTest(t)
+
+	phoneNumber, err := c.GetPhoneNumber(context.Background(), "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the host waits longer than the deadline it sends
+	deadline := time.Now().Add(50 * time.Millisecond)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	go c.GetMessages(ctx, phoneNumber)
+
+	select {
+	case err := <-blocking.done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("plugin call ended with %v, want context.DeadlineExceeded", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the plugin's call outlived its deadline")
+	}
+}
+
+func TestCancelledNumbersAreForgotten(t *testing.T) {
+	p := &plugin{
+		ctx:       context.Background(),
+		client:    &blockingClient{},
+		numbers:   map[string]*sms.PhoneNumber{},
+		calls:     map[uint64]context.CancelFunc{},
+		cancelled: map[uint64]struct{}{},
+	}
+
+	var rented RentalReply
+	if err := p.GetPhoneNumber(GetPhoneNumberArgs{Call: Call{ID: 1}, Service: "test"}, &rented); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.numbers) != 1 {
+		t.Fatalf("tracking %d numbers, want 1", len(p.numbers))
+	}
+
+	var cancelled RentalReply
+	if err := p.CancelPhoneNumber(NumberArgs{Call: Call{ID: 2}, Rental: rented.Rental}, &cancelled); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.numbers) != 0 {
+		t.Fatalf("tracking %d numbers after cancelling, want 0", len(p.numbers))
+	}
+	if len(p.calls) != 0 {
+		t.Fatalf("tracking %d calls after they returned, want 0", len(p.calls))
+	}
+}
@@ -0,0 +1,136 @@
+// Package smsplugin runs providers as separate executables, speaking JSON-RPC
+// 1.0 (net/rpc/jsonrpc) over the plugin's stdin and stdout.
+//
+// A plugin is a program calling Serve with a constructor for its client:
+//
+//	func main() {
+//		err := smsplugin.Serve(func(ctx context.Context, apiKey string) (sms.Client, error) {
+//			return vendor.NewClient(apiKey), nil
+//		})
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// and Start runs it as an sms.Client:
+//
+//	client, err := smsplugin.Start(ctx, "./sms-vendor", apiKey)
+//
+// Plugins must not write anything but the protocol to stdout, their stderr is
+// passed through. Phone numbers cross the process boundary as sms.Rental, the
+// plugin keeps the numbers it rented and restores the others when its client
+// is an sms.RestorableClient.
+//
+// Every call carries the deadline of the host's context, and the host calls
+// Cancel with the call's ID when its context is done before the call returns.
+package smsplugin
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// service is the RPC service name, methods are called as "Plugin.<Method>"
+const service = "Plugin"
+
+// Call identifies a call so the host can cancel it, Deadline is zero when the
+// call has none
+type Call struct {
+	ID       uint64    `json:"call_id"`
+	Deadline time.Time `json:"deadline"`
+}
+
+func (c *Call) setCall(call Call) {
+	*c = call
+}
+
+type CancelArgs struct {
+	ID uint64 `json:"call_id"`
+}
+
+type CancelReply struct{}
+
+type InitArgs struct {
+	Call
+	APIKey string `json:"api_key"`
+}
+
+type InitReply struct {
+	Capabilities sms.Capabilities `json:"capabilities"`
+}
+
+type GetPhoneNumberArgs struct {
+	Call
+	Service string `json:"service"`
+	Country string `json:"country"`
+}
+
+// NumberArgs identifies a rented number
+type NumberArgs struct {
+	Call
+	Rental sms.Rental `json:"rental"`
+}
+
+// RentalReply is the number's state after the call
+type RentalReply struct {
+	Rental sms.Rental `json:"rental"`
+}
+
+type GetMessagesReply struct {
+	Rental   sms.Rental `json:"rental"`
+	Messages []string   `json:"messages"`
+}
+
+type GetStatusReply struct {
+	Rental sms.Rental `json:"rental"`
+	Status sms.Status `json:"status"`
+}
+
+type GetBalanceArgs struct {
+	Call
+}
+
+type GetBalanceReply struct {
+	Balance float64 `json:"balance"`
+}
+
+// sentinels survive the trip through the protocol, which only carries error
+// messages
+var sentinels = []error{
+	sms.ErrExpired,
+	sms.ErrRatelimited,
+	sms.ErrInvalidMetadata,
+	sms.ErrInvalidState,
+	ErrUnsupported,
+}
+
+var ErrUnsupported = errors.New("smsplugin: not supported by the plugin's client")
+
+// remoteError wraps an error returned by the plugin
+type remoteError struct {
+	message  string
+	sentinel error
+}
+
+func (e *remoteError) Error() string {
+	return e.message
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.sentinel
+}
+
+func fromRemote(message string) error {
+	err := &remoteError{message: message}
+	for _, sentinel := range sentinels {
+		if strings.Contains(message, sentinel.Error()) {
+			err.sentinel = sentinel
+			break
+		}
+	}
+
+	return err
+}
@@ -1,1316 +1,6300 @@
 // Code generated by saucesteals/sms; DO NOT EDIT.
+
 package smspool
 
-var (
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceID101Sweets                                          ServiceID = "1106"
+	ServiceID1688                                               ServiceID = "1"
+	ServiceID1Q                                                 ServiceID = "2"
+	ServiceID1StopMove                                          ServiceID = "3"
+	ServiceID2dehands                                           ServiceID = "4"
+	ServiceID2game                                              ServiceID = "5"
+	ServiceID2RedBeans                                          ServiceID = "6"
+	ServiceID360NRS                                             ServiceID = "7"
+	ServiceID3Fun                                               ServiceID = "8"
+	ServiceID5karu                                              ServiceID = "9"
+	ServiceID5miles                                             ServiceID = "10"
+	ServiceID7Eleven                                            ServiceID = "11"
+	ServiceID7Mall                                              ServiceID = "12"
+	ServiceID888poker                                           ServiceID = "13"
+	ServiceIDA1Wallet                                           ServiceID = "14"
+	ServiceIDAARP                                               ServiceID = "1292"
+	ServiceIDAARPRewards                                        ServiceID = "15"
+	ServiceIDAblo                                               ServiceID = "16"
+	ServiceIDAbra                                               ServiceID = "17"
+	ServiceIDAccountKit                                         ServiceID = "18"
+	ServiceIDAccountPatrolMoneyPatrol                           ServiceID = "1107"
+	ServiceIDAcorns                                             ServiceID = "1108"
+	ServiceIDAdGate                                             ServiceID = "1103"
+	ServiceIDAdidas                                             ServiceID = "19"
+	ServiceIDAdira                                              ServiceID = "1094"
+	ServiceIDAdItUp                                             ServiceID = "20"
+	ServiceIDADList24                                           ServiceID = "21"
+	ServiceIDAdobe                                              ServiceID = "22"
+	ServiceIDAdvCash                                            ServiceID = "23"
+	ServiceIDAdWallet                                           ServiceID = "24"
+	ServiceIDAeldra                                             ServiceID = "1109"
+	ServiceIDAffirm                                             ServiceID = "25"
+	ServiceIDAfterpay                                           ServiceID = "26"
+	ServiceIDAgoda                                              ServiceID = "27"
+	ServiceIDAH4R                                               ServiceID = "1248"
+	ServiceIDAhead                                              ServiceID = "1110"
+	ServiceIDAirbnb                                             ServiceID = "28"
+	ServiceIDAirTel                                             ServiceID = "29"
+	ServiceIDAirtm                                              ServiceID = "30"
+	ServiceIDAkulaku                                            ServiceID = "31"
+	ServiceIDAlbert                                             ServiceID = "32"
+	ServiceIDAlibaba                                            ServiceID = "33"
+	ServiceIDAliexpress                                         ServiceID = "1341"
+	ServiceIDAlignable                                          ServiceID = "34"
+	ServiceIDAlipay                                             ServiceID = "35"
+	ServiceIDAllset                                             ServiceID = "36"
+	ServiceIDALTBalaji                                          ServiceID = "37"
+	ServiceIDAmasia                                             ServiceID = "38"
+	ServiceIDAmazonAmazonWebs                                   ServiceID = "39"
+	ServiceIDAmazonWebs                                         ServiceID = "1112"
+	ServiceIDAmericaVoice                                       ServiceID = "40"
+	ServiceIDAndo                                               ServiceID = "41"
+	ServiceIDAngi                                               ServiceID = "1282"
+	ServiceIDAnibis                                             ServiceID = "42"
+	ServiceIDAnkama                                             ServiceID = "43"
+	ServiceIDAnycoinDirect                                      ServiceID = "44"
+	ServiceIDANZ                                                ServiceID = "45"
+	ServiceIDAol                                                ServiceID = "46"
+	ServiceIDAppFlame                                           ServiceID = "47"
+	ServiceIDAppinio                                            ServiceID = "1350"
+	ServiceIDApple                                              ServiceID = "48"
+	ServiceIDAppleWallet                                        ServiceID = "1113"
+	ServiceIDAppLovin                                           ServiceID = "49"
+	ServiceIDAppStation                                         ServiceID = "50"
+	ServiceIDARMSLIST                                           ServiceID = "51"
+	ServiceIDAs2in1                                             ServiceID = "52"
+	ServiceIDAsbucks                                            ServiceID = "1318"
+	ServiceIDAspiration                                         ServiceID = "1114"
+	ServiceIDATMcom                                             ServiceID = "1115"
+	ServiceIDAtom                                               ServiceID = "53"
+	ServiceIDAtomy                                              ServiceID = "54"
+	ServiceIDAttaPoll                                           ServiceID = "55"
+	ServiceIDAustraliaPost                                      ServiceID = "56"
+	ServiceIDAuthy                                              ServiceID = "57"
+	ServiceIDAutoru                                             ServiceID = "58"
+	ServiceIDAutotrader                                         ServiceID = "59"
+	ServiceIDAvail                                              ServiceID = "60"
+	ServiceIDAvito                                              ServiceID = "61"
+	ServiceIDAyoba                                              ServiceID = "62"
+	ServiceIDAzure                                              ServiceID = "1073"
+	ServiceIDBackblaze                                          ServiceID = "63"
+	ServiceIDBadi                                               ServiceID = "64"
+	ServiceIDBadoo                                              ServiceID = "65"
+	ServiceIDBaidu                                              ServiceID = "66"
+	ServiceIDBakkt                                              ServiceID = "1116"
+	ServiceIDBanggood                                           ServiceID = "1090"
+	ServiceIDBankOfAmerica                                      ServiceID = "1337"
+	ServiceIDBanq24                                             ServiceID = "68"
+	ServiceIDBanxa                                              ServiceID = "69"
+	ServiceIDBaselane                                           ServiceID = "1312"
+	ServiceIDBattlenetBlizzard                                  ServiceID = "70"
+	ServiceIDBBVA                                               ServiceID = "71"
+	ServiceIDBDSwiss                                            ServiceID = "72"
+	ServiceIDBeat                                               ServiceID = "1275"
+	ServiceIDBeemIt                                             ServiceID = "73"
+	ServiceIDBeetalk                                            ServiceID = "74"
+	ServiceIDBeForthRight                                       ServiceID = "75"
+	ServiceIDBestOfOurValley                                    ServiceID = "76"
+	ServiceIDBet365                                             ServiceID = "1332"
+	ServiceIDBet9ja                                             ServiceID = "77"
+	ServiceIDBetCris                                            ServiceID = "78"
+	ServiceIDBetfair                                            ServiceID = "79"
+	ServiceIDBetfred                                            ServiceID = "80"
+	ServiceIDBetMGM                                             ServiceID = "1269"
+	ServiceIDBetterment                                         ServiceID = "1119"
+	ServiceIDBidoo                                              ServiceID = "81"
+	ServiceIDBigolive                                           ServiceID = "82"
+	ServiceIDBigToken                                           ServiceID = "83"
+	ServiceIDBiltRewards                                        ServiceID = "1120"
+	ServiceIDBIM                                                ServiceID = "84"
+	ServiceIDBinance                                            ServiceID = "85"
+	ServiceIDBing                                               ServiceID = "86"
+	ServiceIDBingoCash                                          ServiceID = "1308"
+	ServiceIDBit4Coin                                           ServiceID = "87"
+	ServiceIDBit4Sale                                           ServiceID = "88"
+	ServiceIDBitaccess                                          ServiceID = "89"
+	ServiceIDBitClout                                           ServiceID = "90"
+	ServiceIDBitClude                                           ServiceID = "91"
+	ServiceIDbitcoinAlley                                       ServiceID = "1121"
+	ServiceIDBitcoinATM                                         ServiceID = "92"
+	ServiceIDBitcoinde                                          ServiceID = "93"
+	ServiceIDBitcoinSolutions                                   ServiceID = "94"
+	ServiceIDbitFlyer                                           ServiceID = "95"
+	ServiceIDBitfront                                           ServiceID = "96"
+	ServiceIDBitgamesio                                         ServiceID = "97"
+	ServiceIDBithumb                                            ServiceID = "98"
+	ServiceIDBitlabs                                            ServiceID = "1278"
+	ServiceIDBitmax                                             ServiceID = "99"
+	ServiceIDBitmo                                              ServiceID = "100"
+	ServiceIDBitOasis                                           ServiceID = "101"
+	ServiceIDBitonic                                            ServiceID = "102"
+	ServiceIDBitpanda                                           ServiceID = "103"
+	ServiceIDBitsa                                              ServiceID = "104"
+	ServiceIDBitsdaq                                            ServiceID = "105"
+	ServiceIDBitso                                              ServiceID = "106"
+	ServiceIDBitstamp                                           ServiceID = "107"
+	ServiceIDBitTube                                            ServiceID = "108"
+	ServiceIDBitwage                                            ServiceID = "109"
+	ServiceIDBity                                               ServiceID = "110"
+	ServiceIDBlaBla                                             ServiceID = "111"
+	ServiceIDBlackcatcard                                       ServiceID = "112"
+	ServiceIDBlackPeopleMeet                                    ServiceID = "113"
+	ServiceIDBlibli                                             ServiceID = "1092"
+	ServiceIDBLK                                                ServiceID = "115"
+	ServiceIDBlockchain                                         ServiceID = "116"
+	ServiceIDBlockFi                                            ServiceID = "1122"
+	ServiceIDBloomMe                                            ServiceID = "117"
+	ServiceIDBlueAcorn                                          ServiceID = "118"
+	ServiceIDBlueBird                                           ServiceID = "1123"
+	ServiceIDBlued                                              ServiceID = "119"
+	ServiceIDBlueFederalCreditUnion                             ServiceID = "120"
+	ServiceIDBluePay                                            ServiceID = "121"
+	ServiceIDBlueVine                                           ServiceID = "122"
+	ServiceIDBMOHarris                                          ServiceID = "1124"
+	ServiceIDBoatsetter                                         ServiceID = "123"
+	ServiceIDBolt                                               ServiceID = "124"
+	ServiceIDBoo                                                ServiceID = "1300"
+	ServiceIDBookingcom                                         ServiceID = "125"
+	ServiceIDBoon                                               ServiceID = "126"
+	ServiceIDBOSSRevolutionMoney                                ServiceID = "1230"
+	ServiceIDBotBroker                                          ServiceID = "128"
+	ServiceIDBotcode                                            ServiceID = "129"
+	ServiceIDBotim                                              ServiceID = "130"
+	ServiceIDBovada                                             ServiceID = "1125"
+	ServiceIDBoxedDeal                                          ServiceID = "131"
+	ServiceIDBraid                                              ServiceID = "132"
+	ServiceIDBrandclub                                          ServiceID = "1126"
+	ServiceIDBrandedSurvey                                      ServiceID = "133"
+	ServiceIDBrazzers                                           ServiceID = "134"
+	ServiceIDBrex                                               ServiceID = "135"
+	ServiceIDBridge                                             ServiceID = "136"
+	ServiceIDBridgeCard                                         ServiceID = "1127"
+	ServiceIDBroxel                                             ServiceID = "137"
+	ServiceIDBTCDirect                                          ServiceID = "138"
+	ServiceIDBTCsurveys                                         ServiceID = "139"
+	ServiceIDBubbleCash                                         ServiceID = "1307"
+	ServiceIDBukalapak                                          ServiceID = "140"
+	ServiceIDBulkSMScom                                         ServiceID = "141"
+	ServiceIDBumble                                             ServiceID = "142"
+	ServiceIDBump                                               ServiceID = "143"
+	ServiceIDBundil                                             ServiceID = "144"
+	ServiceIDBunq                                               ServiceID = "145"
+	ServiceIDBurgerKing                                         ServiceID = "1231"
+	ServiceIDBurgerKing_146                                     ServiceID = "146"
+	ServiceIDBurnerApp                                          ServiceID = "147"
+	ServiceIDBurstSMS                                           ServiceID = "1246"
+	ServiceIDBuyOnTrust                                         ServiceID = "1128"
+	ServiceIDByBit                                              ServiceID = "148"
+	ServiceIDCabify                                             ServiceID = "149"
+	ServiceIDCanadaComputers                                    ServiceID = "150"
+	ServiceIDCapitalOne                                         ServiceID = "151"
+	ServiceIDCARDcom                                            ServiceID = "152"
+	ServiceIDCardyard                                           ServiceID = "153"
+	ServiceIDCareem                                             ServiceID = "154"
+	ServiceIDCarepoynt                                          ServiceID = "155"
+	ServiceIDCarousell                                          ServiceID = "156"
+	ServiceIDCarsGuide                                          ServiceID = "157"
+	ServiceIDCashAA                                             ServiceID = "158"
+	ServiceIDCashAlarm                                          ServiceID = "159"
+	ServiceIDCashApp                                            ServiceID = "160"
+	ServiceIDCashbackbase                                       ServiceID = "161"
+	ServiceIDCashew                                             ServiceID = "1256"
+	ServiceIDCashShow                                           ServiceID = "162"
+	ServiceIDCashWalk                                           ServiceID = "163"
+	ServiceIDCashZine                                           ServiceID = "164"
+	ServiceIDCasumo                                             ServiceID = "165"
+	ServiceIDCatchMe                                            ServiceID = "166"
+	ServiceIDCaviar                                             ServiceID = "167"
+	ServiceIDcdkeyscom                                          ServiceID = "168"
+	ServiceIDCELEBe                                             ServiceID = "1266"
+	ServiceIDCentroBill                                         ServiceID = "169"
+	ServiceIDCentrum                                            ServiceID = "170"
+	ServiceIDCEXIO                                              ServiceID = "171"
+	ServiceIDChampsSports                                       ServiceID = "1129"
+	ServiceIDChangelly                                          ServiceID = "172"
+	ServiceIDChaosCloud                                         ServiceID = "173"
+	ServiceIDCharlesSchwab                                      ServiceID = "1130"
+	ServiceIDChase                                              ServiceID = "174"
+	ServiceIDCheapVoip                                          ServiceID = "175"
+	ServiceIDCheckbookio                                        ServiceID = "176"
+	ServiceIDCheckPoints                                        ServiceID = "177"
+	ServiceIDCheese                                             ServiceID = "178"
+	ServiceIDChevron                                            ServiceID = "1325"
+	ServiceIDChicksGoldInc                                      ServiceID = "1131"
+	ServiceIDChime                                              ServiceID = "179"
+	ServiceIDChipotle                                           ServiceID = "1313"
+	ServiceIDChipper                                            ServiceID = "180"
+	ServiceIDChispa                                             ServiceID = "181"
+	ServiceIDChowbus                                            ServiceID = "182"
+	ServiceIDChumbaCasino                                       ServiceID = "1085"
+	ServiceIDCIBC                                               ServiceID = "183"
+	ServiceIDCinchbucks                                         ServiceID = "184"
+	ServiceIDCircle                                             ServiceID = "185"
+	ServiceIDCitizen                                            ServiceID = "1302"
+	ServiceIDCJSCDKEYSCOM                                       ServiceID = "186"
+	ServiceIDClearpay                                           ServiceID = "188"
+	ServiceIDClearVoice                                         ServiceID = "189"
+	ServiceIDCledara                                            ServiceID = "190"
+	ServiceIDCleo                                               ServiceID = "191"
+	ServiceIDClickadu                                           ServiceID = "192"
+	ServiceIDClickatell                                         ServiceID = "193"
+	ServiceIDClickDishes                                        ServiceID = "194"
+	ServiceIDclickworker                                        ServiceID = "195"
+	ServiceIDClipClaps                                          ServiceID = "196"
+	ServiceIDCLiQQ                                              ServiceID = "197"
+	ServiceIDCloudBet                                           ServiceID = "198"
+	ServiceIDCloudSim                                           ServiceID = "199"
+	ServiceIDCloudways                                          ServiceID = "200"
+	ServiceIDClover                                             ServiceID = "201"
+	ServiceIDClubFactory                                        ServiceID = "202"
+	ServiceIDClubhouse                                          ServiceID = "203"
+	ServiceIDClubVPS                                            ServiceID = "204"
+	ServiceIDCocaCola                                           ServiceID = "1244"
+	ServiceIDCodaPayments                                       ServiceID = "205"
+	ServiceIDCoffeeMeetsBagel                                   ServiceID = "206"
+	ServiceIDCoinbase                                           ServiceID = "208"
+	ServiceIDCoincasper                                         ServiceID = "1331"
+	ServiceIDCoinChat                                           ServiceID = "209"
+	ServiceIDCoinCircle                                         ServiceID = "1133"
+	ServiceIDCoinCloud                                          ServiceID = "210"
+	ServiceIDCoinEx                                             ServiceID = "211"
+	ServiceIDCoinFlip                                           ServiceID = "212"
+	ServiceIDCoinGate                                           ServiceID = "213"
+	ServiceIDCoinhouse                                          ServiceID = "214"
+	ServiceIDCoinipop                                           ServiceID = "215"
+	ServiceIDCoinjar                                            ServiceID = "216"
+	ServiceIDCoinloot                                           ServiceID = "1283"
+	ServiceIDCoinme                                             ServiceID = "217"
+	ServiceIDCoinomi                                            ServiceID = "218"
+	ServiceIDCoinOut                                            ServiceID = "1134"
+	ServiceIDCoinPop                                            ServiceID = "219"
+	ServiceIDCoinsBaron                                         ServiceID = "1101"
+	ServiceIDCoinseed                                           ServiceID = "220"
+	ServiceIDCoinsph                                            ServiceID = "221"
+	ServiceIDCoinSpot                                           ServiceID = "222"
+	ServiceIDCoinstash                                          ServiceID = "223"
+	ServiceIDCoinSwitch                                         ServiceID = "224"
+	ServiceIDCointelegraph                                      ServiceID = "225"
+	ServiceIDCoinZoom                                           ServiceID = "226"
+	ServiceIDComenityBreadFinancialBreadPay                     ServiceID = "1135"
+	ServiceIDCommunityInsightsForum                             ServiceID = "227"
+	ServiceIDConfirmed                                          ServiceID = "228"
+	ServiceIDCopper                                             ServiceID = "229"
+	ServiceIDCornerCard                                         ServiceID = "230"
+	ServiceIDCouponscom                                         ServiceID = "231"
+	ServiceIDCourseHero                                         ServiceID = "232"
+	ServiceIDCPAGrip                                            ServiceID = "1301"
+	ServiceIDCraigslist                                         ServiceID = "233"
+	ServiceIDCrazyKart                                          ServiceID = "234"
+	ServiceIDCreditKarma                                        ServiceID = "235"
+	ServiceIDCreditSesame                                       ServiceID = "236"
+	ServiceIDCrowdTap                                           ServiceID = "237"
+	ServiceIDCrypterium                                         ServiceID = "238"
+	ServiceIDCryptocom                                          ServiceID = "239"
+	ServiceIDCryptolocally                                      ServiceID = "1136"
+	ServiceIDCryptopay                                          ServiceID = "240"
+	ServiceIDCryptoVoucher                                      ServiceID = "241"
+	ServiceIDCUA                                                ServiceID = "242"
+	ServiceIDCupis                                              ServiceID = "1333"
+	ServiceIDCurb                                               ServiceID = "243"
+	ServiceIDCuriousCat                                         ServiceID = "244"
+	ServiceIDCurrent                                            ServiceID = "245"
+	ServiceIDCurrentMusic                                       ServiceID = "246"
+	ServiceIDCurrentRewards                                     ServiceID = "247"
+	ServiceIDCurtsy                                             ServiceID = "248"
+	ServiceIDCVS                                                ServiceID = "1260"
+	ServiceIDDabbl                                              ServiceID = "250"
+	ServiceIDDailyRewards                                       ServiceID = "251"
+	ServiceIDDana                                               ServiceID = "1317"
+	ServiceIDDapper                                             ServiceID = "252"
+	ServiceIDDasherDirect                                       ServiceID = "1137"
+	ServiceIDDateInAsia                                         ServiceID = "253"
+	ServiceIDDaum                                               ServiceID = "254"
+	ServiceIDDave                                               ServiceID = "255"
+	ServiceIDDaybreakGames                                      ServiceID = "256"
+	ServiceIDDDosGuard                                          ServiceID = "257"
+	ServiceIDDeliveroo                                          ServiceID = "258"
+	ServiceIDDeliveryClub                                       ServiceID = "259"
+	ServiceIDDeliveryHero                                       ServiceID = "260"
+	ServiceIDDent                                               ServiceID = "261"
+	ServiceIDDepop                                              ServiceID = "262"
+	ServiceIDDesignHill                                         ServiceID = "263"
+	ServiceIDDHL                                                ServiceID = "264"
+	ServiceIDDialpad                                            ServiceID = "265"
+	ServiceIDDiDi                                               ServiceID = "266"
+	ServiceIDDigi2Go                                            ServiceID = "267"
+	ServiceIDDigiStore                                          ServiceID = "268"
+	ServiceIDDigit                                              ServiceID = "269"
+	ServiceIDDilMil                                             ServiceID = "270"
+	ServiceIDDing                                               ServiceID = "1138"
+	ServiceIDDingtone                                           ServiceID = "271"
+	ServiceIDDinnerBalls                                        ServiceID = "272"
+	ServiceIDDiscord                                            ServiceID = "273"
+	ServiceIDDistroKid                                          ServiceID = "275"
+	ServiceIDDoctoralia                                         ServiceID = "1316"
+	ServiceIDDocuSign                                           ServiceID = "276"
+	ServiceIDDoku                                               ServiceID = "277"
+	ServiceIDDollarClix                                         ServiceID = "278"
+	ServiceIDDollarGeneral                                      ServiceID = "279"
+	ServiceIDDonately                                           ServiceID = "1273"
+	ServiceIDDonut                                              ServiceID = "1139"
+	ServiceIDDoorDash                                           ServiceID = "280"
+	ServiceIDDora                                               ServiceID = "281"
+	ServiceIDDOSH                                               ServiceID = "282"
+	ServiceIDDosi                                               ServiceID = "1342"
+	ServiceIDDota                                               ServiceID = "283"
+	ServiceIDDouban                                             ServiceID = "284"
+	ServiceIDDoublelist                                         ServiceID = "285"
+	ServiceIDDouugh                                             ServiceID = "286"
+	ServiceIDDouyu                                              ServiceID = "287"
+	ServiceIDDreamSpring                                        ServiceID = "1140"
+	ServiceIDDromru                                             ServiceID = "288"
+	ServiceIDDrop                                               ServiceID = "289"
+	ServiceIDDrugVokrug                                         ServiceID = "290"
+	ServiceIDDrumo                                              ServiceID = "291"
+	ServiceIDDTLR                                               ServiceID = "1291"
+	ServiceIDDubClub                                            ServiceID = "1355"
+	ServiceIDDubizzle                                           ServiceID = "292"
+	ServiceIDDuffl                                              ServiceID = "293"
+	ServiceIDDukascopy                                          ServiceID = "294"
+	ServiceIDDundle                                             ServiceID = "295"
+	ServiceIDDunkinDonuts                                       ServiceID = "296"
+	ServiceIDDynadot                                            ServiceID = "297"
+	ServiceIDEarlyBird                                          ServiceID = "1141"
+	ServiceIDEarn99                                             ServiceID = "298"
+	ServiceIDEarnably                                           ServiceID = "299"
+	ServiceIDEarnHoney                                          ServiceID = "300"
+	ServiceIDEarnin                                             ServiceID = "301"
+	ServiceIDEarningStation                                     ServiceID = "302"
+	ServiceIDEarnly                                             ServiceID = "1345"
+	ServiceIDEASI                                               ServiceID = "303"
+	ServiceIDEastbay                                            ServiceID = "1142"
+	ServiceIDEasyasTap                                          ServiceID = "1077"
+	ServiceIDEasyBucks                                          ServiceID = "1276"
+	ServiceIDEasyPay                                            ServiceID = "1232"
+	ServiceIDEasyPay_304                                        ServiceID = "304"
+	ServiceIDeBay                                               ServiceID = "305"
+	ServiceIDeGifter                                            ServiceID = "306"
+	ServiceIDElepreneur                                         ServiceID = "307"
+	ServiceIDElevacity                                          ServiceID = "308"
+	ServiceIDElGrocer                                           ServiceID = "1347"
+	ServiceIDElootgg                                            ServiceID = "309"
+	ServiceIDEmirex                                             ServiceID = "310"
+	ServiceIDEmpower                                            ServiceID = "311"
+	ServiceIDEneba                                              ServiceID = "312"
+	ServiceIDEngageSpark                                        ServiceID = "313"
+	ServiceIDEntropay                                           ServiceID = "314"
+	ServiceIDenvel                                              ServiceID = "315"
+	ServiceIDEobot                                              ServiceID = "316"
+	ServiceIDEpicNPC                                            ServiceID = "317"
+	ServiceIDEpochTimes                                         ServiceID = "1143"
+	ServiceIDeRewards                                           ServiceID = "318"
+	ServiceIDEsendex                                            ServiceID = "319"
+	ServiceIDEsportal                                           ServiceID = "320"
+	ServiceIDEspressoHouse                                      ServiceID = "321"
+	ServiceIDeToro                                              ServiceID = "322"
+	ServiceIDEtsy                                               ServiceID = "323"
+	ServiceIDEureka                                             ServiceID = "1267"
+	ServiceIDEuroPYM                                            ServiceID = "324"
+	ServiceIDEveryoneAPI                                        ServiceID = "325"
+	ServiceIDExpertOption                                       ServiceID = "326"
+	ServiceIDEyecon                                             ServiceID = "327"
+	ServiceIDEZTexting                                          ServiceID = "1144"
+	ServiceIDFaberlic                                           ServiceID = "328"
+	ServiceIDFacebook                                           ServiceID = "329"
+	ServiceIDFACEIT                                             ServiceID = "330"
+	ServiceIDFAIRTIQ                                            ServiceID = "331"
+	ServiceIDFanTuan                                            ServiceID = "332"
+	ServiceIDFarmersOnly                                        ServiceID = "1287"
+	ServiceIDFastMail                                           ServiceID = "333"
+	ServiceIDFave                                               ServiceID = "334"
+	ServiceIDFBS                                                ServiceID = "335"
+	ServiceIDFeaturePoints                                      ServiceID = "1293"
+	ServiceIDFedEx                                              ServiceID = "336"
+	ServiceIDFetchRewards                                       ServiceID = "337"
+	ServiceIDFetLife                                            ServiceID = "338"
+	ServiceIDFidelityInvestments                                ServiceID = "1145"
+	ServiceIDFigureEight                                        ServiceID = "339"
+	ServiceIDFilimo                                             ServiceID = "340"
+	ServiceIDFindMate                                           ServiceID = "341"
+	ServiceIDFinishLine                                         ServiceID = "342"
+	ServiceIDFirebase                                           ServiceID = "343"
+	ServiceIDFirstTechFederalCreditUnion                        ServiceID = "1147"
+	ServiceIDFitplay                                            ServiceID = "345"
+	ServiceIDFiverr                                             ServiceID = "346"
+	ServiceIDFlare                                              ServiceID = "347"
+	ServiceIDFlashRewards                                       ServiceID = "348"
+	ServiceIDFlatmates                                          ServiceID = "349"
+	ServiceIDFlink                                              ServiceID = "1297"
+	ServiceIDFlipkart                                           ServiceID = "350"
+	ServiceIDFlippa                                             ServiceID = "351"
+	ServiceIDFlurv                                              ServiceID = "352"
+	ServiceIDFlutterwave                                        ServiceID = "353"
+	ServiceIDFluxRewards                                        ServiceID = "354"
+	ServiceIDFluz                                               ServiceID = "355"
+	ServiceIDFlyp                                               ServiceID = "356"
+	ServiceIDFold                                               ServiceID = "1148"
+	ServiceIDFoodora                                            ServiceID = "357"
+	ServiceIDFoodPanda                                          ServiceID = "1233"
+	ServiceIDFoodPanda_358                                      ServiceID = "358"
+	ServiceIDFootLocker                                         ServiceID = "1149"
+	ServiceIDFortuneJack                                        ServiceID = "359"
+	ServiceIDFotocasa                                           ServiceID = "360"
+	ServiceIDFotostrana                                         ServiceID = "361"
+	ServiceIDFound                                              ServiceID = "362"
+	ServiceIDFreeCash                                           ServiceID = "1082"
+	ServiceIDFreeCryptoRewards                                  ServiceID = "1343"
+	ServiceIDFreelancer                                         ServiceID = "363"
+	ServiceIDFreeNow                                            ServiceID = "1294"
+	ServiceIDFreeTaxUSA                                         ServiceID = "364"
+	ServiceIDFreshForex                                         ServiceID = "365"
+	ServiceIDFruitlab                                           ServiceID = "366"
+	ServiceIDFruitz                                             ServiceID = "1335"
+	ServiceIDFTX                                                ServiceID = "367"
+	ServiceIDFusionCash                                         ServiceID = "368"
+	ServiceIDG2A                                                ServiceID = "369"
+	ServiceIDG2G                                                ServiceID = "370"
+	ServiceIDGabi                                               ServiceID = "1150"
+	ServiceIDGagaooLala                                         ServiceID = "371"
+	ServiceIDGaintplay                                          ServiceID = "1280"
+	ServiceIDGameflip                                           ServiceID = "372"
+	ServiceIDGamekit                                            ServiceID = "373"
+	ServiceIDGameMinerclub                                      ServiceID = "374"
+	ServiceIDGamercraft                                         ServiceID = "1151"
+	ServiceIDGamerMine                                          ServiceID = "375"
+	ServiceIDGappx                                              ServiceID = "1323"
+	ServiceIDGarena                                             ServiceID = "376"
+	ServiceIDGCash                                              ServiceID = "377"
+	ServiceIDGCLoot                                             ServiceID = "1268"
+	ServiceIDGemini                                             ServiceID = "378"
+	ServiceIDGemiplay                                           ServiceID = "1152"
+	ServiceIDGenitrust                                          ServiceID = "379"
+	ServiceIDGetir                                              ServiceID = "1088"
+	ServiceIDGetPaidTo                                          ServiceID = "380"
+	ServiceIDGetResponse                                        ServiceID = "381"
+	ServiceIDGetSlide                                           ServiceID = "382"
+	ServiceIDGetTaxi                                            ServiceID = "383"
+	ServiceIDGG                                                 ServiceID = "1303"
+	ServiceIDGiftcloud                                          ServiceID = "384"
+	ServiceIDGifthulk                                           ServiceID = "385"
+	ServiceIDGiftHunterClub                                     ServiceID = "386"
+	ServiceIDGiftPocket                                         ServiceID = "1153"
+	ServiceIDGlassnet                                           ServiceID = "1154"
+	ServiceIDGlidera                                            ServiceID = "387"
+	ServiceIDGlobalPoker                                        ServiceID = "1086"
+	ServiceIDGlobfone                                           ServiceID = "388"
+	ServiceIDGlovo                                              ServiceID = "389"
+	ServiceIDGoDaddy                                            ServiceID = "390"
+	ServiceIDGoFundMe                                           ServiceID = "391"
+	ServiceIDGoJek                                              ServiceID = "392"
+	ServiceIDGoldenFarmery                                      ServiceID = "393"
+	ServiceIDGOmobile                                           ServiceID = "394"
+	ServiceIDGoogleBusinessProfile                              ServiceID = "1158"
+	ServiceIDGoogleGmail                                        ServiceID = "395"
+	ServiceIDGoogleMerchantCenter                               ServiceID = "1159"
+	ServiceIDGooglePlay                                         ServiceID = "1080"
+	ServiceIDGoogleVoice                                        ServiceID = "396"
+	ServiceIDGopuff                                             ServiceID = "397"
+	ServiceIDGorillas                                           ServiceID = "1099"
+	ServiceIDGoSwak                                             ServiceID = "398"
+	ServiceIDGrab                                               ServiceID = "1093"
+	ServiceIDGrabPoints                                         ServiceID = "399"
+	ServiceIDGradOutcome                                        ServiceID = "400"
+	ServiceIDGrailedcom                                         ServiceID = "401"
+	ServiceIDGreenDotGo2BankGoBank                              ServiceID = "1321"
+	ServiceIDGreenDotSmartHome                                  ServiceID = "1160"
+	ServiceIDGreenlight                                         ServiceID = "1105"
+	ServiceIDGreggs                                             ServiceID = "1083"
+	ServiceIDGrindr                                             ServiceID = "403"
+	ServiceIDGroupMe                                            ServiceID = "404"
+	ServiceIDGrubHub                                            ServiceID = "405"
+	ServiceIDGueez                                              ServiceID = "406"
+	ServiceIDGuru                                               ServiceID = "407"
+	ServiceIDHago                                               ServiceID = "408"
+	ServiceIDHandy                                              ServiceID = "1161"
+	ServiceIDHappn                                              ServiceID = "409"
+	ServiceIDHappyCo                                            ServiceID = "410"
+	ServiceIDHappyEscorts                                       ServiceID = "411"
+	ServiceIDHappyPancake                                       ServiceID = "412"
+	ServiceIDHardBlock                                          ServiceID = "413"
+	ServiceIDHarrisPoll                                         ServiceID = "414"
+	ServiceIDHelloTalk                                          ServiceID = "415"
+	ServiceIDHezzl                                              ServiceID = "416"
+	ServiceIDHibbett                                            ServiceID = "417"
+	ServiceIDHiCloud                                            ServiceID = "418"
+	ServiceIDHily                                               ServiceID = "419"
+	ServiceIDHinge                                              ServiceID = "420"
+	ServiceIDHmm                                                ServiceID = "421"
+	ServiceIDHolvi                                              ServiceID = "422"
+	ServiceIDHomeAway                                           ServiceID = "423"
+	ServiceIDHopper                                             ServiceID = "424"
+	ServiceIDHotVOIP                                            ServiceID = "425"
+	ServiceIDHouseparty                                         ServiceID = "426"
+	ServiceIDHQTrivia                                           ServiceID = "427"
+	ServiceIDHsoub                                              ServiceID = "428"
+	ServiceIDHuawei                                             ServiceID = "429"
+	ServiceIDHUD                                                ServiceID = "430"
+	ServiceIDHumbleBundle                                       ServiceID = "431"
+	ServiceIDHumm                                               ServiceID = "432"
+	ServiceIDHungryPanda                                        ServiceID = "433"
+	ServiceIDHunter                                             ServiceID = "1249"
+	ServiceIDHushmail                                           ServiceID = "434"
+	ServiceIDibotta                                             ServiceID = "435"
+	ServiceIDICQ                                                ServiceID = "436"
+	ServiceIDIdealista                                          ServiceID = "437"
+	ServiceIDIdentiteNumerique                                  ServiceID = "1351"
+	ServiceIDIDES                                               ServiceID = "1163"
+	ServiceIDIdleEmpire                                         ServiceID = "438"
+	ServiceIDIDme                                               ServiceID = "439"
+	ServiceIDieadbit                                            ServiceID = "440"
+	ServiceIDImfree                                             ServiceID = "441"
+	ServiceIDImgur                                              ServiceID = "442"
+	ServiceIDImmobiliare                                        ServiceID = "443"
+	ServiceIDImmobilienScout24                                  ServiceID = "444"
+	ServiceIDImmovlan                                           ServiceID = "445"
+	ServiceIDImmowelt                                           ServiceID = "446"
+	ServiceIDImo                                                ServiceID = "447"
+	ServiceIDiMoney                                             ServiceID = "1164"
+	ServiceIDInboxLV                                            ServiceID = "448"
+	ServiceIDInBoxPounds                                        ServiceID = "449"
+	ServiceIDIndacoin                                           ServiceID = "450"
+	ServiceIDIndeed                                             ServiceID = "451"
+	ServiceIDIndi                                               ServiceID = "452"
+	ServiceIDIndomaret                                          ServiceID = "1091"
+	ServiceIDInnago                                             ServiceID = "453"
+	ServiceIDInspire                                            ServiceID = "454"
+	ServiceIDInstacart                                          ServiceID = "455"
+	ServiceIDInstaGC                                            ServiceID = "456"
+	ServiceIDInstagram                                          ServiceID = "457"
+	ServiceIDInstaRem                                           ServiceID = "458"
+	ServiceIDInstaVoice                                         ServiceID = "459"
+	ServiceIDIntuit                                             ServiceID = "460"
+	ServiceIDiOffer                                             ServiceID = "461"
+	ServiceIDIonicware                                          ServiceID = "462"
+	ServiceIDIONOS                                              ServiceID = "463"
+	ServiceIDIpekyol                                            ServiceID = "464"
+	ServiceIDiPlum                                              ServiceID = "465"
+	ServiceIDiPoll                                              ServiceID = "466"
+	ServiceIDIpsosiSay                                          ServiceID = "470"
+	ServiceIDIQOption                                           ServiceID = "467"
+	ServiceIDiRazoo                                             ServiceID = "468"
+	ServiceIDIrazoocom                                          ServiceID = "469"
+	ServiceIDJackd                                              ServiceID = "471"
+	ServiceIDJAGRewards                                         ServiceID = "472"
+	ServiceIDJD                                                 ServiceID = "473"
+	ServiceIDJDID                                               ServiceID = "1095"
+	ServiceIDJeevan                                             ServiceID = "474"
+	ServiceIDJelli                                              ServiceID = "475"
+	ServiceIDJePaiq                                             ServiceID = "476"
+	ServiceIDJerry                                              ServiceID = "477"
+	ServiceIDJiayuan                                            ServiceID = "478"
+	ServiceIDJMTY                                               ServiceID = "479"
+	ServiceIDJobber                                             ServiceID = "1166"
+	ServiceIDJobToday                                           ServiceID = "480"
+	ServiceIDJollyChic                                          ServiceID = "481"
+	ServiceIDJoompay                                            ServiceID = "482"
+	ServiceIDJuanCash                                           ServiceID = "483"
+	ServiceIDJuno                                               ServiceID = "484"
+	ServiceIDKaching                                            ServiceID = "1340"
+	ServiceIDKACN                                               ServiceID = "485"
+	ServiceIDKaggle                                             ServiceID = "486"
+	ServiceIDKakaoTalk                                          ServiceID = "487"
+	ServiceIDKamatera                                           ServiceID = "488"
+	ServiceIDKapten                                             ServiceID = "489"
+	ServiceIDKayoSports                                         ServiceID = "490"
+	ServiceIDKBZpay                                             ServiceID = "491"
+	ServiceIDKeepRewardingcom                                   ServiceID = "492"
+	ServiceIDKeybase                                            ServiceID = "494"
+	ServiceIDKHL                                                ServiceID = "495"
+	ServiceIDKidsFootLocker                                     ServiceID = "1167"
+	ServiceIDKik                                                ServiceID = "1081"
+	ServiceIDKikoff                                             ServiceID = "1168"
+	ServiceIDKink                                               ServiceID = "496"
+	ServiceIDKixify                                             ServiceID = "1169"
+	ServiceIDKlarna                                             ServiceID = "497"
+	ServiceIDKlook                                              ServiceID = "498"
+	ServiceIDKlover                                             ServiceID = "1322"
+	ServiceIDKorekTelecom                                       ServiceID = "499"
+	ServiceIDKraken                                             ServiceID = "500"
+	ServiceIDKriptomat                                          ServiceID = "501"
+	ServiceIDKuCoin                                             ServiceID = "502"
+	ServiceIDKufar                                              ServiceID = "503"
+	ServiceIDKUMU                                               ServiceID = "504"
+	ServiceIDKVBPrime                                           ServiceID = "505"
+	ServiceIDKwai                                               ServiceID = "506"
+	ServiceIDLalaFood                                           ServiceID = "507"
+	ServiceIDLalamove                                           ServiceID = "508"
+	ServiceIDLandingi                                           ServiceID = "509"
+	ServiceIDLaPoste                                            ServiceID = "510"
+	ServiceIDLazada                                             ServiceID = "511"
+	ServiceIDLBRYApp                                            ServiceID = "512"
+	ServiceIDLDSPlanet                                          ServiceID = "1250"
+	ServiceIDLegiit                                             ServiceID = "514"
+	ServiceIDLetgo                                              ServiceID = "515"
+	ServiceIDLeupay                                             ServiceID = "516"
+	ServiceIDLibertyX                                           ServiceID = "517"
+	ServiceIDLibon                                              ServiceID = "518"
+	ServiceIDLIHKG                                              ServiceID = "519"
+	ServiceIDLikeCard                                           ServiceID = "1170"
+	ServiceIDLikee                                              ServiceID = "520"
+	ServiceIDLili                                               ServiceID = "521"
+	ServiceIDLine                                               ServiceID = "522"
+	ServiceIDLine2                                              ServiceID = "1329"
+	ServiceIDLink                                               ServiceID = "1257"
+	ServiceIDLinkedIn                                           ServiceID = "523"
+	ServiceIDLinode                                             ServiceID = "1295"
+	ServiceIDLiqPay                                             ServiceID = "524"
+	ServiceIDListia                                             ServiceID = "525"
+	ServiceIDListYourself                                       ServiceID = "1259"
+	ServiceIDLiteIM                                             ServiceID = "526"
+	ServiceIDLiveScore                                          ServiceID = "527"
+	ServiceIDLiveTribe                                          ServiceID = "528"
+	ServiceIDLiveTV                                             ServiceID = "529"
+	ServiceIDLivU                                               ServiceID = "530"
+	ServiceIDLMK                                                ServiceID = "531"
+	ServiceIDLocalBitcoins                                      ServiceID = "532"
+	ServiceIDLocalCoinATM                                       ServiceID = "533"
+	ServiceIDLocalCryptos                                       ServiceID = "534"
+	ServiceIDLocanto                                            ServiceID = "535"
+	ServiceIDLolli                                              ServiceID = "1078"
+	ServiceIDLomocall                                           ServiceID = "536"
+	ServiceIDLoveAndSeek                                        ServiceID = "1251"
+	ServiceIDLuckyDino                                          ServiceID = "537"
+	ServiceIDLuckyland                                          ServiceID = "538"
+	ServiceIDLuckyPlay                                          ServiceID = "1324"
+	ServiceIDLunaNode                                           ServiceID = "539"
+	ServiceIDLuno                                               ServiceID = "540"
+	ServiceIDLydiaApp                                           ServiceID = "541"
+	ServiceIDLyft                                               ServiceID = "542"
+	ServiceIDLynxWallet                                         ServiceID = "543"
+	ServiceIDM1Finance                                          ServiceID = "544"
+	ServiceIDMaChance                                           ServiceID = "545"
+	ServiceIDMagnit                                             ServiceID = "546"
+	ServiceIDMail2world                                         ServiceID = "547"
+	ServiceIDMailChimp                                          ServiceID = "548"
+	ServiceIDMailcom                                            ServiceID = "549"
+	ServiceIDMailEE                                             ServiceID = "550"
+	ServiceIDMailgun                                            ServiceID = "551"
+	ServiceIDMailPrincess                                       ServiceID = "552"
+	ServiceIDMailRu                                             ServiceID = "553"
+	ServiceIDMakePrintable                                      ServiceID = "554"
+	ServiceIDMamba                                              ServiceID = "555"
+	ServiceIDMapleSEA                                           ServiceID = "556"
+	ServiceIDMarcel                                             ServiceID = "557"
+	ServiceIDMarcoPolo                                          ServiceID = "558"
+	ServiceIDMarcus                                             ServiceID = "1171"
+	ServiceIDMarkid                                             ServiceID = "1357"
+	ServiceIDMatch                                              ServiceID = "559"
+	ServiceIDMaxim                                              ServiceID = "1096"
+	ServiceIDMaza                                               ServiceID = "1326"
+	ServiceIDMcMoney                                            ServiceID = "1172"
+	ServiceIDMealPal                                            ServiceID = "560"
+	ServiceIDMedLife                                            ServiceID = "561"
+	ServiceIDMeeff                                              ServiceID = "562"
+	ServiceIDMeesho                                             ServiceID = "563"
+	ServiceIDMeetMe                                             ServiceID = "564"
+	ServiceIDMeetup                                             ServiceID = "565"
+	ServiceIDMelo                                               ServiceID = "566"
+	ServiceIDMercadoLibre                                       ServiceID = "567"
+	ServiceIDMercari                                            ServiceID = "568"
+	ServiceIDMessageBird                                        ServiceID = "569"
+	ServiceIDMessageDesk                                        ServiceID = "1173"
+	ServiceIDMetalPay                                           ServiceID = "570"
+	ServiceIDMeWe                                               ServiceID = "572"
+	ServiceIDMezu                                               ServiceID = "573"
+	ServiceIDMichat                                             ServiceID = "574"
+	ServiceIDMico                                               ServiceID = "575"
+	ServiceIDMicrocenter                                        ServiceID = "1104"
+	ServiceIDMicrosoft                                          ServiceID = "1072"
+	ServiceIDMicrosoftAzure                                     ServiceID = "1097"
+	ServiceIDMicrosoftOffice365Business                         ServiceID = "1174"
+	ServiceIDMicrosoftOffice365E5                               ServiceID = "1175"
+	ServiceIDMicrosoftOffice365Education                        ServiceID = "1176"
+	ServiceIDMicrosoftRewards                                   ServiceID = "1177"
+	ServiceIDMicroworkers                                       ServiceID = "576"
+	ServiceIDMido                                               ServiceID = "577"
+	ServiceIDMilesMore                                          ServiceID = "578"
+	ServiceIDMilesReward                                        ServiceID = "579"
+	ServiceIDMilk                                               ServiceID = "580"
+	ServiceIDMillionaireMatch                                   ServiceID = "581"
+	ServiceIDMillions                                           ServiceID = "1178"
+	ServiceIDMint                                               ServiceID = "582"
+	ServiceIDMintVine                                           ServiceID = "1179"
+	ServiceIDMistplay                                           ServiceID = "583"
+	ServiceIDmixi                                               ServiceID = "584"
+	ServiceIDMobihapp                                           ServiceID = "585"
+	ServiceIDMobilebet                                          ServiceID = "586"
+	ServiceIDMobileMan                                          ServiceID = "587"
+	ServiceIDMobileMoney                                        ServiceID = "588"
+	ServiceIDMoco                                               ServiceID = "589"
+	ServiceIDModeEarn                                           ServiceID = "1098"
+	ServiceIDModeEarnApp                                        ServiceID = "1234"
+	ServiceIDMoMo                                               ServiceID = "1180"
+	ServiceIDMonese                                             ServiceID = "590"
+	ServiceIDMoneyGram                                          ServiceID = "1181"
+	ServiceIDMoneyLion                                          ServiceID = "591"
+	ServiceIDMoneyPak                                           ServiceID = "592"
+	ServiceIDMoneyRawr                                          ServiceID = "593"
+	ServiceIDMonzo                                              ServiceID = "594"
+	ServiceIDMoolaDays                                          ServiceID = "595"
+	ServiceIDMoonPay                                            ServiceID = "596"
+	ServiceIDMos                                                ServiceID = "1182"
+	ServiceIDMourjan                                            ServiceID = "597"
+	ServiceIDMOVO                                               ServiceID = "598"
+	ServiceIDMowasalat                                          ServiceID = "599"
+	ServiceIDMozoX                                              ServiceID = "600"
+	ServiceIDMrGreen                                            ServiceID = "601"
+	ServiceIDMrsool                                             ServiceID = "602"
+	ServiceIDMrSpin                                             ServiceID = "603"
+	ServiceIDMTCGamePortal                                      ServiceID = "604"
+	ServiceIDMuchBetter                                         ServiceID = "605"
+	ServiceIDMudflap                                            ServiceID = "1183"
+	ServiceIDMusicstream                                        ServiceID = "1274"
+	ServiceIDMyAuto                                             ServiceID = "606"
+	ServiceIDMyBookie                                           ServiceID = "607"
+	ServiceIDMyBoost                                            ServiceID = "608"
+	ServiceIDMyGiftCardSupply                                   ServiceID = "609"
+	ServiceIDMyLOL                                              ServiceID = "610"
+	ServiceIDMyMusicTaste                                       ServiceID = "611"
+	ServiceIDMyOpinions                                         ServiceID = "613"
+	ServiceIDMyOpinions_612                                     ServiceID = "612"
+	ServiceIDMyRobinhood                                        ServiceID = "1185"
+	ServiceIDMySoapBox                                          ServiceID = "614"
+	ServiceIDMyspace                                            ServiceID = "615"
+	ServiceIDMySpendWell                                        ServiceID = "1320"
+	ServiceIDMyTaxi                                             ServiceID = "616"
+	ServiceIDMyTime                                             ServiceID = "617"
+	ServiceIDMyTrainerRewards                                   ServiceID = "618"
+	ServiceIDMyVoice                                            ServiceID = "1186"
+	ServiceIDmyWisely                                           ServiceID = "1187"
+	ServiceIDNAGATrader                                         ServiceID = "619"
+	ServiceIDNarvesen                                           ServiceID = "1258"
+	ServiceIDNaturalBrainai                                     ServiceID = "1188"
+	ServiceIDNaver                                              ServiceID = "620"
+	ServiceIDNBATopshot                                         ServiceID = "621"
+	ServiceIDNCloud                                             ServiceID = "622"
+	ServiceIDNear                                               ServiceID = "623"
+	ServiceIDnearside                                           ServiceID = "624"
+	ServiceIDNectar                                             ServiceID = "625"
+	ServiceIDNerdWallet                                         ServiceID = "626"
+	ServiceIDNetease                                            ServiceID = "628"
+	ServiceIDNETELLER                                           ServiceID = "629"
+	ServiceIDNetflix                                            ServiceID = "630"
+	ServiceIDNetZero                                            ServiceID = "631"
+	ServiceIDNeuron                                             ServiceID = "632"
+	ServiceIDNexmo                                              ServiceID = "633"
+	ServiceIDNextdoor                                           ServiceID = "634"
+	ServiceIDNFCU                                               ServiceID = "1190"
+	ServiceIDNgage                                              ServiceID = "635"
+	ServiceIDNielsen                                            ServiceID = "1263"
+	ServiceIDNielson                                            ServiceID = "636"
+	ServiceIDNiftyGateway                                       ServiceID = "637"
+	ServiceIDNiftyLoans                                         ServiceID = "638"
+	ServiceIDNike                                               ServiceID = "639"
+	ServiceIDNimses                                             ServiceID = "640"
+	ServiceIDNonoh                                              ServiceID = "641"
+	ServiceIDNonolive                                           ServiceID = "642"
+	ServiceIDNoona                                              ServiceID = "643"
+	ServiceIDnoonShopping                                       ServiceID = "1309"
+	ServiceIDNordstrom                                          ServiceID = "644"
+	ServiceIDNotify                                             ServiceID = "645"
+	ServiceIDNotListed                                          ServiceID = "817"
+	ServiceIDNovo                                               ServiceID = "646"
+	ServiceIDNTTGame                                            ServiceID = "647"
+	ServiceIDNTWallet                                           ServiceID = "648"
+	ServiceIDNTWRK                                              ServiceID = "649"
+	ServiceIDNumeroeSIM                                         ServiceID = "650"
+	ServiceIDNuuly                                              ServiceID = "1306"
+	ServiceIDNvidia                                             ServiceID = "651"
+	ServiceIDOcto                                               ServiceID = "1286"
+	ServiceIDOctopus                                            ServiceID = "652"
+	ServiceIDOfferNation                                        ServiceID = "653"
+	ServiceIDOfferUp                                            ServiceID = "654"
+	ServiceIDOffGamers                                          ServiceID = "655"
+	ServiceIDOhmConnect                                         ServiceID = "656"
+	ServiceIDOKCoin                                             ServiceID = "657"
+	ServiceIDOkCupid                                            ServiceID = "658"
+	ServiceIDOKru                                               ServiceID = "659"
+	ServiceIDOlaCabs                                            ServiceID = "660"
+	ServiceIDOlx                                                ServiceID = "661"
+	ServiceIDOmio                                               ServiceID = "662"
+	ServiceIDOneCasino                                          ServiceID = "663"
+	ServiceIDOneDayRewards                                      ServiceID = "664"
+	ServiceIDOneFinance                                         ServiceID = "665"
+	ServiceIDOneMainFinancial                                   ServiceID = "666"
+	ServiceIDOneOpinion                                         ServiceID = "667"
+	ServiceIDOnJuno                                             ServiceID = "668"
+	ServiceIDOnlinenet                                          ServiceID = "669"
+	ServiceIDOnlyFans                                           ServiceID = "1296"
+	ServiceIDOobit                                              ServiceID = "670"
+	ServiceIDOpenAIChatGPT                                      ServiceID = "671"
+	ServiceIDOpenNode                                           ServiceID = "672"
+	ServiceIDOpenPhone                                          ServiceID = "673"
+	ServiceIDOpenPlayground                                     ServiceID = "1328"
+	ServiceIDOpenSesame                                         ServiceID = "674"
+	ServiceIDOpinionOutpost                                     ServiceID = "675"
+	ServiceIDOpinionsOutpost                                    ServiceID = "1235"
+	ServiceIDOpinionWorld                                       ServiceID = "676"
+	ServiceIDOportun                                            ServiceID = "1191"
+	ServiceIDOptusSport                                         ServiceID = "677"
+	ServiceIDOracle                                             ServiceID = "678"
+	ServiceIDOTCBTC                                             ServiceID = "679"
+	ServiceIDOurTime                                            ServiceID = "680"
+	ServiceIDOutlook                                            ServiceID = "1074"
+	ServiceIDOutSmartHPV                                        ServiceID = "681"
+	ServiceIDOVO                                                ServiceID = "1089"
+	ServiceIDOxygen                                             ServiceID = "1192"
+	ServiceIDOYO                                                ServiceID = "682"
+	ServiceIDOzanSuperApp                                       ServiceID = "1193"
+	ServiceIDOZFlatMates                                        ServiceID = "683"
+	ServiceIDPaddyPower                                         ServiceID = "684"
+	ServiceIDPaidCash                                           ServiceID = "1319"
+	ServiceIDPaidToReadEmailcom                                 ServiceID = "685"
+	ServiceIDPaidViewpoint                                      ServiceID = "686"
+	ServiceIDPangea                                             ServiceID = "687"
+	ServiceIDPapara                                             ServiceID = "688"
+	ServiceIDParler                                             ServiceID = "689"
+	ServiceIDPartyPoker                                         ServiceID = "1270"
+	ServiceIDParuVendu                                          ServiceID = "690"
+	ServiceIDPassbook                                           ServiceID = "691"
+	ServiceIDPaxful                                             ServiceID = "692"
+	ServiceIDPayactiv                                           ServiceID = "693"
+	ServiceIDPayAsUGym                                          ServiceID = "694"
+	ServiceIDPaybis                                             ServiceID = "695"
+	ServiceIDPaycell                                            ServiceID = "696"
+	ServiceIDPayCenter                                          ServiceID = "697"
+	ServiceIDPayGo                                              ServiceID = "698"
+	ServiceIDPayMaya                                            ServiceID = "699"
+	ServiceIDPaymeDollar                                        ServiceID = "700"
+	ServiceIDPaymium                                            ServiceID = "701"
+	ServiceIDPayoneer                                           ServiceID = "702"
+	ServiceIDPayPal                                             ServiceID = "703"
+	ServiceIDPayQin                                             ServiceID = "704"
+	ServiceIDPaysafe                                            ServiceID = "705"
+	ServiceIDPaySay                                             ServiceID = "706"
+	ServiceIDPaySend                                            ServiceID = "707"
+	ServiceIDPaysera                                            ServiceID = "708"
+	ServiceIDPaytm                                              ServiceID = "709"
+	ServiceIDPCGameSupply                                       ServiceID = "710"
+	ServiceIDPei                                                ServiceID = "711"
+	ServiceIDPenfed                                             ServiceID = "1194"
+	ServiceIDPeriscope                                          ServiceID = "712"
+	ServiceIDPerk                                               ServiceID = "713"
+	ServiceIDPersonalCapital                                    ServiceID = "714"
+	ServiceIDPGSamsBuyGet                                       ServiceID = "1284"
+	ServiceIDPhyre                                              ServiceID = "715"
+	ServiceIDPinaLove                                           ServiceID = "716"
+	ServiceIDPinata                                             ServiceID = "1195"
+	ServiceIDPinchos                                            ServiceID = "717"
+	ServiceIDPineconeResearch                                   ServiceID = "718"
+	ServiceIDPingPong                                           ServiceID = "719"
+	ServiceIDPinterest                                          ServiceID = "720"
+	ServiceIDPionex                                             ServiceID = "1299"
+	ServiceIDPitacoin                                           ServiceID = "721"
+	ServiceIDPlaid                                              ServiceID = "722"
+	ServiceIDPlay4                                              ServiceID = "1334"
+	ServiceIDPlayerAuctions                                     ServiceID = "723"
+	ServiceIDPlentyOfFish                                       ServiceID = "724"
+	ServiceIDPleo                                               ServiceID = "1338"
+	ServiceIDPlivo                                              ServiceID = "1100"
+	ServiceIDPocketWin                                          ServiceID = "726"
+	ServiceIDPODERcard                                          ServiceID = "727"
+	ServiceIDPoe                                                ServiceID = "1314"
+	ServiceIDPogo                                               ServiceID = "728"
+	ServiceIDPointclub                                          ServiceID = "729"
+	ServiceIDPokec                                              ServiceID = "730"
+	ServiceIDPollPass                                           ServiceID = "731"
+	ServiceIDPollPay                                            ServiceID = "732"
+	ServiceIDPopKonTv                                           ServiceID = "733"
+	ServiceIDPorkbun                                            ServiceID = "1305"
+	ServiceIDPorte                                              ServiceID = "734"
+	ServiceIDPoshmark                                           ServiceID = "735"
+	ServiceIDPosten                                             ServiceID = "736"
+	ServiceIDPotatoChat                                         ServiceID = "738"
+	ServiceIDPREMIER                                            ServiceID = "1240"
+	ServiceIDPrepaid2Cash                                       ServiceID = "739"
+	ServiceIDPrezzee                                            ServiceID = "740"
+	ServiceIDPrivacy                                            ServiceID = "741"
+	ServiceIDProlific                                           ServiceID = "742"
+	ServiceIDPromotionPod                                       ServiceID = "743"
+	ServiceIDProOpinions                                        ServiceID = "744"
+	ServiceIDPropellerAds                                       ServiceID = "1236"
+	ServiceIDPropellerAds_745                                   ServiceID = "745"
+	ServiceIDPropy                                              ServiceID = "746"
+	ServiceIDProtonMail                                         ServiceID = "747"
+	ServiceIDPruvit                                             ServiceID = "748"
+	ServiceIDPUBGMOBILE                                         ServiceID = "749"
+	ServiceIDPubliccom                                          ServiceID = "1298"
+	ServiceIDPunktid                                            ServiceID = "750"
+	ServiceIDPureprofile                                        ServiceID = "751"
+	ServiceIDPurseio                                            ServiceID = "753"
+	ServiceIDPurseio_752                                        ServiceID = "752"
+	ServiceIDQIP                                                ServiceID = "754"
+	ServiceIDQIWIWallet                                         ServiceID = "755"
+	ServiceIDQLive                                              ServiceID = "756"
+	ServiceIDQmeecom                                            ServiceID = "757"
+	ServiceIDQoo10                                              ServiceID = "758"
+	ServiceIDQQTube                                             ServiceID = "759"
+	ServiceIDQuadPay                                            ServiceID = "760"
+	ServiceIDQubeMoney                                          ServiceID = "761"
+	ServiceIDQuickBooks                                         ServiceID = "762"
+	ServiceIDQuickie                                            ServiceID = "763"
+	ServiceIDQuickPaySurvey                                     ServiceID = "764"
+	ServiceIDQuickThoughts                                      ServiceID = "765"
+	ServiceIDQuipp                                              ServiceID = "766"
+	ServiceIDRadialInsight                                      ServiceID = "767"
+	ServiceIDRaise                                              ServiceID = "768"
+	ServiceIDRAM                                                ServiceID = "769"
+	ServiceIDRambler                                            ServiceID = "770"
+	ServiceIDRazer                                              ServiceID = "771"
+	ServiceIDRBFCU                                              ServiceID = "1255"
+	ServiceIDRebtel                                             ServiceID = "772"
+	ServiceIDRECUR                                              ServiceID = "1261"
+	ServiceIDRedCircle                                          ServiceID = "1196"
+	ServiceIDRemitly                                            ServiceID = "773"
+	ServiceIDRentMe                                             ServiceID = "774"
+	ServiceIDReonomy                                            ServiceID = "775"
+	ServiceIDReRyde                                             ServiceID = "776"
+	ServiceIDRetailMeNot                                        ServiceID = "777"
+	ServiceIDRevel                                              ServiceID = "1311"
+	ServiceIDRevolut                                            ServiceID = "778"
+	ServiceIDRewardedPlay                                       ServiceID = "779"
+	ServiceIDRewardingWays                                      ServiceID = "780"
+	ServiceIDRGBI                                               ServiceID = "1352"
+	ServiceIDRI                                                 ServiceID = "1197"
+	ServiceIDRiaFinancial                                       ServiceID = "781"
+	ServiceIDRingCaptcha                                        ServiceID = "782"
+	ServiceIDRingCentral                                        ServiceID = "783"
+	ServiceIDRiotGames                                          ServiceID = "1237"
+	ServiceIDRitualco                                           ServiceID = "785"
+	ServiceIDRizk                                               ServiceID = "786"
+	ServiceIDRizq                                               ServiceID = "787"
+	ServiceIDRLOVE                                              ServiceID = "788"
+	ServiceIDRobinhood                                          ServiceID = "789"
+	ServiceIDRoblox                                             ServiceID = "790"
+	ServiceIDRocketReach                                        ServiceID = "791"
+	ServiceIDRooming                                            ServiceID = "792"
+	ServiceIDRoomster                                           ServiceID = "793"
+	ServiceIDRoot                                               ServiceID = "794"
+	ServiceIDRover                                              ServiceID = "795"
+	ServiceIDRRF                                                ServiceID = "796"
+	ServiceIDRSGoldMine                                         ServiceID = "797"
+	ServiceIDRSocks                                             ServiceID = "1198"
+	ServiceIDRumble                                             ServiceID = "798"
+	ServiceIDRuten                                              ServiceID = "799"
+	ServiceIDSafeCurrency                                       ServiceID = "800"
+	ServiceIDSafewayAlbertsons                                  ServiceID = "1199"
+	ServiceIDSamsClub                                           ServiceID = "801"
+	ServiceIDSantander                                          ServiceID = "1200"
+	ServiceIDSAS                                                ServiceID = "802"
+	ServiceIDSaverLife                                          ServiceID = "1201"
+	ServiceIDSaveWithSurveys                                    ServiceID = "803"
+	ServiceIDSayHi                                              ServiceID = "804"
+	ServiceIDSBA                                                ServiceID = "1202"
+	ServiceIDScaleway                                           ServiceID = "805"
+	ServiceIDScout                                              ServiceID = "806"
+	ServiceIDSCRUFF                                             ServiceID = "807"
+	ServiceIDSeaGamerMall                                       ServiceID = "808"
+	ServiceIDSEAGM                                              ServiceID = "809"
+	ServiceIDSeated                                             ServiceID = "810"
+	ServiceIDSecretBenefits                                     ServiceID = "811"
+	ServiceIDSeis                                               ServiceID = "1344"
+	ServiceIDSendGrid                                           ServiceID = "812"
+	ServiceIDSendInBlue                                         ServiceID = "813"
+	ServiceIDSendwave                                           ServiceID = "814"
+	ServiceIDSEOClerks                                          ServiceID = "815"
+	ServiceIDServerfield                                        ServiceID = "816"
+	ServiceIDSezzle                                             ServiceID = "818"
+	ServiceIDShasso                                             ServiceID = "819"
+	ServiceIDSheerID                                            ServiceID = "820"
+	ServiceIDShopatHome                                         ServiceID = "821"
+	ServiceIDShopBack                                           ServiceID = "822"
+	ServiceIDShopee                                             ServiceID = "823"
+	ServiceIDShopify                                            ServiceID = "824"
+	ServiceIDShopkick                                           ServiceID = "825"
+	ServiceIDShopPay                                            ServiceID = "826"
+	ServiceIDShpock                                             ServiceID = "827"
+	ServiceIDSidelineSwap                                       ServiceID = "828"
+	ServiceIDSignal                                             ServiceID = "829"
+	ServiceIDSimba                                              ServiceID = "830"
+	ServiceIDSimplexSimplexCC                                   ServiceID = "832"
+	ServiceIDSinch                                              ServiceID = "833"
+	ServiceIDSingleMuslim                                       ServiceID = "834"
+	ServiceIDSkipTheDishes                                      ServiceID = "835"
+	ServiceIDSkout                                              ServiceID = "836"
+	ServiceIDSkrill                                             ServiceID = "837"
+	ServiceIDSkyetel                                            ServiceID = "838"
+	ServiceIDSkype                                              ServiceID = "1076"
+	ServiceIDSkyPrivate                                         ServiceID = "1203"
+	ServiceIDSlide                                              ServiceID = "839"
+	ServiceIDSlips                                              ServiceID = "1330"
+	ServiceIDSmarterASP                                         ServiceID = "840"
+	ServiceIDSmores                                             ServiceID = "841"
+	ServiceIDSMSit                                              ServiceID = "842"
+	ServiceIDSMSto                                              ServiceID = "843"
+	ServiceIDSMTP2GO                                            ServiceID = "844"
+	ServiceIDSnagshout                                          ServiceID = "845"
+	ServiceIDSnapchat                                           ServiceID = "846"
+	ServiceIDSnapex                                             ServiceID = "847"
+	ServiceIDSnapFinance                                        ServiceID = "848"
+	ServiceIDSnapKitchen                                        ServiceID = "1238"
+	ServiceIDSnapKitchen_849                                    ServiceID = "849"
+	ServiceIDSneakerboy                                         ServiceID = "850"
+	ServiceIDSneakersnstuff                                     ServiceID = "851"
+	ServiceIDSnippetMedia                                       ServiceID = "852"
+	ServiceIDSOAR                                               ServiceID = "1289"
+	ServiceIDSocieti                                            ServiceID = "853"
+	ServiceIDSoFI                                               ServiceID = "854"
+	ServiceIDSolitaireCash                                      ServiceID = "855"
+	ServiceIDSonetel                                            ServiceID = "856"
+	ServiceIDSoulAPP                                            ServiceID = "857"
+	ServiceIDSouq                                               ServiceID = "858"
+	ServiceIDSpectroCoin                                        ServiceID = "859"
+	ServiceIDSpectrum                                           ServiceID = "1348"
+	ServiceIDSpend                                              ServiceID = "860"
+	ServiceIDSpotify                                            ServiceID = "861"
+	ServiceIDSpruce                                             ServiceID = "1204"
+	ServiceIDSpryng                                             ServiceID = "862"
+	ServiceIDSquare                                             ServiceID = "863"
+	ServiceIDStarbucks                                          ServiceID = "864"
+	ServiceIDStarOf                                             ServiceID = "865"
+	ServiceIDStash                                              ServiceID = "1205"
+	ServiceIDStateFarm                                          ServiceID = "1239"
+	ServiceIDStateFarm_866                                      ServiceID = "866"
+	ServiceIDSteady                                             ServiceID = "867"
+	ServiceIDSteam                                              ServiceID = "868"
+	ServiceIDSteemIt                                            ServiceID = "869"
+	ServiceIDStep                                               ServiceID = "870"
+	ServiceIDStickerMule                                        ServiceID = "1310"
+	ServiceIDStir                                               ServiceID = "1102"
+	ServiceIDStoqo                                              ServiceID = "871"
+	ServiceIDStormGain                                          ServiceID = "872"
+	ServiceIDStormPlay                                          ServiceID = "873"
+	ServiceIDStrato                                             ServiceID = "874"
+	ServiceIDStreetbeat                                         ServiceID = "1285"
+	ServiceIDStreetbees                                         ServiceID = "875"
+	ServiceIDStrike                                             ServiceID = "876"
+	ServiceIDStripe                                             ServiceID = "877"
+	ServiceIDSudsCarWash                                        ServiceID = "1315"
+	ServiceIDSugarbook                                          ServiceID = "1279"
+	ServiceIDSugarDaddyMeet                                     ServiceID = "878"
+	ServiceIDSumUp                                              ServiceID = "879"
+	ServiceIDSuperPay                                           ServiceID = "881"
+	ServiceIDSupreme                                            ServiceID = "882"
+	ServiceIDSurePayroll                                        ServiceID = "1206"
+	ServiceIDSurf                                               ServiceID = "883"
+	ServiceIDSurveyHoney                                        ServiceID = "884"
+	ServiceIDSurveyJunkie                                       ServiceID = "885"
+	ServiceIDSurveyMonkeyRewards                                ServiceID = "886"
+	ServiceIDSurveyRewardz                                      ServiceID = "887"
+	ServiceIDSurveytime                                         ServiceID = "888"
+	ServiceIDSwagbucksInboxDollarsMyPointsySenseClassPassNoones ServiceID = "889"
+	ServiceIDSwapD                                              ServiceID = "890"
+	ServiceIDSweatcoin                                          ServiceID = "891"
+	ServiceIDSweetRing                                          ServiceID = "892"
+	ServiceIDSwissBorg                                          ServiceID = "893"
+	ServiceIDSwitchere                                          ServiceID = "1207"
+	ServiceIDSwych                                              ServiceID = "894"
+	ServiceIDSwyftx                                             ServiceID = "895"
+	ServiceIDTada                                               ServiceID = "1208"
+	ServiceIDTagged                                             ServiceID = "896"
+	ServiceIDTalk2                                              ServiceID = "897"
+	ServiceIDTalken                                             ServiceID = "898"
+	ServiceIDTanTan                                             ServiceID = "899"
+	ServiceIDTaoBao                                             ServiceID = "900"
+	ServiceIDTapchamps                                          ServiceID = "901"
+	ServiceIDTapTap                                             ServiceID = "1356"
+	ServiceIDTarget                                             ServiceID = "902"
+	ServiceIDTaxify                                             ServiceID = "903"
+	ServiceIDTaxSlayer                                          ServiceID = "1209"
+	ServiceIDTCGPlayer                                          ServiceID = "904"
+	ServiceIDTDAmeritrade                                       ServiceID = "905"
+	ServiceIDTechBubble                                         ServiceID = "1210"
+	ServiceIDTelegram                                           ServiceID = "907"
+	ServiceIDTelekom                                            ServiceID = "908"
+	ServiceIDTelnyx                                             ServiceID = "909"
+	ServiceIDTelos                                              ServiceID = "910"
+	ServiceIDTEMU                                               ServiceID = "1346"
+	ServiceIDTencentQQ                                          ServiceID = "911"
+	ServiceIDTenx                                               ServiceID = "912"
+	ServiceIDThaiFriendly                                       ServiceID = "913"
+	ServiceIDTheChange                                          ServiceID = "914"
+	ServiceIDTheFreeNet                                         ServiceID = "915"
+	ServiceIDTheHouseShop                                       ServiceID = "916"
+	ServiceIDThinkOpinion                                       ServiceID = "917"
+	ServiceIDThisFate                                           ServiceID = "918"
+	ServiceIDThumbtack                                          ServiceID = "919"
+	ServiceIDThunderpod                                         ServiceID = "920"
+	ServiceIDTicketmaster                                       ServiceID = "921"
+	ServiceIDTier                                               ServiceID = "922"
+	ServiceIDTikki                                              ServiceID = "923"
+	ServiceIDTikTok                                             ServiceID = "924"
+	ServiceIDTilda                                              ServiceID = "925"
+	ServiceIDTinder                                             ServiceID = "926"
+	ServiceIDTMobileMoney                                       ServiceID = "927"
+	ServiceIDTodayAustralia                                     ServiceID = "928"
+	ServiceIDTogetherPrice                                      ServiceID = "929"
+	ServiceIDToken                                              ServiceID = "1211"
+	ServiceIDTokeneo                                            ServiceID = "930"
+	ServiceIDTokopedia                                          ServiceID = "931"
+	ServiceIDTomaExchange                                       ServiceID = "932"
+	ServiceIDToTalk                                             ServiceID = "933"
+	ServiceIDToTaxi                                             ServiceID = "934"
+	ServiceIDTradeUp                                            ServiceID = "1354"
+	ServiceIDTradingView                                        ServiceID = "935"
+	ServiceIDTransferHome                                       ServiceID = "936"
+	ServiceIDTransferWise                                       ServiceID = "937"
+	ServiceIDTransformCredit                                    ServiceID = "1252"
+	ServiceIDTremolo                                            ServiceID = "938"
+	ServiceIDTripadvisor                                        ServiceID = "939"
+	ServiceIDTrueCaller                                         ServiceID = "940"
+	ServiceIDTrulyMadly                                         ServiceID = "941"
+	ServiceIDTruthSocial                                        ServiceID = "1245"
+	ServiceIDTurboTax                                           ServiceID = "942"
+	ServiceIDTurboTenant                                        ServiceID = "943"
+	ServiceIDTurgame                                            ServiceID = "944"
+	ServiceIDTuro                                               ServiceID = "945"
+	ServiceIDTwig                                               ServiceID = "1327"
+	ServiceIDTwilio                                             ServiceID = "946"
+	ServiceIDTwitch                                             ServiceID = "947"
+	ServiceIDTwitter                                            ServiceID = "948"
+	ServiceIDTwoo                                               ServiceID = "949"
+	ServiceIDUberPostmates                                      ServiceID = "951"
+	ServiceIDUbisoft                                            ServiceID = "952"
+	ServiceIDUltra                                              ServiceID = "953"
+	ServiceIDUltraIO                                            ServiceID = "1079"
+	ServiceIDUniplaces                                          ServiceID = "954"
+	ServiceIDUniqueCasino                                       ServiceID = "955"
+	ServiceIDUnivisionMobileMoney                               ServiceID = "956"
+	ServiceIDUOL                                                ServiceID = "957"
+	ServiceIDUpaynet                                            ServiceID = "958"
+	ServiceIDUpgrade                                            ServiceID = "1264"
+	ServiceIDuphold                                             ServiceID = "959"
+	ServiceIDUplift                                             ServiceID = "960"
+	ServiceIDUpVoice                                            ServiceID = "1214"
+	ServiceIDUpward                                             ServiceID = "961"
+	ServiceIDUpwork                                             ServiceID = "962"
+	ServiceIDUrbanClap                                          ServiceID = "963"
+	ServiceIDUSAA                                               ServiceID = "1215"
+	ServiceIDUSASurvey                                          ServiceID = "964"
+	ServiceIDUSPS                                               ServiceID = "966"
+	ServiceIDValuedOpinions                                     ServiceID = "967"
+	ServiceIDVanguard                                           ServiceID = "1265"
+	ServiceIDVarageSale                                         ServiceID = "968"
+	ServiceIDVaro                                               ServiceID = "969"
+	ServiceIDVase                                               ServiceID = "970"
+	ServiceIDVCollective                                        ServiceID = "1339"
+	ServiceIDVendo                                              ServiceID = "971"
+	ServiceIDVenmo                                              ServiceID = "972"
+	ServiceIDVerse                                              ServiceID = "973"
+	ServiceIDVertex                                             ServiceID = "974"
+	ServiceIDVetsPrevail                                        ServiceID = "975"
+	ServiceIDViaAppViaVan                                       ServiceID = "976"
+	ServiceIDViaBill                                            ServiceID = "1216"
+	ServiceIDViaBTC                                             ServiceID = "977"
+	ServiceIDViber                                              ServiceID = "978"
+	ServiceIDVidaplayer                                         ServiceID = "979"
+	ServiceIDVidio                                              ServiceID = "980"
+	ServiceIDVietJetAir                                         ServiceID = "981"
+	ServiceIDVimpay                                             ServiceID = "982"
+	ServiceIDVinted                                             ServiceID = "983"
+	ServiceIDVivaWallet                                         ServiceID = "984"
+	ServiceIDVK                                                 ServiceID = "985"
+	ServiceIDVnay                                               ServiceID = "986"
+	ServiceIDVoilaNorbert                                       ServiceID = "988"
+	ServiceIDVolny                                              ServiceID = "989"
+	ServiceIDVoopee                                             ServiceID = "990"
+	ServiceIDVoyager                                            ServiceID = "991"
+	ServiceIDVrbo                                               ServiceID = "992"
+	ServiceIDVulkanVegas                                        ServiceID = "993"
+	ServiceIDVumber                                             ServiceID = "994"
+	ServiceIDWafaicloud                                         ServiceID = "995"
+	ServiceIDWagerWeb                                           ServiceID = "1217"
+	ServiceIDWaleteros                                          ServiceID = "996"
+	ServiceIDWalgreens                                          ServiceID = "997"
+	ServiceIDWalletHub                                          ServiceID = "998"
+	ServiceIDWalmart                                            ServiceID = "999"
+	ServiceIDWalmartFamilyMobile                                ServiceID = "1224"
+	ServiceIDWalmartMoneyCard                                   ServiceID = "1218"
+	ServiceIDWapLog                                             ServiceID = "1000"
+	ServiceIDWatchiT                                            ServiceID = "1001"
+	ServiceIDWealthfront                                        ServiceID = "1002"
+	ServiceIDWebmoney                                           ServiceID = "1003"
+	ServiceIDWebull                                             ServiceID = "1253"
+	ServiceIDWeChat                                             ServiceID = "1004"
+	ServiceIDWedoogift                                          ServiceID = "1005"
+	ServiceIDWeebly                                             ServiceID = "1006"
+	ServiceIDWeee                                               ServiceID = "1007"
+	ServiceIDWeibo                                              ServiceID = "1008"
+	ServiceIDWellsFargo                                         ServiceID = "1009"
+	ServiceIDWelspunBrainTrust                                  ServiceID = "1219"
+	ServiceIDWeSing                                             ServiceID = "1010"
+	ServiceIDWestStein                                          ServiceID = "1011"
+	ServiceIDWeverse                                            ServiceID = "1220"
+	ServiceIDWhatnot                                            ServiceID = "1241"
+	ServiceIDWhatsApp                                           ServiceID = "1012"
+	ServiceIDWhatsAround                                        ServiceID = "1013"
+	ServiceIDWhiteCalling                                       ServiceID = "1254"
+	ServiceIDWhop                                               ServiceID = "1014"
+	ServiceIDWickr                                              ServiceID = "1015"
+	ServiceIDWild                                               ServiceID = "1016"
+	ServiceIDWinden                                             ServiceID = "1271"
+	ServiceIDWindowsXboxStore                                   ServiceID = "1221"
+	ServiceIDWing                                               ServiceID = "1017"
+	ServiceIDWingocard                                          ServiceID = "1018"
+	ServiceIDWingspan                                           ServiceID = "1019"
+	ServiceIDWink                                               ServiceID = "1020"
+	ServiceIDWireBarley                                         ServiceID = "1222"
+	ServiceIDWirex                                              ServiceID = "1021"
+	ServiceIDWise                                               ServiceID = "1223"
+	ServiceIDWish                                               ServiceID = "1022"
+	ServiceIDWolt                                               ServiceID = "1023"
+	ServiceIDWomply                                             ServiceID = "1024"
+	ServiceIDWooCommerce                                        ServiceID = "1025"
+	ServiceIDWorkersCreditUnion                                 ServiceID = "1026"
+	ServiceIDWynk                                               ServiceID = "1027"
+	ServiceIDWyre                                               ServiceID = "1028"
+	ServiceIDX1CreditCard                                       ServiceID = "1281"
+	ServiceIDXapo                                               ServiceID = "1029"
+	ServiceIDXbox                                               ServiceID = "1075"
+	ServiceIDxcoins                                             ServiceID = "1225"
+	ServiceIDXfinity                                            ServiceID = "1304"
+	ServiceIDXoom                                               ServiceID = "1031"
+	ServiceIDXS2Exchange                                        ServiceID = "1032"
+	ServiceIDXSERVER                                            ServiceID = "1033"
+	ServiceIDYahoo                                              ServiceID = "1034"
+	ServiceIDYalla                                              ServiceID = "1035"
+	ServiceIDYandex                                             ServiceID = "1036"
+	ServiceIDYeeyi                                              ServiceID = "1037"
+	ServiceIDYeezy                                              ServiceID = "1226"
+	ServiceIDYelp                                               ServiceID = "1038"
+	ServiceIDYFSResearch                                        ServiceID = "1039"
+	ServiceIDYieldstreet                                        ServiceID = "1040"
+	ServiceIDYippi                                              ServiceID = "1041"
+	ServiceIDYocket                                             ServiceID = "1042"
+	ServiceIDYodlee                                             ServiceID = "1043"
+	ServiceIDYoHo                                               ServiceID = "1044"
+	ServiceIDYooMoney                                           ServiceID = "1087"
+	ServiceIDYoti                                               ServiceID = "1045"
+	ServiceIDYouGotaGift                                        ServiceID = "1046"
+	ServiceIDYoula                                              ServiceID = "1047"
+	ServiceIDYourRentals                                        ServiceID = "1048"
+	ServiceIDYouTrip                                            ServiceID = "1049"
+	ServiceIDYoutube                                            ServiceID = "1227"
+	ServiceIDYubo                                               ServiceID = "1050"
+	ServiceIDYunoSurveys                                        ServiceID = "1051"
+	ServiceIDYuroPay                                            ServiceID = "1052"
+	ServiceIDZadarma                                            ServiceID = "1053"
+	ServiceIDZalo                                               ServiceID = "1054"
+	ServiceIDZao                                                ServiceID = "1055"
+	ServiceIDZapZap                                             ServiceID = "1056"
+	ServiceIDZaxby                                              ServiceID = "1349"
+	ServiceIDzcom                                               ServiceID = "1229"
+	ServiceIDZeek                                               ServiceID = "1057"
+	ServiceIDZelle                                              ServiceID = "1058"
+	ServiceIDZen                                                ServiceID = "1290"
+	ServiceIDZenly                                              ServiceID = "1059"
+	ServiceIDZest                                               ServiceID = "1060"
+	ServiceIDZhihu                                              ServiceID = "1061"
+	ServiceIDZillow                                             ServiceID = "1062"
+	ServiceIDZipCo                                              ServiceID = "1063"
+	ServiceIDZipQuadPay                                         ServiceID = "1064"
+	ServiceIDZogo                                               ServiceID = "1065"
+	ServiceIDZoho                                               ServiceID = "1066"
+	ServiceIDZolve                                              ServiceID = "1277"
+	ServiceIDZomato                                             ServiceID = "1067"
+	ServiceIDZoomBucks                                          ServiceID = "1068"
+	ServiceIDZoomInfo                                           ServiceID = "1069"
+	ServiceIDZoosk                                              ServiceID = "1070"
+	ServiceIDZumper                                             ServiceID = "1071"
+)
+
+// Services that were renamed or are no longer listed, kept so code using them
+// still builds
+const (
+	// Deprecated: use ServiceIDBurgerKing_146 instead.
+	ServiceIDBurger_King = ServiceIDBurgerKing_146
+	// Deprecated: use ServiceIDEasyPay_304 instead.
+	ServiceIDEasy_Pay = ServiceIDEasyPay_304
+	// Deprecated: use ServiceIDFoodPanda_358 instead.
+	ServiceIDFood_Panda = ServiceIDFoodPanda_358
+	// Deprecated: use ServiceIDMyOpinions_612 instead.
+	ServiceIDMy_Opinions = ServiceIDMyOpinions_612
+	// Deprecated: use ServiceIDPropellerAds_745 instead.
+	ServiceIDPropeller_Ads = ServiceIDPropellerAds_745
+	// Deprecated: use ServiceIDPurseio_752 instead.
+	ServiceIDPurse_io = ServiceIDPurseio_752
+	// Deprecated: use ServiceIDSnapKitchen_849 instead.
+	ServiceIDSnap_Kitchen = ServiceIDSnapKitchen_849
+	// Deprecated: use ServiceIDStateFarm_866 instead.
+	ServiceIDState_Farm = ServiceIDStateFarm_866
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceID101Sweets instead.
 	Service101Sweets = "1106"
+	// Deprecated: use ServiceID1688 instead.
 	Service1688 = "1"
+	// Deprecated: use ServiceID1Q instead.
 	Service1Q = "2"
+	// Deprecated: use ServiceID1StopMove instead.
 	Service1StopMove = "3"
+	// Deprecated: use ServiceID2dehands instead.
 	Service2dehands = "4"
+	// Deprecated: use ServiceID2game instead.
 	Service2game = "5"
+	// Deprecated: use ServiceID2RedBeans instead.
 	Service2RedBeans = "6"
+	// Deprecated: use ServiceID360NRS instead.
 	Service360NRS = "7"
+	// Deprecated: use ServiceID3Fun instead.
 	Service3Fun = "8"
+	// Deprecated: use ServiceID5karu instead.
 	Service5karu = "9"
+	// Deprecated: use ServiceID5miles instead.
 	Service5miles = "10"
+	// Deprecated: use ServiceID7Eleven instead.
 	Service7Eleven = "11"
+	// Deprecated: use ServiceID7Mall instead.
 	Service7Mall = "12"
+	// Deprecated: use ServiceID888poker instead.
 	Service888poker = "13"
+	// Deprecated: use ServiceIDA1Wallet instead.
 	ServiceA1Wallet = "14"
+	// Deprecated: use ServiceIDAARP instead.
 	ServiceAARP = "1292"
+	// Deprecated: use ServiceIDAARPRewards instead.
 	ServiceAARPRewards = "15"
+	// Deprecated: use ServiceIDAblo instead.
 	ServiceAblo = "16"
+	// Deprecated: use ServiceIDAbra instead.
 	ServiceAbra = "17"
+	// Deprecated: use ServiceIDAccountKit instead.
 	ServiceAccountKit = "18"
+	// Deprecated: use ServiceIDAccountPatrolMoneyPatrol instead.
 	ServiceAccountPatrolMoneyPatrol = "1107"
+	// Deprecated: use ServiceIDAcorns instead.
 	ServiceAcorns = "1108"
-	ServiceAdItUp = "20"
+	// Deprecated: use ServiceIDAdGate instead.
 	ServiceAdGate = "1103"
+	// Deprecated: use ServiceIDAdidas instead.
 	ServiceAdidas = "19"
+	// Deprecated: use ServiceIDAdira instead.
 	ServiceAdira = "1094"
+	// Deprecated: use ServiceIDAdItUp instead.
+	ServiceAdItUp = "20"
+	// Deprecated: use ServiceIDADList24 instead.
 	ServiceADList24 = "21"
+	// Deprecated: use ServiceIDAdobe instead.
 	ServiceAdobe = "22"
+	// Deprecated: use ServiceIDAdvCash instead.
 	ServiceAdvCash = "23"
+	// Deprecated: use ServiceIDAdWallet instead.
 	ServiceAdWallet = "24"
+	// Deprecated: use ServiceIDAeldra instead.
 	ServiceAeldra = "1109"
+	// Deprecated: use ServiceIDAffirm instead.
 	ServiceAffirm = "25"
+	// Deprecated: use ServiceIDAfterpay instead.
 	ServiceAfterpay = "26"
+	// Deprecated: use ServiceIDAgoda instead.
 	ServiceAgoda = "27"
+	// Deprecated: use ServiceIDAH4R instead.
 	ServiceAH4R = "1248"
+	// Deprecated: use ServiceIDAhead instead.
 	ServiceAhead = "1110"
+	// Deprecated: use ServiceIDAirbnb instead.
 	ServiceAirbnb = "28"
+	// Deprecated: use ServiceIDAirTel instead.
 	ServiceAirTel = "29"
+	// Deprecated: use ServiceIDAirtm instead.
 	ServiceAirtm = "30"
+	// Deprecated: use ServiceIDAkulaku instead.
 	ServiceAkulaku = "31"
+	// Deprecated: use ServiceIDAlbert instead.
 	ServiceAlbert = "32"
+	// Deprecated: use ServiceIDAlibaba instead.
 	ServiceAlibaba = "33"
+	// Deprecated: use ServiceIDAliexpress instead.
 	ServiceAliexpress = "1341"
+	// Deprecated: use ServiceIDAlignable instead.
 	ServiceAlignable = "34"
+	// Deprecated: use ServiceIDAlipay instead.
 	ServiceAlipay = "35"
+	// Deprecated: use ServiceIDAllset instead.
 	ServiceAllset = "36"
+	// Deprecated: use ServiceIDALTBalaji instead.
 	ServiceALTBalaji = "37"
+	// Deprecated: use ServiceIDAmasia instead.
 	ServiceAmasia = "38"
+	// Deprecated: use ServiceIDAmazonAmazonWebs instead.
 	ServiceAmazonAmazonWebs = "39"
+	// Deprecated: use ServiceIDAmazonWebs instead.
 	ServiceAmazonWebs = "1112"
+	// Deprecated: use ServiceIDAmericaVoice instead.
 	ServiceAmericaVoice = "40"
+	// Deprecated: use ServiceIDAndo instead.
 	ServiceAndo = "41"
+	// Deprecated: use ServiceIDAngi instead.
 	ServiceAngi = "1282"
+	// Deprecated: use ServiceIDAnibis instead.
 	ServiceAnibis = "42"
+	// Deprecated: use ServiceIDAnkama instead.
 	ServiceAnkama = "43"
+	// Deprecated: use ServiceIDAnycoinDirect instead.
 	ServiceAnycoinDirect = "44"
+	// Deprecated: use ServiceIDANZ instead.
 	ServiceANZ = "45"
+	// Deprecated: use ServiceIDAol instead.
 	ServiceAol = "46"
+	// Deprecated: use ServiceIDAppFlame instead.
 	ServiceAppFlame = "47"
+	// Deprecated: use ServiceIDAppinio instead.
 	ServiceAppinio = "1350"
+	// Deprecated: use ServiceIDApple instead.
 	ServiceApple = "48"
+	// Deprecated: use ServiceIDAppleWallet instead.
 	ServiceAppleWallet = "1113"
+	// Deprecated: use ServiceIDAppLovin instead.
 	ServiceAppLovin = "49"
+	// Deprecated: use ServiceIDAppStation instead.
 	ServiceAppStation = "50"
+	// Deprecated: use ServiceIDARMSLIST instead.
 	ServiceARMSLIST = "51"
+	// Deprecated: use ServiceIDAs2in1 instead.
 	ServiceAs2in1 = "52"
+	// Deprecated: use ServiceIDAsbucks instead.
 	ServiceAsbucks = "1318"
+	// Deprecated: use ServiceIDAspiration instead.
 	ServiceAspiration = "1114"
+	// Deprecated: use ServiceIDATMcom instead.
 	ServiceATMcom = "1115"
+	// Deprecated: use ServiceIDAtom instead.
 	ServiceAtom = "53"
+	// Deprecated: use ServiceIDAtomy instead.
 	ServiceAtomy = "54"
+	// Deprecated: use ServiceIDAttaPoll instead.
 	ServiceAttaPoll = "55"
+	// Deprecated: use ServiceIDAustraliaPost instead.
 	ServiceAustraliaPost = "56"
+	// Deprecated: use ServiceIDAuthy instead.
 	ServiceAuthy = "57"
+	// Deprecated: use ServiceIDAutoru instead.
 	ServiceAutoru = "58"
+	// Deprecated: use ServiceIDAutotrader instead.
 	ServiceAutotrader = "59"
+	// Deprecated: use ServiceIDAvail instead.
 	ServiceAvail = "60"
+	// Deprecated: use ServiceIDAvito instead.
 	ServiceAvito = "61"
+	// Deprecated: use ServiceIDAyoba instead.
 	ServiceAyoba = "62"
+	// Deprecated: use ServiceIDAzure instead.
 	ServiceAzure = "1073"
+	// Deprecated: use ServiceIDBackblaze instead.
 	ServiceBackblaze = "63"
+	// Deprecated: use ServiceIDBadi instead.
 	ServiceBadi = "64"
+	// Deprecated: use ServiceIDBadoo instead.
 	ServiceBadoo = "65"
+	// Deprecated: use ServiceIDBaidu instead.
 	ServiceBaidu = "66"
+	// Deprecated: use ServiceIDBakkt instead.
 	ServiceBakkt = "1116"
+	// Deprecated: use ServiceIDBanggood instead.
 	ServiceBanggood = "1090"
+	// Deprecated: use ServiceIDBankOfAmerica instead.
 	ServiceBankOfAmerica = "1337"
+	// Deprecated: use ServiceIDBanq24 instead.
 	ServiceBanq24 = "68"
+	// Deprecated: use ServiceIDBanxa instead.
 	ServiceBanxa = "69"
+	// Deprecated: use ServiceIDBaselane instead.
 	ServiceBaselane = "1312"
+	// Deprecated: use ServiceIDBattlenetBlizzard instead.
 	ServiceBattlenetBlizzard = "70"
+	// Deprecated: use ServiceIDBBVA instead.
 	ServiceBBVA = "71"
+	// Deprecated: use ServiceIDBDSwiss instead.
 	ServiceBDSwiss = "72"
+	// Deprecated: use ServiceIDBeat instead.
 	ServiceBeat = "1275"
+	// Deprecated: use ServiceIDBeemIt instead.
 	ServiceBeemIt = "73"
+	// Deprecated: use ServiceIDBeetalk instead.
 	ServiceBeetalk = "74"
+	// Deprecated: use ServiceIDBeForthRight instead.
 	ServiceBeForthRight = "75"
+	// Deprecated: use ServiceIDBestOfOurValley instead.
 	ServiceBestOfOurValley = "76"
+	// Deprecated: use ServiceIDBet365 instead.
 	ServiceBet365 = "1332"
+	// Deprecated: use ServiceIDBet9ja instead.
 	ServiceBet9ja = "77"
+	// Deprecated: use ServiceIDBetCris instead.
 	ServiceBetCris = "78"
+	// Deprecated: use ServiceIDBetfair instead.
 	ServiceBetfair = "79"
+	// Deprecated: use ServiceIDBetfred instead.
 	ServiceBetfred = "80"
+	// Deprecated: use ServiceIDBetMGM instead.
 	ServiceBetMGM = "1269"
+	// Deprecated: use ServiceIDBetterment instead.
 	ServiceBetterment = "1119"
+	// Deprecated: use ServiceIDBidoo instead.
 	ServiceBidoo = "81"
+	// Deprecated: use ServiceIDBigolive instead.
 	ServiceBigolive = "82"
+	// Deprecated: use ServiceIDBigToken instead.
 	ServiceBigToken = "83"
+	// Deprecated: use ServiceIDBiltRewards instead.
 	ServiceBiltRewards = "1120"
+	// Deprecated: use ServiceIDBIM instead.
 	ServiceBIM = "84"
+	// Deprecated: use ServiceIDBinance instead.
 	ServiceBinance = "85"
+	// Deprecated: use ServiceIDBing instead.
 	ServiceBing = "86"
+	// Deprecated: use ServiceIDBingoCash instead.
 	ServiceBingoCash = "1308"
+	// Deprecated: use ServiceIDBit4Coin instead.
 	ServiceBit4Coin = "87"
+	// Deprecated: use ServiceIDBit4Sale instead.
 	ServiceBit4Sale = "88"
+	// Deprecated: use ServiceIDBitaccess instead.
 	ServiceBitaccess = "89"
+	// Deprecated: use ServiceIDBitClout instead.
 	ServiceBitClout = "90"
+	// Deprecated: use ServiceIDBitClude instead.
 	ServiceBitClude = "91"
+	// Deprecated: use ServiceIDbitcoinAlley instead.
+	ServicebitcoinAlley = "1121"
+	// Deprecated: use ServiceIDBitcoinATM instead.
 	ServiceBitcoinATM = "92"
+	// Deprecated: use ServiceIDBitcoinde instead.
 	ServiceBitcoinde = "93"
-	ServicebitcoinAlley = "1121"
+	// Deprecated: use ServiceIDBitcoinSolutions instead.
 	ServiceBitcoinSolutions = "94"
+	// Deprecated: use ServiceIDbitFlyer instead.
 	ServicebitFlyer = "95"
+	// Deprecated: use ServiceIDBitfront instead.
 	ServiceBitfront = "96"
+	// Deprecated: use ServiceIDBitgamesio instead.
 	ServiceBitgamesio = "97"
+	// Deprecated: use ServiceIDBithumb instead.
 	ServiceBithumb = "98"
+	// Deprecated: use ServiceIDBitlabs instead.
 	ServiceBitlabs = "1278"
+	// Deprecated: use ServiceIDBitmax instead.
 	ServiceBitmax = "99"
+	// Deprecated: use ServiceIDBitmo instead.
 	ServiceBitmo = "100"
+	// Deprecated: use ServiceIDBitOasis instead.
 	ServiceBitOasis = "101"
+	// Deprecated: use ServiceIDBitonic instead.
 	ServiceBitonic = "102"
+	// Deprecated: use ServiceIDBitpanda instead.
 	ServiceBitpanda = "103"
+	// Deprecated: use ServiceIDBitsa instead.
 	ServiceBitsa = "104"
+	// Deprecated: use ServiceIDBitsdaq instead.
 	ServiceBitsdaq = "105"
+	// Deprecated: use ServiceIDBitso instead.
 	ServiceBitso = "106"
+	// Deprecated: use ServiceIDBitstamp instead.
 	ServiceBitstamp = "107"
+	// Deprecated: use ServiceIDBitTube instead.
 	ServiceBitTube = "108"
+	// Deprecated: use ServiceIDBitwage instead.
 	ServiceBitwage = "109"
+	// Deprecated: use ServiceIDBity instead.
 	ServiceBity = "110"
+	// Deprecated: use ServiceIDBlaBla instead.
 	ServiceBlaBla = "111"
+	// Deprecated: use ServiceIDBlackcatcard instead.
 	ServiceBlackcatcard = "112"
+	// Deprecated: use ServiceIDBlackPeopleMeet instead.
 	ServiceBlackPeopleMeet = "113"
+	// Deprecated: use ServiceIDBlibli instead.
 	ServiceBlibli = "1092"
+	// Deprecated: use ServiceIDBLK instead.
 	ServiceBLK = "115"
+	// Deprecated: use ServiceIDBlockchain instead.
 	ServiceBlockchain = "116"
+	// Deprecated: use ServiceIDBlockFi instead.
 	ServiceBlockFi = "1122"
+	// Deprecated: use ServiceIDBloomMe instead.
 	ServiceBloomMe = "117"
-	ServiceBlueFederalCreditUnion = "120"
+	// Deprecated: use ServiceIDBlueAcorn instead.
 	ServiceBlueAcorn = "118"
+	// Deprecated: use ServiceIDBlueBird instead.
 	ServiceBlueBird = "1123"
+	// Deprecated: use ServiceIDBlued instead.
 	ServiceBlued = "119"
+	// Deprecated: use ServiceIDBlueFederalCreditUnion instead.
+	ServiceBlueFederalCreditUnion = "120"
+	// Deprecated: use ServiceIDBluePay instead.
 	ServiceBluePay = "121"
+	// Deprecated: use ServiceIDBlueVine instead.
 	ServiceBlueVine = "122"
+	// Deprecated: use ServiceIDBMOHarris instead.
 	ServiceBMOHarris = "1124"
+	// Deprecated: use ServiceIDBoatsetter instead.
 	ServiceBoatsetter = "123"
+	// Deprecated: use ServiceIDBolt instead.
 	ServiceBolt = "124"
+	// Deprecated: use ServiceIDBoo instead.
 	ServiceBoo = "1300"
+	// Deprecated: use ServiceIDBookingcom instead.
 	ServiceBookingcom = "125"
+	// Deprecated: use ServiceIDBoon instead.
 	ServiceBoon = "126"
+	// Deprecated: use ServiceIDBOSSRevolutionMoney instead.
 	ServiceBOSSRevolutionMoney = "1230"
+	// Deprecated: use ServiceIDBotBroker instead.
 	ServiceBotBroker = "128"
+	// Deprecated: use ServiceIDBotcode instead.
 	ServiceBotcode = "129"
+	// Deprecated: use ServiceIDBotim instead.
 	ServiceBotim = "130"
+	// Deprecated: use ServiceIDBovada instead.
 	ServiceBovada = "1125"
+	// Deprecated: use ServiceIDBoxedDeal instead.
 	ServiceBoxedDeal = "131"
+	// Deprecated: use ServiceIDBraid instead.
 	ServiceBraid = "132"
+	// Deprecated: use ServiceIDBrandclub instead.
 	ServiceBrandclub = "1126"
+	// Deprecated: use ServiceIDBrandedSurvey instead.
 	ServiceBrandedSurvey = "133"
+	// Deprecated: use ServiceIDBrazzers instead.
 	ServiceBrazzers = "134"
+	// Deprecated: use ServiceIDBrex instead.
 	ServiceBrex = "135"
+	// Deprecated: use ServiceIDBridge instead.
 	ServiceBridge = "136"
+	// Deprecated: use ServiceIDBridgeCard instead.
 	ServiceBridgeCard = "1127"
+	// Deprecated: use ServiceIDBroxel instead.
 	ServiceBroxel = "137"
+	// Deprecated: use ServiceIDBTCDirect instead.
 	ServiceBTCDirect = "138"
+	// Deprecated: use ServiceIDBTCsurveys instead.
 	ServiceBTCsurveys = "139"
+	// Deprecated: use ServiceIDBubbleCash instead.
 	ServiceBubbleCash = "1307"
+	// Deprecated: use ServiceIDBukalapak instead.
 	ServiceBukalapak = "140"
+	// Deprecated: use ServiceIDBulkSMScom instead.
 	ServiceBulkSMScom = "141"
+	// Deprecated: use ServiceIDBumble instead.
 	ServiceBumble = "142"
+	// Deprecated: use ServiceIDBump instead.
 	ServiceBump = "143"
+	// Deprecated: use ServiceIDBundil instead.
 	ServiceBundil = "144"
+	// Deprecated: use ServiceIDBunq instead.
 	ServiceBunq = "145"
-	ServiceBurger_King = "146"
+	// Deprecated: use ServiceIDBurgerKing instead.
 	ServiceBurgerKing = "1231"
+	// Deprecated: use ServiceIDBurgerKing_146 instead.
+	ServiceBurgerKing_146 = "146"
+	// Deprecated: use ServiceIDBurnerApp instead.
 	ServiceBurnerApp = "147"
+	// Deprecated: use ServiceIDBurstSMS instead.
 	ServiceBurstSMS = "1246"
+	// Deprecated: use ServiceIDBuyOnTrust instead.
 	ServiceBuyOnTrust = "1128"
+	// Deprecated: use ServiceIDByBit instead.
 	ServiceByBit = "148"
+	// Deprecated: use ServiceIDCabify instead.
 	ServiceCabify = "149"
+	// Deprecated: use ServiceIDCanadaComputers instead.
 	ServiceCanadaComputers = "150"
+	// Deprecated: use ServiceIDCapitalOne instead.
 	ServiceCapitalOne = "151"
+	// Deprecated: use ServiceIDCARDcom instead.
 	ServiceCARDcom = "152"
+	// Deprecated: use ServiceIDCardyard instead.
 	ServiceCardyard = "153"
+	// Deprecated: use ServiceIDCareem instead.
 	ServiceCareem = "154"
+	// Deprecated: use ServiceIDCarepoynt instead.
 	ServiceCarepoynt = "155"
+	// Deprecated: use ServiceIDCarousell instead.
 	ServiceCarousell = "156"
+	// Deprecated: use ServiceIDCarsGuide instead.
 	ServiceCarsGuide = "157"
-	ServiceCashAlarm = "159"
-	ServiceCashShow = "162"
+	// Deprecated: use ServiceIDCashAA instead.
 	ServiceCashAA = "158"
+	// Deprecated: use ServiceIDCashAlarm instead.
+	ServiceCashAlarm = "159"
+	// Deprecated: use ServiceIDCashApp instead.
 	ServiceCashApp = "160"
+	// Deprecated: use ServiceIDCashbackbase instead.
 	ServiceCashbackbase = "161"
+	// Deprecated: use ServiceIDCashew instead.
 	ServiceCashew = "1256"
+	// Deprecated: use ServiceIDCashShow instead.
+	ServiceCashShow = "162"
+	// Deprecated: use ServiceIDCashWalk instead.
 	ServiceCashWalk = "163"
+	// Deprecated: use ServiceIDCashZine instead.
 	ServiceCashZine = "164"
+	// Deprecated: use ServiceIDCasumo instead.
 	ServiceCasumo = "165"
+	// Deprecated: use ServiceIDCatchMe instead.
 	ServiceCatchMe = "166"
+	// Deprecated: use ServiceIDCaviar instead.
 	ServiceCaviar = "167"
+	// Deprecated: use ServiceIDcdkeyscom instead.
 	Servicecdkeyscom = "168"
+	// Deprecated: use ServiceIDCELEBe instead.
 	ServiceCELEBe = "1266"
+	// Deprecated: use ServiceIDCentroBill instead.
 	ServiceCentroBill = "169"
+	// Deprecated: use ServiceIDCentrum instead.
 	ServiceCentrum = "170"
+	// Deprecated: use ServiceIDCEXIO instead.
 	ServiceCEXIO = "171"
+	// Deprecated: use ServiceIDChampsSports instead.
 	ServiceChampsSports = "1129"
+	// Deprecated: use ServiceIDChangelly instead.
 	ServiceChangelly = "172"
+	// Deprecated: use ServiceIDChaosCloud instead.
 	ServiceChaosCloud = "173"
+	// Deprecated: use ServiceIDCharlesSchwab instead.
 	ServiceCharlesSchwab = "1130"
+	// Deprecated: use ServiceIDChase instead.
 	ServiceChase = "174"
+	// Deprecated: use ServiceIDCheapVoip instead.
 	ServiceCheapVoip = "175"
+	// Deprecated: use ServiceIDCheckbookio instead.
 	ServiceCheckbookio = "176"
+	// Deprecated: use ServiceIDCheckPoints instead.
 	ServiceCheckPoints = "177"
+	// Deprecated: use ServiceIDCheese instead.
 	ServiceCheese = "178"
+	// Deprecated: use ServiceIDChevron instead.
 	ServiceChevron = "1325"
+	// Deprecated: use ServiceIDChicksGoldInc instead.
 	ServiceChicksGoldInc = "1131"
+	// Deprecated: use ServiceIDChime instead.
 	ServiceChime = "179"
+	// Deprecated: use ServiceIDChipotle instead.
 	ServiceChipotle = "1313"
+	// Deprecated: use ServiceIDChipper instead.
 	ServiceChipper = "180"
+	// Deprecated: use ServiceIDChispa instead.
 	ServiceChispa = "181"
+	// Deprecated: use ServiceIDChowbus instead.
 	ServiceChowbus = "182"
+	// Deprecated: use ServiceIDChumbaCasino instead.
 	ServiceChumbaCasino = "1085"
+	// Deprecated: use ServiceIDCIBC instead.
 	ServiceCIBC = "183"
+	// Deprecated: use ServiceIDCinchbucks instead.
 	ServiceCinchbucks = "184"
+	// Deprecated: use ServiceIDCircle instead.
 	ServiceCircle = "185"
+	// Deprecated: use ServiceIDCitizen instead.
 	ServiceCitizen = "1302"
+	// Deprecated: use ServiceIDCJSCDKEYSCOM instead.
 	ServiceCJSCDKEYSCOM = "186"
+	// Deprecated: use ServiceIDClearpay instead.
 	ServiceClearpay = "188"
+	// Deprecated: use ServiceIDClearVoice instead.
 	ServiceClearVoice = "189"
+	// Deprecated: use ServiceIDCledara instead.
 	ServiceCledara = "190"
+	// Deprecated: use ServiceIDCleo instead.
 	ServiceCleo = "191"
+	// Deprecated: use ServiceIDClickadu instead.
 	ServiceClickadu = "192"
+	// Deprecated: use ServiceIDClickatell instead.
 	ServiceClickatell = "193"
+	// Deprecated: use ServiceIDClickDishes instead.
 	ServiceClickDishes = "194"
+	// Deprecated: use ServiceIDclickworker instead.
 	Serviceclickworker = "195"
+	// Deprecated: use ServiceIDClipClaps instead.
 	ServiceClipClaps = "196"
+	// Deprecated: use ServiceIDCLiQQ instead.
 	ServiceCLiQQ = "197"
+	// Deprecated: use ServiceIDCloudBet instead.
 	ServiceCloudBet = "198"
+	// Deprecated: use ServiceIDCloudSim instead.
 	ServiceCloudSim = "199"
+	// Deprecated: use ServiceIDCloudways instead.
 	ServiceCloudways = "200"
+	// Deprecated: use ServiceIDClover instead.
 	ServiceClover = "201"
+	// Deprecated: use ServiceIDClubFactory instead.
 	ServiceClubFactory = "202"
+	// Deprecated: use ServiceIDClubhouse instead.
 	ServiceClubhouse = "203"
+	// Deprecated: use ServiceIDClubVPS instead.
 	ServiceClubVPS = "204"
+	// Deprecated: use ServiceIDCocaCola instead.
 	ServiceCocaCola = "1244"
+	// Deprecated: use ServiceIDCodaPayments instead.
 	ServiceCodaPayments = "205"
+	// Deprecated: use ServiceIDCoffeeMeetsBagel instead.
 	ServiceCoffeeMeetsBagel = "206"
-	ServiceCoinPop = "219"
+	// Deprecated: use ServiceIDCoinbase instead.
 	ServiceCoinbase = "208"
+	// Deprecated: use ServiceIDCoincasper instead.
 	ServiceCoincasper = "1331"
+	// Deprecated: use ServiceIDCoinChat instead.
 	ServiceCoinChat = "209"
+	// Deprecated: use ServiceIDCoinCircle instead.
 	ServiceCoinCircle = "1133"
+	// Deprecated: use ServiceIDCoinCloud instead.
 	ServiceCoinCloud = "210"
+	// Deprecated: use ServiceIDCoinEx instead.
 	ServiceCoinEx = "211"
+	// Deprecated: use ServiceIDCoinFlip instead.
 	ServiceCoinFlip = "212"
+	// Deprecated: use ServiceIDCoinGate instead.
 	ServiceCoinGate = "213"
+	// Deprecated: use ServiceIDCoinhouse instead.
 	ServiceCoinhouse = "214"
+	// Deprecated: use ServiceIDCoinipop instead.
 	ServiceCoinipop = "215"
+	// Deprecated: use ServiceIDCoinjar instead.
 	ServiceCoinjar = "216"
+	// Deprecated: use ServiceIDCoinloot instead.
 	ServiceCoinloot = "1283"
+	// Deprecated: use ServiceIDCoinme instead.
 	ServiceCoinme = "217"
+	// Deprecated: use ServiceIDCoinomi instead.
 	ServiceCoinomi = "218"
+	// Deprecated: use ServiceIDCoinOut instead.
 	ServiceCoinOut = "1134"
+	// Deprecated: use ServiceIDCoinPop instead.
+	ServiceCoinPop = "219"
+	// Deprecated: use ServiceIDCoinsBaron instead.
 	ServiceCoinsBaron = "1101"
+	// Deprecated: use ServiceIDCoinseed instead.
 	ServiceCoinseed = "220"
+	// Deprecated: use ServiceIDCoinsph instead.
 	ServiceCoinsph = "221"
+	// Deprecated: use ServiceIDCoinSpot instead.
 	ServiceCoinSpot = "222"
+	// Deprecated: use ServiceIDCoinstash instead.
 	ServiceCoinstash = "223"
+	// Deprecated: use ServiceIDCoinSwitch instead.
 	ServiceCoinSwitch = "224"
+	// Deprecated: use ServiceIDCointelegraph instead.
 	ServiceCointelegraph = "225"
+	// Deprecated: use ServiceIDCoinZoom instead.
 	ServiceCoinZoom = "226"
+	// Deprecated: use ServiceIDComenityBreadFinancialBreadPay instead.
 	ServiceComenityBreadFinancialBreadPay = "1135"
+	// Deprecated: use ServiceIDCommunityInsightsForum instead.
 	ServiceCommunityInsightsForum = "227"
+	// Deprecated: use ServiceIDConfirmed instead.
 	ServiceConfirmed = "228"
+	// Deprecated: use ServiceIDCopper instead.
 	ServiceCopper = "229"
+	// Deprecated: use ServiceIDCornerCard instead.
 	ServiceCornerCard = "230"
+	// Deprecated: use ServiceIDCouponscom instead.
 	ServiceCouponscom = "231"
+	// Deprecated: use ServiceIDCourseHero instead.
 	ServiceCourseHero = "232"
+	// Deprecated: use ServiceIDCPAGrip instead.
 	ServiceCPAGrip = "1301"
+	// Deprecated: use ServiceIDCraigslist instead.
 	ServiceCraigslist = "233"
+	// Deprecated: use ServiceIDCrazyKart instead.
 	ServiceCrazyKart = "234"
+	// Deprecated: use ServiceIDCreditKarma instead.
 	ServiceCreditKarma = "235"
+	// Deprecated: use ServiceIDCreditSesame instead.
 	ServiceCreditSesame = "236"
+	// Deprecated: use ServiceIDCrowdTap instead.
 	ServiceCrowdTap = "237"
+	// Deprecated: use ServiceIDCrypterium instead.
 	ServiceCrypterium = "238"
+	// Deprecated: use ServiceIDCryptocom instead.
 	ServiceCryptocom = "239"
+	// Deprecated: use ServiceIDCryptolocally instead.
 	ServiceCryptolocally = "1136"
+	// Deprecated: use ServiceIDCryptopay instead.
 	ServiceCryptopay = "240"
+	// Deprecated: use ServiceIDCryptoVoucher instead.
 	ServiceCryptoVoucher = "241"
+	// Deprecated: use ServiceIDCUA instead.
 	ServiceCUA = "242"
+	// Deprecated: use ServiceIDCupis instead.
 	ServiceCupis = "1333"
+	// Deprecated: use ServiceIDCurb instead.
 	ServiceCurb = "243"
+	// Deprecated: use ServiceIDCuriousCat instead.
 	ServiceCuriousCat = "244"
+	// Deprecated: use ServiceIDCurrent instead.
 	ServiceCurrent = "245"
+	// Deprecated: use ServiceIDCurrentMusic instead.
 	ServiceCurrentMusic = "246"
+	// Deprecated: use ServiceIDCurrentRewards instead.
 	ServiceCurrentRewards = "247"
+	// Deprecated: use ServiceIDCurtsy instead.
 	ServiceCurtsy = "248"
+	// Deprecated: use ServiceIDCVS instead.
 	ServiceCVS = "1260"
+	// Deprecated: use ServiceIDDabbl instead.
 	ServiceDabbl = "250"
+	// Deprecated: use ServiceIDDailyRewards instead.
 	ServiceDailyRewards = "251"
+	// Deprecated: use ServiceIDDana instead.
 	ServiceDana = "1317"
+	// Deprecated: use ServiceIDDapper instead.
 	ServiceDapper = "252"
+	// Deprecated: use ServiceIDDasherDirect instead.
 	ServiceDasherDirect = "1137"
+	// Deprecated: use ServiceIDDateInAsia instead.
 	ServiceDateInAsia = "253"
+	// Deprecated: use ServiceIDDaum instead.
 	ServiceDaum = "254"
+	// Deprecated: use ServiceIDDave instead.
 	ServiceDave = "255"
+	// Deprecated: use ServiceIDDaybreakGames instead.
 	ServiceDaybreakGames = "256"
+	// Deprecated: use ServiceIDDDosGuard instead.
 	ServiceDDosGuard = "257"
+	// Deprecated: use ServiceIDDeliveroo instead.
 	ServiceDeliveroo = "258"
+	// Deprecated: use ServiceIDDeliveryClub instead.
 	ServiceDeliveryClub = "259"
+	// Deprecated: use ServiceIDDeliveryHero instead.
 	ServiceDeliveryHero = "260"
+	// Deprecated: use ServiceIDDent instead.
 	ServiceDent = "261"
+	// Deprecated: use ServiceIDDepop instead.
 	ServiceDepop = "262"
+	// Deprecated: use ServiceIDDesignHill instead.
 	ServiceDesignHill = "263"
+	// Deprecated: use ServiceIDDHL instead.
 	ServiceDHL = "264"
+	// Deprecated: use ServiceIDDialpad instead.
 	ServiceDialpad = "265"
+	// Deprecated: use ServiceIDDiDi instead.
 	ServiceDiDi = "266"
+	// Deprecated: use ServiceIDDigi2Go instead.
 	ServiceDigi2Go = "267"
+	// Deprecated: use ServiceIDDigiStore instead.
 	ServiceDigiStore = "268"
+	// Deprecated: use ServiceIDDigit instead.
 	ServiceDigit = "269"
+	// Deprecated: use ServiceIDDilMil instead.
 	ServiceDilMil = "270"
+	// Deprecated: use ServiceIDDing instead.
 	ServiceDing = "1138"
+	// Deprecated: use ServiceIDDingtone instead.
 	ServiceDingtone = "271"
+	// Deprecated: use ServiceIDDinnerBalls instead.
 	ServiceDinnerBalls = "272"
+	// Deprecated: use ServiceIDDiscord instead.
 	ServiceDiscord = "273"
+	// Deprecated: use ServiceIDDistroKid instead.
 	ServiceDistroKid = "275"
+	// Deprecated: use ServiceIDDoctoralia instead.
 	ServiceDoctoralia = "1316"
+	// Deprecated: use ServiceIDDocuSign instead.
 	ServiceDocuSign = "276"
+	// Deprecated: use ServiceIDDoku instead.
 	ServiceDoku = "277"
-	ServiceDollarGeneral = "279"
+	// Deprecated: use ServiceIDDollarClix instead.
 	ServiceDollarClix = "278"
+	// Deprecated: use ServiceIDDollarGeneral instead.
+	ServiceDollarGeneral = "279"
+	// Deprecated: use ServiceIDDonately instead.
 	ServiceDonately = "1273"
+	// Deprecated: use ServiceIDDonut instead.
 	ServiceDonut = "1139"
+	// Deprecated: use ServiceIDDoorDash instead.
 	ServiceDoorDash = "280"
+	// Deprecated: use ServiceIDDora instead.
 	ServiceDora = "281"
+	// Deprecated: use ServiceIDDOSH instead.
 	ServiceDOSH = "282"
+	// Deprecated: use ServiceIDDosi instead.
 	ServiceDosi = "1342"
+	// Deprecated: use ServiceIDDota instead.
 	ServiceDota = "283"
+	// Deprecated: use ServiceIDDouban instead.
 	ServiceDouban = "284"
+	// Deprecated: use ServiceIDDoublelist instead.
 	ServiceDoublelist = "285"
+	// Deprecated: use ServiceIDDouugh instead.
 	ServiceDouugh = "286"
+	// Deprecated: use ServiceIDDouyu instead.
 	ServiceDouyu = "287"
+	// Deprecated: use ServiceIDDreamSpring instead.
 	ServiceDreamSpring = "1140"
+	// Deprecated: use ServiceIDDromru instead.
 	ServiceDromru = "288"
+	// Deprecated: use ServiceIDDrop instead.
 	ServiceDrop = "289"
+	// Deprecated: use ServiceIDDrugVokrug instead.
 	ServiceDrugVokrug = "290"
+	// Deprecated: use ServiceIDDrumo instead.
 	ServiceDrumo = "291"
+	// Deprecated: use ServiceIDDTLR instead.
 	ServiceDTLR = "1291"
+	// Deprecated: use ServiceIDDubClub instead.
 	ServiceDubClub = "1355"
+	// Deprecated: use ServiceIDDubizzle instead.
 	ServiceDubizzle = "292"
+	// Deprecated: use ServiceIDDuffl instead.
 	ServiceDuffl = "293"
+	// Deprecated: use ServiceIDDukascopy instead.
 	ServiceDukascopy = "294"
+	// Deprecated: use ServiceIDDundle instead.
 	ServiceDundle = "295"
+	// Deprecated: use ServiceIDDunkinDonuts instead.
 	ServiceDunkinDonuts = "296"
+	// Deprecated: use ServiceIDDynadot instead.
 	ServiceDynadot = "297"
-	ServiceeRewards = "318"
+	// Deprecated: use ServiceIDEarlyBird instead.
 	ServiceEarlyBird = "1141"
-	ServiceEarnHoney = "300"
+	// Deprecated: use ServiceIDEarn99 instead.
 	ServiceEarn99 = "298"
+	// Deprecated: use ServiceIDEarnably instead.
 	ServiceEarnably = "299"
+	// Deprecated: use ServiceIDEarnHoney instead.
+	ServiceEarnHoney = "300"
+	// Deprecated: use ServiceIDEarnin instead.
 	ServiceEarnin = "301"
+	// Deprecated: use ServiceIDEarningStation instead.
 	ServiceEarningStation = "302"
+	// Deprecated: use ServiceIDEarnly instead.
 	ServiceEarnly = "1345"
+	// Deprecated: use ServiceIDEASI instead.
 	ServiceEASI = "303"
+	// Deprecated: use ServiceIDEastbay instead.
 	ServiceEastbay = "1142"
+	// Deprecated: use ServiceIDEasyasTap instead.
 	ServiceEasyasTap = "1077"
-	ServiceEasy_Pay = "304"
+	// Deprecated: use ServiceIDEasyBucks instead.
 	ServiceEasyBucks = "1276"
+	// Deprecated: use ServiceIDEasyPay instead.
 	ServiceEasyPay = "1232"
+	// Deprecated: use ServiceIDEasyPay_304 instead.
+	ServiceEasyPay_304 = "304"
+	// Deprecated: use ServiceIDeBay instead.
 	ServiceeBay = "305"
+	// Deprecated: use ServiceIDeGifter instead.
 	ServiceeGifter = "306"
+	// Deprecated: use ServiceIDElepreneur instead.
 	ServiceElepreneur = "307"
+	// Deprecated: use ServiceIDElevacity instead.
 	ServiceElevacity = "308"
+	// Deprecated: use ServiceIDElGrocer instead.
 	ServiceElGrocer = "1347"
+	// Deprecated: use ServiceIDElootgg instead.
 	ServiceElootgg = "309"
+	// Deprecated: use ServiceIDEmirex instead.
 	ServiceEmirex = "310"
+	// Deprecated: use ServiceIDEmpower instead.
 	ServiceEmpower = "311"
+	// Deprecated: use ServiceIDEneba instead.
 	ServiceEneba = "312"
+	// Deprecated: use ServiceIDEngageSpark instead.
 	ServiceEngageSpark = "313"
+	// Deprecated: use ServiceIDEntropay instead.
 	ServiceEntropay = "314"
+	// Deprecated: use ServiceIDenvel instead.
 	Serviceenvel = "315"
+	// Deprecated: use ServiceIDEobot instead.
 	ServiceEobot = "316"
+	// Deprecated: use ServiceIDEpicNPC instead.
 	ServiceEpicNPC = "317"
+	// Deprecated: use ServiceIDEpochTimes instead.
 	ServiceEpochTimes = "1143"
+	// Deprecated: use ServiceIDeRewards instead.
+	ServiceeRewards = "318"
+	// Deprecated: use ServiceIDEsendex instead.
 	ServiceEsendex = "319"
+	// Deprecated: use ServiceIDEsportal instead.
 	ServiceEsportal = "320"
+	// Deprecated: use ServiceIDEspressoHouse instead.
 	ServiceEspressoHouse = "321"
+	// Deprecated: use ServiceIDeToro instead.
 	ServiceeToro = "322"
+	// Deprecated: use ServiceIDEtsy instead.
 	ServiceEtsy = "323"
+	// Deprecated: use ServiceIDEureka instead.
 	ServiceEureka = "1267"
+	// Deprecated: use ServiceIDEuroPYM instead.
 	ServiceEuroPYM = "324"
+	// Deprecated: use ServiceIDEveryoneAPI instead.
 	ServiceEveryoneAPI = "325"
+	// Deprecated: use ServiceIDExpertOption instead.
 	ServiceExpertOption = "326"
+	// Deprecated: use ServiceIDEyecon instead.
 	ServiceEyecon = "327"
+	// Deprecated: use ServiceIDEZTexting instead.
 	ServiceEZTexting = "1144"
+	// Deprecated: use ServiceIDFaberlic instead.
 	ServiceFaberlic = "328"
+	// Deprecated: use ServiceIDFacebook instead.
 	ServiceFacebook = "329"
+	// Deprecated: use ServiceIDFACEIT instead.
 	ServiceFACEIT = "330"
+	// Deprecated: use ServiceIDFAIRTIQ instead.
 	ServiceFAIRTIQ = "331"
+	// Deprecated: use ServiceIDFanTuan instead.
 	ServiceFanTuan = "332"
+	// Deprecated: use ServiceIDFarmersOnly instead.
 	ServiceFarmersOnly = "1287"
+	// Deprecated: use ServiceIDFastMail instead.
 	ServiceFastMail = "333"
+	// Deprecated: use ServiceIDFave instead.
 	ServiceFave = "334"
+	// Deprecated: use ServiceIDFBS instead.
 	ServiceFBS = "335"
+	// Deprecated: use ServiceIDFeaturePoints instead.
 	ServiceFeaturePoints = "1293"
+	// Deprecated: use ServiceIDFedEx instead.
 	ServiceFedEx = "336"
+	// Deprecated: use ServiceIDFetchRewards instead.
 	ServiceFetchRewards = "337"
+	// Deprecated: use ServiceIDFetLife instead.
 	ServiceFetLife = "338"
+	// Deprecated: use ServiceIDFidelityInvestments instead.
 	ServiceFidelityInvestments = "1145"
+	// Deprecated: use ServiceIDFigureEight instead.
 	ServiceFigureEight = "339"
+	// Deprecated: use ServiceIDFilimo instead.
 	ServiceFilimo = "340"
+	// Deprecated: use ServiceIDFindMate instead.
 	ServiceFindMate = "341"
+	// Deprecated: use ServiceIDFinishLine instead.
 	ServiceFinishLine = "342"
+	// Deprecated: use ServiceIDFirebase instead.
 	ServiceFirebase = "343"
+	// Deprecated: use ServiceIDFirstTechFederalCreditUnion instead.
 	ServiceFirstTechFederalCreditUnion = "1147"
+	// Deprecated: use ServiceIDFitplay instead.
 	ServiceFitplay = "345"
+	// Deprecated: use ServiceIDFiverr instead.
 	ServiceFiverr = "346"
+	// Deprecated: use ServiceIDFlare instead.
 	ServiceFlare = "347"
+	// Deprecated: use ServiceIDFlashRewards instead.
 	ServiceFlashRewards = "348"
+	// Deprecated: use ServiceIDFlatmates instead.
 	ServiceFlatmates = "349"
+	// Deprecated: use ServiceIDFlink instead.
 	ServiceFlink = "1297"
+	// Deprecated: use ServiceIDFlipkart instead.
 	ServiceFlipkart = "350"
+	// Deprecated: use ServiceIDFlippa instead.
 	ServiceFlippa = "351"
+	// Deprecated: use ServiceIDFlurv instead.
 	ServiceFlurv = "352"
+	// Deprecated: use ServiceIDFlutterwave instead.
 	ServiceFlutterwave = "353"
+	// Deprecated: use ServiceIDFluxRewards instead.
 	ServiceFluxRewards = "354"
+	// Deprecated: use ServiceIDFluz instead.
 	ServiceFluz = "355"
+	// Deprecated: use ServiceIDFlyp instead.
 	ServiceFlyp = "356"
+	// Deprecated: use ServiceIDFold instead.
 	ServiceFold = "1148"
-	ServiceFood_Panda = "358"
+	// Deprecated: use ServiceIDFoodora instead.
 	ServiceFoodora = "357"
+	// Deprecated: use ServiceIDFoodPanda instead.
 	ServiceFoodPanda = "1233"
+	// Deprecated: use ServiceIDFoodPanda_358 instead.
+	ServiceFoodPanda_358 = "358"
+	// Deprecated: use ServiceIDFootLocker instead.
 	ServiceFootLocker = "1149"
+	// Deprecated: use ServiceIDFortuneJack instead.
 	ServiceFortuneJack = "359"
+	// Deprecated: use ServiceIDFotocasa instead.
 	ServiceFotocasa = "360"
+	// Deprecated: use ServiceIDFotostrana instead.
 	ServiceFotostrana = "361"
+	// Deprecated: use ServiceIDFound instead.
 	ServiceFound = "362"
+	// Deprecated: use ServiceIDFreeCash instead.
 	ServiceFreeCash = "1082"
+	// Deprecated: use ServiceIDFreeCryptoRewards instead.
 	ServiceFreeCryptoRewards = "1343"
+	// Deprecated: use ServiceIDFreelancer instead.
 	ServiceFreelancer = "363"
+	// Deprecated: use ServiceIDFreeNow instead.
 	ServiceFreeNow = "1294"
+	// Deprecated: use ServiceIDFreeTaxUSA instead.
 	ServiceFreeTaxUSA = "364"
+	// Deprecated: use ServiceIDFreshForex instead.
 	ServiceFreshForex = "365"
+	// Deprecated: use ServiceIDFruitlab instead.
 	ServiceFruitlab = "366"
+	// Deprecated: use ServiceIDFruitz instead.
 	ServiceFruitz = "1335"
+	// Deprecated: use ServiceIDFTX instead.
 	ServiceFTX = "367"
+	// Deprecated: use ServiceIDFusionCash instead.
 	ServiceFusionCash = "368"
+	// Deprecated: use ServiceIDG2A instead.
 	ServiceG2A = "369"
+	// Deprecated: use ServiceIDG2G instead.
 	ServiceG2G = "370"
+	// Deprecated: use ServiceIDGabi instead.
 	ServiceGabi = "1150"
+	// Deprecated: use ServiceIDGagaooLala instead.
 	ServiceGagaooLala = "371"
+	// Deprecated: use ServiceIDGaintplay instead.
 	ServiceGaintplay = "1280"
+	// Deprecated: use ServiceIDGameflip instead.
 	ServiceGameflip = "372"
+	// Deprecated: use ServiceIDGamekit instead.
 	ServiceGamekit = "373"
+	// Deprecated: use ServiceIDGameMinerclub instead.
 	ServiceGameMinerclub = "374"
+	// Deprecated: use ServiceIDGamercraft instead.
 	ServiceGamercraft = "1151"
+	// Deprecated: use ServiceIDGamerMine instead.
 	ServiceGamerMine = "375"
+	// Deprecated: use ServiceIDGappx instead.
 	ServiceGappx = "1323"
+	// Deprecated: use ServiceIDGarena instead.
 	ServiceGarena = "376"
+	// Deprecated: use ServiceIDGCash instead.
 	ServiceGCash = "377"
+	// Deprecated: use ServiceIDGCLoot instead.
 	ServiceGCLoot = "1268"
+	// Deprecated: use ServiceIDGemini instead.
 	ServiceGemini = "378"
+	// Deprecated: use ServiceIDGemiplay instead.
 	ServiceGemiplay = "1152"
+	// Deprecated: use ServiceIDGenitrust instead.
 	ServiceGenitrust = "379"
+	// Deprecated: use ServiceIDGetir instead.
 	ServiceGetir = "1088"
+	// Deprecated: use ServiceIDGetPaidTo instead.
 	ServiceGetPaidTo = "380"
+	// Deprecated: use ServiceIDGetResponse instead.
 	ServiceGetResponse = "381"
+	// Deprecated: use ServiceIDGetSlide instead.
 	ServiceGetSlide = "382"
+	// Deprecated: use ServiceIDGetTaxi instead.
 	ServiceGetTaxi = "383"
+	// Deprecated: use ServiceIDGG instead.
 	ServiceGG = "1303"
+	// Deprecated: use ServiceIDGiftcloud instead.
 	ServiceGiftcloud = "384"
+	// Deprecated: use ServiceIDGifthulk instead.
 	ServiceGifthulk = "385"
+	// Deprecated: use ServiceIDGiftHunterClub instead.
 	ServiceGiftHunterClub = "386"
+	// Deprecated: use ServiceIDGiftPocket instead.
 	ServiceGiftPocket = "1153"
+	// Deprecated: use ServiceIDGlassnet instead.
 	ServiceGlassnet = "1154"
+	// Deprecated: use ServiceIDGlidera instead.
 	ServiceGlidera = "387"
+	// Deprecated: use ServiceIDGlobalPoker instead.
 	ServiceGlobalPoker = "1086"
+	// Deprecated: use ServiceIDGlobfone instead.
 	ServiceGlobfone = "388"
+	// Deprecated: use ServiceIDGlovo instead.
 	ServiceGlovo = "389"
+	// Deprecated: use ServiceIDGoDaddy instead.
 	ServiceGoDaddy = "390"
+	// Deprecated: use ServiceIDGoFundMe instead.
 	ServiceGoFundMe = "391"
+	// Deprecated: use ServiceIDGoJek instead.
 	ServiceGoJek = "392"
+	// Deprecated: use ServiceIDGoldenFarmery instead.
 	ServiceGoldenFarmery = "393"
+	// Deprecated: use ServiceIDGOmobile instead.
 	ServiceGOmobile = "394"
+	// Deprecated: use ServiceIDGoogleBusinessProfile instead.
 	ServiceGoogleBusinessProfile = "1158"
+	// Deprecated: use ServiceIDGoogleGmail instead.
+	ServiceGoogleGmail = "395"
+	// Deprecated: use ServiceIDGoogleMerchantCenter instead.
 	ServiceGoogleMerchantCenter = "1159"
+	// Deprecated: use ServiceIDGooglePlay instead.
 	ServiceGooglePlay = "1080"
+	// Deprecated: use ServiceIDGoogleVoice instead.
 	ServiceGoogleVoice = "396"
-	ServiceGoogleGmail = "395"
+	// Deprecated: use ServiceIDGopuff instead.
 	ServiceGopuff = "397"
+	// Deprecated: use ServiceIDGorillas instead.
 	ServiceGorillas = "1099"
+	// Deprecated: use ServiceIDGoSwak instead.
 	ServiceGoSwak = "398"
+	// Deprecated: use ServiceIDGrab instead.
 	ServiceGrab = "1093"
+	// Deprecated: use ServiceIDGrabPoints instead.
 	ServiceGrabPoints = "399"
+	// Deprecated: use ServiceIDGradOutcome instead.
 	ServiceGradOutcome = "400"
+	// Deprecated: use ServiceIDGrailedcom instead.
 	ServiceGrailedcom = "401"
+	// Deprecated: use ServiceIDGreenDotGo2BankGoBank instead.
 	ServiceGreenDotGo2BankGoBank = "1321"
+	// Deprecated: use ServiceIDGreenDotSmartHome instead.
 	ServiceGreenDotSmartHome = "1160"
+	// Deprecated: use ServiceIDGreenlight instead.
 	ServiceGreenlight = "1105"
+	// Deprecated: use ServiceIDGreggs instead.
 	ServiceGreggs = "1083"
+	// Deprecated: use ServiceIDGrindr instead.
 	ServiceGrindr = "403"
+	// Deprecated: use ServiceIDGroupMe instead.
 	ServiceGroupMe = "404"
+	// Deprecated: use ServiceIDGrubHub instead.
 	ServiceGrubHub = "405"
+	// Deprecated: use ServiceIDGueez instead.
 	ServiceGueez = "406"
+	// Deprecated: use ServiceIDGuru instead.
 	ServiceGuru = "407"
+	// Deprecated: use ServiceIDHago instead.
 	ServiceHago = "408"
+	// Deprecated: use ServiceIDHandy instead.
 	ServiceHandy = "1161"
+	// Deprecated: use ServiceIDHappn instead.
 	ServiceHappn = "409"
+	// Deprecated: use ServiceIDHappyCo instead.
 	ServiceHappyCo = "410"
+	// Deprecated: use ServiceIDHappyEscorts instead.
 	ServiceHappyEscorts = "411"
+	// Deprecated: use ServiceIDHappyPancake instead.
 	ServiceHappyPancake = "412"
+	// Deprecated: use ServiceIDHardBlock instead.
 	ServiceHardBlock = "413"
+	// Deprecated: use ServiceIDHarrisPoll instead.
 	ServiceHarrisPoll = "414"
+	// Deprecated: use ServiceIDHelloTalk instead.
 	ServiceHelloTalk = "415"
+	// Deprecated: use ServiceIDHezzl instead.
 	ServiceHezzl = "416"
+	// Deprecated: use ServiceIDHibbett instead.
 	ServiceHibbett = "417"
+	// Deprecated: use ServiceIDHiCloud instead.
 	ServiceHiCloud = "418"
+	// Deprecated: use ServiceIDHily instead.
 	ServiceHily = "419"
+	// Deprecated: use ServiceIDHinge instead.
 	ServiceHinge = "420"
+	// Deprecated: use ServiceIDHmm instead.
 	ServiceHmm = "421"
+	// Deprecated: use ServiceIDHolvi instead.
 	ServiceHolvi = "422"
+	// Deprecated: use ServiceIDHomeAway instead.
 	ServiceHomeAway = "423"
+	// Deprecated: use ServiceIDHopper instead.
 	ServiceHopper = "424"
+	// Deprecated: use ServiceIDHotVOIP instead.
 	ServiceHotVOIP = "425"
+	// Deprecated: use ServiceIDHouseparty instead.
 	ServiceHouseparty = "426"
+	// Deprecated: use ServiceIDHQTrivia instead.
 	ServiceHQTrivia = "427"
+	// Deprecated: use ServiceIDHsoub instead.
 	ServiceHsoub = "428"
+	// Deprecated: use ServiceIDHuawei instead.
 	ServiceHuawei = "429"
+	// Deprecated: use ServiceIDHUD instead.
 	ServiceHUD = "430"
+	// Deprecated: use ServiceIDHumbleBundle instead.
 	ServiceHumbleBundle = "431"
+	// Deprecated: use ServiceIDHumm instead.
 	ServiceHumm = "432"
+	// Deprecated: use ServiceIDHungryPanda instead.
 	ServiceHungryPanda = "433"
+	// Deprecated: use ServiceIDHunter instead.
 	ServiceHunter = "1249"
+	// Deprecated: use ServiceIDHushmail instead.
 	ServiceHushmail = "434"
+	// Deprecated: use ServiceIDibotta instead.
 	Serviceibotta = "435"
+	// Deprecated: use ServiceIDICQ instead.
 	ServiceICQ = "436"
-	ServiceIDme = "439"
+	// Deprecated: use ServiceIDIdealista instead.
 	ServiceIdealista = "437"
+	// Deprecated: use ServiceIDIdentiteNumerique instead.
 	ServiceIdentiteNumerique = "1351"
+	// Deprecated: use ServiceIDIDES instead.
 	ServiceIDES = "1163"
+	// Deprecated: use ServiceIDIdleEmpire instead.
 	ServiceIdleEmpire = "438"
+	// Deprecated: use ServiceIDIDme instead.
+	ServiceIDme = "439"
+	// Deprecated: use ServiceIDieadbit instead.
 	Serviceieadbit = "440"
+	// Deprecated: use ServiceIDImfree instead.
 	ServiceImfree = "441"
+	// Deprecated: use ServiceIDImgur instead.
 	ServiceImgur = "442"
+	// Deprecated: use ServiceIDImmobiliare instead.
 	ServiceImmobiliare = "443"
+	// Deprecated: use ServiceIDImmobilienScout24 instead.
 	ServiceImmobilienScout24 = "444"
+	// Deprecated: use ServiceIDImmovlan instead.
 	ServiceImmovlan = "445"
+	// Deprecated: use ServiceIDImmowelt instead.
 	ServiceImmowelt = "446"
+	// Deprecated: use ServiceIDImo instead.
 	ServiceImo = "447"
+	// Deprecated: use ServiceIDiMoney instead.
 	ServiceiMoney = "1164"
+	// Deprecated: use ServiceIDInboxLV instead.
 	ServiceInboxLV = "448"
+	// Deprecated: use ServiceIDInBoxPounds instead.
 	ServiceInBoxPounds = "449"
+	// Deprecated: use ServiceIDIndacoin instead.
 	ServiceIndacoin = "450"
+	// Deprecated: use ServiceIDIndeed instead.
 	ServiceIndeed = "451"
+	// Deprecated: use ServiceIDIndi instead.
 	ServiceIndi = "452"
+	// Deprecated: use ServiceIDIndomaret instead.
 	ServiceIndomaret = "1091"
+	// Deprecated: use ServiceIDInnago instead.
 	ServiceInnago = "453"
+	// Deprecated: use ServiceIDInspire instead.
 	ServiceInspire = "454"
+	// Deprecated: use ServiceIDInstacart instead.
 	ServiceInstacart = "455"
+	// Deprecated: use ServiceIDInstaGC instead.
 	ServiceInstaGC = "456"
+	// Deprecated: use ServiceIDInstagram instead.
 	ServiceInstagram = "457"
+	// Deprecated: use ServiceIDInstaRem instead.
 	ServiceInstaRem = "458"
+	// Deprecated: use ServiceIDInstaVoice instead.
 	ServiceInstaVoice = "459"
+	// Deprecated: use ServiceIDIntuit instead.
 	ServiceIntuit = "460"
+	// Deprecated: use ServiceIDiOffer instead.
 	ServiceiOffer = "461"
+	// Deprecated: use ServiceIDIonicware instead.
 	ServiceIonicware = "462"
+	// Deprecated: use ServiceIDIONOS instead.
 	ServiceIONOS = "463"
+	// Deprecated: use ServiceIDIpekyol instead.
 	ServiceIpekyol = "464"
+	// Deprecated: use ServiceIDiPlum instead.
 	ServiceiPlum = "465"
+	// Deprecated: use ServiceIDiPoll instead.
 	ServiceiPoll = "466"
+	// Deprecated: use ServiceIDIpsosiSay instead.
 	ServiceIpsosiSay = "470"
+	// Deprecated: use ServiceIDIQOption instead.
 	ServiceIQOption = "467"
+	// Deprecated: use ServiceIDiRazoo instead.
 	ServiceiRazoo = "468"
+	// Deprecated: use ServiceIDIrazoocom instead.
 	ServiceIrazoocom = "469"
+	// Deprecated: use ServiceIDJackd instead.
 	ServiceJackd = "471"
+	// Deprecated: use ServiceIDJAGRewards instead.
 	ServiceJAGRewards = "472"
+	// Deprecated: use ServiceIDJD instead.
 	ServiceJD = "473"
+	// Deprecated: use ServiceIDJDID instead.
 	ServiceJDID = "1095"
+	// Deprecated: use ServiceIDJeevan instead.
 	ServiceJeevan = "474"
+	// Deprecated: use ServiceIDJelli instead.
 	ServiceJelli = "475"
+	// Deprecated: use ServiceIDJePaiq instead.
 	ServiceJePaiq = "476"
+	// Deprecated: use ServiceIDJerry instead.
 	ServiceJerry = "477"
+	// Deprecated: use ServiceIDJiayuan instead.
 	ServiceJiayuan = "478"
+	// Deprecated: use ServiceIDJMTY instead.
 	ServiceJMTY = "479"
+	// Deprecated: use ServiceIDJobber instead.
 	ServiceJobber = "1166"
+	// Deprecated: use ServiceIDJobToday instead.
 	ServiceJobToday = "480"
+	// Deprecated: use ServiceIDJollyChic instead.
 	ServiceJollyChic = "481"
+	// Deprecated: use ServiceIDJoompay instead.
 	ServiceJoompay = "482"
+	// Deprecated: use ServiceIDJuanCash instead.
 	ServiceJuanCash = "483"
+	// Deprecated: use ServiceIDJuno instead.
 	ServiceJuno = "484"
+	// Deprecated: use ServiceIDKaching instead.
 	ServiceKaching = "1340"
+	// Deprecated: use ServiceIDKACN instead.
 	ServiceKACN = "485"
+	// Deprecated: use ServiceIDKaggle instead.
 	ServiceKaggle = "486"
+	// Deprecated: use ServiceIDKakaoTalk instead.
 	ServiceKakaoTalk = "487"
+	// Deprecated: use ServiceIDKamatera instead.
 	ServiceKamatera = "488"
+	// Deprecated: use ServiceIDKapten instead.
 	ServiceKapten = "489"
+	// Deprecated: use ServiceIDKayoSports instead.
 	ServiceKayoSports = "490"
+	// Deprecated: use ServiceIDKBZpay instead.
 	ServiceKBZpay = "491"
+	// Deprecated: use ServiceIDKeepRewardingcom instead.
 	ServiceKeepRewardingcom = "492"
+	// Deprecated: use ServiceIDKeybase instead.
 	ServiceKeybase = "494"
+	// Deprecated: use ServiceIDKHL instead.
 	ServiceKHL = "495"
+	// Deprecated: use ServiceIDKidsFootLocker instead.
 	ServiceKidsFootLocker = "1167"
+	// Deprecated: use ServiceIDKik instead.
 	ServiceKik = "1081"
+	// Deprecated: use ServiceIDKikoff instead.
 	ServiceKikoff = "1168"
+	// Deprecated: use ServiceIDKink instead.
 	ServiceKink = "496"
+	// Deprecated: use ServiceIDKixify instead.
 	ServiceKixify = "1169"
+	// Deprecated: use ServiceIDKlarna instead.
 	ServiceKlarna = "497"
+	// Deprecated: use ServiceIDKlook instead.
 	ServiceKlook = "498"
+	// Deprecated: use ServiceIDKlover instead.
 	ServiceKlover = "1322"
+	// Deprecated: use ServiceIDKorekTelecom instead.
 	ServiceKorekTelecom = "499"
+	// Deprecated: use ServiceIDKraken instead.
 	ServiceKraken = "500"
+	// Deprecated: use ServiceIDKriptomat instead.
 	ServiceKriptomat = "501"
+	// Deprecated: use ServiceIDKuCoin instead.
 	ServiceKuCoin = "502"
+	// Deprecated: use ServiceIDKufar instead.
 	ServiceKufar = "503"
+	// Deprecated: use ServiceIDKUMU instead.
 	ServiceKUMU = "504"
+	// Deprecated: use ServiceIDKVBPrime instead.
 	ServiceKVBPrime = "505"
+	// Deprecated: use ServiceIDKwai instead.
 	ServiceKwai = "506"
+	// Deprecated: use ServiceIDLalaFood instead.
 	ServiceLalaFood = "507"
+	// Deprecated: use ServiceIDLalamove instead.
 	ServiceLalamove = "508"
+	// Deprecated: use ServiceIDLandingi instead.
 	ServiceLandingi = "509"
+	// Deprecated: use ServiceIDLaPoste instead.
 	ServiceLaPoste = "510"
+	// Deprecated: use ServiceIDLazada instead.
 	ServiceLazada = "511"
+	// Deprecated: use ServiceIDLBRYApp instead.
 	ServiceLBRYApp = "512"
+	// Deprecated: use ServiceIDLDSPlanet instead.
 	ServiceLDSPlanet = "1250"
+	// Deprecated: use ServiceIDLegiit instead.
 	ServiceLegiit = "514"
+	// Deprecated: use ServiceIDLetgo instead.
 	ServiceLetgo = "515"
+	// Deprecated: use ServiceIDLeupay instead.
 	ServiceLeupay = "516"
+	// Deprecated: use ServiceIDLibertyX instead.
 	ServiceLibertyX = "517"
+	// Deprecated: use ServiceIDLibon instead.
 	ServiceLibon = "518"
+	// Deprecated: use ServiceIDLIHKG instead.
 	ServiceLIHKG = "519"
+	// Deprecated: use ServiceIDLikeCard instead.
 	ServiceLikeCard = "1170"
+	// Deprecated: use ServiceIDLikee instead.
 	ServiceLikee = "520"
+	// Deprecated: use ServiceIDLili instead.
 	ServiceLili = "521"
+	// Deprecated: use ServiceIDLine instead.
 	ServiceLine = "522"
+	// Deprecated: use ServiceIDLine2 instead.
 	ServiceLine2 = "1329"
+	// Deprecated: use ServiceIDLink instead.
 	ServiceLink = "1257"
+	// Deprecated: use ServiceIDLinkedIn instead.
 	ServiceLinkedIn = "523"
+	// Deprecated: use ServiceIDLinode instead.
 	ServiceLinode = "1295"
+	// Deprecated: use ServiceIDLiqPay instead.
 	ServiceLiqPay = "524"
+	// Deprecated: use ServiceIDListia instead.
 	ServiceListia = "525"
+	// Deprecated: use ServiceIDListYourself instead.
 	ServiceListYourself = "1259"
+	// Deprecated: use ServiceIDLiteIM instead.
 	ServiceLiteIM = "526"
+	// Deprecated: use ServiceIDLiveScore instead.
 	ServiceLiveScore = "527"
+	// Deprecated: use ServiceIDLiveTribe instead.
 	ServiceLiveTribe = "528"
+	// Deprecated: use ServiceIDLiveTV instead.
 	ServiceLiveTV = "529"
+	// Deprecated: use ServiceIDLivU instead.
 	ServiceLivU = "530"
+	// Deprecated: use ServiceIDLMK instead.
 	ServiceLMK = "531"
+	// Deprecated: use ServiceIDLocalBitcoins instead.
 	ServiceLocalBitcoins = "532"
+	// Deprecated: use ServiceIDLocalCoinATM instead.
 	ServiceLocalCoinATM = "533"
+	// Deprecated: use ServiceIDLocalCryptos instead.
 	ServiceLocalCryptos = "534"
+	// Deprecated: use ServiceIDLocanto instead.
 	ServiceLocanto = "535"
+	// Deprecated: use ServiceIDLolli instead.
 	ServiceLolli = "1078"
+	// Deprecated: use ServiceIDLomocall instead.
 	ServiceLomocall = "536"
+	// Deprecated: use ServiceIDLoveAndSeek instead.
 	ServiceLoveAndSeek = "1251"
-	ServiceLuckyPlay = "1324"
+	// Deprecated: use ServiceIDLuckyDino instead.
 	ServiceLuckyDino = "537"
+	// Deprecated: use ServiceIDLuckyland instead.
 	ServiceLuckyland = "538"
+	// Deprecated: use ServiceIDLuckyPlay instead.
+	ServiceLuckyPlay = "1324"
+	// Deprecated: use ServiceIDLunaNode instead.
 	ServiceLunaNode = "539"
+	// Deprecated: use ServiceIDLuno instead.
 	ServiceLuno = "540"
+	// Deprecated: use ServiceIDLydiaApp instead.
 	ServiceLydiaApp = "541"
+	// Deprecated: use ServiceIDLyft instead.
 	ServiceLyft = "542"
+	// Deprecated: use ServiceIDLynxWallet instead.
 	ServiceLynxWallet = "543"
+	// Deprecated: use ServiceIDM1Finance instead.
 	ServiceM1Finance = "544"
+	// Deprecated: use ServiceIDMaChance instead.
 	ServiceMaChance = "545"
+	// Deprecated: use ServiceIDMagnit instead.
 	ServiceMagnit = "546"
-	ServiceMailPrincess = "552"
-	ServiceMailcom = "549"
+	// Deprecated: use ServiceIDMail2world instead.
 	ServiceMail2world = "547"
+	// Deprecated: use ServiceIDMailChimp instead.
 	ServiceMailChimp = "548"
+	// Deprecated: use ServiceIDMailcom instead.
+	ServiceMailcom = "549"
+	// Deprecated: use ServiceIDMailEE instead.
 	ServiceMailEE = "550"
+	// Deprecated: use ServiceIDMailgun instead.
 	ServiceMailgun = "551"
+	// Deprecated: use ServiceIDMailPrincess instead.
+	ServiceMailPrincess = "552"
+	// Deprecated: use ServiceIDMailRu instead.
 	ServiceMailRu = "553"
+	// Deprecated: use ServiceIDMakePrintable instead.
 	ServiceMakePrintable = "554"
+	// Deprecated: use ServiceIDMamba instead.
 	ServiceMamba = "555"
+	// Deprecated: use ServiceIDMapleSEA instead.
 	ServiceMapleSEA = "556"
+	// Deprecated: use ServiceIDMarcel instead.
 	ServiceMarcel = "557"
+	// Deprecated: use ServiceIDMarcoPolo instead.
 	ServiceMarcoPolo = "558"
+	// Deprecated: use ServiceIDMarcus instead.
 	ServiceMarcus = "1171"
+	// Deprecated: use ServiceIDMarkid instead.
 	ServiceMarkid = "1357"
+	// Deprecated: use ServiceIDMatch instead.
 	ServiceMatch = "559"
+	// Deprecated: use ServiceIDMaxim instead.
 	ServiceMaxim = "1096"
+	// Deprecated: use ServiceIDMaza instead.
 	ServiceMaza = "1326"
+	// Deprecated: use ServiceIDMcMoney instead.
 	ServiceMcMoney = "1172"
+	// Deprecated: use ServiceIDMealPal instead.
 	ServiceMealPal = "560"
+	// Deprecated: use ServiceIDMedLife instead.
 	ServiceMedLife = "561"
+	// Deprecated: use ServiceIDMeeff instead.
 	ServiceMeeff = "562"
+	// Deprecated: use ServiceIDMeesho instead.
 	ServiceMeesho = "563"
+	// Deprecated: use ServiceIDMeetMe instead.
 	ServiceMeetMe = "564"
+	// Deprecated: use ServiceIDMeetup instead.
 	ServiceMeetup = "565"
+	// Deprecated: use ServiceIDMelo instead.
 	ServiceMelo = "566"
+	// Deprecated: use ServiceIDMercadoLibre instead.
 	ServiceMercadoLibre = "567"
+	// Deprecated: use ServiceIDMercari instead.
 	ServiceMercari = "568"
+	// Deprecated: use ServiceIDMessageBird instead.
 	ServiceMessageBird = "569"
+	// Deprecated: use ServiceIDMessageDesk instead.
 	ServiceMessageDesk = "1173"
+	// Deprecated: use ServiceIDMetalPay instead.
 	ServiceMetalPay = "570"
+	// Deprecated: use ServiceIDMeWe instead.
 	ServiceMeWe = "572"
+	// Deprecated: use ServiceIDMezu instead.
 	ServiceMezu = "573"
+	// Deprecated: use ServiceIDMichat instead.
 	ServiceMichat = "574"
+	// Deprecated: use ServiceIDMico instead.
 	ServiceMico = "575"
+	// Deprecated: use ServiceIDMicrocenter instead.
 	ServiceMicrocenter = "1104"
+	// Deprecated: use ServiceIDMicrosoft instead.
 	ServiceMicrosoft = "1072"
+	// Deprecated: use ServiceIDMicrosoftAzure instead.
 	ServiceMicrosoftAzure = "1097"
+	// Deprecated: use ServiceIDMicrosoftOffice365Business instead.
 	ServiceMicrosoftOffice365Business = "1174"
+	// Deprecated: use ServiceIDMicrosoftOffice365E5 instead.
 	ServiceMicrosoftOffice365E5 = "1175"
+	// Deprecated: use ServiceIDMicrosoftOffice365Education instead.
 	ServiceMicrosoftOffice365Education = "1176"
+	// Deprecated: use ServiceIDMicrosoftRewards instead.
 	ServiceMicrosoftRewards = "1177"
+	// Deprecated: use ServiceIDMicroworkers instead.
 	ServiceMicroworkers = "576"
+	// Deprecated: use ServiceIDMido instead.
 	ServiceMido = "577"
+	// Deprecated: use ServiceIDMilesMore instead.
 	ServiceMilesMore = "578"
+	// Deprecated: use ServiceIDMilesReward instead.
 	ServiceMilesReward = "579"
+	// Deprecated: use ServiceIDMilk instead.
 	ServiceMilk = "580"
+	// Deprecated: use ServiceIDMillionaireMatch instead.
 	ServiceMillionaireMatch = "581"
+	// Deprecated: use ServiceIDMillions instead.
 	ServiceMillions = "1178"
+	// Deprecated: use ServiceIDMint instead.
 	ServiceMint = "582"
+	// Deprecated: use ServiceIDMintVine instead.
 	ServiceMintVine = "1179"
+	// Deprecated: use ServiceIDMistplay instead.
 	ServiceMistplay = "583"
+	// Deprecated: use ServiceIDmixi instead.
 	Servicemixi = "584"
+	// Deprecated: use ServiceIDMobihapp instead.
 	ServiceMobihapp = "585"
-	ServiceMobileMan = "587"
+	// Deprecated: use ServiceIDMobilebet instead.
 	ServiceMobilebet = "586"
+	// Deprecated: use ServiceIDMobileMan instead.
+	ServiceMobileMan = "587"
+	// Deprecated: use ServiceIDMobileMoney instead.
 	ServiceMobileMoney = "588"
+	// Deprecated: use ServiceIDMoco instead.
 	ServiceMoco = "589"
+	// Deprecated: use ServiceIDModeEarn instead.
 	ServiceModeEarn = "1098"
+	// Deprecated: use ServiceIDModeEarnApp instead.
 	ServiceModeEarnApp = "1234"
+	// Deprecated: use ServiceIDMoMo instead.
 	ServiceMoMo = "1180"
+	// Deprecated: use ServiceIDMonese instead.
 	ServiceMonese = "590"
+	// Deprecated: use ServiceIDMoneyGram instead.
 	ServiceMoneyGram = "1181"
+	// Deprecated: use ServiceIDMoneyLion instead.
 	ServiceMoneyLion = "591"
+	// Deprecated: use ServiceIDMoneyPak instead.
 	ServiceMoneyPak = "592"
+	// Deprecated: use ServiceIDMoneyRawr instead.
 	ServiceMoneyRawr = "593"
+	// Deprecated: use ServiceIDMonzo instead.
 	ServiceMonzo = "594"
+	// Deprecated: use ServiceIDMoolaDays instead.
 	ServiceMoolaDays = "595"
+	// Deprecated: use ServiceIDMoonPay instead.
 	ServiceMoonPay = "596"
+	// Deprecated: use ServiceIDMos instead.
 	ServiceMos = "1182"
+	// Deprecated: use ServiceIDMourjan instead.
 	ServiceMourjan = "597"
+	// Deprecated: use ServiceIDMOVO instead.
 	ServiceMOVO = "598"
+	// Deprecated: use ServiceIDMowasalat instead.
 	ServiceMowasalat = "599"
+	// Deprecated: use ServiceIDMozoX instead.
 	ServiceMozoX = "600"
+	// Deprecated: use ServiceIDMrGreen instead.
 	ServiceMrGreen = "601"
+	// Deprecated: use ServiceIDMrsool instead.
 	ServiceMrsool = "602"
+	// Deprecated: use ServiceIDMrSpin instead.
 	ServiceMrSpin = "603"
+	// Deprecated: use ServiceIDMTCGamePortal instead.
 	ServiceMTCGamePortal = "604"
+	// Deprecated: use ServiceIDMuchBetter instead.
 	ServiceMuchBetter = "605"
+	// Deprecated: use ServiceIDMudflap instead.
 	ServiceMudflap = "1183"
+	// Deprecated: use ServiceIDMusicstream instead.
 	ServiceMusicstream = "1274"
-	ServiceMy_Opinions = "612"
-	ServiceMyTrainerRewards = "618"
-	ServiceMyVoice = "1186"
+	// Deprecated: use ServiceIDMyAuto instead.
 	ServiceMyAuto = "606"
+	// Deprecated: use ServiceIDMyBookie instead.
 	ServiceMyBookie = "607"
+	// Deprecated: use ServiceIDMyBoost instead.
 	ServiceMyBoost = "608"
+	// Deprecated: use ServiceIDMyGiftCardSupply instead.
 	ServiceMyGiftCardSupply = "609"
+	// Deprecated: use ServiceIDMyLOL instead.
 	ServiceMyLOL = "610"
+	// Deprecated: use ServiceIDMyMusicTaste instead.
 	ServiceMyMusicTaste = "611"
+	// Deprecated: use ServiceIDMyOpinions instead.
 	ServiceMyOpinions = "613"
+	// Deprecated: use ServiceIDMyOpinions_612 instead.
+	ServiceMyOpinions_612 = "612"
+	// Deprecated: use ServiceIDMyRobinhood instead.
 	ServiceMyRobinhood = "1185"
+	// Deprecated: use ServiceIDMySoapBox instead.
 	ServiceMySoapBox = "614"
+	// Deprecated: use ServiceIDMyspace instead.
 	ServiceMyspace = "615"
+	// Deprecated: use ServiceIDMySpendWell instead.
 	ServiceMySpendWell = "1320"
+	// Deprecated: use ServiceIDMyTaxi instead.
 	ServiceMyTaxi = "616"
+	// Deprecated: use ServiceIDMyTime instead.
 	ServiceMyTime = "617"
+	// Deprecated: use ServiceIDMyTrainerRewards instead.
+	ServiceMyTrainerRewards = "618"
+	// Deprecated: use ServiceIDMyVoice instead.
+	ServiceMyVoice = "1186"
+	// Deprecated: use ServiceIDmyWisely instead.
 	ServicemyWisely = "1187"
+	// Deprecated: use ServiceIDNAGATrader instead.
 	ServiceNAGATrader = "619"
+	// Deprecated: use ServiceIDNarvesen instead.
 	ServiceNarvesen = "1258"
+	// Deprecated: use ServiceIDNaturalBrainai instead.
 	ServiceNaturalBrainai = "1188"
+	// Deprecated: use ServiceIDNaver instead.
 	ServiceNaver = "620"
+	// Deprecated: use ServiceIDNBATopshot instead.
 	ServiceNBATopshot = "621"
+	// Deprecated: use ServiceIDNCloud instead.
 	ServiceNCloud = "622"
+	// Deprecated: use ServiceIDNear instead.
 	ServiceNear = "623"
+	// Deprecated: use ServiceIDnearside instead.
 	Servicenearside = "624"
+	// Deprecated: use ServiceIDNectar instead.
 	ServiceNectar = "625"
+	// Deprecated: use ServiceIDNerdWallet instead.
 	ServiceNerdWallet = "626"
+	// Deprecated: use ServiceIDNetease instead.
 	ServiceNetease = "628"
+	// Deprecated: use ServiceIDNETELLER instead.
 	ServiceNETELLER = "629"
+	// Deprecated: use ServiceIDNetflix instead.
 	ServiceNetflix = "630"
+	// Deprecated: use ServiceIDNetZero instead.
 	ServiceNetZero = "631"
+	// Deprecated: use ServiceIDNeuron instead.
 	ServiceNeuron = "632"
+	// Deprecated: use ServiceIDNexmo instead.
 	ServiceNexmo = "633"
+	// Deprecated: use ServiceIDNextdoor instead.
 	ServiceNextdoor = "634"
+	// Deprecated: use ServiceIDNFCU instead.
 	ServiceNFCU = "1190"
+	// Deprecated: use ServiceIDNgage instead.
 	ServiceNgage = "635"
+	// Deprecated: use ServiceIDNielsen instead.
 	ServiceNielsen = "1263"
+	// Deprecated: use ServiceIDNielson instead.
 	ServiceNielson = "636"
+	// Deprecated: use ServiceIDNiftyGateway instead.
 	ServiceNiftyGateway = "637"
+	// Deprecated: use ServiceIDNiftyLoans instead.
 	ServiceNiftyLoans = "638"
+	// Deprecated: use ServiceIDNike instead.
 	ServiceNike = "639"
+	// Deprecated: use ServiceIDNimses instead.
 	ServiceNimses = "640"
+	// Deprecated: use ServiceIDNonoh instead.
 	ServiceNonoh = "641"
+	// Deprecated: use ServiceIDNonolive instead.
 	ServiceNonolive = "642"
-	ServicenoonShopping = "1309"
+	// Deprecated: use ServiceIDNoona instead.
 	ServiceNoona = "643"
+	// Deprecated: use ServiceIDnoonShopping instead.
+	ServicenoonShopping = "1309"
+	// Deprecated: use ServiceIDNordstrom instead.
 	ServiceNordstrom = "644"
-	ServiceNotListed = "817"
+	// Deprecated: use ServiceIDNotify instead.
 	ServiceNotify = "645"
+	// Deprecated: use ServiceIDNotListed instead.
+	ServiceNotListed = "817"
+	// Deprecated: use ServiceIDNovo instead.
 	ServiceNovo = "646"
+	// Deprecated: use ServiceIDNTTGame instead.
 	ServiceNTTGame = "647"
+	// Deprecated: use ServiceIDNTWallet instead.
 	ServiceNTWallet = "648"
+	// Deprecated: use ServiceIDNTWRK instead.
 	ServiceNTWRK = "649"
+	// Deprecated: use ServiceIDNumeroeSIM instead.
 	ServiceNumeroeSIM = "650"
+	// Deprecated: use ServiceIDNuuly instead.
 	ServiceNuuly = "1306"
+	// Deprecated: use ServiceIDNvidia instead.
 	ServiceNvidia = "651"
+	// Deprecated: use ServiceIDOcto instead.
 	ServiceOcto = "1286"
+	// Deprecated: use ServiceIDOctopus instead.
 	ServiceOctopus = "652"
+	// Deprecated: use ServiceIDOfferNation instead.
 	ServiceOfferNation = "653"
+	// Deprecated: use ServiceIDOfferUp instead.
 	ServiceOfferUp = "654"
+	// Deprecated: use ServiceIDOffGamers instead.
 	ServiceOffGamers = "655"
+	// Deprecated: use ServiceIDOhmConnect instead.
 	ServiceOhmConnect = "656"
+	// Deprecated: use ServiceIDOKCoin instead.
 	ServiceOKCoin = "657"
+	// Deprecated: use ServiceIDOkCupid instead.
 	ServiceOkCupid = "658"
+	// Deprecated: use ServiceIDOKru instead.
 	ServiceOKru = "659"
+	// Deprecated: use ServiceIDOlaCabs instead.
 	ServiceOlaCabs = "660"
+	// Deprecated: use ServiceIDOlx instead.
 	ServiceOlx = "661"
+	// Deprecated: use ServiceIDOmio instead.
 	ServiceOmio = "662"
-	ServiceOneFinance = "665"
+	// Deprecated: use ServiceIDOneCasino instead.
 	ServiceOneCasino = "663"
+	// Deprecated: use ServiceIDOneDayRewards instead.
 	ServiceOneDayRewards = "664"
+	// Deprecated: use ServiceIDOneFinance instead.
+	ServiceOneFinance = "665"
+	// Deprecated: use ServiceIDOneMainFinancial instead.
 	ServiceOneMainFinancial = "666"
+	// Deprecated: use ServiceIDOneOpinion instead.
 	ServiceOneOpinion = "667"
+	// Deprecated: use ServiceIDOnJuno instead.
 	ServiceOnJuno = "668"
+	// Deprecated: use ServiceIDOnlinenet instead.
 	ServiceOnlinenet = "669"
+	// Deprecated: use ServiceIDOnlyFans instead.
 	ServiceOnlyFans = "1296"
+	// Deprecated: use ServiceIDOobit instead.
 	ServiceOobit = "670"
+	// Deprecated: use ServiceIDOpenAIChatGPT instead.
 	ServiceOpenAIChatGPT = "671"
+	// Deprecated: use ServiceIDOpenNode instead.
 	ServiceOpenNode = "672"
+	// Deprecated: use ServiceIDOpenPhone instead.
 	ServiceOpenPhone = "673"
+	// Deprecated: use ServiceIDOpenPlayground instead.
 	ServiceOpenPlayground = "1328"
+	// Deprecated: use ServiceIDOpenSesame instead.
 	ServiceOpenSesame = "674"
+	// Deprecated: use ServiceIDOpinionOutpost instead.
 	ServiceOpinionOutpost = "675"
-	ServiceOpinionWorld = "676"
+	// Deprecated: use ServiceIDOpinionsOutpost instead.
 	ServiceOpinionsOutpost = "1235"
+	// Deprecated: use ServiceIDOpinionWorld instead.
+	ServiceOpinionWorld = "676"
+	// Deprecated: use ServiceIDOportun instead.
 	ServiceOportun = "1191"
+	// Deprecated: use ServiceIDOptusSport instead.
 	ServiceOptusSport = "677"
+	// Deprecated: use ServiceIDOracle instead.
 	ServiceOracle = "678"
+	// Deprecated: use ServiceIDOTCBTC instead.
 	ServiceOTCBTC = "679"
+	// Deprecated: use ServiceIDOurTime instead.
 	ServiceOurTime = "680"
+	// Deprecated: use ServiceIDOutlook instead.
 	ServiceOutlook = "1074"
+	// Deprecated: use ServiceIDOutSmartHPV instead.
 	ServiceOutSmartHPV = "681"
+	// Deprecated: use ServiceIDOVO instead.
 	ServiceOVO = "1089"
+	// Deprecated: use ServiceIDOxygen instead.
 	ServiceOxygen = "1192"
+	// Deprecated: use ServiceIDOYO instead.
 	ServiceOYO = "682"
+	// Deprecated: use ServiceIDOzanSuperApp instead.
 	ServiceOzanSuperApp = "1193"
+	// Deprecated: use ServiceIDOZFlatMates instead.
 	ServiceOZFlatMates = "683"
+	// Deprecated: use ServiceIDPaddyPower instead.
 	ServicePaddyPower = "684"
+	// Deprecated: use ServiceIDPaidCash instead.
 	ServicePaidCash = "1319"
+	// Deprecated: use ServiceIDPaidToReadEmailcom instead.
 	ServicePaidToReadEmailcom = "685"
+	// Deprecated: use ServiceIDPaidViewpoint instead.
 	ServicePaidViewpoint = "686"
+	// Deprecated: use ServiceIDPangea instead.
 	ServicePangea = "687"
+	// Deprecated: use ServiceIDPapara instead.
 	ServicePapara = "688"
+	// Deprecated: use ServiceIDParler instead.
 	ServiceParler = "689"
+	// Deprecated: use ServiceIDPartyPoker instead.
 	ServicePartyPoker = "1270"
+	// Deprecated: use ServiceIDParuVendu instead.
 	ServiceParuVendu = "690"
+	// Deprecated: use ServiceIDPassbook instead.
 	ServicePassbook = "691"
+	// Deprecated: use ServiceIDPaxful instead.
 	ServicePaxful = "692"
+	// Deprecated: use ServiceIDPayactiv instead.
 	ServicePayactiv = "693"
+	// Deprecated: use ServiceIDPayAsUGym instead.
 	ServicePayAsUGym = "694"
+	// Deprecated: use ServiceIDPaybis instead.
 	ServicePaybis = "695"
+	// Deprecated: use ServiceIDPaycell instead.
 	ServicePaycell = "696"
+	// Deprecated: use ServiceIDPayCenter instead.
 	ServicePayCenter = "697"
+	// Deprecated: use ServiceIDPayGo instead.
 	ServicePayGo = "698"
+	// Deprecated: use ServiceIDPayMaya instead.
 	ServicePayMaya = "699"
+	// Deprecated: use ServiceIDPaymeDollar instead.
 	ServicePaymeDollar = "700"
+	// Deprecated: use ServiceIDPaymium instead.
 	ServicePaymium = "701"
+	// Deprecated: use ServiceIDPayoneer instead.
 	ServicePayoneer = "702"
+	// Deprecated: use ServiceIDPayPal instead.
 	ServicePayPal = "703"
+	// Deprecated: use ServiceIDPayQin instead.
 	ServicePayQin = "704"
+	// Deprecated: use ServiceIDPaysafe instead.
 	ServicePaysafe = "705"
+	// Deprecated: use ServiceIDPaySay instead.
 	ServicePaySay = "706"
+	// Deprecated: use ServiceIDPaySend instead.
 	ServicePaySend = "707"
+	// Deprecated: use ServiceIDPaysera instead.
 	ServicePaysera = "708"
+	// Deprecated: use ServiceIDPaytm instead.
 	ServicePaytm = "709"
+	// Deprecated: use ServiceIDPCGameSupply instead.
 	ServicePCGameSupply = "710"
+	// Deprecated: use ServiceIDPei instead.
 	ServicePei = "711"
+	// Deprecated: use ServiceIDPenfed instead.
 	ServicePenfed = "1194"
+	// Deprecated: use ServiceIDPeriscope instead.
 	ServicePeriscope = "712"
+	// Deprecated: use ServiceIDPerk instead.
 	ServicePerk = "713"
+	// Deprecated: use ServiceIDPersonalCapital instead.
 	ServicePersonalCapital = "714"
+	// Deprecated: use ServiceIDPGSamsBuyGet instead.
 	ServicePGSamsBuyGet = "1284"
+	// Deprecated: use ServiceIDPhyre instead.
 	ServicePhyre = "715"
+	// Deprecated: use ServiceIDPinaLove instead.
 	ServicePinaLove = "716"
+	// Deprecated: use ServiceIDPinata instead.
 	ServicePinata = "1195"
+	// Deprecated: use ServiceIDPinchos instead.
 	ServicePinchos = "717"
+	// Deprecated: use ServiceIDPineconeResearch instead.
 	ServicePineconeResearch = "718"
+	// Deprecated: use ServiceIDPingPong instead.
 	ServicePingPong = "719"
+	// Deprecated: use ServiceIDPinterest instead.
 	ServicePinterest = "720"
+	// Deprecated: use ServiceIDPionex instead.
 	ServicePionex = "1299"
+	// Deprecated: use ServiceIDPitacoin instead.
 	ServicePitacoin = "721"
+	// Deprecated: use ServiceIDPlaid instead.
 	ServicePlaid = "722"
+	// Deprecated: use ServiceIDPlay4 instead.
 	ServicePlay4 = "1334"
+	// Deprecated: use ServiceIDPlayerAuctions instead.
 	ServicePlayerAuctions = "723"
+	// Deprecated: use ServiceIDPlentyOfFish instead.
 	ServicePlentyOfFish = "724"
+	// Deprecated: use ServiceIDPleo instead.
 	ServicePleo = "1338"
+	// Deprecated: use ServiceIDPlivo instead.
 	ServicePlivo = "1100"
+	// Deprecated: use ServiceIDPocketWin instead.
 	ServicePocketWin = "726"
+	// Deprecated: use ServiceIDPODERcard instead.
 	ServicePODERcard = "727"
+	// Deprecated: use ServiceIDPoe instead.
 	ServicePoe = "1314"
+	// Deprecated: use ServiceIDPogo instead.
 	ServicePogo = "728"
+	// Deprecated: use ServiceIDPointclub instead.
 	ServicePointclub = "729"
+	// Deprecated: use ServiceIDPokec instead.
 	ServicePokec = "730"
-	ServicePollPay = "732"
+	// Deprecated: use ServiceIDPollPass instead.
 	ServicePollPass = "731"
+	// Deprecated: use ServiceIDPollPay instead.
+	ServicePollPay = "732"
+	// Deprecated: use ServiceIDPopKonTv instead.
 	ServicePopKonTv = "733"
+	// Deprecated: use ServiceIDPorkbun instead.
 	ServicePorkbun = "1305"
+	// Deprecated: use ServiceIDPorte instead.
 	ServicePorte = "734"
+	// Deprecated: use ServiceIDPoshmark instead.
 	ServicePoshmark = "735"
+	// Deprecated: use ServiceIDPosten instead.
 	ServicePosten = "736"
+	// Deprecated: use ServiceIDPotatoChat instead.
 	ServicePotatoChat = "738"
+	// Deprecated: use ServiceIDPREMIER instead.
 	ServicePREMIER = "1240"
+	// Deprecated: use ServiceIDPrepaid2Cash instead.
 	ServicePrepaid2Cash = "739"
+	// Deprecated: use ServiceIDPrezzee instead.
 	ServicePrezzee = "740"
+	// Deprecated: use ServiceIDPrivacy instead.
 	ServicePrivacy = "741"
+	// Deprecated: use ServiceIDProlific instead.
 	ServiceProlific = "742"
+	// Deprecated: use ServiceIDPromotionPod instead.
 	ServicePromotionPod = "743"
+	// Deprecated: use ServiceIDProOpinions instead.
 	ServiceProOpinions = "744"
-	ServicePropeller_Ads = "745"
+	// Deprecated: use ServiceIDPropellerAds instead.
 	ServicePropellerAds = "1236"
+	// Deprecated: use ServiceIDPropellerAds_745 instead.
+	ServicePropellerAds_745 = "745"
+	// Deprecated: use ServiceIDPropy instead.
 	ServicePropy = "746"
+	// Deprecated: use ServiceIDProtonMail instead.
 	ServiceProtonMail = "747"
+	// Deprecated: use ServiceIDPruvit instead.
 	ServicePruvit = "748"
+	// Deprecated: use ServiceIDPUBGMOBILE instead.
 	ServicePUBGMOBILE = "749"
+	// Deprecated: use ServiceIDPubliccom instead.
 	ServicePubliccom = "1298"
+	// Deprecated: use ServiceIDPunktid instead.
 	ServicePunktid = "750"
+	// Deprecated: use ServiceIDPureprofile instead.
 	ServicePureprofile = "751"
-	ServicePurse_io = "752"
+	// Deprecated: use ServiceIDPurseio instead.
 	ServicePurseio = "753"
-	ServiceQLive = "756"
+	// Deprecated: use ServiceIDPurseio_752 instead.
+	ServicePurseio_752 = "752"
+	// Deprecated: use ServiceIDQIP instead.
 	ServiceQIP = "754"
+	// Deprecated: use ServiceIDQIWIWallet instead.
 	ServiceQIWIWallet = "755"
+	// Deprecated: use ServiceIDQLive instead.
+	ServiceQLive = "756"
+	// Deprecated: use ServiceIDQmeecom instead.
 	ServiceQmeecom = "757"
+	// Deprecated: use ServiceIDQoo10 instead.
 	ServiceQoo10 = "758"
+	// Deprecated: use ServiceIDQQTube instead.
 	ServiceQQTube = "759"
+	// Deprecated: use ServiceIDQuadPay instead.
 	ServiceQuadPay = "760"
+	// Deprecated: use ServiceIDQubeMoney instead.
 	ServiceQubeMoney = "761"
-	ServiceQuickPaySurvey = "764"
-	ServiceQuickThoughts = "765"
+	// Deprecated: use ServiceIDQuickBooks instead.
 	ServiceQuickBooks = "762"
+	// Deprecated: use ServiceIDQuickie instead.
 	ServiceQuickie = "763"
+	// Deprecated: use ServiceIDQuickPaySurvey instead.
+	ServiceQuickPaySurvey = "764"
+	// Deprecated: use ServiceIDQuickThoughts instead.
+	ServiceQuickThoughts = "765"
+	// Deprecated: use ServiceIDQuipp instead.
 	ServiceQuipp = "766"
+	// Deprecated: use ServiceIDRadialInsight instead.
 	ServiceRadialInsight = "767"
+	// Deprecated: use ServiceIDRaise instead.
 	ServiceRaise = "768"
+	// Deprecated: use ServiceIDRAM instead.
 	ServiceRAM = "769"
+	// Deprecated: use ServiceIDRambler instead.
 	ServiceRambler = "770"
+	// Deprecated: use ServiceIDRazer instead.
 	ServiceRazer = "771"
+	// Deprecated: use ServiceIDRBFCU instead.
 	ServiceRBFCU = "1255"
+	// Deprecated: use ServiceIDRebtel instead.
 	ServiceRebtel = "772"
+	// Deprecated: use ServiceIDRECUR instead.
 	ServiceRECUR = "1261"
+	// Deprecated: use ServiceIDRedCircle instead.
 	ServiceRedCircle = "1196"
+	// Deprecated: use ServiceIDRemitly instead.
 	ServiceRemitly = "773"
+	// Deprecated: use ServiceIDRentMe instead.
 	ServiceRentMe = "774"
+	// Deprecated: use ServiceIDReonomy instead.
 	ServiceReonomy = "775"
+	// Deprecated: use ServiceIDReRyde instead.
 	ServiceReRyde = "776"
+	// Deprecated: use ServiceIDRetailMeNot instead.
 	ServiceRetailMeNot = "777"
+	// Deprecated: use ServiceIDRevel instead.
 	ServiceRevel = "1311"
+	// Deprecated: use ServiceIDRevolut instead.
 	ServiceRevolut = "778"
+	// Deprecated: use ServiceIDRewardedPlay instead.
 	ServiceRewardedPlay = "779"
+	// Deprecated: use ServiceIDRewardingWays instead.
 	ServiceRewardingWays = "780"
+	// Deprecated: use ServiceIDRGBI instead.
 	ServiceRGBI = "1352"
+	// Deprecated: use ServiceIDRI instead.
 	ServiceRI = "1197"
+	// Deprecated: use ServiceIDRiaFinancial instead.
 	ServiceRiaFinancial = "781"
+	// Deprecated: use ServiceIDRingCaptcha instead.
 	ServiceRingCaptcha = "782"
+	// Deprecated: use ServiceIDRingCentral instead.
 	ServiceRingCentral = "783"
+	// Deprecated: use ServiceIDRiotGames instead.
 	ServiceRiotGames = "1237"
+	// Deprecated: use ServiceIDRitualco instead.
 	ServiceRitualco = "785"
+	// Deprecated: use ServiceIDRizk instead.
 	ServiceRizk = "786"
+	// Deprecated: use ServiceIDRizq instead.
 	ServiceRizq = "787"
+	// Deprecated: use ServiceIDRLOVE instead.
 	ServiceRLOVE = "788"
+	// Deprecated: use ServiceIDRobinhood instead.
 	ServiceRobinhood = "789"
+	// Deprecated: use ServiceIDRoblox instead.
 	ServiceRoblox = "790"
+	// Deprecated: use ServiceIDRocketReach instead.
 	ServiceRocketReach = "791"
+	// Deprecated: use ServiceIDRooming instead.
 	ServiceRooming = "792"
+	// Deprecated: use ServiceIDRoomster instead.
 	ServiceRoomster = "793"
+	// Deprecated: use ServiceIDRoot instead.
 	ServiceRoot = "794"
+	// Deprecated: use ServiceIDRover instead.
 	ServiceRover = "795"
+	// Deprecated: use ServiceIDRRF instead.
 	ServiceRRF = "796"
+	// Deprecated: use ServiceIDRSGoldMine instead.
 	ServiceRSGoldMine = "797"
+	// Deprecated: use ServiceIDRSocks instead.
 	ServiceRSocks = "1198"
+	// Deprecated: use ServiceIDRumble instead.
 	ServiceRumble = "798"
+	// Deprecated: use ServiceIDRuten instead.
 	ServiceRuten = "799"
+	// Deprecated: use ServiceIDSafeCurrency instead.
 	ServiceSafeCurrency = "800"
+	// Deprecated: use ServiceIDSafewayAlbertsons instead.
 	ServiceSafewayAlbertsons = "1199"
+	// Deprecated: use ServiceIDSamsClub instead.
 	ServiceSamsClub = "801"
+	// Deprecated: use ServiceIDSantander instead.
 	ServiceSantander = "1200"
+	// Deprecated: use ServiceIDSAS instead.
 	ServiceSAS = "802"
-	ServiceSaveWithSurveys = "803"
+	// Deprecated: use ServiceIDSaverLife instead.
 	ServiceSaverLife = "1201"
+	// Deprecated: use ServiceIDSaveWithSurveys instead.
+	ServiceSaveWithSurveys = "803"
+	// Deprecated: use ServiceIDSayHi instead.
 	ServiceSayHi = "804"
+	// Deprecated: use ServiceIDSBA instead.
 	ServiceSBA = "1202"
+	// Deprecated: use ServiceIDScaleway instead.
 	ServiceScaleway = "805"
+	// Deprecated: use ServiceIDScout instead.
 	ServiceScout = "806"
+	// Deprecated: use ServiceIDSCRUFF instead.
 	ServiceSCRUFF = "807"
+	// Deprecated: use ServiceIDSeaGamerMall instead.
 	ServiceSeaGamerMall = "808"
+	// Deprecated: use ServiceIDSEAGM instead.
 	ServiceSEAGM = "809"
+	// Deprecated: use ServiceIDSeated instead.
 	ServiceSeated = "810"
+	// Deprecated: use ServiceIDSecretBenefits instead.
 	ServiceSecretBenefits = "811"
+	// Deprecated: use ServiceIDSeis instead.
 	ServiceSeis = "1344"
+	// Deprecated: use ServiceIDSendGrid instead.
 	ServiceSendGrid = "812"
+	// Deprecated: use ServiceIDSendInBlue instead.
 	ServiceSendInBlue = "813"
+	// Deprecated: use ServiceIDSendwave instead.
 	ServiceSendwave = "814"
+	// Deprecated: use ServiceIDSEOClerks instead.
 	ServiceSEOClerks = "815"
+	// Deprecated: use ServiceIDServerfield instead.
 	ServiceServerfield = "816"
+	// Deprecated: use ServiceIDSezzle instead.
 	ServiceSezzle = "818"
+	// Deprecated: use ServiceIDShasso instead.
 	ServiceShasso = "819"
+	// Deprecated: use ServiceIDSheerID instead.
 	ServiceSheerID = "820"
+	// Deprecated: use ServiceIDShopatHome instead.
 	ServiceShopatHome = "821"
-	ServiceShopPay = "826"
+	// Deprecated: use ServiceIDShopBack instead.
 	ServiceShopBack = "822"
+	// Deprecated: use ServiceIDShopee instead.
 	ServiceShopee = "823"
+	// Deprecated: use ServiceIDShopify instead.
 	ServiceShopify = "824"
+	// Deprecated: use ServiceIDShopkick instead.
 	ServiceShopkick = "825"
+	// Deprecated: use ServiceIDShopPay instead.
+	ServiceShopPay = "826"
+	// Deprecated: use ServiceIDShpock instead.
 	ServiceShpock = "827"
+	// Deprecated: use ServiceIDSidelineSwap instead.
 	ServiceSidelineSwap = "828"
+	// Deprecated: use ServiceIDSignal instead.
 	ServiceSignal = "829"
+	// Deprecated: use ServiceIDSimba instead.
 	ServiceSimba = "830"
+	// Deprecated: use ServiceIDSimplexSimplexCC instead.
 	ServiceSimplexSimplexCC = "832"
+	// Deprecated: use ServiceIDSinch instead.
 	ServiceSinch = "833"
+	// Deprecated: use ServiceIDSingleMuslim instead.
 	ServiceSingleMuslim = "834"
+	// Deprecated: use ServiceIDSkipTheDishes instead.
 	ServiceSkipTheDishes = "835"
+	// Deprecated: use ServiceIDSkout instead.
 	ServiceSkout = "836"
+	// Deprecated: use ServiceIDSkrill instead.
 	ServiceSkrill = "837"
+	// Deprecated: use ServiceIDSkyetel instead.
 	ServiceSkyetel = "838"
+	// Deprecated: use ServiceIDSkype instead.
 	ServiceSkype = "1076"
+	// Deprecated: use ServiceIDSkyPrivate instead.
 	ServiceSkyPrivate = "1203"
+	// Deprecated: use ServiceIDSlide instead.
 	ServiceSlide = "839"
+	// Deprecated: use ServiceIDSlips instead.
 	ServiceSlips = "1330"
+	// Deprecated: use ServiceIDSmarterASP instead.
 	ServiceSmarterASP = "840"
+	// Deprecated: use ServiceIDSmores instead.
 	ServiceSmores = "841"
+	// Deprecated: use ServiceIDSMSit instead.
 	ServiceSMSit = "842"
+	// Deprecated: use ServiceIDSMSto instead.
 	ServiceSMSto = "843"
+	// Deprecated: use ServiceIDSMTP2GO instead.
 	ServiceSMTP2GO = "844"
+	// Deprecated: use ServiceIDSnagshout instead.
 	ServiceSnagshout = "845"
-	ServiceSnapFinance = "848"
-	ServiceSnap_Kitchen = "849"
+	// Deprecated: use ServiceIDSnapchat instead.
 	ServiceSnapchat = "846"
+	// Deprecated: use ServiceIDSnapex instead.
 	ServiceSnapex = "847"
+	// Deprecated: use ServiceIDSnapFinance instead.
+	ServiceSnapFinance = "848"
+	// Deprecated: use ServiceIDSnapKitchen instead.
 	ServiceSnapKitchen = "1238"
+	// Deprecated: use ServiceIDSnapKitchen_849 instead.
+	ServiceSnapKitchen_849 = "849"
+	// Deprecated: use ServiceIDSneakerboy instead.
 	ServiceSneakerboy = "850"
+	// Deprecated: use ServiceIDSneakersnstuff instead.
 	ServiceSneakersnstuff = "851"
+	// Deprecated: use ServiceIDSnippetMedia instead.
 	ServiceSnippetMedia = "852"
+	// Deprecated: use ServiceIDSOAR instead.
 	ServiceSOAR = "1289"
+	// Deprecated: use ServiceIDSocieti instead.
 	ServiceSocieti = "853"
+	// Deprecated: use ServiceIDSoFI instead.
 	ServiceSoFI = "854"
+	// Deprecated: use ServiceIDSolitaireCash instead.
 	ServiceSolitaireCash = "855"
+	// Deprecated: use ServiceIDSonetel instead.
 	ServiceSonetel = "856"
+	// Deprecated: use ServiceIDSoulAPP instead.
 	ServiceSoulAPP = "857"
+	// Deprecated: use ServiceIDSouq instead.
 	ServiceSouq = "858"
+	// Deprecated: use ServiceIDSpectroCoin instead.
 	ServiceSpectroCoin = "859"
+	// Deprecated: use ServiceIDSpectrum instead.
 	ServiceSpectrum = "1348"
+	// Deprecated: use ServiceIDSpend instead.
 	ServiceSpend = "860"
+	// Deprecated: use ServiceIDSpotify instead.
 	ServiceSpotify = "861"
+	// Deprecated: use ServiceIDSpruce instead.
 	ServiceSpruce = "1204"
+	// Deprecated: use ServiceIDSpryng instead.
 	ServiceSpryng = "862"
+	// Deprecated: use ServiceIDSquare instead.
 	ServiceSquare = "863"
+	// Deprecated: use ServiceIDStarbucks instead.
 	ServiceStarbucks = "864"
+	// Deprecated: use ServiceIDStarOf instead.
 	ServiceStarOf = "865"
+	// Deprecated: use ServiceIDStash instead.
 	ServiceStash = "1205"
-	ServiceState_Farm = "866"
+	// Deprecated: use ServiceIDStateFarm instead.
 	ServiceStateFarm = "1239"
+	// Deprecated: use ServiceIDStateFarm_866 instead.
+	ServiceStateFarm_866 = "866"
+	// Deprecated: use ServiceIDSteady instead.
 	ServiceSteady = "867"
+	// Deprecated: use ServiceIDSteam instead.
 	ServiceSteam = "868"
+	// Deprecated: use ServiceIDSteemIt instead.
 	ServiceSteemIt = "869"
+	// Deprecated: use ServiceIDStep instead.
 	ServiceStep = "870"
+	// Deprecated: use ServiceIDStickerMule instead.
 	ServiceStickerMule = "1310"
+	// Deprecated: use ServiceIDStir instead.
 	ServiceStir = "1102"
+	// Deprecated: use ServiceIDStoqo instead.
 	ServiceStoqo = "871"
+	// Deprecated: use ServiceIDStormGain instead.
 	ServiceStormGain = "872"
+	// Deprecated: use ServiceIDStormPlay instead.
 	ServiceStormPlay = "873"
+	// Deprecated: use ServiceIDStrato instead.
 	ServiceStrato = "874"
+	// Deprecated: use ServiceIDStreetbeat instead.
 	ServiceStreetbeat = "1285"
+	// Deprecated: use ServiceIDStreetbees instead.
 	ServiceStreetbees = "875"
+	// Deprecated: use ServiceIDStrike instead.
 	ServiceStrike = "876"
+	// Deprecated: use ServiceIDStripe instead.
 	ServiceStripe = "877"
+	// Deprecated: use ServiceIDSudsCarWash instead.
 	ServiceSudsCarWash = "1315"
+	// Deprecated: use ServiceIDSugarbook instead.
 	ServiceSugarbook = "1279"
+	// Deprecated: use ServiceIDSugarDaddyMeet instead.
 	ServiceSugarDaddyMeet = "878"
+	// Deprecated: use ServiceIDSumUp instead.
 	ServiceSumUp = "879"
+	// Deprecated: use ServiceIDSuperPay instead.
 	ServiceSuperPay = "881"
+	// Deprecated: use ServiceIDSupreme instead.
 	ServiceSupreme = "882"
+	// Deprecated: use ServiceIDSurePayroll instead.
 	ServiceSurePayroll = "1206"
+	// Deprecated: use ServiceIDSurf instead.
 	ServiceSurf = "883"
+	// Deprecated: use ServiceIDSurveyHoney instead.
+	ServiceSurveyHoney = "884"
+	// Deprecated: use ServiceIDSurveyJunkie instead.
 	ServiceSurveyJunkie = "885"
+	// Deprecated: use ServiceIDSurveyMonkeyRewards instead.
 	ServiceSurveyMonkeyRewards = "886"
-	ServiceSurveyHoney = "884"
+	// Deprecated: use ServiceIDSurveyRewardz instead.
 	ServiceSurveyRewardz = "887"
+	// Deprecated: use ServiceIDSurveytime instead.
 	ServiceSurveytime = "888"
+	// Deprecated: use ServiceIDSwagbucksInboxDollarsMyPointsySenseClassPassNoones instead.
 	ServiceSwagbucksInboxDollarsMyPointsySenseClassPassNoones = "889"
+	// Deprecated: use ServiceIDSwapD instead.
 	ServiceSwapD = "890"
+	// Deprecated: use ServiceIDSweatcoin instead.
 	ServiceSweatcoin = "891"
+	// Deprecated: use ServiceIDSweetRing instead.
 	ServiceSweetRing = "892"
+	// Deprecated: use ServiceIDSwissBorg instead.
 	ServiceSwissBorg = "893"
+	// Deprecated: use ServiceIDSwitchere instead.
 	ServiceSwitchere = "1207"
+	// Deprecated: use ServiceIDSwych instead.
 	ServiceSwych = "894"
+	// Deprecated: use ServiceIDSwyftx instead.
 	ServiceSwyftx = "895"
-	ServiceTMobileMoney = "927"
+	// Deprecated: use ServiceIDTada instead.
 	ServiceTada = "1208"
+	// Deprecated: use ServiceIDTagged instead.
 	ServiceTagged = "896"
+	// Deprecated: use ServiceIDTalk2 instead.
 	ServiceTalk2 = "897"
+	// Deprecated: use ServiceIDTalken instead.
 	ServiceTalken = "898"
+	// Deprecated: use ServiceIDTanTan instead.
 	ServiceTanTan = "899"
+	// Deprecated: use ServiceIDTaoBao instead.
 	ServiceTaoBao = "900"
+	// Deprecated: use ServiceIDTapchamps instead.
 	ServiceTapchamps = "901"
+	// Deprecated: use ServiceIDTapTap instead.
 	ServiceTapTap = "1356"
+	// Deprecated: use ServiceIDTarget instead.
 	ServiceTarget = "902"
+	// Deprecated: use ServiceIDTaxify instead.
 	ServiceTaxify = "903"
+	// Deprecated: use ServiceIDTaxSlayer instead.
 	ServiceTaxSlayer = "1209"
-	ServiceToTaxi = "934"
+	// Deprecated: use ServiceIDTCGPlayer instead.
 	ServiceTCGPlayer = "904"
+	// Deprecated: use ServiceIDTDAmeritrade instead.
 	ServiceTDAmeritrade = "905"
+	// Deprecated: use ServiceIDTechBubble instead.
 	ServiceTechBubble = "1210"
+	// Deprecated: use ServiceIDTelegram instead.
 	ServiceTelegram = "907"
+	// Deprecated: use ServiceIDTelekom instead.
 	ServiceTelekom = "908"
+	// Deprecated: use ServiceIDTelnyx instead.
 	ServiceTelnyx = "909"
+	// Deprecated: use ServiceIDTelos instead.
 	ServiceTelos = "910"
+	// Deprecated: use ServiceIDTEMU instead.
 	ServiceTEMU = "1346"
+	// Deprecated: use ServiceIDTencentQQ instead.
 	ServiceTencentQQ = "911"
+	// Deprecated: use ServiceIDTenx instead.
 	ServiceTenx = "912"
+	// Deprecated: use ServiceIDThaiFriendly instead.
 	ServiceThaiFriendly = "913"
+	// Deprecated: use ServiceIDTheChange instead.
 	ServiceTheChange = "914"
+	// Deprecated: use ServiceIDTheFreeNet instead.
 	ServiceTheFreeNet = "915"
+	// Deprecated: use ServiceIDTheHouseShop instead.
 	ServiceTheHouseShop = "916"
+	// Deprecated: use ServiceIDThinkOpinion instead.
 	ServiceThinkOpinion = "917"
+	// Deprecated: use ServiceIDThisFate instead.
 	ServiceThisFate = "918"
+	// Deprecated: use ServiceIDThumbtack instead.
 	ServiceThumbtack = "919"
+	// Deprecated: use ServiceIDThunderpod instead.
 	ServiceThunderpod = "920"
+	// Deprecated: use ServiceIDTicketmaster instead.
 	ServiceTicketmaster = "921"
+	// Deprecated: use ServiceIDTier instead.
 	ServiceTier = "922"
+	// Deprecated: use ServiceIDTikki instead.
 	ServiceTikki = "923"
+	// Deprecated: use ServiceIDTikTok instead.
 	ServiceTikTok = "924"
+	// Deprecated: use ServiceIDTilda instead.
 	ServiceTilda = "925"
+	// Deprecated: use ServiceIDTinder instead.
 	ServiceTinder = "926"
+	// Deprecated: use ServiceIDTMobileMoney instead.
+	ServiceTMobileMoney = "927"
+	// Deprecated: use ServiceIDTodayAustralia instead.
 	ServiceTodayAustralia = "928"
+	// Deprecated: use ServiceIDTogetherPrice instead.
 	ServiceTogetherPrice = "929"
+	// Deprecated: use ServiceIDToken instead.
 	ServiceToken = "1211"
+	// Deprecated: use ServiceIDTokeneo instead.
 	ServiceTokeneo = "930"
+	// Deprecated: use ServiceIDTokopedia instead.
 	ServiceTokopedia = "931"
+	// Deprecated: use ServiceIDTomaExchange instead.
 	ServiceTomaExchange = "932"
+	// Deprecated: use ServiceIDToTalk instead.
 	ServiceToTalk = "933"
+	// Deprecated: use ServiceIDToTaxi instead.
+	ServiceToTaxi = "934"
+	// Deprecated: use ServiceIDTradeUp instead.
 	ServiceTradeUp = "1354"
+	// Deprecated: use ServiceIDTradingView instead.
 	ServiceTradingView = "935"
+	// Deprecated: use ServiceIDTransferHome instead.
 	ServiceTransferHome = "936"
+	// Deprecated: use ServiceIDTransferWise instead.
 	ServiceTransferWise = "937"
+	// Deprecated: use ServiceIDTransformCredit instead.
 	ServiceTransformCredit = "1252"
+	// Deprecated: use ServiceIDTremolo instead.
 	ServiceTremolo = "938"
+	// Deprecated: use ServiceIDTripadvisor instead.
 	ServiceTripadvisor = "939"
+	// Deprecated: use ServiceIDTrueCaller instead.
 	ServiceTrueCaller = "940"
+	// Deprecated: use ServiceIDTrulyMadly instead.
 	ServiceTrulyMadly = "941"
+	// Deprecated: use ServiceIDTruthSocial instead.
 	ServiceTruthSocial = "1245"
+	// Deprecated: use ServiceIDTurboTax instead.
 	ServiceTurboTax = "942"
+	// Deprecated: use ServiceIDTurboTenant instead.
 	ServiceTurboTenant = "943"
+	// Deprecated: use ServiceIDTurgame instead.
 	ServiceTurgame = "944"
+	// Deprecated: use ServiceIDTuro instead.
 	ServiceTuro = "945"
+	// Deprecated: use ServiceIDTwig instead.
 	ServiceTwig = "1327"
+	// Deprecated: use ServiceIDTwilio instead.
 	ServiceTwilio = "946"
+	// Deprecated: use ServiceIDTwitch instead.
 	ServiceTwitch = "947"
+	// Deprecated: use ServiceIDTwitter instead.
 	ServiceTwitter = "948"
+	// Deprecated: use ServiceIDTwoo instead.
 	ServiceTwoo = "949"
+	// Deprecated: use ServiceIDUberPostmates instead.
 	ServiceUberPostmates = "951"
+	// Deprecated: use ServiceIDUbisoft instead.
 	ServiceUbisoft = "952"
+	// Deprecated: use ServiceIDUltra instead.
 	ServiceUltra = "953"
+	// Deprecated: use ServiceIDUltraIO instead.
 	ServiceUltraIO = "1079"
+	// Deprecated: use ServiceIDUniplaces instead.
 	ServiceUniplaces = "954"
+	// Deprecated: use ServiceIDUniqueCasino instead.
 	ServiceUniqueCasino = "955"
+	// Deprecated: use ServiceIDUnivisionMobileMoney instead.
 	ServiceUnivisionMobileMoney = "956"
+	// Deprecated: use ServiceIDUOL instead.
 	ServiceUOL = "957"
+	// Deprecated: use ServiceIDUpaynet instead.
 	ServiceUpaynet = "958"
+	// Deprecated: use ServiceIDUpgrade instead.
 	ServiceUpgrade = "1264"
+	// Deprecated: use ServiceIDuphold instead.
 	Serviceuphold = "959"
+	// Deprecated: use ServiceIDUplift instead.
 	ServiceUplift = "960"
+	// Deprecated: use ServiceIDUpVoice instead.
 	ServiceUpVoice = "1214"
+	// Deprecated: use ServiceIDUpward instead.
 	ServiceUpward = "961"
+	// Deprecated: use ServiceIDUpwork instead.
 	ServiceUpwork = "962"
+	// Deprecated: use ServiceIDUrbanClap instead.
 	ServiceUrbanClap = "963"
-	ServiceUSASurvey = "964"
+	// Deprecated: use ServiceIDUSAA instead.
 	ServiceUSAA = "1215"
+	// Deprecated: use ServiceIDUSASurvey instead.
+	ServiceUSASurvey = "964"
+	// Deprecated: use ServiceIDUSPS instead.
 	ServiceUSPS = "966"
+	// Deprecated: use ServiceIDValuedOpinions instead.
 	ServiceValuedOpinions = "967"
+	// Deprecated: use ServiceIDVanguard instead.
 	ServiceVanguard = "1265"
+	// Deprecated: use ServiceIDVarageSale instead.
 	ServiceVarageSale = "968"
+	// Deprecated: use ServiceIDVaro instead.
 	ServiceVaro = "969"
+	// Deprecated: use ServiceIDVase instead.
 	ServiceVase = "970"
+	// Deprecated: use ServiceIDVCollective instead.
 	ServiceVCollective = "1339"
+	// Deprecated: use ServiceIDVendo instead.
 	ServiceVendo = "971"
+	// Deprecated: use ServiceIDVenmo instead.
 	ServiceVenmo = "972"
+	// Deprecated: use ServiceIDVerse instead.
 	ServiceVerse = "973"
+	// Deprecated: use ServiceIDVertex instead.
 	ServiceVertex = "974"
+	// Deprecated: use ServiceIDVetsPrevail instead.
 	ServiceVetsPrevail = "975"
+	// Deprecated: use ServiceIDViaAppViaVan instead.
 	ServiceViaAppViaVan = "976"
+	// Deprecated: use ServiceIDViaBill instead.
 	ServiceViaBill = "1216"
+	// Deprecated: use ServiceIDViaBTC instead.
 	ServiceViaBTC = "977"
+	// Deprecated: use ServiceIDViber instead.
 	ServiceViber = "978"
+	// Deprecated: use ServiceIDVidaplayer instead.
 	ServiceVidaplayer = "979"
+	// Deprecated: use ServiceIDVidio instead.
 	ServiceVidio = "980"
+	// Deprecated: use ServiceIDVietJetAir instead.
 	ServiceVietJetAir = "981"
+	// Deprecated: use ServiceIDVimpay instead.
 	ServiceVimpay = "982"
+	// Deprecated: use ServiceIDVinted instead.
 	ServiceVinted = "983"
+	// Deprecated: use ServiceIDVivaWallet instead.
 	ServiceVivaWallet = "984"
+	// Deprecated: use ServiceIDVK instead.
 	ServiceVK = "985"
+	// Deprecated: use ServiceIDVnay instead.
 	ServiceVnay = "986"
+	// Deprecated: use ServiceIDVoilaNorbert instead.
 	ServiceVoilaNorbert = "988"
+	// Deprecated: use ServiceIDVolny instead.
 	ServiceVolny = "989"
+	// Deprecated: use ServiceIDVoopee instead.
 	ServiceVoopee = "990"
+	// Deprecated: use ServiceIDVoyager instead.
 	ServiceVoyager = "991"
+	// Deprecated: use ServiceIDVrbo instead.
 	ServiceVrbo = "992"
+	// Deprecated: use ServiceIDVulkanVegas instead.
 	ServiceVulkanVegas = "993"
+	// Deprecated: use ServiceIDVumber instead.
 	ServiceVumber = "994"
+	// Deprecated: use ServiceIDWafaicloud instead.
 	ServiceWafaicloud = "995"
+	// Deprecated: use ServiceIDWagerWeb instead.
 	ServiceWagerWeb = "1217"
+	// Deprecated: use ServiceIDWaleteros instead.
 	ServiceWaleteros = "996"
+	// Deprecated: use ServiceIDWalgreens instead.
 	ServiceWalgreens = "997"
+	// Deprecated: use ServiceIDWalletHub instead.
 	ServiceWalletHub = "998"
+	// Deprecated: use ServiceIDWalmart instead.
 	ServiceWalmart = "999"
+	// Deprecated: use ServiceIDWalmartFamilyMobile instead.
 	ServiceWalmartFamilyMobile = "1224"
+	// Deprecated: use ServiceIDWalmartMoneyCard instead.
 	ServiceWalmartMoneyCard = "1218"
+	// Deprecated: use ServiceIDWapLog instead.
 	ServiceWapLog = "1000"
+	// Deprecated: use ServiceIDWatchiT instead.
 	ServiceWatchiT = "1001"
+	// Deprecated: use ServiceIDWealthfront instead.
 	ServiceWealthfront = "1002"
+	// Deprecated: use ServiceIDWebmoney instead.
 	ServiceWebmoney = "1003"
+	// Deprecated: use ServiceIDWebull instead.
 	ServiceWebull = "1253"
+	// Deprecated: use ServiceIDWeChat instead.
 	ServiceWeChat = "1004"
+	// Deprecated: use ServiceIDWedoogift instead.
 	ServiceWedoogift = "1005"
+	// Deprecated: use ServiceIDWeebly instead.
 	ServiceWeebly = "1006"
+	// Deprecated: use ServiceIDWeee instead.
 	ServiceWeee = "1007"
+	// Deprecated: use ServiceIDWeibo instead.
 	ServiceWeibo = "1008"
+	// Deprecated: use ServiceIDWellsFargo instead.
 	ServiceWellsFargo = "1009"
+	// Deprecated: use ServiceIDWelspunBrainTrust instead.
 	ServiceWelspunBrainTrust = "1219"
+	// Deprecated: use ServiceIDWeSing instead.
 	ServiceWeSing = "1010"
+	// Deprecated: use ServiceIDWestStein instead.
 	ServiceWestStein = "1011"
+	// Deprecated: use ServiceIDWeverse instead.
 	ServiceWeverse = "1220"
+	// Deprecated: use ServiceIDWhatnot instead.
 	ServiceWhatnot = "1241"
+	// Deprecated: use ServiceIDWhatsApp instead.
 	ServiceWhatsApp = "1012"
+	// Deprecated: use ServiceIDWhatsAround instead.
 	ServiceWhatsAround = "1013"
+	// Deprecated: use ServiceIDWhiteCalling instead.
 	ServiceWhiteCalling = "1254"
+	// Deprecated: use ServiceIDWhop instead.
 	ServiceWhop = "1014"
+	// Deprecated: use ServiceIDWickr instead.
 	ServiceWickr = "1015"
+	// Deprecated: use ServiceIDWild instead.
 	ServiceWild = "1016"
+	// Deprecated: use ServiceIDWinden instead.
 	ServiceWinden = "1271"
+	// Deprecated: use ServiceIDWindowsXboxStore instead.
 	ServiceWindowsXboxStore = "1221"
+	// Deprecated: use ServiceIDWing instead.
 	ServiceWing = "1017"
+	// Deprecated: use ServiceIDWingocard instead.
 	ServiceWingocard = "1018"
+	// Deprecated: use ServiceIDWingspan instead.
 	ServiceWingspan = "1019"
+	// Deprecated: use ServiceIDWink instead.
 	ServiceWink = "1020"
+	// Deprecated: use ServiceIDWireBarley instead.
 	ServiceWireBarley = "1222"
+	// Deprecated: use ServiceIDWirex instead.
 	ServiceWirex = "1021"
+	// Deprecated: use ServiceIDWise instead.
 	ServiceWise = "1223"
+	// Deprecated: use ServiceIDWish instead.
 	ServiceWish = "1022"
+	// Deprecated: use ServiceIDWolt instead.
 	ServiceWolt = "1023"
+	// Deprecated: use ServiceIDWomply instead.
 	ServiceWomply = "1024"
+	// Deprecated: use ServiceIDWooCommerce instead.
 	ServiceWooCommerce = "1025"
+	// Deprecated: use ServiceIDWorkersCreditUnion instead.
 	ServiceWorkersCreditUnion = "1026"
+	// Deprecated: use ServiceIDWynk instead.
 	ServiceWynk = "1027"
+	// Deprecated: use ServiceIDWyre instead.
 	ServiceWyre = "1028"
+	// Deprecated: use ServiceIDX1CreditCard instead.
 	ServiceX1CreditCard = "1281"
+	// Deprecated: use ServiceIDXapo instead.
 	ServiceXapo = "1029"
+	// Deprecated: use ServiceIDXbox instead.
 	ServiceXbox = "1075"
+	// Deprecated: use ServiceIDxcoins instead.
 	Servicexcoins = "1225"
+	// Deprecated: use ServiceIDXfinity instead.
 	ServiceXfinity = "1304"
+	// Deprecated: use ServiceIDXoom instead.
 	ServiceXoom = "1031"
+	// Deprecated: use ServiceIDXS2Exchange instead.
 	ServiceXS2Exchange = "1032"
+	// Deprecated: use ServiceIDXSERVER instead.
 	ServiceXSERVER = "1033"
+	// Deprecated: use ServiceIDYahoo instead.
 	ServiceYahoo = "1034"
+	// Deprecated: use ServiceIDYalla instead.
 	ServiceYalla = "1035"
+	// Deprecated: use ServiceIDYandex instead.
 	ServiceYandex = "1036"
+	// Deprecated: use ServiceIDYeeyi instead.
 	ServiceYeeyi = "1037"
+	// Deprecated: use ServiceIDYeezy instead.
 	ServiceYeezy = "1226"
+	// Deprecated: use ServiceIDYelp instead.
 	ServiceYelp = "1038"
+	// Deprecated: use ServiceIDYFSResearch instead.
 	ServiceYFSResearch = "1039"
+	// Deprecated: use ServiceIDYieldstreet instead.
 	ServiceYieldstreet = "1040"
+	// Deprecated: use ServiceIDYippi instead.
 	ServiceYippi = "1041"
+	// Deprecated: use ServiceIDYocket instead.
 	ServiceYocket = "1042"
+	// Deprecated: use ServiceIDYodlee instead.
 	ServiceYodlee = "1043"
+	// Deprecated: use ServiceIDYoHo instead.
 	ServiceYoHo = "1044"
+	// Deprecated: use ServiceIDYooMoney instead.
 	ServiceYooMoney = "1087"
+	// Deprecated: use ServiceIDYoti instead.
 	ServiceYoti = "1045"
+	// Deprecated: use ServiceIDYouGotaGift instead.
 	ServiceYouGotaGift = "1046"
+	// Deprecated: use ServiceIDYoula instead.
 	ServiceYoula = "1047"
+	// Deprecated: use ServiceIDYourRentals instead.
 	ServiceYourRentals = "1048"
+	// Deprecated: use ServiceIDYouTrip instead.
 	ServiceYouTrip = "1049"
+	// Deprecated: use ServiceIDYoutube instead.
 	ServiceYoutube = "1227"
+	// Deprecated: use ServiceIDYubo instead.
 	ServiceYubo = "1050"
+	// Deprecated: use ServiceIDYunoSurveys instead.
 	ServiceYunoSurveys = "1051"
+	// Deprecated: use ServiceIDYuroPay instead.
 	ServiceYuroPay = "1052"
-	Servicezcom = "1229"
+	// Deprecated: use ServiceIDZadarma instead.
 	ServiceZadarma = "1053"
+	// Deprecated: use ServiceIDZalo instead.
 	ServiceZalo = "1054"
+	// Deprecated: use ServiceIDZao instead.
 	ServiceZao = "1055"
+	// Deprecated: use ServiceIDZapZap instead.
 	ServiceZapZap = "1056"
+	// Deprecated: use ServiceIDZaxby instead.
 	ServiceZaxby = "1349"
+	// Deprecated: use ServiceIDzcom instead.
+	Servicezcom = "1229"
+	// Deprecated: use ServiceIDZeek instead.
 	ServiceZeek = "1057"
+	// Deprecated: use ServiceIDZelle instead.
 	ServiceZelle = "1058"
+	// Deprecated: use ServiceIDZen instead.
 	ServiceZen = "1290"
+	// Deprecated: use ServiceIDZenly instead.
 	ServiceZenly = "1059"
+	// Deprecated: use ServiceIDZest instead.
 	ServiceZest = "1060"
+	// Deprecated: use ServiceIDZhihu instead.
 	ServiceZhihu = "1061"
+	// Deprecated: use ServiceIDZillow instead.
 	ServiceZillow = "1062"
-	ServiceZipQuadPay = "1064"
+	// Deprecated: use ServiceIDZipCo instead.
 	ServiceZipCo = "1063"
+	// Deprecated: use ServiceIDZipQuadPay instead.
+	ServiceZipQuadPay = "1064"
+	// Deprecated: use ServiceIDZogo instead.
 	ServiceZogo = "1065"
+	// Deprecated: use ServiceIDZoho instead.
 	ServiceZoho = "1066"
+	// Deprecated: use ServiceIDZolve instead.
 	ServiceZolve = "1277"
+	// Deprecated: use ServiceIDZomato instead.
 	ServiceZomato = "1067"
+	// Deprecated: use ServiceIDZoomBucks instead.
 	ServiceZoomBucks = "1068"
+	// Deprecated: use ServiceIDZoomInfo instead.
 	ServiceZoomInfo = "1069"
+	// Deprecated: use ServiceIDZoosk instead.
 	ServiceZoosk = "1070"
+	// Deprecated: use ServiceIDZumper instead.
 	ServiceZumper = "1071"
-	
-)
\ No newline at end of file
+	// Deprecated: use ServiceIDBurgerKing_146 instead.
+	ServiceBurger_King = "146"
+	// Deprecated: use ServiceIDEasyPay_304 instead.
+	ServiceEasy_Pay = "304"
+	// Deprecated: use ServiceIDFoodPanda_358 instead.
+	ServiceFood_Panda = "358"
+	// Deprecated: use ServiceIDMyOpinions_612 instead.
+	ServiceMy_Opinions = "612"
+	// Deprecated: use ServiceIDPropellerAds_745 instead.
+	ServicePropeller_Ads = "745"
+	// Deprecated: use ServiceIDPurseio_752 instead.
+	ServicePurse_io = "752"
+	// Deprecated: use ServiceIDSnapKitchen_849 instead.
+	ServiceSnap_Kitchen = "849"
+	// Deprecated: use ServiceIDStateFarm_866 instead.
+	ServiceState_Farm = "866"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "1", Name: "1688", NormalizedName: "1688"},
+	{ID: "2", Name: "1Q", NormalizedName: "1q"},
+	{ID: "3", Name: "1StopMove", NormalizedName: "1stopmove"},
+	{ID: "4", Name: "2dehands", NormalizedName: "2dehands"},
+	{ID: "5", Name: "2game", NormalizedName: "2game"},
+	{ID: "6", Name: "2RedBeans", NormalizedName: "2redbeans"},
+	{ID: "7", Name: "360NRS", NormalizedName: "360nrs"},
+	{ID: "8", Name: "3Fun", NormalizedName: "3fun"},
+	{ID: "9", Name: "5karu", NormalizedName: "5karu"},
+	{ID: "10", Name: "5miles", NormalizedName: "5miles"},
+	{ID: "11", Name: "7Eleven", NormalizedName: "7eleven"},
+	{ID: "12", Name: "7Mall", NormalizedName: "7mall"},
+	{ID: "13", Name: "888poker", NormalizedName: "888poker"},
+	{ID: "14", Name: "A1Wallet", NormalizedName: "a1wallet"},
+	{ID: "15", Name: "AARPRewards", NormalizedName: "aarprewards"},
+	{ID: "16", Name: "Ablo", NormalizedName: "ablo"},
+	{ID: "17", Name: "Abra", NormalizedName: "abra"},
+	{ID: "18", Name: "AccountKit", NormalizedName: "accountkit"},
+	{ID: "19", Name: "Adidas", NormalizedName: "adidas"},
+	{ID: "20", Name: "AdItUp", NormalizedName: "aditup"},
+	{ID: "21", Name: "ADList24", NormalizedName: "adlist24"},
+	{ID: "22", Name: "Adobe", NormalizedName: "adobe"},
+	{ID: "23", Name: "AdvCash", NormalizedName: "advcash"},
+	{ID: "24", Name: "AdWallet", NormalizedName: "adwallet"},
+	{ID: "25", Name: "Affirm", NormalizedName: "affirm"},
+	{ID: "26", Name: "Afterpay", NormalizedName: "afterpay"},
+	{ID: "27", Name: "Agoda", NormalizedName: "agoda"},
+	{ID: "28", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "29", Name: "AirTel", NormalizedName: "airtel"},
+	{ID: "30", Name: "Airtm", NormalizedName: "airtm"},
+	{ID: "31", Name: "Akulaku", NormalizedName: "akulaku"},
+	{ID: "32", Name: "Albert", NormalizedName: "albert"},
+	{ID: "33", Name: "Alibaba", NormalizedName: "alibaba"},
+	{ID: "34", Name: "Alignable", NormalizedName: "alignable"},
+	{ID: "35", Name: "Alipay", NormalizedName: "alipay"},
+	{ID: "36", Name: "Allset", NormalizedName: "allset"},
+	{ID: "37", Name: "ALTBalaji", NormalizedName: "altbalaji"},
+	{ID: "38", Name: "Amasia", NormalizedName: "amasia"},
+	{ID: "39", Name: "AmazonAmazonWebs", NormalizedName: "amazonamazonwebs"},
+	{ID: "40", Name: "AmericaVoice", NormalizedName: "americavoice"},
+	{ID: "41", Name: "Ando", NormalizedName: "ando"},
+	{ID: "42", Name: "Anibis", NormalizedName: "anibis"},
+	{ID: "43", Name: "Ankama", NormalizedName: "ankama"},
+	{ID: "44", Name: "AnycoinDirect", NormalizedName: "anycoindirect"},
+	{ID: "45", Name: "ANZ", NormalizedName: "anz"},
+	{ID: "46", Name: "Aol", NormalizedName: "aol"},
+	{ID: "47", Name: "AppFlame", NormalizedName: "appflame"},
+	{ID: "48", Name: "Apple", NormalizedName: "apple"},
+	{ID: "49", Name: "AppLovin", NormalizedName: "applovin"},
+	{ID: "50", Name: "AppStation", NormalizedName: "appstation"},
+	{ID: "51", Name: "ARMSLIST", NormalizedName: "armslist"},
+	{ID: "52", Name: "As2in1", NormalizedName: "as2in1"},
+	{ID: "53", Name: "Atom", NormalizedName: "atom"},
+	{ID: "54", Name: "Atomy", NormalizedName: "atomy"},
+	{ID: "55", Name: "AttaPoll", NormalizedName: "attapoll"},
+	{ID: "56", Name: "AustraliaPost", NormalizedName: "australiapost"},
+	{ID: "57", Name: "Authy", NormalizedName: "authy"},
+	{ID: "58", Name: "Autoru", NormalizedName: "autoru"},
+	{ID: "59", Name: "Autotrader", NormalizedName: "autotrader"},
+	{ID: "60", Name: "Avail", NormalizedName: "avail"},
+	{ID: "61", Name: "Avito", NormalizedName: "avito"},
+	{ID: "62", Name: "Ayoba", NormalizedName: "ayoba"},
+	{ID: "63", Name: "Backblaze", NormalizedName: "backblaze"},
+	{ID: "64", Name: "Badi", NormalizedName: "badi"},
+	{ID: "65", Name: "Badoo", NormalizedName: "badoo"},
+	{ID: "66", Name: "Baidu", NormalizedName: "baidu"},
+	{ID: "68", Name: "Banq24", NormalizedName: "banq24"},
+	{ID: "69", Name: "Banxa", NormalizedName: "banxa"},
+	{ID: "70", Name: "BattlenetBlizzard", NormalizedName: "battlenetblizzard"},
+	{ID: "71", Name: "BBVA", NormalizedName: "bbva"},
+	{ID: "72", Name: "BDSwiss", NormalizedName: "bdswiss"},
+	{ID: "73", Name: "BeemIt", NormalizedName: "beemit"},
+	{ID: "74", Name: "Beetalk", NormalizedName: "beetalk"},
+	{ID: "75", Name: "BeForthRight", NormalizedName: "beforthright"},
+	{ID: "76", Name: "BestOfOurValley", NormalizedName: "bestofourvalley"},
+	{ID: "77", Name: "Bet9ja", NormalizedName: "bet9ja"},
+	{ID: "78", Name: "BetCris", NormalizedName: "betcris"},
+	{ID: "79", Name: "Betfair", NormalizedName: "betfair"},
+	{ID: "80", Name: "Betfred", NormalizedName: "betfred"},
+	{ID: "81", Name: "Bidoo", NormalizedName: "bidoo"},
+	{ID: "82", Name: "Bigolive", NormalizedName: "bigolive"},
+	{ID: "83", Name: "BigToken", NormalizedName: "bigtoken"},
+	{ID: "84", Name: "BIM", NormalizedName: "bim"},
+	{ID: "85", Name: "Binance", NormalizedName: "binance"},
+	{ID: "86", Name: "Bing", NormalizedName: "bing"},
+	{ID: "87", Name: "Bit4Coin", NormalizedName: "bit4coin"},
+	{ID: "88", Name: "Bit4Sale", NormalizedName: "bit4sale"},
+	{ID: "89", Name: "Bitaccess", NormalizedName: "bitaccess"},
+	{ID: "90", Name: "BitClout", NormalizedName: "bitclout"},
+	{ID: "91", Name: "BitClude", NormalizedName: "bitclude"},
+	{ID: "92", Name: "BitcoinATM", NormalizedName: "bitcoinatm"},
+	{ID: "93", Name: "Bitcoinde", NormalizedName: "bitcoinde"},
+	{ID: "94", Name: "BitcoinSolutions", NormalizedName: "bitcoinsolutions"},
+	{ID: "95", Name: "bitFlyer", NormalizedName: "bitflyer"},
+	{ID: "96", Name: "Bitfront", NormalizedName: "bitfront"},
+	{ID: "97", Name: "Bitgamesio", NormalizedName: "bitgamesio"},
+	{ID: "98", Name: "Bithumb", NormalizedName: "bithumb"},
+	{ID: "99", Name: "Bitmax", NormalizedName: "bitmax"},
+	{ID: "100", Name: "Bitmo", NormalizedName: "bitmo"},
+	{ID: "101", Name: "BitOasis", NormalizedName: "bitoasis"},
+	{ID: "102", Name: "Bitonic", NormalizedName: "bitonic"},
+	{ID: "103", Name: "Bitpanda", NormalizedName: "bitpanda"},
+	{ID: "104", Name: "Bitsa", NormalizedName: "bitsa"},
+	{ID: "105", Name: "Bitsdaq", NormalizedName: "bitsdaq"},
+	{ID: "106", Name: "Bitso", NormalizedName: "bitso"},
+	{ID: "107", Name: "Bitstamp", NormalizedName: "bitstamp"},
+	{ID: "108", Name: "BitTube", NormalizedName: "bittube"},
+	{ID: "109", Name: "Bitwage", NormalizedName: "bitwage"},
+	{ID: "110", Name: "Bity", NormalizedName: "bity"},
+	{ID: "111", Name: "BlaBla", NormalizedName: "blabla"},
+	{ID: "112", Name: "Blackcatcard", NormalizedName: "blackcatcard"},
+	{ID: "113", Name: "BlackPeopleMeet", NormalizedName: "blackpeoplemeet"},
+	{ID: "115", Name: "BLK", NormalizedName: "blk"},
+	{ID: "116", Name: "Blockchain", NormalizedName: "blockchain"},
+	{ID: "117", Name: "BloomMe", NormalizedName: "bloomme"},
+	{ID: "118", Name: "BlueAcorn", NormalizedName: "blueacorn"},
+	{ID: "119", Name: "Blued", NormalizedName: "blued"},
+	{ID: "120", Name: "BlueFederalCreditUnion", NormalizedName: "bluefederalcreditunion"},
+	{ID: "121", Name: "BluePay", NormalizedName: "bluepay"},
+	{ID: "122", Name: "BlueVine", NormalizedName: "bluevine"},
+	{ID: "123", Name: "Boatsetter", NormalizedName: "boatsetter"},
+	{ID: "124", Name: "Bolt", NormalizedName: "bolt"},
+	{ID: "125", Name: "Bookingcom", NormalizedName: "bookingcom"},
+	{ID: "126", Name: "Boon", NormalizedName: "boon"},
+	{ID: "128", Name: "BotBroker", NormalizedName: "botbroker"},
+	{ID: "129", Name: "Botcode", NormalizedName: "botcode"},
+	{ID: "130", Name: "Botim", NormalizedName: "botim"},
+	{ID: "131", Name: "BoxedDeal", NormalizedName: "boxeddeal"},
+	{ID: "132", Name: "Braid", NormalizedName: "braid"},
+	{ID: "133", Name: "BrandedSurvey", NormalizedName: "brandedsurvey"},
+	{ID: "134", Name: "Brazzers", NormalizedName: "brazzers"},
+	{ID: "135", Name: "Brex", NormalizedName: "brex"},
+	{ID: "136", Name: "Bridge", NormalizedName: "bridge"},
+	{ID: "137", Name: "Broxel", NormalizedName: "broxel"},
+	{ID: "138", Name: "BTCDirect", NormalizedName: "btcdirect"},
+	{ID: "139", Name: "BTCsurveys", NormalizedName: "btcsurveys"},
+	{ID: "140", Name: "Bukalapak", NormalizedName: "bukalapak"},
+	{ID: "141", Name: "BulkSMScom", NormalizedName: "bulksmscom"},
+	{ID: "142", Name: "Bumble", NormalizedName: "bumble"},
+	{ID: "143", Name: "Bump", NormalizedName: "bump"},
+	{ID: "144", Name: "Bundil", NormalizedName: "bundil"},
+	{ID: "145", Name: "Bunq", NormalizedName: "bunq"},
+	{ID: "146", Name: "Burger_King", NormalizedName: "burgerking"},
+	{ID: "147", Name: "BurnerApp", NormalizedName: "burnerapp"},
+	{ID: "148", Name: "ByBit", NormalizedName: "bybit"},
+	{ID: "149", Name: "Cabify", NormalizedName: "cabify"},
+	{ID: "150", Name: "CanadaComputers", NormalizedName: "canadacomputers"},
+	{ID: "151", Name: "CapitalOne", NormalizedName: "capitalone"},
+	{ID: "152", Name: "CARDcom", NormalizedName: "cardcom"},
+	{ID: "153", Name: "Cardyard", NormalizedName: "cardyard"},
+	{ID: "154", Name: "Careem", NormalizedName: "careem"},
+	{ID: "155", Name: "Carepoynt", NormalizedName: "carepoynt"},
+	{ID: "156", Name: "Carousell", NormalizedName: "carousell"},
+	{ID: "157", Name: "CarsGuide", NormalizedName: "carsguide"},
+	{ID: "158", Name: "CashAA", NormalizedName: "cashaa"},
+	{ID: "159", Name: "CashAlarm", NormalizedName: "cashalarm"},
+	{ID: "160", Name: "CashApp", NormalizedName: "cashapp"},
+	{ID: "161", Name: "Cashbackbase", NormalizedName: "cashbackbase"},
+	{ID: "162", Name: "CashShow", NormalizedName: "cashshow"},
+	{ID: "163", Name: "CashWalk", NormalizedName: "cashwalk"},
+	{ID: "164", Name: "CashZine", NormalizedName: "cashzine"},
+	{ID: "165", Name: "Casumo", NormalizedName: "casumo"},
+	{ID: "166", Name: "CatchMe", NormalizedName: "catchme"},
+	{ID: "167", Name: "Caviar", NormalizedName: "caviar"},
+	{ID: "168", Name: "cdkeyscom", NormalizedName: "cdkeyscom"},
+	{ID: "169", Name: "CentroBill", NormalizedName: "centrobill"},
+	{ID: "170", Name: "Centrum", NormalizedName: "centrum"},
+	{ID: "171", Name: "CEXIO", NormalizedName: "cexio"},
+	{ID: "172", Name: "Changelly", NormalizedName: "changelly"},
+	{ID: "173", Name: "ChaosCloud", NormalizedName: "chaoscloud"},
+	{ID: "174", Name: "Chase", NormalizedName: "chase"},
+	{ID: "175", Name: "CheapVoip", NormalizedName: "cheapvoip"},
+	{ID: "176", Name: "Checkbookio", NormalizedName: "checkbookio"},
+	{ID: "177", Name: "CheckPoints", NormalizedName: "checkpoints"},
+	{ID: "178", Name: "Cheese", NormalizedName: "cheese"},
+	{ID: "179", Name: "Chime", NormalizedName: "chime"},
+	{ID: "180", Name: "Chipper", NormalizedName: "chipper"},
+	{ID: "181", Name: "Chispa", NormalizedName: "chispa"},
+	{ID: "182", Name: "Chowbus", NormalizedName: "chowbus"},
+	{ID: "183", Name: "CIBC", NormalizedName: "cibc"},
+	{ID: "184", Name: "Cinchbucks", NormalizedName: "cinchbucks"},
+	{ID: "185", Name: "Circle", NormalizedName: "circle"},
+	{ID: "186", Name: "CJSCDKEYSCOM", NormalizedName: "cjscdkeyscom"},
+	{ID: "188", Name: "Clearpay", NormalizedName: "clearpay"},
+	{ID: "189", Name: "ClearVoice", NormalizedName: "clearvoice"},
+	{ID: "190", Name: "Cledara", NormalizedName: "cledara"},
+	{ID: "191", Name: "Cleo", NormalizedName: "cleo"},
+	{ID: "192", Name: "Clickadu", NormalizedName: "clickadu"},
+	{ID: "193", Name: "Clickatell", NormalizedName: "clickatell"},
+	{ID: "194", Name: "ClickDishes", NormalizedName: "clickdishes"},
+	{ID: "195", Name: "clickworker", NormalizedName: "clickworker"},
+	{ID: "196", Name: "ClipClaps", NormalizedName: "clipclaps"},
+	{ID: "197", Name: "CLiQQ", NormalizedName: "cliqq"},
+	{ID: "198", Name: "CloudBet", NormalizedName: "cloudbet"},
+	{ID: "199", Name: "CloudSim", NormalizedName: "cloudsim"},
+	{ID: "200", Name: "Cloudways", NormalizedName: "cloudways"},
+	{ID: "201", Name: "Clover", NormalizedName: "clover"},
+	{ID: "202", Name: "ClubFactory", NormalizedName: "clubfactory"},
+	{ID: "203", Name: "Clubhouse", NormalizedName: "clubhouse"},
+	{ID: "204", Name: "ClubVPS", NormalizedName: "clubvps"},
+	{ID: "205", Name: "CodaPayments", NormalizedName: "codapayments"},
+	{ID: "206", Name: "CoffeeMeetsBagel", NormalizedName: "coffeemeetsbagel"},
+	{ID: "208", Name: "Coinbase", NormalizedName: "coinbase"},
+	{ID: "209", Name: "CoinChat", NormalizedName: "coinchat"},
+	{ID: "210", Name: "CoinCloud", NormalizedName: "coincloud"},
+	{ID: "211", Name: "CoinEx", NormalizedName: "coinex"},
+	{ID: "212", Name: "CoinFlip", NormalizedName: "coinflip"},
+	{ID: "213", Name: "CoinGate", NormalizedName: "coingate"},
+	{ID: "214", Name: "Coinhouse", NormalizedName: "coinhouse"},
+	{ID: "215", Name: "Coinipop", NormalizedName: "coinipop"},
+	{ID: "216", Name: "Coinjar", NormalizedName: "coinjar"},
+	{ID: "217", Name: "Coinme", NormalizedName: "coinme"},
+	{ID: "218", Name: "Coinomi", NormalizedName: "coinomi"},
+	{ID: "219", Name: "CoinPop", NormalizedName: "coinpop"},
+	{ID: "220", Name: "Coinseed", NormalizedName: "coinseed"},
+	{ID: "221", Name: "Coinsph", NormalizedName: "coinsph"},
+	{ID: "222", Name: "CoinSpot", NormalizedName: "coinspot"},
+	{ID: "223", Name: "Coinstash", NormalizedName: "coinstash"},
+	{ID: "224", Name: "CoinSwitch", NormalizedName: "coinswitch"},
+	{ID: "225", Name: "Cointelegraph", NormalizedName: "cointelegraph"},
+	{ID: "226", Name: "CoinZoom", NormalizedName: "coinzoom"},
+	{ID: "227", Name: "CommunityInsightsForum", NormalizedName: "communityinsightsforum"},
+	{ID: "228", Name: "Confirmed", NormalizedName: "confirmed"},
+	{ID: "229", Name: "Copper", NormalizedName: "copper"},
+	{ID: "230", Name: "CornerCard", NormalizedName: "cornercard"},
+	{ID: "231", Name: "Couponscom", NormalizedName: "couponscom"},
+	{ID: "232", Name: "CourseHero", NormalizedName: "coursehero"},
+	{ID: "233", Name: "Craigslist", NormalizedName: "craigslist"},
+	{ID: "234", Name: "CrazyKart", NormalizedName: "crazykart"},
+	{ID: "235", Name: "CreditKarma", NormalizedName: "creditkarma"},
+	{ID: "236", Name: "CreditSesame", NormalizedName: "creditsesame"},
+	{ID: "237", Name: "CrowdTap", NormalizedName: "crowdtap"},
+	{ID: "238", Name: "Crypterium", NormalizedName: "crypterium"},
+	{ID: "239", Name: "Cryptocom", NormalizedName: "cryptocom"},
+	{ID: "240", Name: "Cryptopay", NormalizedName: "cryptopay"},
+	{ID: "241", Name: "CryptoVoucher", NormalizedName: "cryptovoucher"},
+	{ID: "242", Name: "CUA", NormalizedName: "cua"},
+	{ID: "243", Name: "Curb", NormalizedName: "curb"},
+	{ID: "244", Name: "CuriousCat", NormalizedName: "curiouscat"},
+	{ID: "245", Name: "Current", NormalizedName: "current"},
+	{ID: "246", Name: "CurrentMusic", NormalizedName: "currentmusic"},
+	{ID: "247", Name: "CurrentRewards", NormalizedName: "currentrewards"},
+	{ID: "248", Name: "Curtsy", NormalizedName: "curtsy"},
+	{ID: "250", Name: "Dabbl", NormalizedName: "dabbl"},
+	{ID: "251", Name: "DailyRewards", NormalizedName: "dailyrewards"},
+	{ID: "252", Name: "Dapper", NormalizedName: "dapper"},
+	{ID: "253", Name: "DateInAsia", NormalizedName: "dateinasia"},
+	{ID: "254", Name: "Daum", NormalizedName: "daum"},
+	{ID: "255", Name: "Dave", NormalizedName: "dave"},
+	{ID: "256", Name: "DaybreakGames", NormalizedName: "daybreakgames"},
+	{ID: "257", Name: "DDosGuard", NormalizedName: "ddosguard"},
+	{ID: "258", Name: "Deliveroo", NormalizedName: "deliveroo"},
+	{ID: "259", Name: "DeliveryClub", NormalizedName: "deliveryclub"},
+	{ID: "260", Name: "DeliveryHero", NormalizedName: "deliveryhero"},
+	{ID: "261", Name: "Dent", NormalizedName: "dent"},
+	{ID: "262", Name: "Depop", NormalizedName: "depop"},
+	{ID: "263", Name: "DesignHill", NormalizedName: "designhill"},
+	{ID: "264", Name: "DHL", NormalizedName: "dhl"},
+	{ID: "265", Name: "Dialpad", NormalizedName: "dialpad"},
+	{ID: "266", Name: "DiDi", NormalizedName: "didi"},
+	{ID: "267", Name: "Digi2Go", NormalizedName: "digi2go"},
+	{ID: "268", Name: "DigiStore", NormalizedName: "digistore"},
+	{ID: "269", Name: "Digit", NormalizedName: "digit"},
+	{ID: "270", Name: "DilMil", NormalizedName: "dilmil"},
+	{ID: "271", Name: "Dingtone", NormalizedName: "dingtone"},
+	{ID: "272", Name: "DinnerBalls", NormalizedName: "dinnerballs"},
+	{ID: "273", Name: "Discord", NormalizedName: "discord"},
+	{ID: "275", Name: "DistroKid", NormalizedName: "distrokid"},
+	{ID: "276", Name: "DocuSign", NormalizedName: "docusign"},
+	{ID: "277", Name: "Doku", NormalizedName: "doku"},
+	{ID: "278", Name: "DollarClix", NormalizedName: "dollarclix"},
+	{ID: "279", Name: "DollarGeneral", NormalizedName: "dollargeneral"},
+	{ID: "280", Name: "DoorDash", NormalizedName: "doordash"},
+	{ID: "281", Name: "Dora", NormalizedName: "dora"},
+	{ID: "282", Name: "DOSH", NormalizedName: "dosh"},
+	{ID: "283", Name: "Dota", NormalizedName: "dota"},
+	{ID: "284", Name: "Douban", NormalizedName: "douban"},
+	{ID: "285", Name: "Doublelist", NormalizedName: "doublelist"},
+	{ID: "286", Name: "Douugh", NormalizedName: "douugh"},
+	{ID: "287", Name: "Douyu", NormalizedName: "douyu"},
+	{ID: "288", Name: "Dromru", NormalizedName: "dromru"},
+	{ID: "289", Name: "Drop", NormalizedName: "drop"},
+	{ID: "290", Name: "DrugVokrug", NormalizedName: "drugvokrug"},
+	{ID: "291", Name: "Drumo", NormalizedName: "drumo"},
+	{ID: "292", Name: "Dubizzle", NormalizedName: "dubizzle"},
+	{ID: "293", Name: "Duffl", NormalizedName: "duffl"},
+	{ID: "294", Name: "Dukascopy", NormalizedName: "dukascopy"},
+	{ID: "295", Name: "Dundle", NormalizedName: "dundle"},
+	{ID: "296", Name: "DunkinDonuts", NormalizedName: "dunkindonuts"},
+	{ID: "297", Name: "Dynadot", NormalizedName: "dynadot"},
+	{ID: "298", Name: "Earn99", NormalizedName: "earn99"},
+	{ID: "299", Name: "Earnably", NormalizedName: "earnably"},
+	{ID: "300", Name: "EarnHoney", NormalizedName: "earnhoney"},
+	{ID: "301", Name: "Earnin", NormalizedName: "earnin"},
+	{ID: "302", Name: "EarningStation", NormalizedName: "earningstation"},
+	{ID: "303", Name: "EASI", NormalizedName: "easi"},
+	{ID: "304", Name: "Easy_Pay", NormalizedName: "easypay"},
+	{ID: "305", Name: "eBay", NormalizedName: "ebay"},
+	{ID: "306", Name: "eGifter", NormalizedName: "egifter"},
+	{ID: "307", Name: "Elepreneur", NormalizedName: "elepreneur"},
+	{ID: "308", Name: "Elevacity", NormalizedName: "elevacity"},
+	{ID: "309", Name: "Elootgg", NormalizedName: "elootgg"},
+	{ID: "310", Name: "Emirex", NormalizedName: "emirex"},
+	{ID: "311", Name: "Empower", NormalizedName: "empower"},
+	{ID: "312", Name: "Eneba", NormalizedName: "eneba"},
+	{ID: "313", Name: "EngageSpark", NormalizedName: "engagespark"},
+	{ID: "314", Name: "Entropay", NormalizedName: "entropay"},
+	{ID: "315", Name: "envel", NormalizedName: "envel"},
+	{ID: "316", Name: "Eobot", NormalizedName: "eobot"},
+	{ID: "317", Name: "EpicNPC", NormalizedName: "epicnpc"},
+	{ID: "318", Name: "eRewards", NormalizedName: "erewards"},
+	{ID: "319", Name: "Esendex", NormalizedName: "esendex"},
+	{ID: "320", Name: "Esportal", NormalizedName: "esportal"},
+	{ID: "321", Name: "EspressoHouse", NormalizedName: "espressohouse"},
+	{ID: "322", Name: "eToro", NormalizedName: "etoro"},
+	{ID: "323", Name: "Etsy", NormalizedName: "etsy"},
+	{ID: "324", Name: "EuroPYM", NormalizedName: "europym"},
+	{ID: "325", Name: "EveryoneAPI", NormalizedName: "everyoneapi"},
+	{ID: "326", Name: "ExpertOption", NormalizedName: "expertoption"},
+	{ID: "327", Name: "Eyecon", NormalizedName: "eyecon"},
+	{ID: "328", Name: "Faberlic", NormalizedName: "faberlic"},
+	{ID: "329", Name: "Facebook", NormalizedName: "facebook"},
+	{ID: "330", Name: "FACEIT", NormalizedName: "faceit"},
+	{ID: "331", Name: "FAIRTIQ", NormalizedName: "fairtiq"},
+	{ID: "332", Name: "FanTuan", NormalizedName: "fantuan"},
+	{ID: "333", Name: "FastMail", NormalizedName: "fastmail"},
+	{ID: "334", Name: "Fave", NormalizedName: "fave"},
+	{ID: "335", Name: "FBS", NormalizedName: "fbs"},
+	{ID: "336", Name: "FedEx", NormalizedName: "fedex"},
+	{ID: "337", Name: "FetchRewards", NormalizedName: "fetchrewards"},
+	{ID: "338", Name: "FetLife", NormalizedName: "fetlife"},
+	{ID: "339", Name: "FigureEight", NormalizedName: "figureeight"},
+	{ID: "340", Name: "Filimo", NormalizedName: "filimo"},
+	{ID: "341", Name: "FindMate", NormalizedName: "findmate"},
+	{ID: "342", Name: "FinishLine", NormalizedName: "finishline"},
+	{ID: "343", Name: "Firebase", NormalizedName: "firebase"},
+	{ID: "345", Name: "Fitplay", NormalizedName: "fitplay"},
+	{ID: "346", Name: "Fiverr", NormalizedName: "fiverr"},
+	{ID: "347", Name: "Flare", NormalizedName: "flare"},
+	{ID: "348", Name: "FlashRewards", NormalizedName: "flashrewards"},
+	{ID: "349", Name: "Flatmates", NormalizedName: "flatmates"},
+	{ID: "350", Name: "Flipkart", NormalizedName: "flipkart"},
+	{ID: "351", Name: "Flippa", NormalizedName: "flippa"},
+	{ID: "352", Name: "Flurv", NormalizedName: "flurv"},
+	{ID: "353", Name: "Flutterwave", NormalizedName: "flutterwave"},
+	{ID: "354", Name: "FluxRewards", NormalizedName: "fluxrewards"},
+	{ID: "355", Name: "Fluz", NormalizedName: "fluz"},
+	{ID: "356", Name: "Flyp", NormalizedName: "flyp"},
+	{ID: "357", Name: "Foodora", NormalizedName: "foodora"},
+	{ID: "358", Name: "Food_Panda", NormalizedName: "foodpanda"},
+	{ID: "359", Name: "FortuneJack", NormalizedName: "fortunejack"},
+	{ID: "360", Name: "Fotocasa", NormalizedName: "fotocasa"},
+	{ID: "361", Name: "Fotostrana", NormalizedName: "fotostrana"},
+	{ID: "362", Name: "Found", NormalizedName: "found"},
+	{ID: "363", Name: "Freelancer", NormalizedName: "freelancer"},
+	{ID: "364", Name: "FreeTaxUSA", NormalizedName: "freetaxusa"},
+	{ID: "365", Name: "FreshForex", NormalizedName: "freshforex"},
+	{ID: "366", Name: "Fruitlab", NormalizedName: "fruitlab"},
+	{ID: "367", Name: "FTX", NormalizedName: "ftx"},
+	{ID: "368", Name: "FusionCash", NormalizedName: "fusioncash"},
+	{ID: "369", Name: "G2A", NormalizedName: "g2a"},
+	{ID: "370", Name: "G2G", NormalizedName: "g2g"},
+	{ID: "371", Name: "GagaooLala", NormalizedName: "gagaoolala"},
+	{ID: "372", Name: "Gameflip", NormalizedName: "gameflip"},
+	{ID: "373", Name: "Gamekit", NormalizedName: "gamekit"},
+	{ID: "374", Name: "GameMinerclub", NormalizedName: "gameminerclub"},
+	{ID: "375", Name: "GamerMine", NormalizedName: "gamermine"},
+	{ID: "376", Name: "Garena", NormalizedName: "garena"},
+	{ID: "377", Name: "GCash", NormalizedName: "gcash"},
+	{ID: "378", Name: "Gemini", NormalizedName: "gemini"},
+	{ID: "379", Name: "Genitrust", NormalizedName: "genitrust"},
+	{ID: "380", Name: "GetPaidTo", NormalizedName: "getpaidto"},
+	{ID: "381", Name: "GetResponse", NormalizedName: "getresponse"},
+	{ID: "382", Name: "GetSlide", NormalizedName: "getslide"},
+	{ID: "383", Name: "GetTaxi", NormalizedName: "gettaxi"},
+	{ID: "384", Name: "Giftcloud", NormalizedName: "giftcloud"},
+	{ID: "385", Name: "Gifthulk", NormalizedName: "gifthulk"},
+	{ID: "386", Name: "GiftHunterClub", NormalizedName: "gifthunterclub"},
+	{ID: "387", Name: "Glidera", NormalizedName: "glidera"},
+	{ID: "388", Name: "Globfone", NormalizedName: "globfone"},
+	{ID: "389", Name: "Glovo", NormalizedName: "glovo"},
+	{ID: "390", Name: "GoDaddy", NormalizedName: "godaddy"},
+	{ID: "391", Name: "GoFundMe", NormalizedName: "gofundme"},
+	{ID: "392", Name: "GoJek", NormalizedName: "gojek"},
+	{ID: "393", Name: "GoldenFarmery", NormalizedName: "goldenfarmery"},
+	{ID: "394", Name: "GOmobile", NormalizedName: "gomobile"},
+	{ID: "395", Name: "GoogleGmail", NormalizedName: "googlegmail"},
+	{ID: "396", Name: "GoogleVoice", NormalizedName: "googlevoice"},
+	{ID: "397", Name: "Gopuff", NormalizedName: "gopuff"},
+	{ID: "398", Name: "GoSwak", NormalizedName: "goswak"},
+	{ID: "399", Name: "GrabPoints", NormalizedName: "grabpoints"},
+	{ID: "400", Name: "GradOutcome", NormalizedName: "gradoutcome"},
+	{ID: "401", Name: "Grailedcom", NormalizedName: "grailedcom"},
+	{ID: "403", Name: "Grindr", NormalizedName: "grindr"},
+	{ID: "404", Name: "GroupMe", NormalizedName: "groupme"},
+	{ID: "405", Name: "GrubHub", NormalizedName: "grubhub"},
+	{ID: "406", Name: "Gueez", NormalizedName: "gueez"},
+	{ID: "407", Name: "Guru", NormalizedName: "guru"},
+	{ID: "408", Name: "Hago", NormalizedName: "hago"},
+	{ID: "409", Name: "Happn", NormalizedName: "happn"},
+	{ID: "410", Name: "HappyCo", NormalizedName: "happyco"},
+	{ID: "411", Name: "HappyEscorts", NormalizedName: "happyescorts"},
+	{ID: "412", Name: "HappyPancake", NormalizedName: "happypancake"},
+	{ID: "413", Name: "HardBlock", NormalizedName: "hardblock"},
+	{ID: "414", Name: "HarrisPoll", NormalizedName: "harrispoll"},
+	{ID: "415", Name: "HelloTalk", NormalizedName: "hellotalk"},
+	{ID: "416", Name: "Hezzl", NormalizedName: "hezzl"},
+	{ID: "417", Name: "Hibbett", NormalizedName: "hibbett"},
+	{ID: "418", Name: "HiCloud", NormalizedName: "hicloud"},
+	{ID: "419", Name: "Hily", NormalizedName: "hily"},
+	{ID: "420", Name: "Hinge", NormalizedName: "hinge"},
+	{ID: "421", Name: "Hmm", NormalizedName: "hmm"},
+	{ID: "422", Name: "Holvi", NormalizedName: "holvi"},
+	{ID: "423", Name: "HomeAway", NormalizedName: "homeaway"},
+	{ID: "424", Name: "Hopper", NormalizedName: "hopper"},
+	{ID: "425", Name: "HotVOIP", NormalizedName: "hotvoip"},
+	{ID: "426", Name: "Houseparty", NormalizedName: "houseparty"},
+	{ID: "427", Name: "HQTrivia", NormalizedName: "hqtrivia"},
+	{ID: "428", Name: "Hsoub", NormalizedName: "hsoub"},
+	{ID: "429", Name: "Huawei", NormalizedName: "huawei"},
+	{ID: "430", Name: "HUD", NormalizedName: "hud"},
+	{ID: "431", Name: "HumbleBundle", NormalizedName: "humblebundle"},
+	{ID: "432", Name: "Humm", NormalizedName: "humm"},
+	{ID: "433", Name: "HungryPanda", NormalizedName: "hungrypanda"},
+	{ID: "434", Name: "Hushmail", NormalizedName: "hushmail"},
+	{ID: "435", Name: "ibotta", NormalizedName: "ibotta"},
+	{ID: "436", Name: "ICQ", NormalizedName: "icq"},
+	{ID: "437", Name: "Idealista", NormalizedName: "idealista"},
+	{ID: "438", Name: "IdleEmpire", NormalizedName: "idleempire"},
+	{ID: "439", Name: "IDme", NormalizedName: "idme"},
+	{ID: "440", Name: "ieadbit", NormalizedName: "ieadbit"},
+	{ID: "441", Name: "Imfree", NormalizedName: "imfree"},
+	{ID: "442", Name: "Imgur", NormalizedName: "imgur"},
+	{ID: "443", Name: "Immobiliare", NormalizedName: "immobiliare"},
+	{ID: "444", Name: "ImmobilienScout24", NormalizedName: "immobilienscout24"},
+	{ID: "445", Name: "Immovlan", NormalizedName: "immovlan"},
+	{ID: "446", Name: "Immowelt", NormalizedName: "immowelt"},
+	{ID: "447", Name: "Imo", NormalizedName: "imo"},
+	{ID: "448", Name: "InboxLV", NormalizedName: "inboxlv"},
+	{ID: "449", Name: "InBoxPounds", NormalizedName: "inboxpounds"},
+	{ID: "450", Name: "Indacoin", NormalizedName: "indacoin"},
+	{ID: "451", Name: "Indeed", NormalizedName: "indeed"},
+	{ID: "452", Name: "Indi", NormalizedName: "indi"},
+	{ID: "453", Name: "Innago", NormalizedName: "innago"},
+	{ID: "454", Name: "Inspire", NormalizedName: "inspire"},
+	{ID: "455", Name: "Instacart", NormalizedName: "instacart"},
+	{ID: "456", Name: "InstaGC", NormalizedName: "instagc"},
+	{ID: "457", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "458", Name: "InstaRem", NormalizedName: "instarem"},
+	{ID: "459", Name: "InstaVoice", NormalizedName: "instavoice"},
+	{ID: "460", Name: "Intuit", NormalizedName: "intuit"},
+	{ID: "461", Name: "iOffer", NormalizedName: "ioffer"},
+	{ID: "462", Name: "Ionicware", NormalizedName: "ionicware"},
+	{ID: "463", Name: "IONOS", NormalizedName: "ionos"},
+	{ID: "464", Name: "Ipekyol", NormalizedName: "ipekyol"},
+	{ID: "465", Name: "iPlum", NormalizedName: "iplum"},
+	{ID: "466", Name: "iPoll", NormalizedName: "ipoll"},
+	{ID: "467", Name: "IQOption", NormalizedName: "iqoption"},
+	{ID: "468", Name: "iRazoo", NormalizedName: "irazoo"},
+	{ID: "469", Name: "Irazoocom", NormalizedName: "irazoocom"},
+	{ID: "470", Name: "IpsosiSay", NormalizedName: "ipsosisay"},
+	{ID: "471", Name: "Jackd", NormalizedName: "jackd"},
+	{ID: "472", Name: "JAGRewards", NormalizedName: "jagrewards"},
+	{ID: "473", Name: "JD", NormalizedName: "jd"},
+	{ID: "474", Name: "Jeevan", NormalizedName: "jeevan"},
+	{ID: "475", Name: "Jelli", NormalizedName: "jelli"},
+	{ID: "476", Name: "JePaiq", NormalizedName: "jepaiq"},
+	{ID: "477", Name: "Jerry", NormalizedName: "jerry"},
+	{ID: "478", Name: "Jiayuan", NormalizedName: "jiayuan"},
+	{ID: "479", Name: "JMTY", NormalizedName: "jmty"},
+	{ID: "480", Name: "JobToday", NormalizedName: "jobtoday"},
+	{ID: "481", Name: "JollyChic", NormalizedName: "jollychic"},
+	{ID: "482", Name: "Joompay", NormalizedName: "joompay"},
+	{ID: "483", Name: "JuanCash", NormalizedName: "juancash"},
+	{ID: "484", Name: "Juno", NormalizedName: "juno"},
+	{ID: "485", Name: "KACN", NormalizedName: "kacn"},
+	{ID: "486", Name: "Kaggle", NormalizedName: "kaggle"},
+	{ID: "487", Name: "KakaoTalk", NormalizedName: "kakaotalk"},
+	{ID: "488", Name: "Kamatera", NormalizedName: "kamatera"},
+	{ID: "489", Name: "Kapten", NormalizedName: "kapten"},
+	{ID: "490", Name: "KayoSports", NormalizedName: "kayosports"},
+	{ID: "491", Name: "KBZpay", NormalizedName: "kbzpay"},
+	{ID: "492", Name: "KeepRewardingcom", NormalizedName: "keeprewardingcom"},
+	{ID: "494", Name: "Keybase", NormalizedName: "keybase"},
+	{ID: "495", Name: "KHL", NormalizedName: "khl"},
+	{ID: "496", Name: "Kink", NormalizedName: "kink"},
+	{ID: "497", Name: "Klarna", NormalizedName: "klarna"},
+	{ID: "498", Name: "Klook", NormalizedName: "klook"},
+	{ID: "499", Name: "KorekTelecom", NormalizedName: "korektelecom"},
+	{ID: "500", Name: "Kraken", NormalizedName: "kraken"},
+	{ID: "501", Name: "Kriptomat", NormalizedName: "kriptomat"},
+	{ID: "502", Name: "KuCoin", NormalizedName: "kucoin"},
+	{ID: "503", Name: "Kufar", NormalizedName: "kufar"},
+	{ID: "504", Name: "KUMU", NormalizedName: "kumu"},
+	{ID: "505", Name: "KVBPrime", NormalizedName: "kvbprime"},
+	{ID: "506", Name: "Kwai", NormalizedName: "kwai"},
+	{ID: "507", Name: "LalaFood", NormalizedName: "lalafood"},
+	{ID: "508", Name: "Lalamove", NormalizedName: "lalamove"},
+	{ID: "509", Name: "Landingi", NormalizedName: "landingi"},
+	{ID: "510", Name: "LaPoste", NormalizedName: "laposte"},
+	{ID: "511", Name: "Lazada", NormalizedName: "lazada"},
+	{ID: "512", Name: "LBRYApp", NormalizedName: "lbryapp"},
+	{ID: "514", Name: "Legiit", NormalizedName: "legiit"},
+	{ID: "515", Name: "Letgo", NormalizedName: "letgo"},
+	{ID: "516", Name: "Leupay", NormalizedName: "leupay"},
+	{ID: "517", Name: "LibertyX", NormalizedName: "libertyx"},
+	{ID: "518", Name: "Libon", NormalizedName: "libon"},
+	{ID: "519", Name: "LIHKG", NormalizedName: "lihkg"},
+	{ID: "520", Name: "Likee", NormalizedName: "likee"},
+	{ID: "521", Name: "Lili", NormalizedName: "lili"},
+	{ID: "522", Name: "Line", NormalizedName: "line"},
+	{ID: "523", Name: "LinkedIn", NormalizedName: "linkedin"},
+	{ID: "524", Name: "LiqPay", NormalizedName: "liqpay"},
+	{ID: "525", Name: "Listia", NormalizedName: "listia"},
+	{ID: "526", Name: "LiteIM", NormalizedName: "liteim"},
+	{ID: "527", Name: "LiveScore", NormalizedName: "livescore"},
+	{ID: "528", Name: "LiveTribe", NormalizedName: "livetribe"},
+	{ID: "529", Name: "LiveTV", NormalizedName: "livetv"},
+	{ID: "530", Name: "LivU", NormalizedName: "livu"},
+	{ID: "531", Name: "LMK", NormalizedName: "lmk"},
+	{ID: "532", Name: "LocalBitcoins", NormalizedName: "localbitcoins"},
+	{ID: "533", Name: "LocalCoinATM", NormalizedName: "localcoinatm"},
+	{ID: "534", Name: "LocalCryptos", NormalizedName: "localcryptos"},
+	{ID: "535", Name: "Locanto", NormalizedName: "locanto"},
+	{ID: "536", Name: "Lomocall", NormalizedName: "lomocall"},
+	{ID: "537", Name: "LuckyDino", NormalizedName: "luckydino"},
+	{ID: "538", Name: "Luckyland", NormalizedName: "luckyland"},
+	{ID: "539", Name: "LunaNode", NormalizedName: "lunanode"},
+	{ID: "540", Name: "Luno", NormalizedName: "luno"},
+	{ID: "541", Name: "LydiaApp", NormalizedName: "lydiaapp"},
+	{ID: "542", Name: "Lyft", NormalizedName: "lyft"},
+	{ID: "543", Name: "LynxWallet", NormalizedName: "lynxwallet"},
+	{ID: "544", Name: "M1Finance", NormalizedName: "m1finance"},
+	{ID: "545", Name: "MaChance", NormalizedName: "machance"},
+	{ID: "546", Name: "Magnit", NormalizedName: "magnit"},
+	{ID: "547", Name: "Mail2world", NormalizedName: "mail2world"},
+	{ID: "548", Name: "MailChimp", NormalizedName: "mailchimp"},
+	{ID: "549", Name: "Mailcom", NormalizedName: "mailcom"},
+	{ID: "550", Name: "MailEE", NormalizedName: "mailee"},
+	{ID: "551", Name: "Mailgun", NormalizedName: "mailgun"},
+	{ID: "552", Name: "MailPrincess", NormalizedName: "mailprincess"},
+	{ID: "553", Name: "MailRu", NormalizedName: "mailru"},
+	{ID: "554", Name: "MakePrintable", NormalizedName: "makeprintable"},
+	{ID: "555", Name: "Mamba", NormalizedName: "mamba"},
+	{ID: "556", Name: "MapleSEA", NormalizedName: "maplesea"},
+	{ID: "557", Name: "Marcel", NormalizedName: "marcel"},
+	{ID: "558", Name: "MarcoPolo", NormalizedName: "marcopolo"},
+	{ID: "559", Name: "Match", NormalizedName: "match"},
+	{ID: "560", Name: "MealPal", NormalizedName: "mealpal"},
+	{ID: "561", Name: "MedLife", NormalizedName: "medlife"},
+	{ID: "562", Name: "Meeff", NormalizedName: "meeff"},
+	{ID: "563", Name: "Meesho", NormalizedName: "meesho"},
+	{ID: "564", Name: "MeetMe", NormalizedName: "meetme"},
+	{ID: "565", Name: "Meetup", NormalizedName: "meetup"},
+	{ID: "566", Name: "Melo", NormalizedName: "melo"},
+	{ID: "567", Name: "MercadoLibre", NormalizedName: "mercadolibre"},
+	{ID: "568", Name: "Mercari", NormalizedName: "mercari"},
+	{ID: "569", Name: "MessageBird", NormalizedName: "messagebird"},
+	{ID: "570", Name: "MetalPay", NormalizedName: "metalpay"},
+	{ID: "572", Name: "MeWe", NormalizedName: "mewe"},
+	{ID: "573", Name: "Mezu", NormalizedName: "mezu"},
+	{ID: "574", Name: "Michat", NormalizedName: "michat"},
+	{ID: "575", Name: "Mico", NormalizedName: "mico"},
+	{ID: "576", Name: "Microworkers", NormalizedName: "microworkers"},
+	{ID: "577", Name: "Mido", NormalizedName: "mido"},
+	{ID: "578", Name: "MilesMore", NormalizedName: "milesmore"},
+	{ID: "579", Name: "MilesReward", NormalizedName: "milesreward"},
+	{ID: "580", Name: "Milk", NormalizedName: "milk"},
+	{ID: "581", Name: "MillionaireMatch", NormalizedName: "millionairematch"},
+	{ID: "582", Name: "Mint", NormalizedName: "mint"},
+	{ID: "583", Name: "Mistplay", NormalizedName: "mistplay"},
+	{ID: "584", Name: "mixi", NormalizedName: "mixi"},
+	{ID: "585", Name: "Mobihapp", NormalizedName: "mobihapp"},
+	{ID: "586", Name: "Mobilebet", NormalizedName: "mobilebet"},
+	{ID: "587", Name: "MobileMan", NormalizedName: "mobileman"},
+	{ID: "588", Name: "MobileMoney", NormalizedName: "mobilemoney"},
+	{ID: "589", Name: "Moco", NormalizedName: "moco"},
+	{ID: "590", Name: "Monese", NormalizedName: "monese"},
+	{ID: "591", Name: "MoneyLion", NormalizedName: "moneylion"},
+	{ID: "592", Name: "MoneyPak", NormalizedName: "moneypak"},
+	{ID: "593", Name: "MoneyRawr", NormalizedName: "moneyrawr"},
+	{ID: "594", Name: "Monzo", NormalizedName: "monzo"},
+	{ID: "595", Name: "MoolaDays", NormalizedName: "mooladays"},
+	{ID: "596", Name: "MoonPay", NormalizedName: "moonpay"},
+	{ID: "597", Name: "Mourjan", NormalizedName: "mourjan"},
+	{ID: "598", Name: "MOVO", NormalizedName: "movo"},
+	{ID: "599", Name: "Mowasalat", NormalizedName: "mowasalat"},
+	{ID: "600", Name: "MozoX", NormalizedName: "mozox"},
+	{ID: "601", Name: "MrGreen", NormalizedName: "mrgreen"},
+	{ID: "602", Name: "Mrsool", NormalizedName: "mrsool"},
+	{ID: "603", Name: "MrSpin", NormalizedName: "mrspin"},
+	{ID: "604", Name: "MTCGamePortal", NormalizedName: "mtcgameportal"},
+	{ID: "605", Name: "MuchBetter", NormalizedName: "muchbetter"},
+	{ID: "606", Name: "MyAuto", NormalizedName: "myauto"},
+	{ID: "607", Name: "MyBookie", NormalizedName: "mybookie"},
+	{ID: "608", Name: "MyBoost", NormalizedName: "myboost"},
+	{ID: "609", Name: "MyGiftCardSupply", NormalizedName: "mygiftcardsupply"},
+	{ID: "610", Name: "MyLOL", NormalizedName: "mylol"},
+	{ID: "611", Name: "MyMusicTaste", NormalizedName: "mymusictaste"},
+	{ID: "612", Name: "My_Opinions", NormalizedName: "myopinions"},
+	{ID: "613", Name: "MyOpinions", NormalizedName: "myopinions"},
+	{ID: "614", Name: "MySoapBox", NormalizedName: "mysoapbox"},
+	{ID: "615", Name: "Myspace", NormalizedName: "myspace"},
+	{ID: "616", Name: "MyTaxi", NormalizedName: "mytaxi"},
+	{ID: "617", Name: "MyTime", NormalizedName: "mytime"},
+	{ID: "618", Name: "MyTrainerRewards", NormalizedName: "mytrainerrewards"},
+	{ID: "619", Name: "NAGATrader", NormalizedName: "nagatrader"},
+	{ID: "620", Name: "Naver", NormalizedName: "naver"},
+	{ID: "621", Name: "NBATopshot", NormalizedName: "nbatopshot"},
+	{ID: "622", Name: "NCloud", NormalizedName: "ncloud"},
+	{ID: "623", Name: "Near", NormalizedName: "near"},
+	{ID: "624", Name: "nearside", NormalizedName: "nearside"},
+	{ID: "625", Name: "Nectar", NormalizedName: "nectar"},
+	{ID: "626", Name: "NerdWallet", NormalizedName: "nerdwallet"},
+	{ID: "628", Name: "Netease", NormalizedName: "netease"},
+	{ID: "629", Name: "NETELLER", NormalizedName: "neteller"},
+	{ID: "630", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "631", Name: "NetZero", NormalizedName: "netzero"},
+	{ID: "632", Name: "Neuron", NormalizedName: "neuron"},
+	{ID: "633", Name: "Nexmo", NormalizedName: "nexmo"},
+	{ID: "634", Name: "Nextdoor", NormalizedName: "nextdoor"},
+	{ID: "635", Name: "Ngage", NormalizedName: "ngage"},
+	{ID: "636", Name: "Nielson", NormalizedName: "nielson"},
+	{ID: "637", Name: "NiftyGateway", NormalizedName: "niftygateway"},
+	{ID: "638", Name: "NiftyLoans", NormalizedName: "niftyloans"},
+	{ID: "639", Name: "Nike", NormalizedName: "nike"},
+	{ID: "640", Name: "Nimses", NormalizedName: "nimses"},
+	{ID: "641", Name: "Nonoh", NormalizedName: "nonoh"},
+	{ID: "642", Name: "Nonolive", NormalizedName: "nonolive"},
+	{ID: "643", Name: "Noona", NormalizedName: "noona"},
+	{ID: "644", Name: "Nordstrom", NormalizedName: "nordstrom"},
+	{ID: "645", Name: "Notify", NormalizedName: "notify"},
+	{ID: "646", Name: "Novo", NormalizedName: "novo"},
+	{ID: "647", Name: "NTTGame", NormalizedName: "nttgame"},
+	{ID: "648", Name: "NTWallet", NormalizedName: "ntwallet"},
+	{ID: "649", Name: "NTWRK", NormalizedName: "ntwrk"},
+	{ID: "650", Name: "NumeroeSIM", NormalizedName: "numeroesim"},
+	{ID: "651", Name: "Nvidia", NormalizedName: "nvidia"},
+	{ID: "652", Name: "Octopus", NormalizedName: "octopus"},
+	{ID: "653", Name: "OfferNation", NormalizedName: "offernation"},
+	{ID: "654", Name: "OfferUp", NormalizedName: "offerup"},
+	{ID: "655", Name: "OffGamers", NormalizedName: "offgamers"},
+	{ID: "656", Name: "OhmConnect", NormalizedName: "ohmconnect"},
+	{ID: "657", Name: "OKCoin", NormalizedName: "okcoin"},
+	{ID: "658", Name: "OkCupid", NormalizedName: "okcupid"},
+	{ID: "659", Name: "OKru", NormalizedName: "okru"},
+	{ID: "660", Name: "OlaCabs", NormalizedName: "olacabs"},
+	{ID: "661", Name: "Olx", NormalizedName: "olx"},
+	{ID: "662", Name: "Omio", NormalizedName: "omio"},
+	{ID: "663", Name: "OneCasino", NormalizedName: "onecasino"},
+	{ID: "664", Name: "OneDayRewards", NormalizedName: "onedayrewards"},
+	{ID: "665", Name: "OneFinance", NormalizedName: "onefinance"},
+	{ID: "666", Name: "OneMainFinancial", NormalizedName: "onemainfinancial"},
+	{ID: "667", Name: "OneOpinion", NormalizedName: "oneopinion"},
+	{ID: "668", Name: "OnJuno", NormalizedName: "onjuno"},
+	{ID: "669", Name: "Onlinenet", NormalizedName: "onlinenet"},
+	{ID: "670", Name: "Oobit", NormalizedName: "oobit"},
+	{ID: "671", Name: "OpenAIChatGPT", NormalizedName: "openaichatgpt"},
+	{ID: "672", Name: "OpenNode", NormalizedName: "opennode"},
+	{ID: "673", Name: "OpenPhone", NormalizedName: "openphone"},
+	{ID: "674", Name: "OpenSesame", NormalizedName: "opensesame"},
+	{ID: "675", Name: "OpinionOutpost", NormalizedName: "opinionoutpost"},
+	{ID: "676", Name: "OpinionWorld", NormalizedName: "opinionworld"},
+	{ID: "677", Name: "OptusSport", NormalizedName: "optussport"},
+	{ID: "678", Name: "Oracle", NormalizedName: "oracle"},
+	{ID: "679", Name: "OTCBTC", NormalizedName: "otcbtc"},
+	{ID: "680", Name: "OurTime", NormalizedName: "ourtime"},
+	{ID: "681", Name: "OutSmartHPV", NormalizedName: "outsmarthpv"},
+	{ID: "682", Name: "OYO", NormalizedName: "oyo"},
+	{ID: "683", Name: "OZFlatMates", NormalizedName: "ozflatmates"},
+	{ID: "684", Name: "PaddyPower", NormalizedName: "paddypower"},
+	{ID: "685", Name: "PaidToReadEmailcom", NormalizedName: "paidtoreademailcom"},
+	{ID: "686", Name: "PaidViewpoint", NormalizedName: "paidviewpoint"},
+	{ID: "687", Name: "Pangea", NormalizedName: "pangea"},
+	{ID: "688", Name: "Papara", NormalizedName: "papara"},
+	{ID: "689", Name: "Parler", NormalizedName: "parler"},
+	{ID: "690", Name: "ParuVendu", NormalizedName: "paruvendu"},
+	{ID: "691", Name: "Passbook", NormalizedName: "passbook"},
+	{ID: "692", Name: "Paxful", NormalizedName: "paxful"},
+	{ID: "693", Name: "Payactiv", NormalizedName: "payactiv"},
+	{ID: "694", Name: "PayAsUGym", NormalizedName: "payasugym"},
+	{ID: "695", Name: "Paybis", NormalizedName: "paybis"},
+	{ID: "696", Name: "Paycell", NormalizedName: "paycell"},
+	{ID: "697", Name: "PayCenter", NormalizedName: "paycenter"},
+	{ID: "698", Name: "PayGo", NormalizedName: "paygo"},
+	{ID: "699", Name: "PayMaya", NormalizedName: "paymaya"},
+	{ID: "700", Name: "PaymeDollar", NormalizedName: "paymedollar"},
+	{ID: "701", Name: "Paymium", NormalizedName: "paymium"},
+	{ID: "702", Name: "Payoneer", NormalizedName: "payoneer"},
+	{ID: "703", Name: "PayPal", NormalizedName: "paypal"},
+	{ID: "704", Name: "PayQin", NormalizedName: "payqin"},
+	{ID: "705", Name: "Paysafe", NormalizedName: "paysafe"},
+	{ID: "706", Name: "PaySay", NormalizedName: "paysay"},
+	{ID: "707", Name: "PaySend", NormalizedName: "paysend"},
+	{ID: "708", Name: "Paysera", NormalizedName: "paysera"},
+	{ID: "709", Name: "Paytm", NormalizedName: "paytm"},
+	{ID: "710", Name: "PCGameSupply", NormalizedName: "pcgamesupply"},
+	{ID: "711", Name: "Pei", NormalizedName: "pei"},
+	{ID: "712", Name: "Periscope", NormalizedName: "periscope"},
+	{ID: "713", Name: "Perk", NormalizedName: "perk"},
+	{ID: "714", Name: "PersonalCapital", NormalizedName: "personalcapital"},
+	{ID: "715", Name: "Phyre", NormalizedName: "phyre"},
+	{ID: "716", Name: "PinaLove", NormalizedName: "pinalove"},
+	{ID: "717", Name: "Pinchos", NormalizedName: "pinchos"},
+	{ID: "718", Name: "PineconeResearch", NormalizedName: "pineconeresearch"},
+	{ID: "719", Name: "PingPong", NormalizedName: "pingpong"},
+	{ID: "720", Name: "Pinterest", NormalizedName: "pinterest"},
+	{ID: "721", Name: "Pitacoin", NormalizedName: "pitacoin"},
+	{ID: "722", Name: "Plaid", NormalizedName: "plaid"},
+	{ID: "723", Name: "PlayerAuctions", NormalizedName: "playerauctions"},
+	{ID: "724", Name: "PlentyOfFish", NormalizedName: "plentyoffish"},
+	{ID: "726", Name: "PocketWin", NormalizedName: "pocketwin"},
+	{ID: "727", Name: "PODERcard", NormalizedName: "podercard"},
+	{ID: "728", Name: "Pogo", NormalizedName: "pogo"},
+	{ID: "729", Name: "Pointclub", NormalizedName: "pointclub"},
+	{ID: "730", Name: "Pokec", NormalizedName: "pokec"},
+	{ID: "731", Name: "PollPass", NormalizedName: "pollpass"},
+	{ID: "732", Name: "PollPay", NormalizedName: "pollpay"},
+	{ID: "733", Name: "PopKonTv", NormalizedName: "popkontv"},
+	{ID: "734", Name: "Porte", NormalizedName: "porte"},
+	{ID: "735", Name: "Poshmark", NormalizedName: "poshmark"},
+	{ID: "736", Name: "Posten", NormalizedName: "posten"},
+	{ID: "738", Name: "PotatoChat", NormalizedName: "potatochat"},
+	{ID: "739", Name: "Prepaid2Cash", NormalizedName: "prepaid2cash"},
+	{ID: "740", Name: "Prezzee", NormalizedName: "prezzee"},
+	{ID: "741", Name: "Privacy", NormalizedName: "privacy"},
+	{ID: "742", Name: "Prolific", NormalizedName: "prolific"},
+	{ID: "743", Name: "PromotionPod", NormalizedName: "promotionpod"},
+	{ID: "744", Name: "ProOpinions", NormalizedName: "proopinions"},
+	{ID: "745", Name: "Propeller_Ads", NormalizedName: "propellerads"},
+	{ID: "746", Name: "Propy", NormalizedName: "propy"},
+	{ID: "747", Name: "ProtonMail", NormalizedName: "protonmail"},
+	{ID: "748", Name: "Pruvit", NormalizedName: "pruvit"},
+	{ID: "749", Name: "PUBGMOBILE", NormalizedName: "pubgmobile"},
+	{ID: "750", Name: "Punktid", NormalizedName: "punktid"},
+	{ID: "751", Name: "Pureprofile", NormalizedName: "pureprofile"},
+	{ID: "752", Name: "Purse_io", NormalizedName: "purseio"},
+	{ID: "753", Name: "Purseio", NormalizedName: "purseio"},
+	{ID: "754", Name: "QIP", NormalizedName: "qip"},
+	{ID: "755", Name: "QIWIWallet", NormalizedName: "qiwiwallet"},
+	{ID: "756", Name: "QLive", NormalizedName: "qlive"},
+	{ID: "757", Name: "Qmeecom", NormalizedName: "qmeecom"},
+	{ID: "758", Name: "Qoo10", NormalizedName: "qoo10"},
+	{ID: "759", Name: "QQTube", NormalizedName: "qqtube"},
+	{ID: "760", Name: "QuadPay", NormalizedName: "quadpay"},
+	{ID: "761", Name: "QubeMoney", NormalizedName: "qubemoney"},
+	{ID: "762", Name: "QuickBooks", NormalizedName: "quickbooks"},
+	{ID: "763", Name: "Quickie", NormalizedName: "quickie"},
+	{ID: "764", Name: "QuickPaySurvey", NormalizedName: "quickpaysurvey"},
+	{ID: "765", Name: "QuickThoughts", NormalizedName: "quickthoughts"},
+	{ID: "766", Name: "Quipp", NormalizedName: "quipp"},
+	{ID: "767", Name: "RadialInsight", NormalizedName: "radialinsight"},
+	{ID: "768", Name: "Raise", NormalizedName: "raise"},
+	{ID: "769", Name: "RAM", NormalizedName: "ram"},
+	{ID: "770", Name: "Rambler", NormalizedName: "rambler"},
+	{ID: "771", Name: "Razer", NormalizedName: "razer"},
+	{ID: "772", Name: "Rebtel", NormalizedName: "rebtel"},
+	{ID: "773", Name: "Remitly", NormalizedName: "remitly"},
+	{ID: "774", Name: "RentMe", NormalizedName: "rentme"},
+	{ID: "775", Name: "Reonomy", NormalizedName: "reonomy"},
+	{ID: "776", Name: "ReRyde", NormalizedName: "reryde"},
+	{ID: "777", Name: "RetailMeNot", NormalizedName: "retailmenot"},
+	{ID: "778", Name: "Revolut", NormalizedName: "revolut"},
+	{ID: "779", Name: "RewardedPlay", NormalizedName: "rewardedplay"},
+	{ID: "780", Name: "RewardingWays", NormalizedName: "rewardingways"},
+	{ID: "781", Name: "RiaFinancial", NormalizedName: "riafinancial"},
+	{ID: "782", Name: "RingCaptcha", NormalizedName: "ringcaptcha"},
+	{ID: "783", Name: "RingCentral", NormalizedName: "ringcentral"},
+	{ID: "785", Name: "Ritualco", NormalizedName: "ritualco"},
+	{ID: "786", Name: "Rizk", NormalizedName: "rizk"},
+	{ID: "787", Name: "Rizq", NormalizedName: "rizq"},
+	{ID: "788", Name: "RLOVE", NormalizedName: "rlove"},
+	{ID: "789", Name: "Robinhood", NormalizedName: "robinhood"},
+	{ID: "790", Name: "Roblox", NormalizedName: "roblox"},
+	{ID: "791", Name: "RocketReach", NormalizedName: "rocketreach"},
+	{ID: "792", Name: "Rooming", NormalizedName: "rooming"},
+	{ID: "793", Name: "Roomster", NormalizedName: "roomster"},
+	{ID: "794", Name: "Root", NormalizedName: "root"},
+	{ID: "795", Name: "Rover", NormalizedName: "rover"},
+	{ID: "796", Name: "RRF", NormalizedName: "rrf"},
+	{ID: "797", Name: "RSGoldMine", NormalizedName: "rsgoldmine"},
+	{ID: "798", Name: "Rumble", NormalizedName: "rumble"},
+	{ID: "799", Name: "Ruten", NormalizedName: "ruten"},
+	{ID: "800", Name: "SafeCurrency", NormalizedName: "safecurrency"},
+	{ID: "801", Name: "SamsClub", NormalizedName: "samsclub"},
+	{ID: "802", Name: "SAS", NormalizedName: "sas"},
+	{ID: "803", Name: "SaveWithSurveys", NormalizedName: "savewithsurveys"},
+	{ID: "804", Name: "SayHi", NormalizedName: "sayhi"},
+	{ID: "805", Name: "Scaleway", NormalizedName: "scaleway"},
+	{ID: "806", Name: "Scout", NormalizedName: "scout"},
+	{ID: "807", Name: "SCRUFF", NormalizedName: "scruff"},
+	{ID: "808", Name: "SeaGamerMall", NormalizedName: "seagamermall"},
+	{ID: "809", Name: "SEAGM", NormalizedName: "seagm"},
+	{ID: "810", Name: "Seated", NormalizedName: "seated"},
+	{ID: "811", Name: "SecretBenefits", NormalizedName: "secretbenefits"},
+	{ID: "812", Name: "SendGrid", NormalizedName: "sendgrid"},
+	{ID: "813", Name: "SendInBlue", NormalizedName: "sendinblue"},
+	{ID: "814", Name: "Sendwave", NormalizedName: "sendwave"},
+	{ID: "815", Name: "SEOClerks", NormalizedName: "seoclerks"},
+	{ID: "816", Name: "Serverfield", NormalizedName: "serverfield"},
+	{ID: "817", Name: "NotListed", NormalizedName: "notlisted"},
+	{ID: "818", Name: "Sezzle", NormalizedName: "sezzle"},
+	{ID: "819", Name: "Shasso", NormalizedName: "shasso"},
+	{ID: "820", Name: "SheerID", NormalizedName: "sheerid"},
+	{ID: "821", Name: "ShopatHome", NormalizedName: "shopathome"},
+	{ID: "822", Name: "ShopBack", NormalizedName: "shopback"},
+	{ID: "823", Name: "Shopee", NormalizedName: "shopee"},
+	{ID: "824", Name: "Shopify", NormalizedName: "shopify"},
+	{ID: "825", Name: "Shopkick", NormalizedName: "shopkick"},
+	{ID: "826", Name: "ShopPay", NormalizedName: "shoppay"},
+	{ID: "827", Name: "Shpock", NormalizedName: "shpock"},
+	{ID: "828", Name: "SidelineSwap", NormalizedName: "sidelineswap"},
+	{ID: "829", Name: "Signal", NormalizedName: "signal"},
+	{ID: "830", Name: "Simba", NormalizedName: "simba"},
+	{ID: "832", Name: "SimplexSimplexCC", NormalizedName: "simplexsimplexcc"},
+	{ID: "833", Name: "Sinch", NormalizedName: "sinch"},
+	{ID: "834", Name: "SingleMuslim", NormalizedName: "singlemuslim"},
+	{ID: "835", Name: "SkipTheDishes", NormalizedName: "skipthedishes"},
+	{ID: "836", Name: "Skout", NormalizedName: "skout"},
+	{ID: "837", Name: "Skrill", NormalizedName: "skrill"},
+	{ID: "838", Name: "Skyetel", NormalizedName: "skyetel"},
+	{ID: "839", Name: "Slide", NormalizedName: "slide"},
+	{ID: "840", Name: "SmarterASP", NormalizedName: "smarterasp"},
+	{ID: "841", Name: "Smores", NormalizedName: "smores"},
+	{ID: "842", Name: "SMSit", NormalizedName: "smsit"},
+	{ID: "843", Name: "SMSto", NormalizedName: "smsto"},
+	{ID: "844", Name: "SMTP2GO", NormalizedName: "smtp2go"},
+	{ID: "845", Name: "Snagshout", NormalizedName: "snagshout"},
+	{ID: "846", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "847", Name: "Snapex", NormalizedName: "snapex"},
+	{ID: "848", Name: "SnapFinance", NormalizedName: "snapfinance"},
+	{ID: "849", Name: "Snap_Kitchen", NormalizedName: "snapkitchen"},
+	{ID: "850", Name: "Sneakerboy", NormalizedName: "sneakerboy"},
+	{ID: "851", Name: "Sneakersnstuff", NormalizedName: "sneakersnstuff"},
+	{ID: "852", Name: "SnippetMedia", NormalizedName: "snippetmedia"},
+	{ID: "853", Name: "Societi", NormalizedName: "societi"},
+	{ID: "854", Name: "SoFI", NormalizedName: "sofi"},
+	{ID: "855", Name: "SolitaireCash", NormalizedName: "solitairecash"},
+	{ID: "856", Name: "Sonetel", NormalizedName: "sonetel"},
+	{ID: "857", Name: "SoulAPP", NormalizedName: "soulapp"},
+	{ID: "858", Name: "Souq", NormalizedName: "souq"},
+	{ID: "859", Name: "SpectroCoin", NormalizedName: "spectrocoin"},
+	{ID: "860", Name: "Spend", NormalizedName: "spend"},
+	{ID: "861", Name: "Spotify", NormalizedName: "spotify"},
+	{ID: "862", Name: "Spryng", NormalizedName: "spryng"},
+	{ID: "863", Name: "Square", NormalizedName: "square"},
+	{ID: "864", Name: "Starbucks", NormalizedName: "starbucks"},
+	{ID: "865", Name: "StarOf", NormalizedName: "starof"},
+	{ID: "866", Name: "State_Farm", NormalizedName: "statefarm"},
+	{ID: "867", Name: "Steady", NormalizedName: "steady"},
+	{ID: "868", Name: "Steam", NormalizedName: "steam"},
+	{ID: "869", Name: "SteemIt", NormalizedName: "steemit"},
+	{ID: "870", Name: "Step", NormalizedName: "step"},
+	{ID: "871", Name: "Stoqo", NormalizedName: "stoqo"},
+	{ID: "872", Name: "StormGain", NormalizedName: "stormgain"},
+	{ID: "873", Name: "StormPlay", NormalizedName: "stormplay"},
+	{ID: "874", Name: "Strato", NormalizedName: "strato"},
+	{ID: "875", Name: "Streetbees", NormalizedName: "streetbees"},
+	{ID: "876", Name: "Strike", NormalizedName: "strike"},
+	{ID: "877", Name: "Stripe", NormalizedName: "stripe"},
+	{ID: "878", Name: "SugarDaddyMeet", NormalizedName: "sugardaddymeet"},
+	{ID: "879", Name: "SumUp", NormalizedName: "sumup"},
+	{ID: "881", Name: "SuperPay", NormalizedName: "superpay"},
+	{ID: "882", Name: "Supreme", NormalizedName: "supreme"},
+	{ID: "883", Name: "Surf", NormalizedName: "surf"},
+	{ID: "884", Name: "SurveyHoney", NormalizedName: "surveyhoney"},
+	{ID: "885", Name: "SurveyJunkie", NormalizedName: "surveyjunkie"},
+	{ID: "886", Name: "SurveyMonkeyRewards", NormalizedName: "surveymonkeyrewards"},
+	{ID: "887", Name: "SurveyRewardz", NormalizedName: "surveyrewardz"},
+	{ID: "888", Name: "Surveytime", NormalizedName: "surveytime"},
+	{ID: "889", Name: "SwagbucksInboxDollarsMyPointsySenseClassPassNoones", NormalizedName: "swagbucksinboxdollarsmypointsysenseclasspassnoones"},
+	{ID: "890", Name: "SwapD", NormalizedName: "swapd"},
+	{ID: "891", Name: "Sweatcoin", NormalizedName: "sweatcoin"},
+	{ID: "892", Name: "SweetRing", NormalizedName: "sweetring"},
+	{ID: "893", Name: "SwissBorg", NormalizedName: "swissborg"},
+	{ID: "894", Name: "Swych", NormalizedName: "swych"},
+	{ID: "895", Name: "Swyftx", NormalizedName: "swyftx"},
+	{ID: "896", Name: "Tagged", NormalizedName: "tagged"},
+	{ID: "897", Name: "Talk2", NormalizedName: "talk2"},
+	{ID: "898", Name: "Talken", NormalizedName: "talken"},
+	{ID: "899", Name: "TanTan", NormalizedName: "tantan"},
+	{ID: "900", Name: "TaoBao", NormalizedName: "taobao"},
+	{ID: "901", Name: "Tapchamps", NormalizedName: "tapchamps"},
+	{ID: "902", Name: "Target", NormalizedName: "target"},
+	{ID: "903", Name: "Taxify", NormalizedName: "taxify"},
+	{ID: "904", Name: "TCGPlayer", NormalizedName: "tcgplayer"},
+	{ID: "905", Name: "TDAmeritrade", NormalizedName: "tdameritrade"},
+	{ID: "907", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "908", Name: "Telekom", NormalizedName: "telekom"},
+	{ID: "909", Name: "Telnyx", NormalizedName: "telnyx"},
+	{ID: "910", Name: "Telos", NormalizedName: "telos"},
+	{ID: "911", Name: "TencentQQ", NormalizedName: "tencentqq"},
+	{ID: "912", Name: "Tenx", NormalizedName: "tenx"},
+	{ID: "913", Name: "ThaiFriendly", NormalizedName: "thaifriendly"},
+	{ID: "914", Name: "TheChange", NormalizedName: "thechange"},
+	{ID: "915", Name: "TheFreeNet", NormalizedName: "thefreenet"},
+	{ID: "916", Name: "TheHouseShop", NormalizedName: "thehouseshop"},
+	{ID: "917", Name: "ThinkOpinion", NormalizedName: "thinkopinion"},
+	{ID: "918", Name: "ThisFate", NormalizedName: "thisfate"},
+	{ID: "919", Name: "Thumbtack", NormalizedName: "thumbtack"},
+	{ID: "920", Name: "Thunderpod", NormalizedName: "thunderpod"},
+	{ID: "921", Name: "Ticketmaster", NormalizedName: "ticketmaster"},
+	{ID: "922", Name: "Tier", NormalizedName: "tier"},
+	{ID: "923", Name: "Tikki", NormalizedName: "tikki"},
+	{ID: "924", Name: "TikTok", NormalizedName: "tiktok"},
+	{ID: "925", Name: "Tilda", NormalizedName: "tilda"},
+	{ID: "926", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "927", Name: "TMobileMoney", NormalizedName: "tmobilemoney"},
+	{ID: "928", Name: "TodayAustralia", NormalizedName: "todayaustralia"},
+	{ID: "929", Name: "TogetherPrice", NormalizedName: "togetherprice"},
+	{ID: "930", Name: "Tokeneo", NormalizedName: "tokeneo"},
+	{ID: "931", Name: "Tokopedia", NormalizedName: "tokopedia"},
+	{ID: "932", Name: "TomaExchange", NormalizedName: "tomaexchange"},
+	{ID: "933", Name: "ToTalk", NormalizedName: "totalk"},
+	{ID: "934", Name: "ToTaxi", NormalizedName: "totaxi"},
+	{ID: "935", Name: "TradingView", NormalizedName: "tradingview"},
+	{ID: "936", Name: "TransferHome", NormalizedName: "transferhome"},
+	{ID: "937", Name: "TransferWise", NormalizedName: "transferwise"},
+	{ID: "938", Name: "Tremolo", NormalizedName: "tremolo"},
+	{ID: "939", Name: "Tripadvisor", NormalizedName: "tripadvisor"},
+	{ID: "940", Name: "TrueCaller", NormalizedName: "truecaller"},
+	{ID: "941", Name: "TrulyMadly", NormalizedName: "trulymadly"},
+	{ID: "942", Name: "TurboTax", NormalizedName: "turbotax"},
+	{ID: "943", Name: "TurboTenant", NormalizedName: "turbotenant"},
+	{ID: "944", Name: "Turgame", NormalizedName: "turgame"},
+	{ID: "945", Name: "Turo", NormalizedName: "turo"},
+	{ID: "946", Name: "Twilio", NormalizedName: "twilio"},
+	{ID: "947", Name: "Twitch", NormalizedName: "twitch"},
+	{ID: "948", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "949", Name: "Twoo", NormalizedName: "twoo"},
+	{ID: "951", Name: "UberPostmates", NormalizedName: "uberpostmates"},
+	{ID: "952", Name: "Ubisoft", NormalizedName: "ubisoft"},
+	{ID: "953", Name: "Ultra", NormalizedName: "ultra"},
+	{ID: "954", Name: "Uniplaces", NormalizedName: "uniplaces"},
+	{ID: "955", Name: "UniqueCasino", NormalizedName: "uniquecasino"},
+	{ID: "956", Name: "UnivisionMobileMoney", NormalizedName: "univisionmobilemoney"},
+	{ID: "957", Name: "UOL", NormalizedName: "uol"},
+	{ID: "958", Name: "Upaynet", NormalizedName: "upaynet"},
+	{ID: "959", Name: "uphold", NormalizedName: "uphold"},
+	{ID: "960", Name: "Uplift", NormalizedName: "uplift"},
+	{ID: "961", Name: "Upward", NormalizedName: "upward"},
+	{ID: "962", Name: "Upwork", NormalizedName: "upwork"},
+	{ID: "963", Name: "UrbanClap", NormalizedName: "urbanclap"},
+	{ID: "964", Name: "USASurvey", NormalizedName: "usasurvey"},
+	{ID: "966", Name: "USPS", NormalizedName: "usps"},
+	{ID: "967", Name: "ValuedOpinions", NormalizedName: "valuedopinions"},
+	{ID: "968", Name: "VarageSale", NormalizedName: "varagesale"},
+	{ID: "969", Name: "Varo", NormalizedName: "varo"},
+	{ID: "970", Name: "Vase", NormalizedName: "vase"},
+	{ID: "971", Name: "Vendo", NormalizedName: "vendo"},
+	{ID: "972", Name: "Venmo", NormalizedName: "venmo"},
+	{ID: "973", Name: "Verse", NormalizedName: "verse"},
+	{ID: "974", Name: "Vertex", NormalizedName: "vertex"},
+	{ID: "975", Name: "VetsPrevail", NormalizedName: "vetsprevail"},
+	{ID: "976", Name: "ViaAppViaVan", NormalizedName: "viaappviavan"},
+	{ID: "977", Name: "ViaBTC", NormalizedName: "viabtc"},
+	{ID: "978", Name: "Viber", NormalizedName: "viber"},
+	{ID: "979", Name: "Vidaplayer", NormalizedName: "vidaplayer"},
+	{ID: "980", Name: "Vidio", NormalizedName: "vidio"},
+	{ID: "981", Name: "VietJetAir", NormalizedName: "vietjetair"},
+	{ID: "982", Name: "Vimpay", NormalizedName: "vimpay"},
+	{ID: "983", Name: "Vinted", NormalizedName: "vinted"},
+	{ID: "984", Name: "VivaWallet", NormalizedName: "vivawallet"},
+	{ID: "985", Name: "VK", NormalizedName: "vk"},
+	{ID: "986", Name: "Vnay", NormalizedName: "vnay"},
+	{ID: "988", Name: "VoilaNorbert", NormalizedName: "voilanorbert"},
+	{ID: "989", Name: "Volny", NormalizedName: "volny"},
+	{ID: "990", Name: "Voopee", NormalizedName: "voopee"},
+	{ID: "991", Name: "Voyager", NormalizedName: "voyager"},
+	{ID: "992", Name: "Vrbo", NormalizedName: "vrbo"},
+	{ID: "993", Name: "VulkanVegas", NormalizedName: "vulkanvegas"},
+	{ID: "994", Name: "Vumber", NormalizedName: "vumber"},
+	{ID: "995", Name: "Wafaicloud", NormalizedName: "wafaicloud"},
+	{ID: "996", Name: "Waleteros", NormalizedName: "waleteros"},
+	{ID: "997", Name: "Walgreens", NormalizedName: "walgreens"},
+	{ID: "998", Name: "WalletHub", NormalizedName: "wallethub"},
+	{ID: "999", Name: "Walmart", NormalizedName: "walmart"},
+	{ID: "1000", Name: "WapLog", NormalizedName: "waplog"},
+	{ID: "1001", Name: "WatchiT", NormalizedName: "watchit"},
+	{ID: "1002", Name: "Wealthfront", NormalizedName: "wealthfront"},
+	{ID: "1003", Name: "Webmoney", NormalizedName: "webmoney"},
+	{ID: "1004", Name: "WeChat", NormalizedName: "wechat"},
+	{ID: "1005", Name: "Wedoogift", NormalizedName: "wedoogift"},
+	{ID: "1006", Name: "Weebly", NormalizedName: "weebly"},
+	{ID: "1007", Name: "Weee", NormalizedName: "weee"},
+	{ID: "1008", Name: "Weibo", NormalizedName: "weibo"},
+	{ID: "1009", Name: "WellsFargo", NormalizedName: "wellsfargo"},
+	{ID: "1010", Name: "WeSing", NormalizedName: "wesing"},
+	{ID: "1011", Name: "WestStein", NormalizedName: "weststein"},
+	{ID: "1012", Name: "WhatsApp", NormalizedName: "whatsapp"},
+	{ID: "1013", Name: "WhatsAround", NormalizedName: "whatsaround"},
+	{ID: "1014", Name: "Whop", NormalizedName: "whop"},
+	{ID: "1015", Name: "Wickr", NormalizedName: "wickr"},
+	{ID: "1016", Name: "Wild", NormalizedName: "wild"},
+	{ID: "1017", Name: "Wing", NormalizedName: "wing"},
+	{ID: "1018", Name: "Wingocard", NormalizedName: "wingocard"},
+	{ID: "1019", Name: "Wingspan", NormalizedName: "wingspan"},
+	{ID: "1020", Name: "Wink", NormalizedName: "wink"},
+	{ID: "1021", Name: "Wirex", NormalizedName: "wirex"},
+	{ID: "1022", Name: "Wish", NormalizedName: "wish"},
+	{ID: "1023", Name: "Wolt", NormalizedName: "wolt"},
+	{ID: "1024", Name: "Womply", NormalizedName: "womply"},
+	{ID: "1025", Name: "WooCommerce", NormalizedName: "woocommerce"},
+	{ID: "1026", Name: "WorkersCreditUnion", NormalizedName: "workerscreditunion"},
+	{ID: "1027", Name: "Wynk", NormalizedName: "wynk"},
+	{ID: "1028", Name: "Wyre", NormalizedName: "wyre"},
+	{ID: "1029", Name: "Xapo", NormalizedName: "xapo"},
+	{ID: "1031", Name: "Xoom", NormalizedName: "xoom"},
+	{ID: "1032", Name: "XS2Exchange", NormalizedName: "xs2exchange"},
+	{ID: "1033", Name: "XSERVER", NormalizedName: "xserver"},
+	{ID: "1034", Name: "Yahoo", NormalizedName: "yahoo"},
+	{ID: "1035", Name: "Yalla", NormalizedName: "yalla"},
+	{ID: "1036", Name: "Yandex", NormalizedName: "yandex"},
+	{ID: "1037", Name: "Yeeyi", NormalizedName: "yeeyi"},
+	{ID: "1038", Name: "Yelp", NormalizedName: "yelp"},
+	{ID: "1039", Name: "YFSResearch", NormalizedName: "yfsresearch"},
+	{ID: "1040", Name: "Yieldstreet", NormalizedName: "yieldstreet"},
+	{ID: "1041", Name: "Yippi", NormalizedName: "yippi"},
+	{ID: "1042", Name: "Yocket", NormalizedName: "yocket"},
+	{ID: "1043", Name: "Yodlee", NormalizedName: "yodlee"},
+	{ID: "1044", Name: "YoHo", NormalizedName: "yoho"},
+	{ID: "1045", Name: "Yoti", NormalizedName: "yoti"},
+	{ID: "1046", Name: "YouGotaGift", NormalizedName: "yougotagift"},
+	{ID: "1047", Name: "Youla", NormalizedName: "youla"},
+	{ID: "1048", Name: "YourRentals", NormalizedName: "yourrentals"},
+	{ID: "1049", Name: "YouTrip", NormalizedName: "youtrip"},
+	{ID: "1050", Name: "Yubo", NormalizedName: "yubo"},
+	{ID: "1051", Name: "YunoSurveys", NormalizedName: "yunosurveys"},
+	{ID: "1052", Name: "YuroPay", NormalizedName: "yuropay"},
+	{ID: "1053", Name: "Zadarma", NormalizedName: "zadarma"},
+	{ID: "1054", Name: "Zalo", NormalizedName: "zalo"},
+	{ID: "1055", Name: "Zao", NormalizedName: "zao"},
+	{ID: "1056", Name: "ZapZap", NormalizedName: "zapzap"},
+	{ID: "1057", Name: "Zeek", NormalizedName: "zeek"},
+	{ID: "1058", Name: "Zelle", NormalizedName: "zelle"},
+	{ID: "1059", Name: "Zenly", NormalizedName: "zenly"},
+	{ID: "1060", Name: "Zest", NormalizedName: "zest"},
+	{ID: "1061", Name: "Zhihu", NormalizedName: "zhihu"},
+	{ID: "1062", Name: "Zillow", NormalizedName: "zillow"},
+	{ID: "1063", Name: "ZipCo", NormalizedName: "zipco"},
+	{ID: "1064", Name: "ZipQuadPay", NormalizedName: "zipquadpay"},
+	{ID: "1065", Name: "Zogo", NormalizedName: "zogo"},
+	{ID: "1066", Name: "Zoho", NormalizedName: "zoho"},
+	{ID: "1067", Name: "Zomato", NormalizedName: "zomato"},
+	{ID: "1068", Name: "ZoomBucks", NormalizedName: "zoombucks"},
+	{ID: "1069", Name: "ZoomInfo", NormalizedName: "zoominfo"},
+	{ID: "1070", Name: "Zoosk", NormalizedName: "zoosk"},
+	{ID: "1071", Name: "Zumper", NormalizedName: "zumper"},
+	{ID: "1072", Name: "Microsoft", NormalizedName: "microsoft"},
+	{ID: "1073", Name: "Azure", NormalizedName: "azure"},
+	{ID: "1074", Name: "Outlook", NormalizedName: "outlook"},
+	{ID: "1075", Name: "Xbox", NormalizedName: "xbox"},
+	{ID: "1076", Name: "Skype", NormalizedName: "skype"},
+	{ID: "1077", Name: "EasyasTap", NormalizedName: "easyastap"},
+	{ID: "1078", Name: "Lolli", NormalizedName: "lolli"},
+	{ID: "1079", Name: "UltraIO", NormalizedName: "ultraio"},
+	{ID: "1080", Name: "GooglePlay", NormalizedName: "googleplay"},
+	{ID: "1081", Name: "Kik", NormalizedName: "kik"},
+	{ID: "1082", Name: "FreeCash", NormalizedName: "freecash"},
+	{ID: "1083", Name: "Greggs", NormalizedName: "greggs"},
+	{ID: "1085", Name: "ChumbaCasino", NormalizedName: "chumbacasino"},
+	{ID: "1086", Name: "GlobalPoker", NormalizedName: "globalpoker"},
+	{ID: "1087", Name: "YooMoney", NormalizedName: "yoomoney"},
+	{ID: "1088", Name: "Getir", NormalizedName: "getir"},
+	{ID: "1089", Name: "OVO", NormalizedName: "ovo"},
+	{ID: "1090", Name: "Banggood", NormalizedName: "banggood"},
+	{ID: "1091", Name: "Indomaret", NormalizedName: "indomaret"},
+	{ID: "1092", Name: "Blibli", NormalizedName: "blibli"},
+	{ID: "1093", Name: "Grab", NormalizedName: "grab"},
+	{ID: "1094", Name: "Adira", NormalizedName: "adira"},
+	{ID: "1095", Name: "JDID", NormalizedName: "jdid"},
+	{ID: "1096", Name: "Maxim", NormalizedName: "maxim"},
+	{ID: "1097", Name: "MicrosoftAzure", NormalizedName: "microsoftazure"},
+	{ID: "1098", Name: "ModeEarn", NormalizedName: "modeearn"},
+	{ID: "1099", Name: "Gorillas", NormalizedName: "gorillas"},
+	{ID: "1100", Name: "Plivo", NormalizedName: "plivo"},
+	{ID: "1101", Name: "CoinsBaron", NormalizedName: "coinsbaron"},
+	{ID: "1102", Name: "Stir", NormalizedName: "stir"},
+	{ID: "1103", Name: "AdGate", NormalizedName: "adgate"},
+	{ID: "1104", Name: "Microcenter", NormalizedName: "microcenter"},
+	{ID: "1105", Name: "Greenlight", NormalizedName: "greenlight"},
+	{ID: "1106", Name: "101Sweets", NormalizedName: "101sweets"},
+	{ID: "1107", Name: "AccountPatrolMoneyPatrol", NormalizedName: "accountpatrolmoneypatrol"},
+	{ID: "1108", Name: "Acorns", NormalizedName: "acorns"},
+	{ID: "1109", Name: "Aeldra", NormalizedName: "aeldra"},
+	{ID: "1110", Name: "Ahead", NormalizedName: "ahead"},
+	{ID: "1112", Name: "AmazonWebs", NormalizedName: "amazonwebs"},
+	{ID: "1113", Name: "AppleWallet", NormalizedName: "applewallet"},
+	{ID: "1114", Name: "Aspiration", NormalizedName: "aspiration"},
+	{ID: "1115", Name: "ATMcom", NormalizedName: "atmcom"},
+	{ID: "1116", Name: "Bakkt", NormalizedName: "bakkt"},
+	{ID: "1119", Name: "Betterment", NormalizedName: "betterment"},
+	{ID: "1120", Name: "BiltRewards", NormalizedName: "biltrewards"},
+	{ID: "1121", Name: "bitcoinAlley", NormalizedName: "bitcoinalley"},
+	{ID: "1122", Name: "BlockFi", NormalizedName: "blockfi"},
+	{ID: "1123", Name: "BlueBird", NormalizedName: "bluebird"},
+	{ID: "1124", Name: "BMOHarris", NormalizedName: "bmoharris"},
+	{ID: "1125", Name: "Bovada", NormalizedName: "bovada"},
+	{ID: "1126", Name: "Brandclub", NormalizedName: "brandclub"},
+	{ID: "1127", Name: "BridgeCard", NormalizedName: "bridgecard"},
+	{ID: "1128", Name: "BuyOnTrust", NormalizedName: "buyontrust"},
+	{ID: "1129", Name: "ChampsSports", NormalizedName: "champssports"},
+	{ID: "1130", Name: "CharlesSchwab", NormalizedName: "charlesschwab"},
+	{ID: "1131", Name: "ChicksGoldInc", NormalizedName: "chicksgoldinc"},
+	{ID: "1133", Name: "CoinCircle", NormalizedName: "coincircle"},
+	{ID: "1134", Name: "CoinOut", NormalizedName: "coinout"},
+	{ID: "1135", Name: "ComenityBreadFinancialBreadPay", NormalizedName: "comenitybreadfinancialbreadpay"},
+	{ID: "1136", Name: "Cryptolocally", NormalizedName: "cryptolocally"},
+	{ID: "1137", Name: "DasherDirect", NormalizedName: "dasherdirect"},
+	{ID: "1138", Name: "Ding", NormalizedName: "ding"},
+	{ID: "1139", Name: "Donut", NormalizedName: "donut"},
+	{ID: "1140", Name: "DreamSpring", NormalizedName: "dreamspring"},
+	{ID: "1141", Name: "EarlyBird", NormalizedName: "earlybird"},
+	{ID: "1142", Name: "Eastbay", NormalizedName: "eastbay"},
+	{ID: "1143", Name: "EpochTimes", NormalizedName: "epochtimes"},
+	{ID: "1144", Name: "EZTexting", NormalizedName: "eztexting"},
+	{ID: "1145", Name: "FidelityInvestments", NormalizedName: "fidelityinvestments"},
+	{ID: "1147", Name: "FirstTechFederalCreditUnion", NormalizedName: "firsttechfederalcreditunion"},
+	{ID: "1148", Name: "Fold", NormalizedName: "fold"},
+	{ID: "1149", Name: "FootLocker", NormalizedName: "footlocker"},
+	{ID: "1150", Name: "Gabi", NormalizedName: "gabi"},
+	{ID: "1151", Name: "Gamercraft", NormalizedName: "gamercraft"},
+	{ID: "1152", Name: "Gemiplay", NormalizedName: "gemiplay"},
+	{ID: "1153", Name: "GiftPocket", NormalizedName: "giftpocket"},
+	{ID: "1154", Name: "Glassnet", NormalizedName: "glassnet"},
+	{ID: "1158", Name: "GoogleBusinessProfile", NormalizedName: "googlebusinessprofile"},
+	{ID: "1159", Name: "GoogleMerchantCenter", NormalizedName: "googlemerchantcenter"},
+	{ID: "1160", Name: "GreenDotSmartHome", NormalizedName: "greendotsmarthome"},
+	{ID: "1161", Name: "Handy", NormalizedName: "handy"},
+	{ID: "1163", Name: "IDES", NormalizedName: "ides"},
+	{ID: "1164", Name: "iMoney", NormalizedName: "imoney"},
+	{ID: "1166", Name: "Jobber", NormalizedName: "jobber"},
+	{ID: "1167", Name: "KidsFootLocker", NormalizedName: "kidsfootlocker"},
+	{ID: "1168", Name: "Kikoff", NormalizedName: "kikoff"},
+	{ID: "1169", Name: "Kixify", NormalizedName: "kixify"},
+	{ID: "1170", Name: "LikeCard", NormalizedName: "likecard"},
+	{ID: "1171", Name: "Marcus", NormalizedName: "marcus"},
+	{ID: "1172", Name: "McMoney", NormalizedName: "mcmoney"},
+	{ID: "1173", Name: "MessageDesk", NormalizedName: "messagedesk"},
+	{ID: "1174", Name: "MicrosoftOffice365Business", NormalizedName: "microsoftoffice365business"},
+	{ID: "1175", Name: "MicrosoftOffice365E5", NormalizedName: "microsoftoffice365e5"},
+	{ID: "1176", Name: "MicrosoftOffice365Education", NormalizedName: "microsoftoffice365education"},
+	{ID: "1177", Name: "MicrosoftRewards", NormalizedName: "microsoftrewards"},
+	{ID: "1178", Name: "Millions", NormalizedName: "millions"},
+	{ID: "1179", Name: "MintVine", NormalizedName: "mintvine"},
+	{ID: "1180", Name: "MoMo", NormalizedName: "momo"},
+	{ID: "1181", Name: "MoneyGram", NormalizedName: "moneygram"},
+	{ID: "1182", Name: "Mos", NormalizedName: "mos"},
+	{ID: "1183", Name: "Mudflap", NormalizedName: "mudflap"},
+	{ID: "1185", Name: "MyRobinhood", NormalizedName: "myrobinhood"},
+	{ID: "1186", Name: "MyVoice", NormalizedName: "myvoice"},
+	{ID: "1187", Name: "myWisely", NormalizedName: "mywisely"},
+	{ID: "1188", Name: "NaturalBrainai", NormalizedName: "naturalbrainai"},
+	{ID: "1190", Name: "NFCU", NormalizedName: "nfcu"},
+	{ID: "1191", Name: "Oportun", NormalizedName: "oportun"},
+	{ID: "1192", Name: "Oxygen", NormalizedName: "oxygen"},
+	{ID: "1193", Name: "OzanSuperApp", NormalizedName: "ozansuperapp"},
+	{ID: "1194", Name: "Penfed", NormalizedName: "penfed"},
+	{ID: "1195", Name: "Pinata", NormalizedName: "pinata"},
+	{ID: "1196", Name: "RedCircle", NormalizedName: "redcircle"},
+	{ID: "1197", Name: "RI", NormalizedName: "ri"},
+	{ID: "1198", Name: "RSocks", NormalizedName: "rsocks"},
+	{ID: "1199", Name: "SafewayAlbertsons", NormalizedName: "safewayalbertsons"},
+	{ID: "1200", Name: "Santander", NormalizedName: "santander"},
+	{ID: "1201", Name: "SaverLife", NormalizedName: "saverlife"},
+	{ID: "1202", Name: "SBA", NormalizedName: "sba"},
+	{ID: "1203", Name: "SkyPrivate", NormalizedName: "skyprivate"},
+	{ID: "1204", Name: "Spruce", NormalizedName: "spruce"},
+	{ID: "1205", Name: "Stash", NormalizedName: "stash"},
+	{ID: "1206", Name: "SurePayroll", NormalizedName: "surepayroll"},
+	{ID: "1207", Name: "Switchere", NormalizedName: "switchere"},
+	{ID: "1208", Name: "Tada", NormalizedName: "tada"},
+	{ID: "1209", Name: "TaxSlayer", NormalizedName: "taxslayer"},
+	{ID: "1210", Name: "TechBubble", NormalizedName: "techbubble"},
+	{ID: "1211", Name: "Token", NormalizedName: "token"},
+	{ID: "1214", Name: "UpVoice", NormalizedName: "upvoice"},
+	{ID: "1215", Name: "USAA", NormalizedName: "usaa"},
+	{ID: "1216", Name: "ViaBill", NormalizedName: "viabill"},
+	{ID: "1217", Name: "WagerWeb", NormalizedName: "wagerweb"},
+	{ID: "1218", Name: "WalmartMoneyCard", NormalizedName: "walmartmoneycard"},
+	{ID: "1219", Name: "WelspunBrainTrust", NormalizedName: "welspunbraintrust"},
+	{ID: "1220", Name: "Weverse", NormalizedName: "weverse"},
+	{ID: "1221", Name: "WindowsXboxStore", NormalizedName: "windowsxboxstore"},
+	{ID: "1222", Name: "WireBarley", NormalizedName: "wirebarley"},
+	{ID: "1223", Name: "Wise", NormalizedName: "wise"},
+	{ID: "1224", Name: "WalmartFamilyMobile", NormalizedName: "walmartfamilymobile"},
+	{ID: "1225", Name: "xcoins", NormalizedName: "xcoins"},
+	{ID: "1226", Name: "Yeezy", NormalizedName: "yeezy"},
+	{ID: "1227", Name: "Youtube", NormalizedName: "youtube"},
+	{ID: "1229", Name: "zcom", NormalizedName: "zcom"},
+	{ID: "1230", Name: "BOSSRevolutionMoney", NormalizedName: "bossrevolutionmoney"},
+	{ID: "1231", Name: "BurgerKing", NormalizedName: "burgerking"},
+	{ID: "1232", Name: "EasyPay", NormalizedName: "easypay"},
+	{ID: "1233", Name: "FoodPanda", NormalizedName: "foodpanda"},
+	{ID: "1234", Name: "ModeEarnApp", NormalizedName: "modeearnapp"},
+	{ID: "1235", Name: "OpinionsOutpost", NormalizedName: "opinionsoutpost"},
+	{ID: "1236", Name: "PropellerAds", NormalizedName: "propellerads"},
+	{ID: "1237", Name: "RiotGames", NormalizedName: "riotgames"},
+	{ID: "1238", Name: "SnapKitchen", NormalizedName: "snapkitchen"},
+	{ID: "1239", Name: "StateFarm", NormalizedName: "statefarm"},
+	{ID: "1240", Name: "PREMIER", NormalizedName: "premier"},
+	{ID: "1241", Name: "Whatnot", NormalizedName: "whatnot"},
+	{ID: "1244", Name: "CocaCola", NormalizedName: "cocacola"},
+	{ID: "1245", Name: "TruthSocial", NormalizedName: "truthsocial"},
+	{ID: "1246", Name: "BurstSMS", NormalizedName: "burstsms"},
+	{ID: "1248", Name: "AH4R", NormalizedName: "ah4r"},
+	{ID: "1249", Name: "Hunter", NormalizedName: "hunter"},
+	{ID: "1250", Name: "LDSPlanet", NormalizedName: "ldsplanet"},
+	{ID: "1251", Name: "LoveAndSeek", NormalizedName: "loveandseek"},
+	{ID: "1252", Name: "TransformCredit", NormalizedName: "transformcredit"},
+	{ID: "1253", Name: "Webull", NormalizedName: "webull"},
+	{ID: "1254", Name: "WhiteCalling", NormalizedName: "whitecalling"},
+	{ID: "1255", Name: "RBFCU", NormalizedName: "rbfcu"},
+	{ID: "1256", Name: "Cashew", NormalizedName: "cashew"},
+	{ID: "1257", Name: "Link", NormalizedName: "link"},
+	{ID: "1258", Name: "Narvesen", NormalizedName: "narvesen"},
+	{ID: "1259", Name: "ListYourself", NormalizedName: "listyourself"},
+	{ID: "1260", Name: "CVS", NormalizedName: "cvs"},
+	{ID: "1261", Name: "RECUR", NormalizedName: "recur"},
+	{ID: "1263", Name: "Nielsen", NormalizedName: "nielsen"},
+	{ID: "1264", Name: "Upgrade", NormalizedName: "upgrade"},
+	{ID: "1265", Name: "Vanguard", NormalizedName: "vanguard"},
+	{ID: "1266", Name: "CELEBe", NormalizedName: "celebe"},
+	{ID: "1267", Name: "Eureka", NormalizedName: "eureka"},
+	{ID: "1268", Name: "GCLoot", NormalizedName: "gcloot"},
+	{ID: "1269", Name: "BetMGM", NormalizedName: "betmgm"},
+	{ID: "1270", Name: "PartyPoker", NormalizedName: "partypoker"},
+	{ID: "1271", Name: "Winden", NormalizedName: "winden"},
+	{ID: "1273", Name: "Donately", NormalizedName: "donately"},
+	{ID: "1274", Name: "Musicstream", NormalizedName: "musicstream"},
+	{ID: "1275", Name: "Beat", NormalizedName: "beat"},
+	{ID: "1276", Name: "EasyBucks", NormalizedName: "easybucks"},
+	{ID: "1277", Name: "Zolve", NormalizedName: "zolve"},
+	{ID: "1278", Name: "Bitlabs", NormalizedName: "bitlabs"},
+	{ID: "1279", Name: "Sugarbook", NormalizedName: "sugarbook"},
+	{ID: "1280", Name: "Gaintplay", NormalizedName: "gaintplay"},
+	{ID: "1281", Name: "X1CreditCard", NormalizedName: "x1creditcard"},
+	{ID: "1282", Name: "Angi", NormalizedName: "angi"},
+	{ID: "1283", Name: "Coinloot", NormalizedName: "coinloot"},
+	{ID: "1284", Name: "PGSamsBuyGet", NormalizedName: "pgsamsbuyget"},
+	{ID: "1285", Name: "Streetbeat", NormalizedName: "streetbeat"},
+	{ID: "1286", Name: "Octo", NormalizedName: "octo"},
+	{ID: "1287", Name: "FarmersOnly", NormalizedName: "farmersonly"},
+	{ID: "1289", Name: "SOAR", NormalizedName: "soar"},
+	{ID: "1290", Name: "Zen", NormalizedName: "zen"},
+	{ID: "1291", Name: "DTLR", NormalizedName: "dtlr"},
+	{ID: "1292", Name: "AARP", NormalizedName: "aarp"},
+	{ID: "1293", Name: "FeaturePoints", NormalizedName: "featurepoints"},
+	{ID: "1294", Name: "FreeNow", NormalizedName: "freenow"},
+	{ID: "1295", Name: "Linode", NormalizedName: "linode"},
+	{ID: "1296", Name: "OnlyFans", NormalizedName: "onlyfans"},
+	{ID: "1297", Name: "Flink", NormalizedName: "flink"},
+	{ID: "1298", Name: "Publiccom", NormalizedName: "publiccom"},
+	{ID: "1299", Name: "Pionex", NormalizedName: "pionex"},
+	{ID: "1300", Name: "Boo", NormalizedName: "boo"},
+	{ID: "1301", Name: "CPAGrip", NormalizedName: "cpagrip"},
+	{ID: "1302", Name: "Citizen", NormalizedName: "citizen"},
+	{ID: "1303", Name: "GG", NormalizedName: "gg"},
+	{ID: "1304", Name: "Xfinity", NormalizedName: "xfinity"},
+	{ID: "1305", Name: "Porkbun", NormalizedName: "porkbun"},
+	{ID: "1306", Name: "Nuuly", NormalizedName: "nuuly"},
+	{ID: "1307", Name: "BubbleCash", NormalizedName: "bubblecash"},
+	{ID: "1308", Name: "BingoCash", NormalizedName: "bingocash"},
+	{ID: "1309", Name: "noonShopping", NormalizedName: "noonshopping"},
+	{ID: "1310", Name: "StickerMule", NormalizedName: "stickermule"},
+	{ID: "1311", Name: "Revel", NormalizedName: "revel"},
+	{ID: "1312", Name: "Baselane", NormalizedName: "baselane"},
+	{ID: "1313", Name: "Chipotle", NormalizedName: "chipotle"},
+	{ID: "1314", Name: "Poe", NormalizedName: "poe"},
+	{ID: "1315", Name: "SudsCarWash", NormalizedName: "sudscarwash"},
+	{ID: "1316", Name: "Doctoralia", NormalizedName: "doctoralia"},
+	{ID: "1317", Name: "Dana", NormalizedName: "dana"},
+	{ID: "1318", Name: "Asbucks", NormalizedName: "asbucks"},
+	{ID: "1319", Name: "PaidCash", NormalizedName: "paidcash"},
+	{ID: "1320", Name: "MySpendWell", NormalizedName: "myspendwell"},
+	{ID: "1321", Name: "GreenDotGo2BankGoBank", NormalizedName: "greendotgo2bankgobank"},
+	{ID: "1322", Name: "Klover", NormalizedName: "klover"},
+	{ID: "1323", Name: "Gappx", NormalizedName: "gappx"},
+	{ID: "1324", Name: "LuckyPlay", NormalizedName: "luckyplay"},
+	{ID: "1325", Name: "Chevron", NormalizedName: "chevron"},
+	{ID: "1326", Name: "Maza", NormalizedName: "maza"},
+	{ID: "1327", Name: "Twig", NormalizedName: "twig"},
+	{ID: "1328", Name: "OpenPlayground", NormalizedName: "openplayground"},
+	{ID: "1329", Name: "Line2", NormalizedName: "line2"},
+	{ID: "1330", Name: "Slips", NormalizedName: "slips"},
+	{ID: "1331", Name: "Coincasper", NormalizedName: "coincasper"},
+	{ID: "1332", Name: "Bet365", NormalizedName: "bet365"},
+	{ID: "1333", Name: "Cupis", NormalizedName: "cupis"},
+	{ID: "1334", Name: "Play4", NormalizedName: "play4"},
+	{ID: "1335", Name: "Fruitz", NormalizedName: "fruitz"},
+	{ID: "1337", Name: "BankOfAmerica", NormalizedName: "bankofamerica"},
+	{ID: "1338", Name: "Pleo", NormalizedName: "pleo"},
+	{ID: "1339", Name: "VCollective", NormalizedName: "vcollective"},
+	{ID: "1340", Name: "Kaching", NormalizedName: "kaching"},
+	{ID: "1341", Name: "Aliexpress", NormalizedName: "aliexpress"},
+	{ID: "1342", Name: "Dosi", NormalizedName: "dosi"},
+	{ID: "1343", Name: "FreeCryptoRewards", NormalizedName: "freecryptorewards"},
+	{ID: "1344", Name: "Seis", NormalizedName: "seis"},
+	{ID: "1345", Name: "Earnly", NormalizedName: "earnly"},
+	{ID: "1346", Name: "TEMU", NormalizedName: "temu"},
+	{ID: "1347", Name: "ElGrocer", NormalizedName: "elgrocer"},
+	{ID: "1348", Name: "Spectrum", NormalizedName: "spectrum"},
+	{ID: "1349", Name: "Zaxby", NormalizedName: "zaxby"},
+	{ID: "1350", Name: "Appinio", NormalizedName: "appinio"},
+	{ID: "1351", Name: "IdentiteNumerique", NormalizedName: "identitenumerique"},
+	{ID: "1352", Name: "RGBI", NormalizedName: "rgbi"},
+	{ID: "1354", Name: "TradeUp", NormalizedName: "tradeup"},
+	{ID: "1355", Name: "DubClub", NormalizedName: "dubclub"},
+	{ID: "1356", Name: "TapTap", NormalizedName: "taptap"},
+	{ID: "1357", Name: "Markid", NormalizedName: "markid"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDAfghanistan                 CountryID = "AF"
+	CountryIDAlandIslands                CountryID = "AX"
+	CountryIDAlbania                     CountryID = "AL"
+	CountryIDAlgeria                     CountryID = "DZ"
+	CountryIDAmericanSamoa               CountryID = "AS"
+	CountryIDAndorra                     CountryID = "AD"
+	CountryIDAngola                      CountryID = "AO"
+	CountryIDAnguilla                    CountryID = "AI"
+	CountryIDAntiguaBarbuda              CountryID = "AG"
+	CountryIDArgentina                   CountryID = "AR"
+	CountryIDArmenia                     CountryID = "AM"
+	CountryIDAruba                       CountryID = "AW"
+	CountryIDAscensionIsland             CountryID = "AC"
+	CountryIDAustralia                   CountryID = "AU"
+	CountryIDAustria                     CountryID = "AT"
+	CountryIDAzerbaijan                  CountryID = "AZ"
+	CountryIDBahamas                     CountryID = "BS"
+	CountryIDBahrain                     CountryID = "BH"
+	CountryIDBangladesh                  CountryID = "BD"
+	CountryIDBarbados                    CountryID = "BB"
+	CountryIDBelarus                     CountryID = "BY"
+	CountryIDBelgium                     CountryID = "BE"
+	CountryIDBelize                      CountryID = "BZ"
+	CountryIDBenin                       CountryID = "BJ"
+	CountryIDBermuda                     CountryID = "BM"
+	CountryIDBhutan                      CountryID = "BT"
+	CountryIDBolivia                     CountryID = "BO"
+	CountryIDBosniaHerzegovina           CountryID = "BA"
+	CountryIDBotswana                    CountryID = "BW"
+	CountryIDBrazil                      CountryID = "BR"
+	CountryIDBritishIndianOceanTerritory CountryID = "IO"
+	CountryIDBritishVirginIslands        CountryID = "VG"
+	CountryIDBrunei                      CountryID = "BN"
+	CountryIDBulgaria                    CountryID = "BG"
+	CountryIDBurkinaFaso                 CountryID = "BF"
+	CountryIDBurundi                     CountryID = "BI"
+	CountryIDCambodia                    CountryID = "KH"
+	CountryIDCameroon                    CountryID = "CM"
+	CountryIDCanada                      CountryID = "CA"
+	CountryIDCapeVerde                   CountryID = "CV"
+	CountryIDCaribbeanNetherlands        CountryID = "BQ"
+	CountryIDCaymanIslands               CountryID = "KY"
+	CountryIDCentralAfricanRepublic      CountryID = "CF"
+	CountryIDChad                        CountryID = "TD"
+	CountryIDChile                       CountryID = "CL"
+	CountryIDChina                       CountryID = "CN"
+	CountryIDChristmasIsland             CountryID = "CX"
+	CountryIDCocosKeelingIslands         CountryID = "CC"
+	CountryIDColombia                    CountryID = "CO"
+	CountryIDComoros                     CountryID = "KM"
+	CountryIDCongoBrazzaville            CountryID = "CG"
+	CountryIDCongoKinshasa               CountryID = "CD"
+	CountryIDCookIslands                 CountryID = "CK"
+	CountryIDCostaRica                   CountryID = "CR"
+	CountryIDCoteDIvoire                 CountryID = "CI"
+	CountryIDCroatia                     CountryID = "HR"
+	CountryIDCuba                        CountryID = "CU"
+	CountryIDCuracao                     CountryID = "CW"
+	CountryIDCyprus                      CountryID = "CY"
+	CountryIDCzechia                     CountryID = "CZ"
+	CountryIDDenmark                     CountryID = "DK"
+	CountryIDDjibouti                    CountryID = "DJ"
+	CountryIDDominica                    CountryID = "DM"
+	CountryIDDominicanRepublic           CountryID = "DO"
+	CountryIDEcuador                     CountryID = "EC"
+	CountryIDEgypt                       CountryID = "EG"
+	CountryIDElSalvador                  CountryID = "SV"
+	CountryIDEquatorialGuinea            CountryID = "GQ"
+	CountryIDEritrea                     CountryID = "ER"
+	CountryIDEstonia                     CountryID = "EE"
+	CountryIDEthiopia                    CountryID = "ET"
+	CountryIDFalklandIslands             CountryID = "FK"
+	CountryIDFaroeIslands                CountryID = "FO"
+	CountryIDFiji                        CountryID = "FJ"
+	CountryIDFinland                     CountryID = "FI"
+	CountryIDFrance                      CountryID = "FR"
+	CountryIDFrenchGuiana                CountryID = "GF"
+	CountryIDFrenchPolynesia             CountryID = "PF"
+	CountryIDGabon                       CountryID = "GA"
+	CountryIDGambia                      CountryID = "GM"
+	CountryIDGeorgia                     CountryID = "GE"
+	CountryIDGermany                     CountryID = "DE"
+	CountryIDGhana                       CountryID = "GH"
+	CountryIDGibraltar                   CountryID = "GI"
+	CountryIDGreece                      CountryID = "GR"
+	CountryIDGreenland                   CountryID = "GL"
+	CountryIDGrenada                     CountryID = "GD"
+	CountryIDGuadeloupe                  CountryID = "GP"
+	CountryIDGuam                        CountryID = "GU"
+	CountryIDGuatemala                   CountryID = "GT"
+	CountryIDGuernsey                    CountryID = "GG"
+	CountryIDGuinea                      CountryID = "GN"
+	CountryIDGuineaBissau                CountryID = "GW"
+	CountryIDGuyana                      CountryID = "GY"
+	CountryIDHaiti                       CountryID = "HT"
+	CountryIDHonduras                    CountryID = "HN"
+	CountryIDHongKongSARChina            CountryID = "HK"
+	CountryIDHungary                     CountryID = "HU"
+	CountryIDIceland                     CountryID = "IS"
+	CountryIDIndia                       CountryID = "IN"
+	CountryIDIndonesia                   CountryID = "ID"
+	CountryIDIran                        CountryID = "IR"
+	CountryIDIraq                        CountryID = "IQ"
+	CountryIDIreland                     CountryID = "IE"
+	CountryIDIsleOfMan                   CountryID = "IM"
+	CountryIDIsrael                      CountryID = "IL"
+	CountryIDItaly                       CountryID = "IT"
+	CountryIDJamaica                     CountryID = "JM"
+	CountryIDJapan                       CountryID = "JP"
+	CountryIDJersey                      CountryID = "JE"
+	CountryIDJordan                      CountryID = "JO"
+	CountryIDKazakhstan                  CountryID = "KZ"
+	CountryIDKenya                       CountryID = "KE"
+	CountryIDKiribati                    CountryID = "KI"
+	CountryIDKosovo                      CountryID = "XK"
+	CountryIDKuwait                      CountryID = "KW"
+	CountryIDKyrgyzstan                  CountryID = "KG"
+	CountryIDLaos                        CountryID = "LA"
+	CountryIDLatvia                      CountryID = "LV"
+	CountryIDLebanon                     CountryID = "LB"
+	CountryIDLesotho                     CountryID = "LS"
+	CountryIDLiberia                     CountryID = "LR"
+	CountryIDLibya                       CountryID = "LY"
+	CountryIDLiechtenstein               CountryID = "LI"
+	CountryIDLithuania                   CountryID = "LT"
+	CountryIDLuxembourg                  CountryID = "LU"
+	CountryIDMacauSARChina               CountryID = "MO"
+	CountryIDMacedonia                   CountryID = "MK"
+	CountryIDMadagascar                  CountryID = "MG"
+	CountryIDMalawi                      CountryID = "MW"
+	CountryIDMalaysia                    CountryID = "MY"
+	CountryIDMaldives                    CountryID = "MV"
+	CountryIDMali                        CountryID = "ML"
+	CountryIDMalta                       CountryID = "MT"
+	CountryIDMarshallIslands             CountryID = "MH"
+	CountryIDMartinique                  CountryID = "MQ"
+	CountryIDMauritania                  CountryID = "MR"
+	CountryIDMauritius                   CountryID = "MU"
+	CountryIDMayotte                     CountryID = "YT"
+	CountryIDMexico                      CountryID = "MX"
+	CountryIDMicronesia                  CountryID = "FM"
+	CountryIDMoldova                     CountryID = "MD"
+	CountryIDMonaco                      CountryID = "MC"
+	CountryIDMongolia                    CountryID = "MN"
+	CountryIDMontenegro                  CountryID = "ME"
+	CountryIDMontserrat                  CountryID = "MS"
+	CountryIDMorocco                     CountryID = "MA"
+	CountryIDMozambique                  CountryID = "MZ"
+	CountryIDMyanmarBurma                CountryID = "MM"
+	CountryIDNamibia                     CountryID = "NA"
+	CountryIDNauru                       CountryID = "NR"
+	CountryIDNepal                       CountryID = "NP"
+	CountryIDNetherlands                 CountryID = "NL"
+	CountryIDNewCaledonia                CountryID = "NC"
+	CountryIDNewZealand                  CountryID = "NZ"
+	CountryIDNicaragua                   CountryID = "NI"
+	CountryIDNiger                       CountryID = "NE"
+	CountryIDNigeria                     CountryID = "NG"
+	CountryIDNiue                        CountryID = "NU"
+	CountryIDNorfolkIsland               CountryID = "NF"
+	CountryIDNorthernMarianaIslands      CountryID = "MP"
+	CountryIDNorthKorea                  CountryID = "KP"
+	CountryIDNorway                      CountryID = "NO"
+	CountryIDOman                        CountryID = "OM"
+	CountryIDPakistan                    CountryID = "PK"
+	CountryIDPalau                       CountryID = "PW"
+	CountryIDPalestinianTerritories      CountryID = "PS"
+	CountryIDPanama                      CountryID = "PA"
+	CountryIDPapuaNewGuinea              CountryID = "PG"
+	CountryIDParaguay                    CountryID = "PY"
+	CountryIDPeru                        CountryID = "PE"
+	CountryIDPhilippines                 CountryID = "PH"
+	CountryIDPoland                      CountryID = "PL"
+	CountryIDPortugal                    CountryID = "PT"
+	CountryIDPuertoRico                  CountryID = "PR"
+	CountryIDQatar                       CountryID = "QA"
+	CountryIDReunion                     CountryID = "RE"
+	CountryIDRomania                     CountryID = "RO"
+	CountryIDRussia                      CountryID = "RU"
+	CountryIDRwanda                      CountryID = "RW"
+	CountryIDSamoa                       CountryID = "WS"
+	CountryIDSanMarino                   CountryID = "SM"
+	CountryIDSaoTomePrincipe             CountryID = "ST"
+	CountryIDSaudiArabia                 CountryID = "SA"
+	CountryIDSenegal                     CountryID = "SN"
+	CountryIDSerbia                      CountryID = "RS"
+	CountryIDSeychelles                  CountryID = "SC"
+	CountryIDSierraLeone                 CountryID = "SL"
+	CountryIDSingapore                   CountryID = "SG"
+	CountryIDSintMaarten                 CountryID = "SX"
+	CountryIDSlovakia                    CountryID = "SK"
+	CountryIDSlovenia                    CountryID = "SI"
+	CountryIDSolomonIslands              CountryID = "SB"
+	CountryIDSomalia                     CountryID = "SO"
+	CountryIDSouthAfrica                 CountryID = "ZA"
+	CountryIDSouthKorea                  CountryID = "KR"
+	CountryIDSouthSudan                  CountryID = "SS"
+	CountryIDSpain                       CountryID = "ES"
+	CountryIDSriLanka                    CountryID = "LK"
+	CountryIDStBarthelemy                CountryID = "BL"
+	CountryIDStHelena                    CountryID = "SH"
+	CountryIDStKittsNevis                CountryID = "KN"
+	CountryIDStLucia                     CountryID = "LC"
+	CountryIDStMartin                    CountryID = "MF"
+	CountryIDStPierreMiquelon            CountryID = "PM"
+	CountryIDStVincentGrenadines         CountryID = "VC"
+	CountryIDSudan                       CountryID = "SD"
+	CountryIDSuriname                    CountryID = "SR"
+	CountryIDSvalbardJanMayen            CountryID = "SJ"
+	CountryIDSwaziland                   CountryID = "SZ"
+	CountryIDSweden                      CountryID = "SE"
+	CountryIDSwitzerland                 CountryID = "CH"
+	CountryIDSyria                       CountryID = "SY"
+	CountryIDTaiwan                      CountryID = "TW"
+	CountryIDTajikistan                  CountryID = "TJ"
+	CountryIDTanzania                    CountryID = "TZ"
+	CountryIDThailand                    CountryID = "TH"
+	CountryIDTimorLeste                  CountryID = "TL"
+	CountryIDTogo                        CountryID = "TG"
+	CountryIDTokelau                     CountryID = "TK"
+	CountryIDTonga                       CountryID = "TO"
+	CountryIDTrinidadTobago              CountryID = "TT"
+	CountryIDTristanDaCunha              CountryID = "TA"
+	CountryIDTunisia                     CountryID = "TN"
+	CountryIDTurkey                      CountryID = "TR"
+	CountryIDTurkmenistan                CountryID = "TM"
+	CountryIDTurksCaicosIslands          CountryID = "TC"
+	CountryIDTuvalu                      CountryID = "TV"
+	CountryIDUganda                      CountryID = "UG"
+	CountryIDUkraine                     CountryID = "UA"
+	CountryIDUnitedArabEmirates          CountryID = "AE"
+	CountryIDUnitedKingdom               CountryID = "GB"
+	CountryIDUnitedStates                CountryID = "US"
+	CountryIDUruguay                     CountryID = "UY"
+	CountryIDUSVirginIslands             CountryID = "VI"
+	CountryIDUzbekistan                  CountryID = "UZ"
+	CountryIDVanuatu                     CountryID = "VU"
+	CountryIDVaticanCity                 CountryID = "VA"
+	CountryIDVenezuela                   CountryID = "VE"
+	CountryIDVietnam                     CountryID = "VN"
+	CountryIDWallisFutuna                CountryID = "WF"
+	CountryIDWesternSahara               CountryID = "EH"
+	CountryIDYemen                       CountryID = "YE"
+	CountryIDZambia                      CountryID = "ZM"
+	CountryIDZimbabwe                    CountryID = "ZW"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDAfghanistan instead.
+	CountryAfghanistan = "AF"
+	// Deprecated: use CountryIDAlandIslands instead.
+	CountryAlandIslands = "AX"
+	// Deprecated: use CountryIDAlbania instead.
+	CountryAlbania = "AL"
+	// Deprecated: use CountryIDAlgeria instead.
+	CountryAlgeria = "DZ"
+	// Deprecated: use CountryIDAmericanSamoa instead.
+	CountryAmericanSamoa = "AS"
+	// Deprecated: use CountryIDAndorra instead.
+	CountryAndorra = "AD"
+	// Deprecated: use CountryIDAngola instead.
+	CountryAngola = "AO"
+	// Deprecated: use CountryIDAnguilla instead.
+	CountryAnguilla = "AI"
+	// Deprecated: use CountryIDAntiguaBarbuda instead.
+	CountryAntiguaBarbuda = "AG"
+	// Deprecated: use CountryIDArgentina instead.
+	CountryArgentina = "AR"
+	// Deprecated: use CountryIDArmenia instead.
+	CountryArmenia = "AM"
+	// Deprecated: use CountryIDAruba instead.
+	CountryAruba = "AW"
+	// Deprecated: use CountryIDAscensionIsland instead.
+	CountryAscensionIsland = "AC"
+	// Deprecated: use CountryIDAustralia instead.
+	CountryAustralia = "AU"
+	// Deprecated: use CountryIDAustria instead.
+	CountryAustria = "AT"
+	// Deprecated: use CountryIDAzerbaijan instead.
+	CountryAzerbaijan = "AZ"
+	// Deprecated: use CountryIDBahamas instead.
+	CountryBahamas = "BS"
+	// Deprecated: use CountryIDBahrain instead.
+	CountryBahrain = "BH"
+	// Deprecated: use CountryIDBangladesh instead.
+	CountryBangladesh = "BD"
+	// Deprecated: use CountryIDBarbados instead.
+	CountryBarbados = "BB"
+	// Deprecated: use CountryIDBelarus instead.
+	CountryBelarus = "BY"
+	// Deprecated: use CountryIDBelgium instead.
+	CountryBelgium = "BE"
+	// Deprecated: use CountryIDBelize instead.
+	CountryBelize = "BZ"
+	// Deprecated: use CountryIDBenin instead.
+	CountryBenin = "BJ"
+	// Deprecated: use CountryIDBermuda instead.
+	CountryBermuda = "BM"
+	// Deprecated: use CountryIDBhutan instead.
+	CountryBhutan = "BT"
+	// Deprecated: use CountryIDBolivia instead.
+	CountryBolivia = "BO"
+	// Deprecated: use CountryIDBosniaHerzegovina instead.
+	CountryBosniaHerzegovina = "BA"
+	// Deprecated: use CountryIDBotswana instead.
+	CountryBotswana = "BW"
+	// Deprecated: use CountryIDBrazil instead.
+	CountryBrazil = "BR"
+	// Deprecated: use CountryIDBritishIndianOceanTerritory instead.
+	CountryBritishIndianOceanTerritory = "IO"
+	// Deprecated: use CountryIDBritishVirginIslands instead.
+	CountryBritishVirginIslands = "VG"
+	// Deprecated: use CountryIDBrunei instead.
+	CountryBrunei = "BN"
+	// Deprecated: use CountryIDBulgaria instead.
+	CountryBulgaria = "BG"
+	// Deprecated: use CountryIDBurkinaFaso instead.
+	CountryBurkinaFaso = "BF"
+	// Deprecated: use CountryIDBurundi instead.
+	CountryBurundi = "BI"
+	// Deprecated: use CountryIDCambodia instead.
+	CountryCambodia = "KH"
+	// Deprecated: use CountryIDCameroon instead.
+	CountryCameroon = "CM"
+	// Deprecated: use CountryIDCanada instead.
+	CountryCanada = "CA"
+	// Deprecated: use CountryIDCapeVerde instead.
+	CountryCapeVerde = "CV"
+	// Deprecated: use CountryIDCaribbeanNetherlands instead.
+	CountryCaribbeanNetherlands = "BQ"
+	// Deprecated: use CountryIDCaymanIslands instead.
+	CountryCaymanIslands = "KY"
+	// Deprecated: use CountryIDCentralAfricanRepublic instead.
+	CountryCentralAfricanRepublic = "CF"
+	// Deprecated: use CountryIDChad instead.
+	CountryChad = "TD"
+	// Deprecated: use CountryIDChile instead.
+	CountryChile = "CL"
+	// Deprecated: use CountryIDChina instead.
+	CountryChina = "CN"
+	// Deprecated: use CountryIDChristmasIsland instead.
+	CountryChristmasIsland = "CX"
+	// Deprecated: use CountryIDCocosKeelingIslands instead.
+	CountryCocosKeelingIslands = "CC"
+	// Deprecated: use CountryIDColombia instead.
+	CountryColombia = "CO"
+	// Deprecated: use CountryIDComoros instead.
+	CountryComoros = "KM"
+	// Deprecated: use CountryIDCongoBrazzaville instead.
+	CountryCongoBrazzaville = "CG"
+	// Deprecated: use CountryIDCongoKinshasa instead.
+	CountryCongoKinshasa = "CD"
+	// Deprecated: use CountryIDCookIslands instead.
+	CountryCookIslands = "CK"
+	// Deprecated: use CountryIDCostaRica instead.
+	CountryCostaRica = "CR"
+	// Deprecated: use CountryIDCoteDIvoire instead.
+	CountryCoteDIvoire = "CI"
+	// Deprecated: use CountryIDCroatia instead.
+	CountryCroatia = "HR"
+	// Deprecated: use CountryIDCuba instead.
+	CountryCuba = "CU"
+	// Deprecated: use CountryIDCuracao instead.
+	CountryCuracao = "CW"
+	// Deprecated: use CountryIDCyprus instead.
+	CountryCyprus = "CY"
+	// Deprecated: use CountryIDCzechia instead.
+	CountryCzechia = "CZ"
+	// Deprecated: use CountryIDDenmark instead.
+	CountryDenmark = "DK"
+	// Deprecated: use CountryIDDjibouti instead.
+	CountryDjibouti = "DJ"
+	// Deprecated: use CountryIDDominica instead.
+	CountryDominica = "DM"
+	// Deprecated: use CountryIDDominicanRepublic instead.
+	CountryDominicanRepublic = "DO"
+	// Deprecated: use CountryIDEcuador instead.
+	CountryEcuador = "EC"
+	// Deprecated: use CountryIDEgypt instead.
+	CountryEgypt = "EG"
+	// Deprecated: use CountryIDElSalvador instead.
+	CountryElSalvador = "SV"
+	// Deprecated: use CountryIDEquatorialGuinea instead.
+	CountryEquatorialGuinea = "GQ"
+	// Deprecated: use CountryIDEritrea instead.
+	CountryEritrea = "ER"
+	// Deprecated: use CountryIDEstonia instead.
+	CountryEstonia = "EE"
+	// Deprecated: use CountryIDEthiopia instead.
+	CountryEthiopia = "ET"
+	// Deprecated: use CountryIDFalklandIslands instead.
+	CountryFalklandIslands = "FK"
+	// Deprecated: use CountryIDFaroeIslands instead.
+	CountryFaroeIslands = "FO"
+	// Deprecated: use CountryIDFiji instead.
+	CountryFiji = "FJ"
+	// Deprecated: use CountryIDFinland instead.
+	CountryFinland = "FI"
+	// Deprecated: use CountryIDFrance instead.
+	CountryFrance = "FR"
+	// Deprecated: use CountryIDFrenchGuiana instead.
+	CountryFrenchGuiana = "GF"
+	// Deprecated: use CountryIDFrenchPolynesia instead.
+	CountryFrenchPolynesia = "PF"
+	// Deprecated: use CountryIDGabon instead.
+	CountryGabon = "GA"
+	// Deprecated: use CountryIDGambia instead.
+	CountryGambia = "GM"
+	// Deprecated: use CountryIDGeorgia instead.
+	CountryGeorgia = "GE"
+	// Deprecated: use CountryIDGermany instead.
+	CountryGermany = "DE"
+	// Deprecated: use CountryIDGhana instead.
+	CountryGhana = "GH"
+	// Deprecated: use CountryIDGibraltar instead.
+	CountryGibraltar = "GI"
+	// Deprecated: use CountryIDGreece instead.
+	CountryGreece = "GR"
+	// Deprecated: use CountryIDGreenland instead.
+	CountryGreenland = "GL"
+	// Deprecated: use CountryIDGrenada instead.
+	CountryGrenada = "GD"
+	// Deprecated: use CountryIDGuadeloupe instead.
+	CountryGuadeloupe = "GP"
+	// Deprecated: use CountryIDGuam instead.
+	CountryGuam = "GU"
+	// Deprecated: use CountryIDGuatemala instead.
+	CountryGuatemala = "GT"
+	// Deprecated: use CountryIDGuernsey instead.
+	CountryGuernsey = "GG"
+	// Deprecated: use CountryIDGuinea instead.
+	CountryGuinea = "GN"
+	// Deprecated: use CountryIDGuineaBissau instead.
+	CountryGuineaBissau = "GW"
+	// Deprecated: use CountryIDGuyana instead.
+	CountryGuyana = "GY"
+	// Deprecated: use CountryIDHaiti instead.
+	CountryHaiti = "HT"
+	// Deprecated: use CountryIDHonduras instead.
+	CountryHonduras = "HN"
+	// Deprecated: use CountryIDHongKongSARChina instead.
+	CountryHongKongSARChina = "HK"
+	// Deprecated: use CountryIDHungary instead.
+	CountryHungary = "HU"
+	// Deprecated: use CountryIDIceland instead.
+	CountryIceland = "IS"
+	// Deprecated: use CountryIDIndia instead.
+	CountryIndia = "IN"
+	// Deprecated: use CountryIDIndonesia instead.
+	CountryIndonesia = "ID"
+	// Deprecated: use CountryIDIran instead.
+	CountryIran = "IR"
+	// Deprecated: use CountryIDIraq instead.
+	CountryIraq = "IQ"
+	// Deprecated: use CountryIDIreland instead.
+	CountryIreland = "IE"
+	// Deprecated: use CountryIDIsleOfMan instead.
+	CountryIsleOfMan = "IM"
+	// Deprecated: use CountryIDIsrael instead.
+	CountryIsrael = "IL"
+	// Deprecated: use CountryIDItaly instead.
+	CountryItaly = "IT"
+	// Deprecated: use CountryIDJamaica instead.
+	CountryJamaica = "JM"
+	// Deprecated: use CountryIDJapan instead.
+	CountryJapan = "JP"
+	// Deprecated: use CountryIDJersey instead.
+	CountryJersey = "JE"
+	// Deprecated: use CountryIDJordan instead.
+	CountryJordan = "JO"
+	// Deprecated: use CountryIDKazakhstan instead.
+	CountryKazakhstan = "KZ"
+	// Deprecated: use CountryIDKenya instead.
+	CountryKenya = "KE"
+	// Deprecated: use CountryIDKiribati instead.
+	CountryKiribati = "KI"
+	// Deprecated: use CountryIDKosovo instead.
+	CountryKosovo = "XK"
+	// Deprecated: use CountryIDKuwait instead.
+	CountryKuwait = "KW"
+	// Deprecated: use CountryIDKyrgyzstan instead.
+	CountryKyrgyzstan = "KG"
+	// Deprecated: use CountryIDLaos instead.
+	CountryLaos = "LA"
+	// Deprecated: use CountryIDLatvia instead.
+	CountryLatvia = "LV"
+	// Deprecated: use CountryIDLebanon instead.
+	CountryLebanon = "LB"
+	// Deprecated: use CountryIDLesotho instead.
+	CountryLesotho = "LS"
+	// Deprecated: use CountryIDLiberia instead.
+	CountryLiberia = "LR"
+	// Deprecated: use CountryIDLibya instead.
+	CountryLibya = "LY"
+	// Deprecated: use CountryIDLiechtenstein instead.
+	CountryLiechtenstein = "LI"
+	// Deprecated: use CountryIDLithuania instead.
+	CountryLithuania = "LT"
+	// Deprecated: use CountryIDLuxembourg instead.
+	CountryLuxembourg = "LU"
+	// Deprecated: use CountryIDMacauSARChina instead.
+	CountryMacauSARChina = "MO"
+	// Deprecated: use CountryIDMacedonia instead.
+	CountryMacedonia = "MK"
+	// Deprecated: use CountryIDMadagascar instead.
+	CountryMadagascar = "MG"
+	// Deprecated: use CountryIDMalawi instead.
+	CountryMalawi = "MW"
+	// Deprecated: use CountryIDMalaysia instead.
+	CountryMalaysia = "MY"
+	// Deprecated: use CountryIDMaldives instead.
+	CountryMaldives = "MV"
+	// Deprecated: use CountryIDMali instead.
+	CountryMali = "ML"
+	// Deprecated: use CountryIDMalta instead.
+	CountryMalta = "MT"
+	// Deprecated: use CountryIDMarshallIslands instead.
+	CountryMarshallIslands = "MH"
+	// Deprecated: use CountryIDMartinique instead.
+	CountryMartinique = "MQ"
+	// Deprecated: use CountryIDMauritania instead.
+	CountryMauritania = "MR"
+	// Deprecated: use CountryIDMauritius instead.
+	CountryMauritius = "MU"
+	// Deprecated: use CountryIDMayotte instead.
+	CountryMayotte = "YT"
+	// Deprecated: use CountryIDMexico instead.
+	CountryMexico = "MX"
+	// Deprecated: use CountryIDMicronesia instead.
+	CountryMicronesia = "FM"
+	// Deprecated: use CountryIDMoldova instead.
+	CountryMoldova = "MD"
+	// Deprecated: use CountryIDMonaco instead.
+	CountryMonaco = "MC"
+	// Deprecated: use CountryIDMongolia instead.
+	CountryMongolia = "MN"
+	// Deprecated: use CountryIDMontenegro instead.
+	CountryMontenegro = "ME"
+	// Deprecated: use CountryIDMontserrat instead.
+	CountryMontserrat = "MS"
+	// Deprecated: use CountryIDMorocco instead.
+	CountryMorocco = "MA"
+	// Deprecated: use CountryIDMozambique instead.
+	CountryMozambique = "MZ"
+	// Deprecated: use CountryIDMyanmarBurma instead.
+	CountryMyanmarBurma = "MM"
+	// Deprecated: use CountryIDNamibia instead.
+	CountryNamibia = "NA"
+	// Deprecated: use CountryIDNauru instead.
+	CountryNauru = "NR"
+	// Deprecated: use CountryIDNepal instead.
+	CountryNepal = "NP"
+	// Deprecated: use CountryIDNetherlands instead.
+	CountryNetherlands = "NL"
+	// Deprecated: use CountryIDNewCaledonia instead.
+	CountryNewCaledonia = "NC"
+	// Deprecated: use CountryIDNewZealand instead.
+	CountryNewZealand = "NZ"
+	// Deprecated: use CountryIDNicaragua instead.
+	CountryNicaragua = "NI"
+	// Deprecated: use CountryIDNiger instead.
+	CountryNiger = "NE"
+	// Deprecated: use CountryIDNigeria instead.
+	CountryNigeria = "NG"
+	// Deprecated: use CountryIDNiue instead.
+	CountryNiue = "NU"
+	// Deprecated: use CountryIDNorfolkIsland instead.
+	CountryNorfolkIsland = "NF"
+	// Deprecated: use CountryIDNorthernMarianaIslands instead.
+	CountryNorthernMarianaIslands = "MP"
+	// Deprecated: use CountryIDNorthKorea instead.
+	CountryNorthKorea = "KP"
+	// Deprecated: use CountryIDNorway instead.
+	CountryNorway = "NO"
+	// Deprecated: use CountryIDOman instead.
+	CountryOman = "OM"
+	// Deprecated: use CountryIDPakistan instead.
+	CountryPakistan = "PK"
+	// Deprecated: use CountryIDPalau instead.
+	CountryPalau = "PW"
+	// Deprecated: use CountryIDPalestinianTerritories instead.
+	CountryPalestinianTerritories = "PS"
+	// Deprecated: use CountryIDPanama instead.
+	CountryPanama = "PA"
+	// Deprecated: use CountryIDPapuaNewGuinea instead.
+	CountryPapuaNewGuinea = "PG"
+	// Deprecated: use CountryIDParaguay instead.
+	CountryParaguay = "PY"
+	// Deprecated: use CountryIDPeru instead.
+	CountryPeru = "PE"
+	// Deprecated: use CountryIDPhilippines instead.
+	CountryPhilippines = "PH"
+	// Deprecated: use CountryIDPoland instead.
+	CountryPoland = "PL"
+	// Deprecated: use CountryIDPortugal instead.
+	CountryPortugal = "PT"
+	// Deprecated: use CountryIDPuertoRico instead.
+	CountryPuertoRico = "PR"
+	// Deprecated: use CountryIDQatar instead.
+	CountryQatar = "QA"
+	// Deprecated: use CountryIDReunion instead.
+	CountryReunion = "RE"
+	// Deprecated: use CountryIDRomania instead.
+	CountryRomania = "RO"
+	// Deprecated: use CountryIDRussia instead.
+	CountryRussia = "RU"
+	// Deprecated: use CountryIDRwanda instead.
+	CountryRwanda = "RW"
+	// Deprecated: use CountryIDSamoa instead.
+	CountrySamoa = "WS"
+	// Deprecated: use CountryIDSanMarino instead.
+	CountrySanMarino = "SM"
+	// Deprecated: use CountryIDSaoTomePrincipe instead.
+	CountrySaoTomePrincipe = "ST"
+	// Deprecated: use CountryIDSaudiArabia instead.
+	CountrySaudiArabia = "SA"
+	// Deprecated: use CountryIDSenegal instead.
+	CountrySenegal = "SN"
+	// Deprecated: use CountryIDSerbia instead.
+	CountrySerbia = "RS"
+	// Deprecated: use CountryIDSeychelles instead.
+	CountrySeychelles = "SC"
+	// Deprecated: use CountryIDSierraLeone instead.
+	CountrySierraLeone = "SL"
+	// Deprecated: use CountryIDSingapore instead.
+	CountrySingapore = "SG"
+	// Deprecated: use CountryIDSintMaarten instead.
+	CountrySintMaarten = "SX"
+	// Deprecated: use CountryIDSlovakia instead.
+	CountrySlovakia = "SK"
+	// Deprecated: use CountryIDSlovenia instead.
+	CountrySlovenia = "SI"
+	// Deprecated: use CountryIDSolomonIslands instead.
+	CountrySolomonIslands = "SB"
+	// Deprecated: use CountryIDSomalia instead.
+	CountrySomalia = "SO"
+	// Deprecated: use CountryIDSouthAfrica instead.
+	CountrySouthAfrica = "ZA"
+	// Deprecated: use CountryIDSouthKorea instead.
+	CountrySouthKorea = "KR"
+	// Deprecated: use CountryIDSouthSudan instead.
+	CountrySouthSudan = "SS"
+	// Deprecated: use CountryIDSpain instead.
+	CountrySpain = "ES"
+	// Deprecated: use CountryIDSriLanka instead.
+	CountrySriLanka = "LK"
+	// Deprecated: use CountryIDStBarthelemy instead.
+	CountryStBarthelemy = "BL"
+	// Deprecated: use CountryIDStHelena instead.
+	CountryStHelena = "SH"
+	// Deprecated: use CountryIDStKittsNevis instead.
+	CountryStKittsNevis = "KN"
+	// Deprecated: use CountryIDStLucia instead.
+	CountryStLucia = "LC"
+	// Deprecated: use CountryIDStMartin instead.
+	CountryStMartin = "MF"
+	// Deprecated: use CountryIDStPierreMiquelon instead.
+	CountryStPierreMiquelon = "PM"
+	// Deprecated: use CountryIDStVincentGrenadines instead.
+	CountryStVincentGrenadines = "VC"
+	// Deprecated: use CountryIDSudan instead.
+	CountrySudan = "SD"
+	// Deprecated: use CountryIDSuriname instead.
+	CountrySuriname = "SR"
+	// Deprecated: use CountryIDSvalbardJanMayen instead.
+	CountrySvalbardJanMayen = "SJ"
+	// Deprecated: use CountryIDSwaziland instead.
+	CountrySwaziland = "SZ"
+	// Deprecated: use CountryIDSweden instead.
+	CountrySweden = "SE"
+	// Deprecated: use CountryIDSwitzerland instead.
+	CountrySwitzerland = "CH"
+	// Deprecated: use CountryIDSyria instead.
+	CountrySyria = "SY"
+	// Deprecated: use CountryIDTaiwan instead.
+	CountryTaiwan = "TW"
+	// Deprecated: use CountryIDTajikistan instead.
+	CountryTajikistan = "TJ"
+	// Deprecated: use CountryIDTanzania instead.
+	CountryTanzania = "TZ"
+	// Deprecated: use CountryIDThailand instead.
+	CountryThailand = "TH"
+	// Deprecated: use CountryIDTimorLeste instead.
+	CountryTimorLeste = "TL"
+	// Deprecated: use CountryIDTogo instead.
+	CountryTogo = "TG"
+	// Deprecated: use CountryIDTokelau instead.
+	CountryTokelau = "TK"
+	// Deprecated: use CountryIDTonga instead.
+	CountryTonga = "TO"
+	// Deprecated: use CountryIDTrinidadTobago instead.
+	CountryTrinidadTobago = "TT"
+	// Deprecated: use CountryIDTristanDaCunha instead.
+	CountryTristanDaCunha = "TA"
+	// Deprecated: use CountryIDTunisia instead.
+	CountryTunisia = "TN"
+	// Deprecated: use CountryIDTurkey instead.
+	CountryTurkey = "TR"
+	// Deprecated: use CountryIDTurkmenistan instead.
+	CountryTurkmenistan = "TM"
+	// Deprecated: use CountryIDTurksCaicosIslands instead.
+	CountryTurksCaicosIslands = "TC"
+	// Deprecated: use CountryIDTuvalu instead.
+	CountryTuvalu = "TV"
+	// Deprecated: use CountryIDUganda instead.
+	CountryUganda = "UG"
+	// Deprecated: use CountryIDUkraine instead.
+	CountryUkraine = "UA"
+	// Deprecated: use CountryIDUnitedArabEmirates instead.
+	CountryUnitedArabEmirates = "AE"
+	// Deprecated: use CountryIDUnitedKingdom instead.
+	CountryUnitedKingdom = "GB"
+	// Deprecated: use CountryIDUnitedStates instead.
+	CountryUnitedStates = "US"
+	// Deprecated: use CountryIDUruguay instead.
+	CountryUruguay = "UY"
+	// Deprecated: use CountryIDUSVirginIslands instead.
+	CountryUSVirginIslands = "VI"
+	// Deprecated: use CountryIDUzbekistan instead.
+	CountryUzbekistan = "UZ"
+	// Deprecated: use CountryIDVanuatu instead.
+	CountryVanuatu = "VU"
+	// Deprecated: use CountryIDVaticanCity instead.
+	CountryVaticanCity = "VA"
+	// Deprecated: use CountryIDVenezuela instead.
+	CountryVenezuela = "VE"
+	// Deprecated: use CountryIDVietnam instead.
+	CountryVietnam = "VN"
+	// Deprecated: use CountryIDWallisFutuna instead.
+	CountryWallisFutuna = "WF"
+	// Deprecated: use CountryIDWesternSahara instead.
+	CountryWesternSahara = "EH"
+	// Deprecated: use CountryIDYemen instead.
+	CountryYemen = "YE"
+	// Deprecated: use CountryIDZambia instead.
+	CountryZambia = "ZM"
+	// Deprecated: use CountryIDZimbabwe instead.
+	CountryZimbabwe = "ZW"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "AC", Alpha2: "AC", Name: "Ascension Island", DialCode: 247},
+	{ID: "AD", Alpha2: "AD", Name: "Andorra", DialCode: 376},
+	{ID: "AE", Alpha2: "AE", Name: "United Arab Emirates", DialCode: 971},
+	{ID: "AF", Alpha2: "AF", Name: "Afghanistan", DialCode: 93},
+	{ID: "AG", Alpha2: "AG", Name: "Antigua & Barbuda", DialCode: 1},
+	{ID: "AI", Alpha2: "AI", Name: "Anguilla", DialCode: 1},
+	{ID: "AL", Alpha2: "AL", Name: "Albania", DialCode: 355},
+	{ID: "AM", Alpha2: "AM", Name: "Armenia", DialCode: 374},
+	{ID: "AO", Alpha2: "AO", Name: "Angola", DialCode: 244},
+	{ID: "AR", Alpha2: "AR", Name: "Argentina", DialCode: 54},
+	{ID: "AS", Alpha2: "AS", Name: "American Samoa", DialCode: 1},
+	{ID: "AT", Alpha2: "AT", Name: "Austria", DialCode: 43},
+	{ID: "AU", Alpha2: "AU", Name: "Australia", DialCode: 61},
+	{ID: "AW", Alpha2: "AW", Name: "Aruba", DialCode: 297},
+	{ID: "AX", Alpha2: "AX", Name: "Åland Islands", DialCode: 358},
+	{ID: "AZ", Alpha2: "AZ", Name: "Azerbaijan", DialCode: 994},
+	{ID: "BA", Alpha2: "BA", Name: "Bosnia & Herzegovina", DialCode: 387},
+	{ID: "BB", Alpha2: "BB", Name: "Barbados", DialCode: 1},
+	{ID: "BD", Alpha2: "BD", Name: "Bangladesh", DialCode: 880},
+	{ID: "BE", Alpha2: "BE", Name: "Belgium", DialCode: 32},
+	{ID: "BF", Alpha2: "BF", Name: "Burkina Faso", DialCode: 226},
+	{ID: "BG", Alpha2: "BG", Name: "Bulgaria", DialCode: 359},
+	{ID: "BH", Alpha2: "BH", Name: "Bahrain", DialCode: 973},
+	{ID: "BI", Alpha2: "BI", Name: "Burundi", DialCode: 257},
+	{ID: "BJ", Alpha2: "BJ", Name: "Benin", DialCode: 229},
+	{ID: "BL", Alpha2: "BL", Name: "St. Barthélemy", DialCode: 590},
+	{ID: "BM", Alpha2: "BM", Name: "Bermuda", DialCode: 1},
+	{ID: "BN", Alpha2: "BN", Name: "Brunei", DialCode: 673},
+	{ID: "BO", Alpha2: "BO", Name: "Bolivia", DialCode: 591},
+	{ID: "BQ", Alpha2: "BQ", Name: "Caribbean Netherlands", DialCode: 599},
+	{ID: "BR", Alpha2: "BR", Name: "Brazil", DialCode: 55},
+	{ID: "BS", Alpha2: "BS", Name: "Bahamas", DialCode: 1},
+	{ID: "BT", Alpha2: "BT", Name: "Bhutan", DialCode: 975},
+	{ID: "BW", Alpha2: "BW", Name: "Botswana", DialCode: 267},
+	{ID: "BY", Alpha2: "BY", Name: "Belarus", DialCode: 375},
+	{ID: "BZ", Alpha2: "BZ", Name: "Belize", DialCode: 501},
+	{ID: "CA", Alpha2: "CA", Name: "Canada", DialCode: 1},
+	{ID: "CC", Alpha2: "CC", Name: "Cocos (Keeling) Islands", DialCode: 61},
+	{ID: "CD", Alpha2: "CD", Name: "Congo - Kinshasa", DialCode: 243},
+	{ID: "CF", Alpha2: "CF", Name: "Central African Republic", DialCode: 236},
+	{ID: "CG", Alpha2: "CG", Name: "Congo - Brazzaville", DialCode: 242},
+	{ID: "CH", Alpha2: "CH", Name: "Switzerland", DialCode: 41},
+	{ID: "CI", Alpha2: "CI", Name: "Côte d’Ivoire", DialCode: 225},
+	{ID: "CK", Alpha2: "CK", Name: "Cook Islands", DialCode: 682},
+	{ID: "CL", Alpha2: "CL", Name: "Chile", DialCode: 56},
+	{ID: "CM", Alpha2: "CM", Name: "Cameroon", DialCode: 237},
+	{ID: "CN", Alpha2: "CN", Name: "China", DialCode: 86},
+	{ID: "CO", Alpha2: "CO", Name: "Colombia", DialCode: 57},
+	{ID: "CR", Alpha2: "CR", Name: "Costa Rica", DialCode: 506},
+	{ID: "CU", Alpha2: "CU", Name: "Cuba", DialCode: 53},
+	{ID: "CV", Alpha2: "CV", Name: "Cape Verde", DialCode: 238},
+	{ID: "CW", Alpha2: "CW", Name: "Curaçao", DialCode: 599},
+	{ID: "CX", Alpha2: "CX", Name: "Christmas Island", DialCode: 61},
+	{ID: "CY", Alpha2: "CY", Name: "Cyprus", DialCode: 357},
+	{ID: "CZ", Alpha2: "CZ", Name: "Czechia", DialCode: 420},
+	{ID: "DE", Alpha2: "DE", Name: "Germany", DialCode: 49},
+	{ID: "DJ", Alpha2: "DJ", Name: "Djibouti", DialCode: 253},
+	{ID: "DK", Alpha2: "DK", Name: "Denmark", DialCode: 45},
+	{ID: "DM", Alpha2: "DM", Name: "Dominica", DialCode: 1},
+	{ID: "DO", Alpha2: "DO", Name: "Dominican Republic", DialCode: 1},
+	{ID: "DZ", Alpha2: "DZ", Name: "Algeria", DialCode: 213},
+	{ID: "EC", Alpha2: "EC", Name: "Ecuador", DialCode: 593},
+	{ID: "EE", Alpha2: "EE", Name: "Estonia", DialCode: 372},
+	{ID: "EG", Alpha2: "EG", Name: "Egypt", DialCode: 20},
+	{ID: "EH", Alpha2: "EH", Name: "Western Sahara", DialCode: 212},
+	{ID: "ER", Alpha2: "ER", Name: "Eritrea", DialCode: 291},
+	{ID: "ES", Alpha2: "ES", Name: "Spain", DialCode: 34},
+	{ID: "ET", Alpha2: "ET", Name: "Ethiopia", DialCode: 251},
+	{ID: "FI", Alpha2: "FI", Name: "Finland", DialCode: 358},
+	{ID: "FJ", Alpha2: "FJ", Name: "Fiji", DialCode: 679},
+	{ID: "FK", Alpha2: "FK", Name: "Falkland Islands", DialCode: 500},
+	{ID: "FM", Alpha2: "FM", Name: "Micronesia", DialCode: 691},
+	{ID: "FO", Alpha2: "FO", Name: "Faroe Islands", DialCode: 298},
+	{ID: "FR", Alpha2: "FR", Name: "France", DialCode: 33},
+	{ID: "GA", Alpha2: "GA", Name: "Gabon", DialCode: 241},
+	{ID: "GB", Alpha2: "GB", Name: "United Kingdom", DialCode: 44},
+	{ID: "GD", Alpha2: "GD", Name: "Grenada", DialCode: 1},
+	{ID: "GE", Alpha2: "GE", Name: "Georgia", DialCode: 995},
+	{ID: "GF", Alpha2: "GF", Name: "French Guiana", DialCode: 594},
+	{ID: "GG", Alpha2: "GG", Name: "Guernsey", DialCode: 44},
+	{ID: "GH", Alpha2: "GH", Name: "Ghana", DialCode: 233},
+	{ID: "GI", Alpha2: "GI", Name: "Gibraltar", DialCode: 350},
+	{ID: "GL", Alpha2: "GL", Name: "Greenland", DialCode: 299},
+	{ID: "GM", Alpha2: "GM", Name: "Gambia", DialCode: 220},
+	{ID: "GN", Alpha2: "GN", Name: "Guinea", DialCode: 224},
+	{ID: "GP", Alpha2: "GP", Name: "Guadeloupe", DialCode: 590},
+	{ID: "GQ", Alpha2: "GQ", Name: "Equatorial Guinea", DialCode: 240},
+	{ID: "GR", Alpha2: "GR", Name: "Greece", DialCode: 30},
+	{ID: "GT", Alpha2: "GT", Name: "Guatemala", DialCode: 502},
+	{ID: "GU", Alpha2: "GU", Name: "Guam", DialCode: 1},
+	{ID: "GW", Alpha2: "GW", Name: "Guinea-Bissau", DialCode: 245},
+	{ID: "GY", Alpha2: "GY", Name: "Guyana", DialCode: 592},
+	{ID: "HK", Alpha2: "HK", Name: "Hong Kong SAR China", DialCode: 852},
+	{ID: "HN", Alpha2: "HN", Name: "Honduras", DialCode: 504},
+	{ID: "HR", Alpha2: "HR", Name: "Croatia", DialCode: 385},
+	{ID: "HT", Alpha2: "HT", Name: "Haiti", DialCode: 509},
+	{ID: "HU", Alpha2: "HU", Name: "Hungary", DialCode: 36},
+	{ID: "ID", Alpha2: "ID", Name: "Indonesia", DialCode: 62},
+	{ID: "IE", Alpha2: "IE", Name: "Ireland", DialCode: 353},
+	{ID: "IL", Alpha2: "IL", Name: "Israel", DialCode: 972},
+	{ID: "IM", Alpha2: "IM", Name: "Isle of Man", DialCode: 44},
+	{ID: "IN", Alpha2: "IN", Name: "India", DialCode: 91},
+	{ID: "IO", Alpha2: "IO", Name: "British Indian Ocean Territory", DialCode: 246},
+	{ID: "IQ", Alpha2: "IQ", Name: "Iraq", DialCode: 964},
+	{ID: "IR", Alpha2: "IR", Name: "Iran", DialCode: 98},
+	{ID: "IS", Alpha2: "IS", Name: "Iceland", DialCode: 354},
+	{ID: "IT", Alpha2: "IT", Name: "Italy", DialCode: 39},
+	{ID: "JE", Alpha2: "JE", Name: "Jersey", DialCode: 44},
+	{ID: "JM", Alpha2: "JM", Name: "Jamaica", DialCode: 1},
+	{ID: "JO", Alpha2: "JO", Name: "Jordan", DialCode: 962},
+	{ID: "JP", Alpha2: "JP", Name: "Japan", DialCode: 81},
+	{ID: "KE", Alpha2: "KE", Name: "Kenya", DialCode: 254},
+	{ID: "KG", Alpha2: "KG", Name: "Kyrgyzstan", DialCode: 996},
+	{ID: "KH", Alpha2: "KH", Name: "Cambodia", DialCode: 855},
+	{ID: "KI", Alpha2: "KI", Name: "Kiribati", DialCode: 686},
+	{ID: "KM", Alpha2: "KM", Name: "Comoros", DialCode: 269},
+	{ID: "KN", Alpha2: "KN", Name: "St. Kitts & Nevis", DialCode: 1},
+	{ID: "KP", Alpha2: "KP", Name: "North Korea", DialCode: 850},
+	{ID: "KR", Alpha2: "KR", Name: "South Korea", DialCode: 82},
+	{ID: "KW", Alpha2: "KW", Name: "Kuwait", DialCode: 965},
+	{ID: "KY", Alpha2: "KY", Name: "Cayman Islands", DialCode: 1},
+	{ID: "KZ", Alpha2: "KZ", Name: "Kazakhstan", DialCode: 7},
+	{ID: "LA", Alpha2: "LA", Name: "Laos", DialCode: 856},
+	{ID: "LB", Alpha2: "LB", Name: "Lebanon", DialCode: 961},
+	{ID: "LC", Alpha2: "LC", Name: "St. Lucia", DialCode: 1},
+	{ID: "LI", Alpha2: "LI", Name: "Liechtenstein", DialCode: 423},
+	{ID: "LK", Alpha2: "LK", Name: "Sri Lanka", DialCode: 94},
+	{ID: "LR", Alpha2: "LR", Name: "Liberia", DialCode: 231},
+	{ID: "LS", Alpha2: "LS", Name: "Lesotho", DialCode: 266},
+	{ID: "LT", Alpha2: "LT", Name: "Lithuania", DialCode: 370},
+	{ID: "LU", Alpha2: "LU", Name: "Luxembourg", DialCode: 352},
+	{ID: "LV", Alpha2: "LV", Name: "Latvia", DialCode: 371},
+	{ID: "LY", Alpha2: "LY", Name: "Libya", DialCode: 218},
+	{ID: "MA", Alpha2: "MA", Name: "Morocco", DialCode: 212},
+	{ID: "MC", Alpha2: "MC", Name: "Monaco", DialCode: 377},
+	{ID: "MD", Alpha2: "MD", Name: "Moldova", DialCode: 373},
+	{ID: "ME", Alpha2: "ME", Name: "Montenegro", DialCode: 382},
+	{ID: "MF", Alpha2: "MF", Name: "St. Martin", DialCode: 590},
+	{ID: "MG", Alpha2: "MG", Name: "Madagascar", DialCode: 261},
+	{ID: "MH", Alpha2: "MH", Name: "Marshall Islands", DialCode: 692},
+	{ID: "MK", Alpha2: "MK", Name: "Macedonia", DialCode: 389},
+	{ID: "ML", Alpha2: "ML", Name: "Mali", DialCode: 223},
+	{ID: "MM", Alpha2: "MM", Name: "Myanmar (Burma)", DialCode: 95},
+	{ID: "MN", Alpha2: "MN", Name: "Mongolia", DialCode: 976},
+	{ID: "MO", Alpha2: "MO", Name: "Macau SAR China", DialCode: 853},
+	{ID: "MP", Alpha2: "MP", Name: "Northern Mariana Islands", DialCode: 1},
+	{ID: "MQ", Alpha2: "MQ", Name: "Martinique", DialCode: 596},
+	{ID: "MR", Alpha2: "MR", Name: "Mauritania", DialCode: 222},
+	{ID: "MS", Alpha2: "MS", Name: "Montserrat", DialCode: 1},
+	{ID: "MT", Alpha2: "MT", Name: "Malta", DialCode: 356},
+	{ID: "MU", Alpha2: "MU", Name: "Mauritius", DialCode: 230},
+	{ID: "MV", Alpha2: "MV", Name: "Maldives", DialCode: 960},
+	{ID: "MW", Alpha2: "MW", Name: "Malawi", DialCode: 265},
+	{ID: "MX", Alpha2: "MX", Name: "Mexico", DialCode: 52},
+	{ID: "MY", Alpha2: "MY", Name: "Malaysia", DialCode: 60},
+	{ID: "MZ", Alpha2: "MZ", Name: "Mozambique", DialCode: 258},
+	{ID: "NA", Alpha2: "NA", Name: "Namibia", DialCode: 264},
+	{ID: "NC", Alpha2: "NC", Name: "New Caledonia", DialCode: 687},
+	{ID: "NE", Alpha2: "NE", Name: "Niger", DialCode: 227},
+	{ID: "NF", Alpha2: "NF", Name: "Norfolk Island", DialCode: 672},
+	{ID: "NG", Alpha2: "NG", Name: "Nigeria", DialCode: 234},
+	{ID: "NI", Alpha2: "NI", Name: "Nicaragua", DialCode: 505},
+	{ID: "NL", Alpha2: "NL", Name: "Netherlands", DialCode: 31},
+	{ID: "NO", Alpha2: "NO", Name: "Norway", DialCode: 47},
+	{ID: "NP", Alpha2: "NP", Name: "Nepal", DialCode: 977},
+	{ID: "NR", Alpha2: "NR", Name: "Nauru", DialCode: 674},
+	{ID: "NU", Alpha2: "NU", Name: "Niue", DialCode: 683},
+	{ID: "NZ", Alpha2: "NZ", Name: "New Zealand", DialCode: 64},
+	{ID: "OM", Alpha2: "OM", Name: "Oman", DialCode: 968},
+	{ID: "PA", Alpha2: "PA", Name: "Panama", DialCode: 507},
+	{ID: "PE", Alpha2: "PE", Name: "Peru", DialCode: 51},
+	{ID: "PF", Alpha2: "PF", Name: "French Polynesia", DialCode: 689},
+	{ID: "PG", Alpha2: "PG", Name: "Papua New Guinea", DialCode: 675},
+	{ID: "PH", Alpha2: "PH", Name: "Philippines", DialCode: 63},
+	{ID: "PK", Alpha2: "PK", Name: "Pakistan", DialCode: 92},
+	{ID: "PL", Alpha2: "PL", Name: "Poland", DialCode: 48},
+	{ID: "PM", Alpha2: "PM", Name: "St. Pierre & Miquelon", DialCode: 508},
+	{ID: "PR", Alpha2: "PR", Name: "Puerto Rico", DialCode: 1},
+	{ID: "PS", Alpha2: "PS", Name: "Palestinian Territories", DialCode: 970},
+	{ID: "PT", Alpha2: "PT", Name: "Portugal", DialCode: 351},
+	{ID: "PW", Alpha2: "PW", Name: "Palau", DialCode: 680},
+	{ID: "PY", Alpha2: "PY", Name: "Paraguay", DialCode: 595},
+	{ID: "QA", Alpha2: "QA", Name: "Qatar", DialCode: 974},
+	{ID: "RE", Alpha2: "RE", Name: "Réunion", DialCode: 262},
+	{ID: "RO", Alpha2: "RO", Name: "Romania", DialCode: 40},
+	{ID: "RS", Alpha2: "RS", Name: "Serbia", DialCode: 381},
+	{ID: "RU", Alpha2: "RU", Name: "Russia", DialCode: 7},
+	{ID: "RW", Alpha2: "RW", Name: "Rwanda", DialCode: 250},
+	{ID: "SA", Alpha2: "SA", Name: "Saudi Arabia", DialCode: 966},
+	{ID: "SB", Alpha2: "SB", Name: "Solomon Islands", DialCode: 677},
+	{ID: "SC", Alpha2: "SC", Name: "Seychelles", DialCode: 248},
+	{ID: "SD", Alpha2: "SD", Name: "Sudan", DialCode: 249},
+	{ID: "SE", Alpha2: "SE", Name: "Sweden", DialCode: 46},
+	{ID: "SG", Alpha2: "SG", Name: "Singapore", DialCode: 65},
+	{ID: "SH", Alpha2: "SH", Name: "St. Helena", DialCode: 290},
+	{ID: "SI", Alpha2: "SI", Name: "Slovenia", DialCode: 386},
+	{ID: "SJ", Alpha2: "SJ", Name: "Svalbard & Jan Mayen", DialCode: 47},
+	{ID: "SK", Alpha2: "SK", Name: "Slovakia", DialCode: 421},
+	{ID: "SL", Alpha2: "SL", Name: "Sierra Leone", DialCode: 232},
+	{ID: "SM", Alpha2: "SM", Name: "San Marino", DialCode: 378},
+	{ID: "SN", Alpha2: "SN", Name: "Senegal", DialCode: 221},
+	{ID: "SO", Alpha2: "SO", Name: "Somalia", DialCode: 252},
+	{ID: "SR", Alpha2: "SR", Name: "Suriname", DialCode: 597},
+	{ID: "SS", Alpha2: "SS", Name: "South Sudan", DialCode: 211},
+	{ID: "ST", Alpha2: "ST", Name: "São Tomé & Príncipe", DialCode: 239},
+	{ID: "SV", Alpha2: "SV", Name: "El Salvador", DialCode: 503},
+	{ID: "SX", Alpha2: "SX", Name: "Sint Maarten", DialCode: 1},
+	{ID: "SY", Alpha2: "SY", Name: "Syria", DialCode: 963},
+	{ID: "SZ", Alpha2: "SZ", Name: "Swaziland", DialCode: 268},
+	{ID: "TA", Alpha2: "TA", Name: "Tristan da Cunha", DialCode: 290},
+	{ID: "TC", Alpha2: "TC", Name: "Turks & Caicos Islands", DialCode: 1},
+	{ID: "TD", Alpha2: "TD", Name: "Chad", DialCode: 235},
+	{ID: "TG", Alpha2: "TG", Name: "Togo", DialCode: 228},
+	{ID: "TH", Alpha2: "TH", Name: "Thailand", DialCode: 66},
+	{ID: "TJ", Alpha2: "TJ", Name: "Tajikistan", DialCode: 992},
+	{ID: "TK", Alpha2: "TK", Name: "Tokelau", DialCode: 690},
+	{ID: "TL", Alpha2: "TL", Name: "Timor-Leste", DialCode: 670},
+	{ID: "TM", Alpha2: "TM", Name: "Turkmenistan", DialCode: 993},
+	{ID: "TN", Alpha2: "TN", Name: "Tunisia", DialCode: 216},
+	{ID: "TO", Alpha2: "TO", Name: "Tonga", DialCode: 676},
+	{ID: "TR", Alpha2: "TR", Name: "Turkey", DialCode: 90},
+	{ID: "TT", Alpha2: "TT", Name: "Trinidad & Tobago", DialCode: 1},
+	{ID: "TV", Alpha2: "TV", Name: "Tuvalu", DialCode: 688},
+	{ID: "TW", Alpha2: "TW", Name: "Taiwan", DialCode: 886},
+	{ID: "TZ", Alpha2: "TZ", Name: "Tanzania", DialCode: 255},
+	{ID: "UA", Alpha2: "UA", Name: "Ukraine", DialCode: 380},
+	{ID: "UG", Alpha2: "UG", Name: "Uganda", DialCode: 256},
+	{ID: "US", Alpha2: "US", Name: "United States", DialCode: 1},
+	{ID: "UY", Alpha2: "UY", Name: "Uruguay", DialCode: 598},
+	{ID: "UZ", Alpha2: "UZ", Name: "Uzbekistan", DialCode: 998},
+	{ID: "VA", Alpha2: "VA", Name: "Vatican City", DialCode: 39},
+	{ID: "VC", Alpha2: "VC", Name: "St. Vincent & Grenadines", DialCode: 1},
+	{ID: "VE", Alpha2: "VE", Name: "Venezuela", DialCode: 58},
+	{ID: "VG", Alpha2: "VG", Name: "British Virgin Islands", DialCode: 1},
+	{ID: "VI", Alpha2: "VI", Name: "U.S. Virgin Islands", DialCode: 1},
+	{ID: "VN", Alpha2: "VN", Name: "Vietnam", DialCode: 84},
+	{ID: "VU", Alpha2: "VU", Name: "Vanuatu", DialCode: 678},
+	{ID: "WF", Alpha2: "WF", Name: "Wallis & Futuna", DialCode: 681},
+	{ID: "WS", Alpha2: "WS", Name: "Samoa", DialCode: 685},
+	{ID: "XK", Alpha2: "XK", Name: "Kosovo", DialCode: 383},
+	{ID: "YE", Alpha2: "YE", Name: "Yemen", DialCode: 967},
+	{ID: "YT", Alpha2: "YT", Name: "Mayotte", DialCode: 262},
+	{ID: "ZA", Alpha2: "ZA", Name: "South Africa", DialCode: 27},
+	{ID: "ZM", Alpha2: "ZM", Name: "Zambia", DialCode: 260},
+	{ID: "ZW", Alpha2: "ZW", Name: "Zimbabwe", DialCode: 263},
+}
@@ -1,5 +1,7 @@
 package smspool
 
+//go:generate go run ../cmd/smsgen -dir . smspool
+
 import (
 	"context"
 	"encoding/json"
@@ -7,26 +9,41 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/saucesteals/sms"
 )
 
 var (
-	ErrVerificationExpired = errors.New("smspool: verification expired")
+	ErrVerificationExpired = fmt.Errorf("smspool: verification expired: %w", sms.ErrExpired)
 	ErrReported            = errors.New("smspool: verification reported")
 	ErrCancelled           = errors.New("smspool: verification was cancelled by user or system")
 
 	ErrUnauthorized = errors.New("smspool: unauthorized")
 )
 
+const (
+	provider = "smspool"
+	baseURL  = "https://api.smspool.net/"
+)
+
 type Client struct {
-	http   *http.Client
-	apiKey string
+	http    *http.Client
+	apiKey  string
+	baseURL string
 }
 
 var (
-	_ sms.ReusableClient = &Client{}
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.ActiveClient     = &Client{}
+	_ sms.HistoryClient    = &Client{}
 )
 
 type metadata struct {
@@ -35,8 +52,9 @@ type metadata struct {
 
 func NewClient(apiKey string) *Client {
 	return &Client{
-		http:   http.DefaultClient,
-		apiKey: apiKey,
+		http:    http.DefaultClient,
+		apiKey:  apiKey,
+		baseURL: baseURL,
 	}
 }
 
@@ -47,7 +65,7 @@ func (c *Client) do(ctx context.Context, method string, path string, query url.V
 
 	query.Set("key", c.apiKey)
 
-	url := "https://api.smspool.net/" + path + "?" + query.Encode()
+	url := c.baseURL + path + "?" + query.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
@@ -119,6 +137,91 @@ func (c *Client) GetServices(ctx context.Context) ([]service, error) {
 	return services, nil
 }
 
+type country struct {
+	Id        int    `json:"ID"`
+	Name      string `json:"name"`
+	ShortName string `json:"short_name"`
+}
+
+func (c *Client) GetCountries(ctx context.Context) ([]country, error) {
+	var countries []country
+	err := c.do(ctx, http.MethodGet, "country/retrieve_all", nil, &countries)
+	if err != nil {
+		return nil, err
+	}
+
+	return countries, nil
+}
+
+// Price is what renting a service's number costs in a country
+type Price struct {
+	Service     string
+	ServiceName string
+	Country     string
+	CountryName string
+	Cost        float64
+}
+
+// price is a Price as request/pricing lists it, with IDs and costs as JSON
+// numbers or strings
+type price struct {
+	Service     json.Number `json:"service"`
+	ServiceName string      `json:"service_name"`
+	Country     json.Number `json:"country"`
+	CountryName string      `json:"country_name"`
+	Price       json.Number `json:"price"`
+}
+
+// GetPrices lists the prices of service in country, either left empty lists
+// every service or country
+func (c *Client) GetPrices(ctx context.Context, service string, country string) ([]Price, error) {
+	query := url.Values{}
+	if service != "" {
+		query.Set("service", service)
+	}
+	if country != "" {
+		query.Set("country", country)
+	}
+
+	var listed []price
+	if err := c.do(ctx, http.MethodPost, "request/pricing", query, &listed); err != nil {
+		return nil, err
+	}
+
+	prices := make([]Price, len(listed))
+	for i, p := range listed {
+		cost, err := p.Price.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("smspool: parsing price %q of service %s: %w", p.Price, p.Service, err)
+		}
+
+		prices[i] = Price{
+			Service:     p.Service.String(),
+			ServiceName: p.ServiceName,
+			Country:     p.Country.String(),
+			CountryName: p.CountryName,
+			Cost:        cost,
+		}
+	}
+
+	return prices, nil
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Reuse:            true,
+		Cancel:           true,
+		// reporting cancels the number
+		Report:           false,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+		ListActive:       true,
+		History:          true,
+	}
+}
+
 func (c *Client) GetPhoneNumber(ctx context.Context, serviceId string, country string) (*sms.PhoneNumber, error) {
 	var res verification
 	err := c.do(ctx, http.MethodGet, "purchase/sms", url.Values{
@@ -138,11 +241,35 @@ func (c *Client) GetPhoneNumber(ctx context.Context, serviceId string, country s
 		return nil, fmt.Errorf("smspool: parsing phone number (%s): %w", res.Phonenumber, err)
 	}
 
-	return &sms.PhoneNumber{PhoneNumber: number, Metadata: metadata{id: res.OrderID}}, nil
+	// the number is already paid for, so an unparseable cost only loses the cost
+	cost, _ := strconv.ParseFloat(res.Cost, 64)
+
+	phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       res.OrderID,
+		Service:  serviceId,
+		Country:  country,
+		Cost:     cost,
+	}, metadata{id: res.OrderID})
+	if res.ExpiresIn > 0 {
+		phoneNumber.SetExpiresAt(time.Now().Add(time.Duration(res.ExpiresIn) * time.Second))
+	}
+
+	return phoneNumber, nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{id: rental.ID})
+}
+
+func setExpiration(phoneNumber *sms.PhoneNumber, expiration int) {
+	if expiration > 0 {
+		phoneNumber.SetExpiresAt(time.Unix(int64(expiration), 0))
+	}
 }
 
 func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
@@ -154,6 +281,8 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 		return nil, err
 	}
 
+	setExpiration(phoneNumber, res.Expiration)
+
 	switch res.Status {
 	case 1, 4:
 		return []string{}, nil
@@ -163,19 +292,51 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 		phoneNumber.MarkUsed()
 
 		return []string{res.FullSms}, nil
-	case 5:
+	case 5, 6:
 		return nil, ErrCancelled
 	default:
 		return nil, fmt.Errorf("smspool: unknown status %d: %s", res.Status, res.Message)
 	}
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	var res smsCheckResponse
+	if err := c.do(ctx, http.MethodGet, "sms/check", url.Values{
+		"orderid": {metadata.id},
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	setExpiration(phoneNumber, res.Expiration)
+
+	var status sms.Status
+	switch res.Status {
+	case 1, 4:
+		status = sms.StatusWaiting
+	case 2:
+		status = sms.StatusExpired
+	case 3:
+		status = sms.StatusReceived
+	case 5, 6:
+		status = sms.StatusCancelled
+	default:
+		return nil, fmt.Errorf("smspool: unknown status %d: %s", res.Status, res.Message)
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
 func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	if phoneNumber.Used() || phoneNumber.Cancelled() {
 		return nil
 	}
 
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -201,11 +362,15 @@ func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 }
 
 func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
 
+	if err := phoneNumber.CanReuse(); err != nil {
+		return nil, err
+	}
+
 	var res apiResponse
 	err := c.do(ctx, http.MethodPost, "sms/resend", url.Values{
 		"orderid": {metadata.id},
@@ -220,3 +385,172 @@ func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNum
 
 	return phoneNumber, nil
 }
+
+// listedOrder is an order as request/active and request/history list it,
+// which report numbers and costs either as JSON numbers or strings
+type listedOrder struct {
+	OrderCode   string      `json:"order_code"`
+	Phonenumber json.Number `json:"phonenumber"`
+	CC          json.Number `json:"cc"`
+	Service     string      `json:"service"`
+	Country     string      `json:"short_name"`
+	Cost        json.Number `json:"cost"`
+	Expiry      json.Number `json:"expiry"`
+	Code        string      `json:"code"`
+	FullCode    string      `json:"full_code"`
+	Status      string      `json:"status"`
+	Timestamp   string      `json:"timestamp"`
+}
+
+// order returns the listed order's number and order, the service of which is
+// its ID in Services when listed there and its name otherwise
+func (o listedOrder) order() (*phonenumbers.PhoneNumber, sms.Order, error) {
+	number, err := phonenumbers.Parse(fmt.Sprintf("+%s%s", o.CC, strings.TrimPrefix(o.Phonenumber.String(), o.CC.String())), "US")
+	if err != nil {
+		return nil, sms.Order{}, fmt.Errorf("smspool: parsing phone number (%s): %w", o.Phonenumber, err)
+	}
+
+	service := o.Service
+	if s, ok := Services.ByName(o.Service); ok {
+		service = s.ID
+	}
+
+	// the order is already paid for, so an unparseable cost only loses the cost
+	cost, _ := o.Cost.Float64()
+
+	order := sms.Order{
+		Provider: provider,
+		ID:       o.OrderCode,
+		Service:  service,
+		Country:  o.Country,
+		Cost:     cost,
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", o.Timestamp, time.UTC); err == nil {
+		order.RentedAt = t
+	}
+
+	return number, order, nil
+}
+
+func (o listedOrder) received() bool {
+	return o.Code != "" && o.Code != "0"
+}
+
+// ListActive lists the orders that are still pending
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	var orders []listedOrder
+	if err := c.do(ctx, http.MethodPost, "request/active", nil, &orders); err != nil {
+		return nil, err
+	}
+
+	phoneNumbers := make([]*sms.PhoneNumber, 0, len(orders))
+	for _, o := range orders {
+		number, order, err := o.order()
+		if err != nil {
+			return nil, err
+		}
+
+		phoneNumber := sms.NewPhoneNumber(number, order, metadata{id: o.OrderCode})
+		if expiry, err := o.Expiry.Int64(); err == nil {
+			setExpiration(phoneNumber, int(expiry))
+		}
+		if o.received() {
+			phoneNumber.MarkUsed()
+		}
+
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+	}
+
+	return phoneNumbers, nil
+}
+
+// historyLimit is the page size when the query leaves it unset
+const historyLimit = 100
+
+// OrderHistory lists a page of past orders, newest first
+func (c *Client) OrderHistory(ctx context.Context, query sms.HistoryQuery) (*sms.HistoryPage, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = historyLimit
+	}
+
+	var orders []listedOrder
+	if err := c.do(ctx, http.MethodPost, "request/history", url.Values{
+		"start":  {strconv.Itoa(query.Page * limit)},
+		"length": {strconv.Itoa(limit)},
+	}, &orders); err != nil {
+		return nil, err
+	}
+
+	records := []sms.HistoryRecord{}
+	// a short page is the last one
+	more := len(orders) == limit
+	for _, o := range orders {
+		number, order, err := o.order()
+		if err != nil {
+			return nil, err
+		}
+
+		// orders are listed newest first, so once one is older than From
+		// the rest of this page and every later page are too. Orders without
+		// a timestamp say nothing about those after them
+		if !query.From.IsZero() && !order.RentedAt.IsZero() && order.RentedAt.Before(query.From) {
+			more = false
+			break
+		}
+		if !query.Contains(order.RentedAt) {
+			continue
+		}
+
+		record := sms.HistoryRecord{
+			Order:  order,
+			Number: phonenumbers.Format(number, phonenumbers.E164),
+			Status: historyStatus(o),
+		}
+		if o.received() {
+			record.Code = o.FullCode
+			if record.Code == "" {
+				record.Code = o.Code
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return &sms.HistoryPage{Records: records, More: more}, nil
+}
+
+func historyStatus(o listedOrder) sms.Status {
+	switch strings.ToLower(o.Status) {
+	case "refunded", "cancelled", "canceled":
+		return sms.StatusCancelled
+	case "expired":
+		return sms.StatusExpired
+	case "completed":
+		return sms.StatusFinished
+	}
+
+	if o.received() {
+		return sms.StatusReceived
+	}
+
+	return sms.StatusWaiting
+}
+
+type balanceResponse struct {
+	Balance json.Number `json:"balance"`
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	var res balanceResponse
+	if err := c.do(ctx, http.MethodPost, "request/balance", nil, &res); err != nil {
+		return 0, err
+	}
+
+	bal, err := res.Balance.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("smspool: parsing balance %q: %w", res.Balance, err)
+	}
+
+	return bal, nil
+}
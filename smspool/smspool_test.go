@@ -0,0 +1,152 @@
+package smspool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("key")
+	c.baseURL = server.URL + "/"
+
+	return c
+}
+
+// history serves one order an hour, newest first, the latest rented at now
+func history(now time.Time, count int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/request/history" {
+			http.NotFound(w, r)
+			return
+		}
+
+		start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+		length, _ := strconv.Atoi(r.URL.Query().Get("length"))
+
+		orders := []map[string]any{}
+		for i := start; i < start+length && i < count; i++ {
+			orders = append(orders, map[string]any{
+				"order_code":  fmt.Sprintf("order%d", i),
+				"phonenumber": fmt.Sprintf("20255501%02d", i),
+				"cc":          "1",
+				"service":     "Test",
+				"short_name":  "US",
+				"cost":        "0.10",
+				"code":        "0",
+				"status":      "completed",
+				"timestamp":   now.Add(-time.Duration(i) * time.Hour).Format("2006-01-02 15:04:05"),
+			})
+		}
+
+		json.NewEncoder(w).Encode(orders)
+	}
+}
+
+func TestOrderHistoryMore(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, history(now, 5))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		query   sms.HistoryQuery
+		records int
+		more    bool
+	}{
+		{"full page", sms.HistoryQuery{Limit: 2}, 2, true},
+		// only the next, empty, page tells a full page was the last
+		{"last full page", sms.HistoryQuery{Limit: 5}, 5, true},
+		{"empty page", sms.HistoryQuery{Page: 1, Limit: 5}, 0, false},
+		{"short page", sms.HistoryQuery{Page: 2, Limit: 2}, 1, false},
+		{"older than from", sms.HistoryQuery{From: now.Add(-90 * time.Minute), Limit: 4}, 2, false},
+		{"from on a later page", sms.HistoryQuery{From: now.Add(-150 * time.Minute), Limit: 2}, 2, true},
+		{"before to", sms.HistoryQuery{To: now.Add(-30 * time.Minute), Limit: 2}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := c.OrderHistory(ctx, tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(page.Records) != tt.records || page.More != tt.more {
+				t.Fatalf("%d records, More = %t, want %d records, More = %t", len(page.Records), page.More, tt.records, tt.more)
+			}
+		})
+	}
+}
+
+func TestAllHistory(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, history(now, 2*historyLimit+1))
+
+	records, err := sms.AllHistory(context.Background(), c, now.Add(-150*time.Hour), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 151 {
+		t.Fatalf("%d records, want the 151 rented in the last 150 hours", len(records))
+	}
+
+	first := records[0]
+	if first.ID != "order0" || first.Number != "+12025550100" || first.Status != sms.StatusFinished || !first.RentedAt.Equal(now) {
+		t.Fatalf("first record = %+v", first)
+	}
+}
+
+func TestCheckStatuses(t *testing.T) {
+	status := 0
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"success": 1, "status": status, "full_sms": "123456", "expiration": 600})
+	}))
+	ctx := context.Background()
+
+	tests := []struct {
+		status int
+		want   sms.Status
+		err    error
+	}{
+		{1, sms.StatusWaiting, nil},
+		{2, sms.StatusExpired, ErrVerificationExpired},
+		{3, sms.StatusReceived, nil},
+		{4, sms.StatusWaiting, nil},
+		{5, sms.StatusCancelled, ErrCancelled},
+		{6, sms.StatusCancelled, ErrCancelled},
+	}
+
+	for _, tt := range tests {
+		status = tt.status
+
+		phoneNumber, err := c.RestorePhoneNumber(ctx, sms.Rental{Order: sms.Order{Provider: provider, ID: "order"}, Number: "+12025550100"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := c.GetStatus(ctx, phoneNumber)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Status != tt.want {
+			t.Errorf("status %d: GetStatus = %s, want %s", tt.status, got.Status, tt.want)
+		}
+
+		if _, err := c.GetMessages(ctx, phoneNumber); err != tt.err {
+			t.Errorf("status %d: GetMessages err = %v, want %v", tt.status, err, tt.err)
+		}
+	}
+}
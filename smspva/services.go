@@ -1,108 +1,1431 @@
 // Code generated by saucesteals/sms; DO NOT EDIT.
+
 package smspva
 
-var (
-	ServiceOpenapi = "opt132"
-	ServiceAnother = "opt22"
-	ServiceNike = "opt86"
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceIDAirbnb        ServiceID = "opt46"
+	ServiceIDAmazon        ServiceID = "opt44"
+	ServiceIDAnother       ServiceID = "opt22"
+	ServiceIDAol           ServiceID = "opt10"
+	ServiceIDApple         ServiceID = "opt131"
+	ServiceIDAvito         ServiceID = "opt59"
+	ServiceIDBadoo         ServiceID = "opt56"
+	ServiceIDBet365        ServiceID = "opt17"
+	ServiceIDBetfair       ServiceID = "opt25"
+	ServiceIDBlizzard      ServiceID = "opt78"
+	ServiceIDBolt          ServiceID = "opt81"
+	ServiceIDCareem        ServiceID = "opt89"
+	ServiceIDCmobil        ServiceID = "opt76"
+	ServiceIDCoinbase      ServiceID = "opt112"
+	ServiceIDContact       ServiceID = "opt51"
+	ServiceIDCraigslist    ServiceID = "opt26"
+	ServiceIDDidi          ServiceID = "opt92"
+	ServiceIDDiscord       ServiceID = "opt45"
+	ServiceIDDodopizza     ServiceID = "opt27"
+	ServiceIDDromru        ServiceID = "opt32"
+	ServiceIDDrug          ServiceID = "opt31"
+	ServiceIDFastmail      ServiceID = "opt43"
+	ServiceIDFb            ServiceID = "opt2"
+	ServiceIDFoodpanda     ServiceID = "opt115"
+	ServiceIDFotostrana    ServiceID = "opt13"
+	ServiceIDG2a           ServiceID = "opt68"
+	ServiceIDGettaxi       ServiceID = "opt35"
+	ServiceIDGlovoraketa   ServiceID = "opt108"
+	ServiceIDGmail         ServiceID = "opt1"
+	ServiceIDGolgol        ServiceID = "opt128"
+	ServiceIDGrabtaxi      ServiceID = "opt30"
+	ServiceIDGrailed       ServiceID = "opt420"
+	ServiceIDGrindr        ServiceID = "opt110"
+	ServiceIDIcard         ServiceID = "opt103"
+	ServiceIDImo           ServiceID = "opt111"
+	ServiceIDInboxdollars  ServiceID = "opt118"
+	ServiceIDInstagram     ServiceID = "opt16"
+	ServiceIDJd            ServiceID = "opt94"
+	ServiceIDKakao         ServiceID = "opt71"
+	ServiceIDKwiff         ServiceID = "opt129"
+	ServiceIDLazada        ServiceID = "opt60"
+	ServiceIDLine          ServiceID = "opt37"
+	ServiceIDLinkedin      ServiceID = "opt8"
+	ServiceIDLivescore     ServiceID = "opt42"
+	ServiceIDLocalbitcoins ServiceID = "opt105"
+	ServiceIDLocanto       ServiceID = "opt114"
+	ServiceIDLyft          ServiceID = "opt75"
+	ServiceIDMailru        ServiceID = "opt33"
+	ServiceIDMamba         ServiceID = "opt100"
+	ServiceIDMichat        ServiceID = "opt96"
+	ServiceIDMonese        ServiceID = "opt121"
+	ServiceIDMs            ServiceID = "opt15"
+	ServiceIDNaver         ServiceID = "opt73"
+	ServiceIDNetbet        ServiceID = "opt95"
+	ServiceIDNeteller      ServiceID = "opt116"
+	ServiceIDNetflix       ServiceID = "opt101"
+	ServiceIDNike          ServiceID = "opt86"
+	ServiceIDOfferup       ServiceID = "opt113"
+	ServiceIDOffice365     ServiceID = "opt7"
+	ServiceIDOk            ServiceID = "opt5"
+	ServiceIDOlimpbetkz    ServiceID = "opt143"
+	ServiceIDOlx           ServiceID = "opt70"
+	ServiceIDOpenapi       ServiceID = "opt132"
+	ServiceIDPaddypower    ServiceID = "opt109"
+	ServiceIDPaxful        ServiceID = "opt77"
+	ServiceIDPaypal        ServiceID = "opt83"
+	ServiceIDPlexbet       ServiceID = "opt28"
+	ServiceIDPof           ServiceID = "opt84"
+	ServiceIDPromua        ServiceID = "opt107"
+	ServiceIDProtonmail    ServiceID = "opt57"
+	ServiceIDQq            ServiceID = "opt34"
+	ServiceIDSbermarket    ServiceID = "opt97"
+	ServiceIDShopee        ServiceID = "opt48"
+	ServiceIDSignal        ServiceID = "opt127"
+	ServiceIDSkout         ServiceID = "opt49"
+	ServiceIDSkrill        ServiceID = "opt117"
+	ServiceIDSnapchat      ServiceID = "opt90"
+	ServiceIDSteam         ServiceID = "opt58"
+	ServiceIDSwagbucks     ServiceID = "opt125"
+	ServiceIDTango         ServiceID = "opt82"
+	ServiceIDTaobao        ServiceID = "opt61"
+	ServiceIDTaximaxim     ServiceID = "opt74"
+	ServiceIDTelegram      ServiceID = "opt29"
+	ServiceIDTicketmaster  ServiceID = "opt52"
+	ServiceIDTiktok        ServiceID = "opt104"
+	ServiceIDTinder        ServiceID = "opt9"
+	ServiceIDTwilio        ServiceID = "opt66"
+	ServiceIDTwitter       ServiceID = "opt41"
+	ServiceIDUber          ServiceID = "opt72"
+	ServiceIDViber         ServiceID = "opt11"
+	ServiceIDVinted        ServiceID = "opt130"
+	ServiceIDVk            ServiceID = "opt69"
+	ServiceIDWebmoney      ServiceID = "opt24"
+	ServiceIDWechat        ServiceID = "opt67"
+	ServiceIDWeebly        ServiceID = "opt54"
+	ServiceIDWeststein     ServiceID = "opt80"
+	ServiceIDWhatsapp      ServiceID = "opt20"
+	ServiceIDWhoosh        ServiceID = "opt123"
+	ServiceIDYahoo         ServiceID = "opt65"
+	ServiceIDYalla         ServiceID = "opt88"
+	ServiceIDYandex        ServiceID = "opt23"
+	ServiceIDZoho          ServiceID = "opt93"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceIDAirbnb instead.
 	ServiceAirbnb = "opt46"
-	ServiceTaobao = "opt61"
+	// Deprecated: use ServiceIDAmazon instead.
 	ServiceAmazon = "opt44"
+	// Deprecated: use ServiceIDAnother instead.
+	ServiceAnother = "opt22"
+	// Deprecated: use ServiceIDAol instead.
 	ServiceAol = "opt10"
+	// Deprecated: use ServiceIDApple instead.
 	ServiceApple = "opt131"
+	// Deprecated: use ServiceIDAvito instead.
 	ServiceAvito = "opt59"
+	// Deprecated: use ServiceIDBadoo instead.
 	ServiceBadoo = "opt56"
+	// Deprecated: use ServiceIDBet365 instead.
 	ServiceBet365 = "opt17"
+	// Deprecated: use ServiceIDBetfair instead.
 	ServiceBetfair = "opt25"
+	// Deprecated: use ServiceIDBlizzard instead.
 	ServiceBlizzard = "opt78"
+	// Deprecated: use ServiceIDBolt instead.
 	ServiceBolt = "opt81"
+	// Deprecated: use ServiceIDCareem instead.
 	ServiceCareem = "opt89"
+	// Deprecated: use ServiceIDCmobil instead.
 	ServiceCmobil = "opt76"
+	// Deprecated: use ServiceIDCoinbase instead.
 	ServiceCoinbase = "opt112"
+	// Deprecated: use ServiceIDContact instead.
 	ServiceContact = "opt51"
+	// Deprecated: use ServiceIDCraigslist instead.
 	ServiceCraigslist = "opt26"
+	// Deprecated: use ServiceIDDidi instead.
 	ServiceDidi = "opt92"
+	// Deprecated: use ServiceIDDiscord instead.
 	ServiceDiscord = "opt45"
+	// Deprecated: use ServiceIDDodopizza instead.
 	ServiceDodopizza = "opt27"
+	// Deprecated: use ServiceIDDromru instead.
 	ServiceDromru = "opt32"
+	// Deprecated: use ServiceIDDrug instead.
 	ServiceDrug = "opt31"
-	ServiceFb = "opt2"
+	// Deprecated: use ServiceIDFastmail instead.
 	ServiceFastmail = "opt43"
+	// Deprecated: use ServiceIDFb instead.
+	ServiceFb = "opt2"
+	// Deprecated: use ServiceIDFoodpanda instead.
 	ServiceFoodpanda = "opt115"
+	// Deprecated: use ServiceIDFotostrana instead.
 	ServiceFotostrana = "opt13"
+	// Deprecated: use ServiceIDG2a instead.
 	ServiceG2a = "opt68"
-	ServicePaxful = "opt77"
+	// Deprecated: use ServiceIDGettaxi instead.
 	ServiceGettaxi = "opt35"
+	// Deprecated: use ServiceIDGlovoraketa instead.
 	ServiceGlovoraketa = "opt108"
-	ServiceGolgol = "opt128"
+	// Deprecated: use ServiceIDGmail instead.
 	ServiceGmail = "opt1"
+	// Deprecated: use ServiceIDGolgol instead.
+	ServiceGolgol = "opt128"
+	// Deprecated: use ServiceIDGrabtaxi instead.
 	ServiceGrabtaxi = "opt30"
+	// Deprecated: use ServiceIDGrailed instead.
 	ServiceGrailed = "opt420"
+	// Deprecated: use ServiceIDGrindr instead.
 	ServiceGrindr = "opt110"
+	// Deprecated: use ServiceIDIcard instead.
 	ServiceIcard = "opt103"
+	// Deprecated: use ServiceIDImo instead.
 	ServiceImo = "opt111"
+	// Deprecated: use ServiceIDInboxdollars instead.
 	ServiceInboxdollars = "opt118"
+	// Deprecated: use ServiceIDInstagram instead.
 	ServiceInstagram = "opt16"
+	// Deprecated: use ServiceIDJd instead.
 	ServiceJd = "opt94"
+	// Deprecated: use ServiceIDKakao instead.
 	ServiceKakao = "opt71"
+	// Deprecated: use ServiceIDKwiff instead.
 	ServiceKwiff = "opt129"
+	// Deprecated: use ServiceIDLazada instead.
 	ServiceLazada = "opt60"
+	// Deprecated: use ServiceIDLine instead.
 	ServiceLine = "opt37"
+	// Deprecated: use ServiceIDLinkedin instead.
 	ServiceLinkedin = "opt8"
+	// Deprecated: use ServiceIDLivescore instead.
 	ServiceLivescore = "opt42"
+	// Deprecated: use ServiceIDLocalbitcoins instead.
 	ServiceLocalbitcoins = "opt105"
+	// Deprecated: use ServiceIDLocanto instead.
 	ServiceLocanto = "opt114"
+	// Deprecated: use ServiceIDLyft instead.
 	ServiceLyft = "opt75"
+	// Deprecated: use ServiceIDMailru instead.
 	ServiceMailru = "opt33"
+	// Deprecated: use ServiceIDMamba instead.
 	ServiceMamba = "opt100"
+	// Deprecated: use ServiceIDMichat instead.
 	ServiceMichat = "opt96"
+	// Deprecated: use ServiceIDMonese instead.
 	ServiceMonese = "opt121"
-	ServiceOffice365 = "opt7"
+	// Deprecated: use ServiceIDMs instead.
 	ServiceMs = "opt15"
+	// Deprecated: use ServiceIDNaver instead.
 	ServiceNaver = "opt73"
+	// Deprecated: use ServiceIDNetbet instead.
 	ServiceNetbet = "opt95"
+	// Deprecated: use ServiceIDNeteller instead.
 	ServiceNeteller = "opt116"
+	// Deprecated: use ServiceIDNetflix instead.
 	ServiceNetflix = "opt101"
-	ServiceOk = "opt5"
+	// Deprecated: use ServiceIDNike instead.
+	ServiceNike = "opt86"
+	// Deprecated: use ServiceIDOfferup instead.
 	ServiceOfferup = "opt113"
+	// Deprecated: use ServiceIDOffice365 instead.
+	ServiceOffice365 = "opt7"
+	// Deprecated: use ServiceIDOk instead.
+	ServiceOk = "opt5"
+	// Deprecated: use ServiceIDOlimpbetkz instead.
 	ServiceOlimpbetkz = "opt143"
+	// Deprecated: use ServiceIDOlx instead.
 	ServiceOlx = "opt70"
+	// Deprecated: use ServiceIDOpenapi instead.
+	ServiceOpenapi = "opt132"
+	// Deprecated: use ServiceIDPaddypower instead.
 	ServicePaddypower = "opt109"
+	// Deprecated: use ServiceIDPaxful instead.
+	ServicePaxful = "opt77"
+	// Deprecated: use ServiceIDPaypal instead.
 	ServicePaypal = "opt83"
+	// Deprecated: use ServiceIDPlexbet instead.
 	ServicePlexbet = "opt28"
+	// Deprecated: use ServiceIDPof instead.
 	ServicePof = "opt84"
+	// Deprecated: use ServiceIDPromua instead.
 	ServicePromua = "opt107"
+	// Deprecated: use ServiceIDProtonmail instead.
 	ServiceProtonmail = "opt57"
+	// Deprecated: use ServiceIDQq instead.
+	ServiceQq = "opt34"
+	// Deprecated: use ServiceIDSbermarket instead.
 	ServiceSbermarket = "opt97"
+	// Deprecated: use ServiceIDShopee instead.
 	ServiceShopee = "opt48"
+	// Deprecated: use ServiceIDSignal instead.
 	ServiceSignal = "opt127"
+	// Deprecated: use ServiceIDSkout instead.
 	ServiceSkout = "opt49"
+	// Deprecated: use ServiceIDSkrill instead.
 	ServiceSkrill = "opt117"
+	// Deprecated: use ServiceIDSnapchat instead.
 	ServiceSnapchat = "opt90"
+	// Deprecated: use ServiceIDSteam instead.
 	ServiceSteam = "opt58"
+	// Deprecated: use ServiceIDSwagbucks instead.
 	ServiceSwagbucks = "opt125"
+	// Deprecated: use ServiceIDTango instead.
 	ServiceTango = "opt82"
+	// Deprecated: use ServiceIDTaobao instead.
+	ServiceTaobao = "opt61"
+	// Deprecated: use ServiceIDTaximaxim instead.
 	ServiceTaximaxim = "opt74"
+	// Deprecated: use ServiceIDTelegram instead.
 	ServiceTelegram = "opt29"
-	ServiceQq = "opt34"
+	// Deprecated: use ServiceIDTicketmaster instead.
 	ServiceTicketmaster = "opt52"
+	// Deprecated: use ServiceIDTiktok instead.
 	ServiceTiktok = "opt104"
+	// Deprecated: use ServiceIDTinder instead.
 	ServiceTinder = "opt9"
+	// Deprecated: use ServiceIDTwilio instead.
 	ServiceTwilio = "opt66"
+	// Deprecated: use ServiceIDTwitter instead.
 	ServiceTwitter = "opt41"
+	// Deprecated: use ServiceIDUber instead.
 	ServiceUber = "opt72"
+	// Deprecated: use ServiceIDViber instead.
 	ServiceViber = "opt11"
+	// Deprecated: use ServiceIDVinted instead.
 	ServiceVinted = "opt130"
+	// Deprecated: use ServiceIDVk instead.
 	ServiceVk = "opt69"
+	// Deprecated: use ServiceIDWebmoney instead.
 	ServiceWebmoney = "opt24"
+	// Deprecated: use ServiceIDWechat instead.
 	ServiceWechat = "opt67"
+	// Deprecated: use ServiceIDWeebly instead.
 	ServiceWeebly = "opt54"
+	// Deprecated: use ServiceIDWeststein instead.
 	ServiceWeststein = "opt80"
+	// Deprecated: use ServiceIDWhatsapp instead.
 	ServiceWhatsapp = "opt20"
+	// Deprecated: use ServiceIDWhoosh instead.
 	ServiceWhoosh = "opt123"
+	// Deprecated: use ServiceIDYahoo instead.
 	ServiceYahoo = "opt65"
+	// Deprecated: use ServiceIDYalla instead.
 	ServiceYalla = "opt88"
+	// Deprecated: use ServiceIDYandex instead.
 	ServiceYandex = "opt23"
+	// Deprecated: use ServiceIDZoho instead.
 	ServiceZoho = "opt93"
-	
-)
\ No newline at end of file
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "opt1", Name: "Gmail", NormalizedName: "gmail"},
+	{ID: "opt10", Name: "Aol", NormalizedName: "aol"},
+	{ID: "opt100", Name: "Mamba", NormalizedName: "mamba"},
+	{ID: "opt101", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "opt103", Name: "Icard", NormalizedName: "icard"},
+	{ID: "opt104", Name: "Tiktok", NormalizedName: "tiktok"},
+	{ID: "opt105", Name: "Localbitcoins", NormalizedName: "localbitcoins"},
+	{ID: "opt107", Name: "Promua", NormalizedName: "promua"},
+	{ID: "opt108", Name: "Glovoraketa", NormalizedName: "glovoraketa"},
+	{ID: "opt109", Name: "Paddypower", NormalizedName: "paddypower"},
+	{ID: "opt11", Name: "Viber", NormalizedName: "viber"},
+	{ID: "opt110", Name: "Grindr", NormalizedName: "grindr"},
+	{ID: "opt111", Name: "Imo", NormalizedName: "imo"},
+	{ID: "opt112", Name: "Coinbase", NormalizedName: "coinbase"},
+	{ID: "opt113", Name: "Offerup", NormalizedName: "offerup"},
+	{ID: "opt114", Name: "Locanto", NormalizedName: "locanto"},
+	{ID: "opt115", Name: "Foodpanda", NormalizedName: "foodpanda"},
+	{ID: "opt116", Name: "Neteller", NormalizedName: "neteller"},
+	{ID: "opt117", Name: "Skrill", NormalizedName: "skrill"},
+	{ID: "opt118", Name: "Inboxdollars", NormalizedName: "inboxdollars"},
+	{ID: "opt121", Name: "Monese", NormalizedName: "monese"},
+	{ID: "opt123", Name: "Whoosh", NormalizedName: "whoosh"},
+	{ID: "opt125", Name: "Swagbucks", NormalizedName: "swagbucks"},
+	{ID: "opt127", Name: "Signal", NormalizedName: "signal"},
+	{ID: "opt128", Name: "Golgol", NormalizedName: "golgol"},
+	{ID: "opt129", Name: "Kwiff", NormalizedName: "kwiff"},
+	{ID: "opt13", Name: "Fotostrana", NormalizedName: "fotostrana"},
+	{ID: "opt130", Name: "Vinted", NormalizedName: "vinted"},
+	{ID: "opt131", Name: "Apple", NormalizedName: "apple"},
+	{ID: "opt132", Name: "Openapi", NormalizedName: "openapi"},
+	{ID: "opt143", Name: "Olimpbetkz", NormalizedName: "olimpbetkz"},
+	{ID: "opt15", Name: "Ms", NormalizedName: "ms"},
+	{ID: "opt16", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "opt17", Name: "Bet365", NormalizedName: "bet365"},
+	{ID: "opt2", Name: "Fb", NormalizedName: "fb"},
+	{ID: "opt20", Name: "Whatsapp", NormalizedName: "whatsapp"},
+	{ID: "opt22", Name: "Another", NormalizedName: "another"},
+	{ID: "opt23", Name: "Yandex", NormalizedName: "yandex"},
+	{ID: "opt24", Name: "Webmoney", NormalizedName: "webmoney"},
+	{ID: "opt25", Name: "Betfair", NormalizedName: "betfair"},
+	{ID: "opt26", Name: "Craigslist", NormalizedName: "craigslist"},
+	{ID: "opt27", Name: "Dodopizza", NormalizedName: "dodopizza"},
+	{ID: "opt28", Name: "Plexbet", NormalizedName: "plexbet"},
+	{ID: "opt29", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "opt30", Name: "Grabtaxi", NormalizedName: "grabtaxi"},
+	{ID: "opt31", Name: "Drug", NormalizedName: "drug"},
+	{ID: "opt32", Name: "Dromru", NormalizedName: "dromru"},
+	{ID: "opt33", Name: "Mailru", NormalizedName: "mailru"},
+	{ID: "opt34", Name: "Qq", NormalizedName: "qq"},
+	{ID: "opt35", Name: "Gettaxi", NormalizedName: "gettaxi"},
+	{ID: "opt37", Name: "Line", NormalizedName: "line"},
+	{ID: "opt41", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "opt42", Name: "Livescore", NormalizedName: "livescore"},
+	{ID: "opt420", Name: "Grailed", NormalizedName: "grailed"},
+	{ID: "opt43", Name: "Fastmail", NormalizedName: "fastmail"},
+	{ID: "opt44", Name: "Amazon", NormalizedName: "amazon"},
+	{ID: "opt45", Name: "Discord", NormalizedName: "discord"},
+	{ID: "opt46", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "opt48", Name: "Shopee", NormalizedName: "shopee"},
+	{ID: "opt49", Name: "Skout", NormalizedName: "skout"},
+	{ID: "opt5", Name: "Ok", NormalizedName: "ok"},
+	{ID: "opt51", Name: "Contact", NormalizedName: "contact"},
+	{ID: "opt52", Name: "Ticketmaster", NormalizedName: "ticketmaster"},
+	{ID: "opt54", Name: "Weebly", NormalizedName: "weebly"},
+	{ID: "opt56", Name: "Badoo", NormalizedName: "badoo"},
+	{ID: "opt57", Name: "Protonmail", NormalizedName: "protonmail"},
+	{ID: "opt58", Name: "Steam", NormalizedName: "steam"},
+	{ID: "opt59", Name: "Avito", NormalizedName: "avito"},
+	{ID: "opt60", Name: "Lazada", NormalizedName: "lazada"},
+	{ID: "opt61", Name: "Taobao", NormalizedName: "taobao"},
+	{ID: "opt65", Name: "Yahoo", NormalizedName: "yahoo"},
+	{ID: "opt66", Name: "Twilio", NormalizedName: "twilio"},
+	{ID: "opt67", Name: "Wechat", NormalizedName: "wechat"},
+	{ID: "opt68", Name: "G2a", NormalizedName: "g2a"},
+	{ID: "opt69", Name: "Vk", NormalizedName: "vk"},
+	{ID: "opt7", Name: "Office365", NormalizedName: "office365"},
+	{ID: "opt70", Name: "Olx", NormalizedName: "olx"},
+	{ID: "opt71", Name: "Kakao", NormalizedName: "kakao"},
+	{ID: "opt72", Name: "Uber", NormalizedName: "uber"},
+	{ID: "opt73", Name: "Naver", NormalizedName: "naver"},
+	{ID: "opt74", Name: "Taximaxim", NormalizedName: "taximaxim"},
+	{ID: "opt75", Name: "Lyft", NormalizedName: "lyft"},
+	{ID: "opt76", Name: "Cmobil", NormalizedName: "cmobil"},
+	{ID: "opt77", Name: "Paxful", NormalizedName: "paxful"},
+	{ID: "opt78", Name: "Blizzard", NormalizedName: "blizzard"},
+	{ID: "opt8", Name: "Linkedin", NormalizedName: "linkedin"},
+	{ID: "opt80", Name: "Weststein", NormalizedName: "weststein"},
+	{ID: "opt81", Name: "Bolt", NormalizedName: "bolt"},
+	{ID: "opt82", Name: "Tango", NormalizedName: "tango"},
+	{ID: "opt83", Name: "Paypal", NormalizedName: "paypal"},
+	{ID: "opt84", Name: "Pof", NormalizedName: "pof"},
+	{ID: "opt86", Name: "Nike", NormalizedName: "nike"},
+	{ID: "opt88", Name: "Yalla", NormalizedName: "yalla"},
+	{ID: "opt89", Name: "Careem", NormalizedName: "careem"},
+	{ID: "opt9", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "opt90", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "opt92", Name: "Didi", NormalizedName: "didi"},
+	{ID: "opt93", Name: "Zoho", NormalizedName: "zoho"},
+	{ID: "opt94", Name: "Jd", NormalizedName: "jd"},
+	{ID: "opt95", Name: "Netbet", NormalizedName: "netbet"},
+	{ID: "opt96", Name: "Michat", NormalizedName: "michat"},
+	{ID: "opt97", Name: "Sbermarket", NormalizedName: "sbermarket"},
+}
+
+// CountryID is one of the provider's country IDs
+type CountryID string
+
+func (c CountryID) String() string {
+	return string(c)
+}
+
+const (
+	CountryIDAfghanistan                 CountryID = "AF"
+	CountryIDAlandIslands                CountryID = "AX"
+	CountryIDAlbania                     CountryID = "AL"
+	CountryIDAlgeria                     CountryID = "DZ"
+	CountryIDAmericanSamoa               CountryID = "AS"
+	CountryIDAndorra                     CountryID = "AD"
+	CountryIDAngola                      CountryID = "AO"
+	CountryIDAnguilla                    CountryID = "AI"
+	CountryIDAntiguaBarbuda              CountryID = "AG"
+	CountryIDArgentina                   CountryID = "AR"
+	CountryIDArmenia                     CountryID = "AM"
+	CountryIDAruba                       CountryID = "AW"
+	CountryIDAscensionIsland             CountryID = "AC"
+	CountryIDAustralia                   CountryID = "AU"
+	CountryIDAustria                     CountryID = "AT"
+	CountryIDAzerbaijan                  CountryID = "AZ"
+	CountryIDBahamas                     CountryID = "BS"
+	CountryIDBahrain                     CountryID = "BH"
+	CountryIDBangladesh                  CountryID = "BD"
+	CountryIDBarbados                    CountryID = "BB"
+	CountryIDBelarus                     CountryID = "BY"
+	CountryIDBelgium                     CountryID = "BE"
+	CountryIDBelize                      CountryID = "BZ"
+	CountryIDBenin                       CountryID = "BJ"
+	CountryIDBermuda                     CountryID = "BM"
+	CountryIDBhutan                      CountryID = "BT"
+	CountryIDBolivia                     CountryID = "BO"
+	CountryIDBosniaHerzegovina           CountryID = "BA"
+	CountryIDBotswana                    CountryID = "BW"
+	CountryIDBrazil                      CountryID = "BR"
+	CountryIDBritishIndianOceanTerritory CountryID = "IO"
+	CountryIDBritishVirginIslands        CountryID = "VG"
+	CountryIDBrunei                      CountryID = "BN"
+	CountryIDBulgaria                    CountryID = "BG"
+	CountryIDBurkinaFaso                 CountryID = "BF"
+	CountryIDBurundi                     CountryID = "BI"
+	CountryIDCambodia                    CountryID = "KH"
+	CountryIDCameroon                    CountryID = "CM"
+	CountryIDCanada                      CountryID = "CA"
+	CountryIDCapeVerde                   CountryID = "CV"
+	CountryIDCaribbeanNetherlands        CountryID = "BQ"
+	CountryIDCaymanIslands               CountryID = "KY"
+	CountryIDCentralAfricanRepublic      CountryID = "CF"
+	CountryIDChad                        CountryID = "TD"
+	CountryIDChile                       CountryID = "CL"
+	CountryIDChina                       CountryID = "CN"
+	CountryIDChristmasIsland             CountryID = "CX"
+	CountryIDCocosKeelingIslands         CountryID = "CC"
+	CountryIDColombia                    CountryID = "CO"
+	CountryIDComoros                     CountryID = "KM"
+	CountryIDCongoBrazzaville            CountryID = "CG"
+	CountryIDCongoKinshasa               CountryID = "CD"
+	CountryIDCookIslands                 CountryID = "CK"
+	CountryIDCostaRica                   CountryID = "CR"
+	CountryIDCoteDIvoire                 CountryID = "CI"
+	CountryIDCroatia                     CountryID = "HR"
+	CountryIDCuba                        CountryID = "CU"
+	CountryIDCuracao                     CountryID = "CW"
+	CountryIDCyprus                      CountryID = "CY"
+	CountryIDCzechia                     CountryID = "CZ"
+	CountryIDDenmark                     CountryID = "DK"
+	CountryIDDjibouti                    CountryID = "DJ"
+	CountryIDDominica                    CountryID = "DM"
+	CountryIDDominicanRepublic           CountryID = "DO"
+	CountryIDEcuador                     CountryID = "EC"
+	CountryIDEgypt                       CountryID = "EG"
+	CountryIDElSalvador                  CountryID = "SV"
+	CountryIDEquatorialGuinea            CountryID = "GQ"
+	CountryIDEritrea                     CountryID = "ER"
+	CountryIDEstonia                     CountryID = "EE"
+	CountryIDEthiopia                    CountryID = "ET"
+	CountryIDFalklandIslands             CountryID = "FK"
+	CountryIDFaroeIslands                CountryID = "FO"
+	CountryIDFiji                        CountryID = "FJ"
+	CountryIDFinland                     CountryID = "FI"
+	CountryIDFrance                      CountryID = "FR"
+	CountryIDFrenchGuiana                CountryID = "GF"
+	CountryIDFrenchPolynesia             CountryID = "PF"
+	CountryIDGabon                       CountryID = "GA"
+	CountryIDGambia                      CountryID = "GM"
+	CountryIDGeorgia                     CountryID = "GE"
+	CountryIDGermany                     CountryID = "DE"
+	CountryIDGhana                       CountryID = "GH"
+	CountryIDGibraltar                   CountryID = "GI"
+	CountryIDGreece                      CountryID = "GR"
+	CountryIDGreenland                   CountryID = "GL"
+	CountryIDGrenada                     CountryID = "GD"
+	CountryIDGuadeloupe                  CountryID = "GP"
+	CountryIDGuam                        CountryID = "GU"
+	CountryIDGuatemala                   CountryID = "GT"
+	CountryIDGuernsey                    CountryID = "GG"
+	CountryIDGuinea                      CountryID = "GN"
+	CountryIDGuineaBissau                CountryID = "GW"
+	CountryIDGuyana                      CountryID = "GY"
+	CountryIDHaiti                       CountryID = "HT"
+	CountryIDHonduras                    CountryID = "HN"
+	CountryIDHongKongSARChina            CountryID = "HK"
+	CountryIDHungary                     CountryID = "HU"
+	CountryIDIceland                     CountryID = "IS"
+	CountryIDIndia                       CountryID = "IN"
+	CountryIDIndonesia                   CountryID = "ID"
+	CountryIDIran                        CountryID = "IR"
+	CountryIDIraq                        CountryID = "IQ"
+	CountryIDIreland                     CountryID = "IE"
+	CountryIDIsleOfMan                   CountryID = "IM"
+	CountryIDIsrael                      CountryID = "IL"
+	CountryIDItaly                       CountryID = "IT"
+	CountryIDJamaica                     CountryID = "JM"
+	CountryIDJapan                       CountryID = "JP"
+	CountryIDJersey                      CountryID = "JE"
+	CountryIDJordan                      CountryID = "JO"
+	CountryIDKazakhstan                  CountryID = "KZ"
+	CountryIDKenya                       CountryID = "KE"
+	CountryIDKiribati                    CountryID = "KI"
+	CountryIDKosovo                      CountryID = "XK"
+	CountryIDKuwait                      CountryID = "KW"
+	CountryIDKyrgyzstan                  CountryID = "KG"
+	CountryIDLaos                        CountryID = "LA"
+	CountryIDLatvia                      CountryID = "LV"
+	CountryIDLebanon                     CountryID = "LB"
+	CountryIDLesotho                     CountryID = "LS"
+	CountryIDLiberia                     CountryID = "LR"
+	CountryIDLibya                       CountryID = "LY"
+	CountryIDLiechtenstein               CountryID = "LI"
+	CountryIDLithuania                   CountryID = "LT"
+	CountryIDLuxembourg                  CountryID = "LU"
+	CountryIDMacauSARChina               CountryID = "MO"
+	CountryIDMacedonia                   CountryID = "MK"
+	CountryIDMadagascar                  CountryID = "MG"
+	CountryIDMalawi                      CountryID = "MW"
+	CountryIDMalaysia                    CountryID = "MY"
+	CountryIDMaldives                    CountryID = "MV"
+	CountryIDMali                        CountryID = "ML"
+	CountryIDMalta                       CountryID = "MT"
+	CountryIDMarshallIslands             CountryID = "MH"
+	CountryIDMartinique                  CountryID = "MQ"
+	CountryIDMauritania                  CountryID = "MR"
+	CountryIDMauritius                   CountryID = "MU"
+	CountryIDMayotte                     CountryID = "YT"
+	CountryIDMexico                      CountryID = "MX"
+	CountryIDMicronesia                  CountryID = "FM"
+	CountryIDMoldova                     CountryID = "MD"
+	CountryIDMonaco                      CountryID = "MC"
+	CountryIDMongolia                    CountryID = "MN"
+	CountryIDMontenegro                  CountryID = "ME"
+	CountryIDMontserrat                  CountryID = "MS"
+	CountryIDMorocco                     CountryID = "MA"
+	CountryIDMozambique                  CountryID = "MZ"
+	CountryIDMyanmarBurma                CountryID = "MM"
+	CountryIDNamibia                     CountryID = "NA"
+	CountryIDNauru                       CountryID = "NR"
+	CountryIDNepal                       CountryID = "NP"
+	CountryIDNetherlands                 CountryID = "NL"
+	CountryIDNewCaledonia                CountryID = "NC"
+	CountryIDNewZealand                  CountryID = "NZ"
+	CountryIDNicaragua                   CountryID = "NI"
+	CountryIDNiger                       CountryID = "NE"
+	CountryIDNigeria                     CountryID = "NG"
+	CountryIDNiue                        CountryID = "NU"
+	CountryIDNorfolkIsland               CountryID = "NF"
+	CountryIDNorthernMarianaIslands      CountryID = "MP"
+	CountryIDNorthKorea                  CountryID = "KP"
+	CountryIDNorway                      CountryID = "NO"
+	CountryIDOman                        CountryID = "OM"
+	CountryIDPakistan                    CountryID = "PK"
+	CountryIDPalau                       CountryID = "PW"
+	CountryIDPalestinianTerritories      CountryID = "PS"
+	CountryIDPanama                      CountryID = "PA"
+	CountryIDPapuaNewGuinea              CountryID = "PG"
+	CountryIDParaguay                    CountryID = "PY"
+	CountryIDPeru                        CountryID = "PE"
+	CountryIDPhilippines                 CountryID = "PH"
+	CountryIDPoland                      CountryID = "PL"
+	CountryIDPortugal                    CountryID = "PT"
+	CountryIDPuertoRico                  CountryID = "PR"
+	CountryIDQatar                       CountryID = "QA"
+	CountryIDReunion                     CountryID = "RE"
+	CountryIDRomania                     CountryID = "RO"
+	CountryIDRussia                      CountryID = "RU"
+	CountryIDRwanda                      CountryID = "RW"
+	CountryIDSamoa                       CountryID = "WS"
+	CountryIDSanMarino                   CountryID = "SM"
+	CountryIDSaoTomePrincipe             CountryID = "ST"
+	CountryIDSaudiArabia                 CountryID = "SA"
+	CountryIDSenegal                     CountryID = "SN"
+	CountryIDSerbia                      CountryID = "RS"
+	CountryIDSeychelles                  CountryID = "SC"
+	CountryIDSierraLeone                 CountryID = "SL"
+	CountryIDSingapore                   CountryID = "SG"
+	CountryIDSintMaarten                 CountryID = "SX"
+	CountryIDSlovakia                    CountryID = "SK"
+	CountryIDSlovenia                    CountryID = "SI"
+	CountryIDSolomonIslands              CountryID = "SB"
+	CountryIDSomalia                     CountryID = "SO"
+	CountryIDSouthAfrica                 CountryID = "ZA"
+	CountryIDSouthKorea                  CountryID = "KR"
+	CountryIDSouthSudan                  CountryID = "SS"
+	CountryIDSpain                       CountryID = "ES"
+	CountryIDSriLanka                    CountryID = "LK"
+	CountryIDStBarthelemy                CountryID = "BL"
+	CountryIDStHelena                    CountryID = "SH"
+	CountryIDStKittsNevis                CountryID = "KN"
+	CountryIDStLucia                     CountryID = "LC"
+	CountryIDStMartin                    CountryID = "MF"
+	CountryIDStPierreMiquelon            CountryID = "PM"
+	CountryIDStVincentGrenadines         CountryID = "VC"
+	CountryIDSudan                       CountryID = "SD"
+	CountryIDSuriname                    CountryID = "SR"
+	CountryIDSvalbardJanMayen            CountryID = "SJ"
+	CountryIDSwaziland                   CountryID = "SZ"
+	CountryIDSweden                      CountryID = "SE"
+	CountryIDSwitzerland                 CountryID = "CH"
+	CountryIDSyria                       CountryID = "SY"
+	CountryIDTaiwan                      CountryID = "TW"
+	CountryIDTajikistan                  CountryID = "TJ"
+	CountryIDTanzania                    CountryID = "TZ"
+	CountryIDThailand                    CountryID = "TH"
+	CountryIDTimorLeste                  CountryID = "TL"
+	CountryIDTogo                        CountryID = "TG"
+	CountryIDTokelau                     CountryID = "TK"
+	CountryIDTonga                       CountryID = "TO"
+	CountryIDTrinidadTobago              CountryID = "TT"
+	CountryIDTristanDaCunha              CountryID = "TA"
+	CountryIDTunisia                     CountryID = "TN"
+	CountryIDTurkey                      CountryID = "TR"
+	CountryIDTurkmenistan                CountryID = "TM"
+	CountryIDTurksCaicosIslands          CountryID = "TC"
+	CountryIDTuvalu                      CountryID = "TV"
+	CountryIDUganda                      CountryID = "UG"
+	CountryIDUkraine                     CountryID = "UA"
+	CountryIDUnitedArabEmirates          CountryID = "AE"
+	CountryIDUnitedKingdom               CountryID = "GB"
+	CountryIDUnitedStates                CountryID = "US"
+	CountryIDUruguay                     CountryID = "UY"
+	CountryIDUSVirginIslands             CountryID = "VI"
+	CountryIDUzbekistan                  CountryID = "UZ"
+	CountryIDVanuatu                     CountryID = "VU"
+	CountryIDVaticanCity                 CountryID = "VA"
+	CountryIDVenezuela                   CountryID = "VE"
+	CountryIDVietnam                     CountryID = "VN"
+	CountryIDWallisFutuna                CountryID = "WF"
+	CountryIDWesternSahara               CountryID = "EH"
+	CountryIDYemen                       CountryID = "YE"
+	CountryIDZambia                      CountryID = "ZM"
+	CountryIDZimbabwe                    CountryID = "ZW"
+)
+
+// Country constants predate CountryID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use CountryIDAfghanistan instead.
+	CountryAfghanistan = "AF"
+	// Deprecated: use CountryIDAlandIslands instead.
+	CountryAlandIslands = "AX"
+	// Deprecated: use CountryIDAlbania instead.
+	CountryAlbania = "AL"
+	// Deprecated: use CountryIDAlgeria instead.
+	CountryAlgeria = "DZ"
+	// Deprecated: use CountryIDAmericanSamoa instead.
+	CountryAmericanSamoa = "AS"
+	// Deprecated: use CountryIDAndorra instead.
+	CountryAndorra = "AD"
+	// Deprecated: use CountryIDAngola instead.
+	CountryAngola = "AO"
+	// Deprecated: use CountryIDAnguilla instead.
+	CountryAnguilla = "AI"
+	// Deprecated: use CountryIDAntiguaBarbuda instead.
+	CountryAntiguaBarbuda = "AG"
+	// Deprecated: use CountryIDArgentina instead.
+	CountryArgentina = "AR"
+	// Deprecated: use CountryIDArmenia instead.
+	CountryArmenia = "AM"
+	// Deprecated: use CountryIDAruba instead.
+	CountryAruba = "AW"
+	// Deprecated: use CountryIDAscensionIsland instead.
+	CountryAscensionIsland = "AC"
+	// Deprecated: use CountryIDAustralia instead.
+	CountryAustralia = "AU"
+	// Deprecated: use CountryIDAustria instead.
+	CountryAustria = "AT"
+	// Deprecated: use CountryIDAzerbaijan instead.
+	CountryAzerbaijan = "AZ"
+	// Deprecated: use CountryIDBahamas instead.
+	CountryBahamas = "BS"
+	// Deprecated: use CountryIDBahrain instead.
+	CountryBahrain = "BH"
+	// Deprecated: use CountryIDBangladesh instead.
+	CountryBangladesh = "BD"
+	// Deprecated: use CountryIDBarbados instead.
+	CountryBarbados = "BB"
+	// Deprecated: use CountryIDBelarus instead.
+	CountryBelarus = "BY"
+	// Deprecated: use CountryIDBelgium instead.
+	CountryBelgium = "BE"
+	// Deprecated: use CountryIDBelize instead.
+	CountryBelize = "BZ"
+	// Deprecated: use CountryIDBenin instead.
+	CountryBenin = "BJ"
+	// Deprecated: use CountryIDBermuda instead.
+	CountryBermuda = "BM"
+	// Deprecated: use CountryIDBhutan instead.
+	CountryBhutan = "BT"
+	// Deprecated: use CountryIDBolivia instead.
+	CountryBolivia = "BO"
+	// Deprecated: use CountryIDBosniaHerzegovina instead.
+	CountryBosniaHerzegovina = "BA"
+	// Deprecated: use CountryIDBotswana instead.
+	CountryBotswana = "BW"
+	// Deprecated: use CountryIDBrazil instead.
+	CountryBrazil = "BR"
+	// Deprecated: use CountryIDBritishIndianOceanTerritory instead.
+	CountryBritishIndianOceanTerritory = "IO"
+	// Deprecated: use CountryIDBritishVirginIslands instead.
+	CountryBritishVirginIslands = "VG"
+	// Deprecated: use CountryIDBrunei instead.
+	CountryBrunei = "BN"
+	// Deprecated: use CountryIDBulgaria instead.
+	CountryBulgaria = "BG"
+	// Deprecated: use CountryIDBurkinaFaso instead.
+	CountryBurkinaFaso = "BF"
+	// Deprecated: use CountryIDBurundi instead.
+	CountryBurundi = "BI"
+	// Deprecated: use CountryIDCambodia instead.
+	CountryCambodia = "KH"
+	// Deprecated: use CountryIDCameroon instead.
+	CountryCameroon = "CM"
+	// Deprecated: use CountryIDCanada instead.
+	CountryCanada = "CA"
+	// Deprecated: use CountryIDCapeVerde instead.
+	CountryCapeVerde = "CV"
+	// Deprecated: use CountryIDCaribbeanNetherlands instead.
+	CountryCaribbeanNetherlands = "BQ"
+	// Deprecated: use CountryIDCaymanIslands instead.
+	CountryCaymanIslands = "KY"
+	// Deprecated: use CountryIDCentralAfricanRepublic instead.
+	CountryCentralAfricanRepublic = "CF"
+	// Deprecated: use CountryIDChad instead.
+	CountryChad = "TD"
+	// Deprecated: use CountryIDChile instead.
+	CountryChile = "CL"
+	// Deprecated: use CountryIDChina instead.
+	CountryChina = "CN"
+	// Deprecated: use CountryIDChristmasIsland instead.
+	CountryChristmasIsland = "CX"
+	// Deprecated: use CountryIDCocosKeelingIslands instead.
+	CountryCocosKeelingIslands = "CC"
+	// Deprecated: use CountryIDColombia instead.
+	CountryColombia = "CO"
+	// Deprecated: use CountryIDComoros instead.
+	CountryComoros = "KM"
+	// Deprecated: use CountryIDCongoBrazzaville instead.
+	CountryCongoBrazzaville = "CG"
+	// Deprecated: use CountryIDCongoKinshasa instead.
+	CountryCongoKinshasa = "CD"
+	// Deprecated: use CountryIDCookIslands instead.
+	CountryCookIslands = "CK"
+	// Deprecated: use CountryIDCostaRica instead.
+	CountryCostaRica = "CR"
+	// Deprecated: use CountryIDCoteDIvoire instead.
+	CountryCoteDIvoire = "CI"
+	// Deprecated: use CountryIDCroatia instead.
+	CountryCroatia = "HR"
+	// Deprecated: use CountryIDCuba instead.
+	CountryCuba = "CU"
+	// Deprecated: use CountryIDCuracao instead.
+	CountryCuracao = "CW"
+	// Deprecated: use CountryIDCyprus instead.
+	CountryCyprus = "CY"
+	// Deprecated: use CountryIDCzechia instead.
+	CountryCzechia = "CZ"
+	// Deprecated: use CountryIDDenmark instead.
+	CountryDenmark = "DK"
+	// Deprecated: use CountryIDDjibouti instead.
+	CountryDjibouti = "DJ"
+	// Deprecated: use CountryIDDominica instead.
+	CountryDominica = "DM"
+	// Deprecated: use CountryIDDominicanRepublic instead.
+	CountryDominicanRepublic = "DO"
+	// Deprecated: use CountryIDEcuador instead.
+	CountryEcuador = "EC"
+	// Deprecated: use CountryIDEgypt instead.
+	CountryEgypt = "EG"
+	// Deprecated: use CountryIDElSalvador instead.
+	CountryElSalvador = "SV"
+	// Deprecated: use CountryIDEquatorialGuinea instead.
+	CountryEquatorialGuinea = "GQ"
+	// Deprecated: use CountryIDEritrea instead.
+	CountryEritrea = "ER"
+	// Deprecated: use CountryIDEstonia instead.
+	CountryEstonia = "EE"
+	// Deprecated: use CountryIDEthiopia instead.
+	CountryEthiopia = "ET"
+	// Deprecated: use CountryIDFalklandIslands instead.
+	CountryFalklandIslands = "FK"
+	// Deprecated: use CountryIDFaroeIslands instead.
+	CountryFaroeIslands = "FO"
+	// Deprecated: use CountryIDFiji instead.
+	CountryFiji = "FJ"
+	// Deprecated: use CountryIDFinland instead.
+	CountryFinland = "FI"
+	// Deprecated: use CountryIDFrance instead.
+	CountryFrance = "FR"
+	// Deprecated: use CountryIDFrenchGuiana instead.
+	CountryFrenchGuiana = "GF"
+	// Deprecated: use CountryIDFrenchPolynesia instead.
+	CountryFrenchPolynesia = "PF"
+	// Deprecated: use CountryIDGabon instead.
+	CountryGabon = "GA"
+	// Deprecated: use CountryIDGambia instead.
+	CountryGambia = "GM"
+	// Deprecated: use CountryIDGeorgia instead.
+	CountryGeorgia = "GE"
+	// Deprecated: use CountryIDGermany instead.
+	CountryGermany = "DE"
+	// Deprecated: use CountryIDGhana instead.
+	CountryGhana = "GH"
+	// Deprecated: use CountryIDGibraltar instead.
+	CountryGibraltar = "GI"
+	// Deprecated: use CountryIDGreece instead.
+	CountryGreece = "GR"
+	// Deprecated: use CountryIDGreenland instead.
+	CountryGreenland = "GL"
+	// Deprecated: use CountryIDGrenada instead.
+	CountryGrenada = "GD"
+	// Deprecated: use CountryIDGuadeloupe instead.
+	CountryGuadeloupe = "GP"
+	// Deprecated: use CountryIDGuam instead.
+	CountryGuam = "GU"
+	// Deprecated: use CountryIDGuatemala instead.
+	CountryGuatemala = "GT"
+	// Deprecated: use CountryIDGuernsey instead.
+	CountryGuernsey = "GG"
+	// Deprecated: use CountryIDGuinea instead.
+	CountryGuinea = "GN"
+	// Deprecated: use CountryIDGuineaBissau instead.
+	CountryGuineaBissau = "GW"
+	// Deprecated: use CountryIDGuyana instead.
+	CountryGuyana = "GY"
+	// Deprecated: use CountryIDHaiti instead.
+	CountryHaiti = "HT"
+	// Deprecated: use CountryIDHonduras instead.
+	CountryHonduras = "HN"
+	// Deprecated: use CountryIDHongKongSARChina instead.
+	CountryHongKongSARChina = "HK"
+	// Deprecated: use CountryIDHungary instead.
+	CountryHungary = "HU"
+	// Deprecated: use CountryIDIceland instead.
+	CountryIceland = "IS"
+	// Deprecated: use CountryIDIndia instead.
+	CountryIndia = "IN"
+	// Deprecated: use CountryIDIndonesia instead.
+	CountryIndonesia = "ID"
+	// Deprecated: use CountryIDIran instead.
+	CountryIran = "IR"
+	// Deprecated: use CountryIDIraq instead.
+	CountryIraq = "IQ"
+	// Deprecated: use CountryIDIreland instead.
+	CountryIreland = "IE"
+	// Deprecated: use CountryIDIsleOfMan instead.
+	CountryIsleOfMan = "IM"
+	// Deprecated: use CountryIDIsrael instead.
+	CountryIsrael = "IL"
+	// Deprecated: use CountryIDItaly instead.
+	CountryItaly = "IT"
+	// Deprecated: use CountryIDJamaica instead.
+	CountryJamaica = "JM"
+	// Deprecated: use CountryIDJapan instead.
+	CountryJapan = "JP"
+	// Deprecated: use CountryIDJersey instead.
+	CountryJersey = "JE"
+	// Deprecated: use CountryIDJordan instead.
+	CountryJordan = "JO"
+	// Deprecated: use CountryIDKazakhstan instead.
+	CountryKazakhstan = "KZ"
+	// Deprecated: use CountryIDKenya instead.
+	CountryKenya = "KE"
+	// Deprecated: use CountryIDKiribati instead.
+	CountryKiribati = "KI"
+	// Deprecated: use CountryIDKosovo instead.
+	CountryKosovo = "XK"
+	// Deprecated: use CountryIDKuwait instead.
+	CountryKuwait = "KW"
+	// Deprecated: use CountryIDKyrgyzstan instead.
+	CountryKyrgyzstan = "KG"
+	// Deprecated: use CountryIDLaos instead.
+	CountryLaos = "LA"
+	// Deprecated: use CountryIDLatvia instead.
+	CountryLatvia = "LV"
+	// Deprecated: use CountryIDLebanon instead.
+	CountryLebanon = "LB"
+	// Deprecated: use CountryIDLesotho instead.
+	CountryLesotho = "LS"
+	// Deprecated: use CountryIDLiberia instead.
+	CountryLiberia = "LR"
+	// Deprecated: use CountryIDLibya instead.
+	CountryLibya = "LY"
+	// Deprecated: use CountryIDLiechtenstein instead.
+	CountryLiechtenstein = "LI"
+	// Deprecated: use CountryIDLithuania instead.
+	CountryLithuania = "LT"
+	// Deprecated: use CountryIDLuxembourg instead.
+	CountryLuxembourg = "LU"
+	// Deprecated: use CountryIDMacauSARChina instead.
+	CountryMacauSARChina = "MO"
+	// Deprecated: use CountryIDMacedonia instead.
+	CountryMacedonia = "MK"
+	// Deprecated: use CountryIDMadagascar instead.
+	CountryMadagascar = "MG"
+	// Deprecated: use CountryIDMalawi instead.
+	CountryMalawi = "MW"
+	// Deprecated: use CountryIDMalaysia instead.
+	CountryMalaysia = "MY"
+	// Deprecated: use CountryIDMaldives instead.
+	CountryMaldives = "MV"
+	// Deprecated: use CountryIDMali instead.
+	CountryMali = "ML"
+	// Deprecated: use CountryIDMalta instead.
+	CountryMalta = "MT"
+	// Deprecated: use CountryIDMarshallIslands instead.
+	CountryMarshallIslands = "MH"
+	// Deprecated: use CountryIDMartinique instead.
+	CountryMartinique = "MQ"
+	// Deprecated: use CountryIDMauritania instead.
+	CountryMauritania = "MR"
+	// Deprecated: use CountryIDMauritius instead.
+	CountryMauritius = "MU"
+	// Deprecated: use CountryIDMayotte instead.
+	CountryMayotte = "YT"
+	// Deprecated: use CountryIDMexico instead.
+	CountryMexico = "MX"
+	// Deprecated: use CountryIDMicronesia instead.
+	CountryMicronesia = "FM"
+	// Deprecated: use CountryIDMoldova instead.
+	CountryMoldova = "MD"
+	// Deprecated: use CountryIDMonaco instead.
+	CountryMonaco = "MC"
+	// Deprecated: use CountryIDMongolia instead.
+	CountryMongolia = "MN"
+	// Deprecated: use CountryIDMontenegro instead.
+	CountryMontenegro = "ME"
+	// Deprecated: use CountryIDMontserrat instead.
+	CountryMontserrat = "MS"
+	// Deprecated: use CountryIDMorocco instead.
+	CountryMorocco = "MA"
+	// Deprecated: use CountryIDMozambique instead.
+	CountryMozambique = "MZ"
+	// Deprecated: use CountryIDMyanmarBurma instead.
+	CountryMyanmarBurma = "MM"
+	// Deprecated: use CountryIDNamibia instead.
+	CountryNamibia = "NA"
+	// Deprecated: use CountryIDNauru instead.
+	CountryNauru = "NR"
+	// Deprecated: use CountryIDNepal instead.
+	CountryNepal = "NP"
+	// Deprecated: use CountryIDNetherlands instead.
+	CountryNetherlands = "NL"
+	// Deprecated: use CountryIDNewCaledonia instead.
+	CountryNewCaledonia = "NC"
+	// Deprecated: use CountryIDNewZealand instead.
+	CountryNewZealand = "NZ"
+	// Deprecated: use CountryIDNicaragua instead.
+	CountryNicaragua = "NI"
+	// Deprecated: use CountryIDNiger instead.
+	CountryNiger = "NE"
+	// Deprecated: use CountryIDNigeria instead.
+	CountryNigeria = "NG"
+	// Deprecated: use CountryIDNiue instead.
+	CountryNiue = "NU"
+	// Deprecated: use CountryIDNorfolkIsland instead.
+	CountryNorfolkIsland = "NF"
+	// Deprecated: use CountryIDNorthernMarianaIslands instead.
+	CountryNorthernMarianaIslands = "MP"
+	// Deprecated: use CountryIDNorthKorea instead.
+	CountryNorthKorea = "KP"
+	// Deprecated: use CountryIDNorway instead.
+	CountryNorway = "NO"
+	// Deprecated: use CountryIDOman instead.
+	CountryOman = "OM"
+	// Deprecated: use CountryIDPakistan instead.
+	CountryPakistan = "PK"
+	// Deprecated: use CountryIDPalau instead.
+	CountryPalau = "PW"
+	// Deprecated: use CountryIDPalestinianTerritories instead.
+	CountryPalestinianTerritories = "PS"
+	// Deprecated: use CountryIDPanama instead.
+	CountryPanama = "PA"
+	// Deprecated: use CountryIDPapuaNewGuinea instead.
+	CountryPapuaNewGuinea = "PG"
+	// Deprecated: use CountryIDParaguay instead.
+	CountryParaguay = "PY"
+	// Deprecated: use CountryIDPeru instead.
+	CountryPeru = "PE"
+	// Deprecated: use CountryIDPhilippines instead.
+	CountryPhilippines = "PH"
+	// Deprecated: use CountryIDPoland instead.
+	CountryPoland = "PL"
+	// Deprecated: use CountryIDPortugal instead.
+	CountryPortugal = "PT"
+	// Deprecated: use CountryIDPuertoRico instead.
+	CountryPuertoRico = "PR"
+	// Deprecated: use CountryIDQatar instead.
+	CountryQatar = "QA"
+	// Deprecated: use CountryIDReunion instead.
+	CountryReunion = "RE"
+	// Deprecated: use CountryIDRomania instead.
+	CountryRomania = "RO"
+	// Deprecated: use CountryIDRussia instead.
+	CountryRussia = "RU"
+	// Deprecated: use CountryIDRwanda instead.
+	CountryRwanda = "RW"
+	// Deprecated: use CountryIDSamoa instead.
+	CountrySamoa = "WS"
+	// Deprecated: use CountryIDSanMarino instead.
+	CountrySanMarino = "SM"
+	// Deprecated: use CountryIDSaoTomePrincipe instead.
+	CountrySaoTomePrincipe = "ST"
+	// Deprecated: use CountryIDSaudiArabia instead.
+	CountrySaudiArabia = "SA"
+	// Deprecated: use CountryIDSenegal instead.
+	CountrySenegal = "SN"
+	// Deprecated: use CountryIDSerbia instead.
+	CountrySerbia = "RS"
+	// Deprecated: use CountryIDSeychelles instead.
+	CountrySeychelles = "SC"
+	// Deprecated: use CountryIDSierraLeone instead.
+	CountrySierraLeone = "SL"
+	// Deprecated: use CountryIDSingapore instead.
+	CountrySingapore = "SG"
+	// Deprecated: use CountryIDSintMaarten instead.
+	CountrySintMaarten = "SX"
+	// Deprecated: use CountryIDSlovakia instead.
+	CountrySlovakia = "SK"
+	// Deprecated: use CountryIDSlovenia instead.
+	CountrySlovenia = "SI"
+	// Deprecated: use CountryIDSolomonIslands instead.
+	CountrySolomonIslands = "SB"
+	// Deprecated: use CountryIDSomalia instead.
+	CountrySomalia = "SO"
+	// Deprecated: use CountryIDSouthAfrica instead.
+	CountrySouthAfrica = "ZA"
+	// Deprecated: use CountryIDSouthKorea instead.
+	CountrySouthKorea = "KR"
+	// Deprecated: use CountryIDSouthSudan instead.
+	CountrySouthSudan = "SS"
+	// Deprecated: use CountryIDSpain instead.
+	CountrySpain = "ES"
+	// Deprecated: use CountryIDSriLanka instead.
+	CountrySriLanka = "LK"
+	// Deprecated: use CountryIDStBarthelemy instead.
+	CountryStBarthelemy = "BL"
+	// Deprecated: use CountryIDStHelena instead.
+	CountryStHelena = "SH"
+	// Deprecated: use CountryIDStKittsNevis instead.
+	CountryStKittsNevis = "KN"
+	// Deprecated: use CountryIDStLucia instead.
+	CountryStLucia = "LC"
+	// Deprecated: use CountryIDStMartin instead.
+	CountryStMartin = "MF"
+	// Deprecated: use CountryIDStPierreMiquelon instead.
+	CountryStPierreMiquelon = "PM"
+	// Deprecated: use CountryIDStVincentGrenadines instead.
+	CountryStVincentGrenadines = "VC"
+	// Deprecated: use CountryIDSudan instead.
+	CountrySudan = "SD"
+	// Deprecated: use CountryIDSuriname instead.
+	CountrySuriname = "SR"
+	// Deprecated: use CountryIDSvalbardJanMayen instead.
+	CountrySvalbardJanMayen = "SJ"
+	// Deprecated: use CountryIDSwaziland instead.
+	CountrySwaziland = "SZ"
+	// Deprecated: use CountryIDSweden instead.
+	CountrySweden = "SE"
+	// Deprecated: use CountryIDSwitzerland instead.
+	CountrySwitzerland = "CH"
+	// Deprecated: use CountryIDSyria instead.
+	CountrySyria = "SY"
+	// Deprecated: use CountryIDTaiwan instead.
+	CountryTaiwan = "TW"
+	// Deprecated: use CountryIDTajikistan instead.
+	CountryTajikistan = "TJ"
+	// Deprecated: use CountryIDTanzania instead.
+	CountryTanzania = "TZ"
+	// Deprecated: use CountryIDThailand instead.
+	CountryThailand = "TH"
+	// Deprecated: use CountryIDTimorLeste instead.
+	CountryTimorLeste = "TL"
+	// Deprecated: use CountryIDTogo instead.
+	CountryTogo = "TG"
+	// Deprecated: use CountryIDTokelau instead.
+	CountryTokelau = "TK"
+	// Deprecated: use CountryIDTonga instead.
+	CountryTonga = "TO"
+	// Deprecated: use CountryIDTrinidadTobago instead.
+	CountryTrinidadTobago = "TT"
+	// Deprecated: use CountryIDTristanDaCunha instead.
+	CountryTristanDaCunha = "TA"
+	// Deprecated: use CountryIDTunisia instead.
+	CountryTunisia = "TN"
+	// Deprecated: use CountryIDTurkey instead.
+	CountryTurkey = "TR"
+	// Deprecated: use CountryIDTurkmenistan instead.
+	CountryTurkmenistan = "TM"
+	// Deprecated: use CountryIDTurksCaicosIslands instead.
+	CountryTurksCaicosIslands = "TC"
+	// Deprecated: use CountryIDTuvalu instead.
+	CountryTuvalu = "TV"
+	// Deprecated: use CountryIDUganda instead.
+	CountryUganda = "UG"
+	// Deprecated: use CountryIDUkraine instead.
+	CountryUkraine = "UA"
+	// Deprecated: use CountryIDUnitedArabEmirates instead.
+	CountryUnitedArabEmirates = "AE"
+	// Deprecated: use CountryIDUnitedKingdom instead.
+	CountryUnitedKingdom = "GB"
+	// Deprecated: use CountryIDUnitedStates instead.
+	CountryUnitedStates = "US"
+	// Deprecated: use CountryIDUruguay instead.
+	CountryUruguay = "UY"
+	// Deprecated: use CountryIDUSVirginIslands instead.
+	CountryUSVirginIslands = "VI"
+	// Deprecated: use CountryIDUzbekistan instead.
+	CountryUzbekistan = "UZ"
+	// Deprecated: use CountryIDVanuatu instead.
+	CountryVanuatu = "VU"
+	// Deprecated: use CountryIDVaticanCity instead.
+	CountryVaticanCity = "VA"
+	// Deprecated: use CountryIDVenezuela instead.
+	CountryVenezuela = "VE"
+	// Deprecated: use CountryIDVietnam instead.
+	CountryVietnam = "VN"
+	// Deprecated: use CountryIDWallisFutuna instead.
+	CountryWallisFutuna = "WF"
+	// Deprecated: use CountryIDWesternSahara instead.
+	CountryWesternSahara = "EH"
+	// Deprecated: use CountryIDYemen instead.
+	CountryYemen = "YE"
+	// Deprecated: use CountryIDZambia instead.
+	CountryZambia = "ZM"
+	// Deprecated: use CountryIDZimbabwe instead.
+	CountryZimbabwe = "ZW"
+)
+
+// Countries lists every country, sorted by ID
+var Countries = sms.Countries{
+	{ID: "AC", Alpha2: "AC", Name: "Ascension Island", DialCode: 247},
+	{ID: "AD", Alpha2: "AD", Name: "Andorra", DialCode: 376},
+	{ID: "AE", Alpha2: "AE", Name: "United Arab Emirates", DialCode: 971},
+	{ID: "AF", Alpha2: "AF", Name: "Afghanistan", DialCode: 93},
+	{ID: "AG", Alpha2: "AG", Name: "Antigua & Barbuda", DialCode: 1},
+	{ID: "AI", Alpha2: "AI", Name: "Anguilla", DialCode: 1},
+	{ID: "AL", Alpha2: "AL", Name: "Albania", DialCode: 355},
+	{ID: "AM", Alpha2: "AM", Name: "Armenia", DialCode: 374},
+	{ID: "AO", Alpha2: "AO", Name: "Angola", DialCode: 244},
+	{ID: "AR", Alpha2: "AR", Name: "Argentina", DialCode: 54},
+	{ID: "AS", Alpha2: "AS", Name: "American Samoa", DialCode: 1},
+	{ID: "AT", Alpha2: "AT", Name: "Austria", DialCode: 43},
+	{ID: "AU", Alpha2: "AU", Name: "Australia", DialCode: 61},
+	{ID: "AW", Alpha2: "AW", Name: "Aruba", DialCode: 297},
+	{ID: "AX", Alpha2: "AX", Name: "Åland Islands", DialCode: 358},
+	{ID: "AZ", Alpha2: "AZ", Name: "Azerbaijan", DialCode: 994},
+	{ID: "BA", Alpha2: "BA", Name: "Bosnia & Herzegovina", DialCode: 387},
+	{ID: "BB", Alpha2: "BB", Name: "Barbados", DialCode: 1},
+	{ID: "BD", Alpha2: "BD", Name: "Bangladesh", DialCode: 880},
+	{ID: "BE", Alpha2: "BE", Name: "Belgium", DialCode: 32},
+	{ID: "BF", Alpha2: "BF", Name: "Burkina Faso", DialCode: 226},
+	{ID: "BG", Alpha2: "BG", Name: "Bulgaria", DialCode: 359},
+	{ID: "BH", Alpha2: "BH", Name: "Bahrain", DialCode: 973},
+	{ID: "BI", Alpha2: "BI", Name: "Burundi", DialCode: 257},
+	{ID: "BJ", Alpha2: "BJ", Name: "Benin", DialCode: 229},
+	{ID: "BL", Alpha2: "BL", Name: "St. Barthélemy", DialCode: 590},
+	{ID: "BM", Alpha2: "BM", Name: "Bermuda", DialCode: 1},
+	{ID: "BN", Alpha2: "BN", Name: "Brunei", DialCode: 673},
+	{ID: "BO", Alpha2: "BO", Name: "Bolivia", DialCode: 591},
+	{ID: "BQ", Alpha2: "BQ", Name: "Caribbean Netherlands", DialCode: 599},
+	{ID: "BR", Alpha2: "BR", Name: "Brazil", DialCode: 55},
+	{ID: "BS", Alpha2: "BS", Name: "Bahamas", DialCode: 1},
+	{ID: "BT", Alpha2: "BT", Name: "Bhutan", DialCode: 975},
+	{ID: "BW", Alpha2: "BW", Name: "Botswana", DialCode: 267},
+	{ID: "BY", Alpha2: "BY", Name: "Belarus", DialCode: 375},
+	{ID: "BZ", Alpha2: "BZ", Name: "Belize", DialCode: 501},
+	{ID: "CA", Alpha2: "CA", Name: "Canada", DialCode: 1},
+	{ID: "CC", Alpha2: "CC", Name: "Cocos (Keeling) Islands", DialCode: 61},
+	{ID: "CD", Alpha2: "CD", Name: "Congo - Kinshasa", DialCode: 243},
+	{ID: "CF", Alpha2: "CF", Name: "Central African Republic", DialCode: 236},
+	{ID: "CG", Alpha2: "CG", Name: "Congo - Brazzaville", DialCode: 242},
+	{ID: "CH", Alpha2: "CH", Name: "Switzerland", DialCode: 41},
+	{ID: "CI", Alpha2: "CI", Name: "Côte d’Ivoire", DialCode: 225},
+	{ID: "CK", Alpha2: "CK", Name: "Cook Islands", DialCode: 682},
+	{ID: "CL", Alpha2: "CL", Name: "Chile", DialCode: 56},
+	{ID: "CM", Alpha2: "CM", Name: "Cameroon", DialCode: 237},
+	{ID: "CN", Alpha2: "CN", Name: "China", DialCode: 86},
+	{ID: "CO", Alpha2: "CO", Name: "Colombia", DialCode: 57},
+	{ID: "CR", Alpha2: "CR", Name: "Costa Rica", DialCode: 506},
+	{ID: "CU", Alpha2: "CU", Name: "Cuba", DialCode: 53},
+	{ID: "CV", Alpha2: "CV", Name: "Cape Verde", DialCode: 238},
+	{ID: "CW", Alpha2: "CW", Name: "Curaçao", DialCode: 599},
+	{ID: "CX", Alpha2: "CX", Name: "Christmas Island", DialCode: 61},
+	{ID: "CY", Alpha2: "CY", Name: "Cyprus", DialCode: 357},
+	{ID: "CZ", Alpha2: "CZ", Name: "Czechia", DialCode: 420},
+	{ID: "DE", Alpha2: "DE", Name: "Germany", DialCode: 49},
+	{ID: "DJ", Alpha2: "DJ", Name: "Djibouti", DialCode: 253},
+	{ID: "DK", Alpha2: "DK", Name: "Denmark", DialCode: 45},
+	{ID: "DM", Alpha2: "DM", Name: "Dominica", DialCode: 1},
+	{ID: "DO", Alpha2: "DO", Name: "Dominican Republic", DialCode: 1},
+	{ID: "DZ", Alpha2: "DZ", Name: "Algeria", DialCode: 213},
+	{ID: "EC", Alpha2: "EC", Name: "Ecuador", DialCode: 593},
+	{ID: "EE", Alpha2: "EE", Name: "Estonia", DialCode: 372},
+	{ID: "EG", Alpha2: "EG", Name: "Egypt", DialCode: 20},
+	{ID: "EH", Alpha2: "EH", Name: "Western Sahara", DialCode: 212},
+	{ID: "ER", Alpha2: "ER", Name: "Eritrea", DialCode: 291},
+	{ID: "ES", Alpha2: "ES", Name: "Spain", DialCode: 34},
+	{ID: "ET", Alpha2: "ET", Name: "Ethiopia", DialCode: 251},
+	{ID: "FI", Alpha2: "FI", Name: "Finland", DialCode: 358},
+	{ID: "FJ", Alpha2: "FJ", Name: "Fiji", DialCode: 679},
+	{ID: "FK", Alpha2: "FK", Name: "Falkland Islands", DialCode: 500},
+	{ID: "FM", Alpha2: "FM", Name: "Micronesia", DialCode: 691},
+	{ID: "FO", Alpha2: "FO", Name: "Faroe Islands", DialCode: 298},
+	{ID: "FR", Alpha2: "FR", Name: "France", DialCode: 33},
+	{ID: "GA", Alpha2: "GA", Name: "Gabon", DialCode: 241},
+	{ID: "GB", Alpha2: "GB", Name: "United Kingdom", DialCode: 44},
+	{ID: "GD", Alpha2: "GD", Name: "Grenada", DialCode: 1},
+	{ID: "GE", Alpha2: "GE", Name: "Georgia", DialCode: 995},
+	{ID: "GF", Alpha2: "GF", Name: "French Guiana", DialCode: 594},
+	{ID: "GG", Alpha2: "GG", Name: "Guernsey", DialCode: 44},
+	{ID: "GH", Alpha2: "GH", Name: "Ghana", DialCode: 233},
+	{ID: "GI", Alpha2: "GI", Name: "Gibraltar", DialCode: 350},
+	{ID: "GL", Alpha2: "GL", Name: "Greenland", DialCode: 299},
+	{ID: "GM", Alpha2: "GM", Name: "Gambia", DialCode: 220},
+	{ID: "GN", Alpha2: "GN", Name: "Guinea", DialCode: 224},
+	{ID: "GP", Alpha2: "GP", Name: "Guadeloupe", DialCode: 590},
+	{ID: "GQ", Alpha2: "GQ", Name: "Equatorial Guinea", DialCode: 240},
+	{ID: "GR", Alpha2: "GR", Name: "Greece", DialCode: 30},
+	{ID: "GT", Alpha2: "GT", Name: "Guatemala", DialCode: 502},
+	{ID: "GU", Alpha2: "GU", Name: "Guam", DialCode: 1},
+	{ID: "GW", Alpha2: "GW", Name: "Guinea-Bissau", DialCode: 245},
+	{ID: "GY", Alpha2: "GY", Name: "Guyana", DialCode: 592},
+	{ID: "HK", Alpha2: "HK", Name: "Hong Kong SAR China", DialCode: 852},
+	{ID: "HN", Alpha2: "HN", Name: "Honduras", DialCode: 504},
+	{ID: "HR", Alpha2: "HR", Name: "Croatia", DialCode: 385},
+	{ID: "HT", Alpha2: "HT", Name: "Haiti", DialCode: 509},
+	{ID: "HU", Alpha2: "HU", Name: "Hungary", DialCode: 36},
+	{ID: "ID", Alpha2: "ID", Name: "Indonesia", DialCode: 62},
+	{ID: "IE", Alpha2: "IE", Name: "Ireland", DialCode: 353},
+	{ID: "IL", Alpha2: "IL", Name: "Israel", DialCode: 972},
+	{ID: "IM", Alpha2: "IM", Name: "Isle of Man", DialCode: 44},
+	{ID: "IN", Alpha2: "IN", Name: "India", DialCode: 91},
+	{ID: "IO", Alpha2: "IO", Name: "British Indian Ocean Territory", DialCode: 246},
+	{ID: "IQ", Alpha2: "IQ", Name: "Iraq", DialCode: 964},
+	{ID: "IR", Alpha2: "IR", Name: "Iran", DialCode: 98},
+	{ID: "IS", Alpha2: "IS", Name: "Iceland", DialCode: 354},
+	{ID: "IT", Alpha2: "IT", Name: "Italy", DialCode: 39},
+	{ID: "JE", Alpha2: "JE", Name: "Jersey", DialCode: 44},
+	{ID: "JM", Alpha2: "JM", Name: "Jamaica", DialCode: 1},
+	{ID: "JO", Alpha2: "JO", Name: "Jordan", DialCode: 962},
+	{ID: "JP", Alpha2: "JP", Name: "Japan", DialCode: 81},
+	{ID: "KE", Alpha2: "KE", Name: "Kenya", DialCode: 254},
+	{ID: "KG", Alpha2: "KG", Name: "Kyrgyzstan", DialCode: 996},
+	{ID: "KH", Alpha2: "KH", Name: "Cambodia", DialCode: 855},
+	{ID: "KI", Alpha2: "KI", Name: "Kiribati", DialCode: 686},
+	{ID: "KM", Alpha2: "KM", Name: "Comoros", DialCode: 269},
+	{ID: "KN", Alpha2: "KN", Name: "St. Kitts & Nevis", DialCode: 1},
+	{ID: "KP", Alpha2: "KP", Name: "North Korea", DialCode: 850},
+	{ID: "KR", Alpha2: "KR", Name: "South Korea", DialCode: 82},
+	{ID: "KW", Alpha2: "KW", Name: "Kuwait", DialCode: 965},
+	{ID: "KY", Alpha2: "KY", Name: "Cayman Islands", DialCode: 1},
+	{ID: "KZ", Alpha2: "KZ", Name: "Kazakhstan", DialCode: 7},
+	{ID: "LA", Alpha2: "LA", Name: "Laos", DialCode: 856},
+	{ID: "LB", Alpha2: "LB", Name: "Lebanon", DialCode: 961},
+	{ID: "LC", Alpha2: "LC", Name: "St. Lucia", DialCode: 1},
+	{ID: "LI", Alpha2: "LI", Name: "Liechtenstein", DialCode: 423},
+	{ID: "LK", Alpha2: "LK", Name: "Sri Lanka", DialCode: 94},
+	{ID: "LR", Alpha2: "LR", Name: "Liberia", DialCode: 231},
+	{ID: "LS", Alpha2: "LS", Name: "Lesotho", DialCode: 266},
+	{ID: "LT", Alpha2: "LT", Name: "Lithuania", DialCode: 370},
+	{ID: "LU", Alpha2: "LU", Name: "Luxembourg", DialCode: 352},
+	{ID: "LV", Alpha2: "LV", Name: "Latvia", DialCode: 371},
+	{ID: "LY", Alpha2: "LY", Name: "Libya", DialCode: 218},
+	{ID: "MA", Alpha2: "MA", Name: "Morocco", DialCode: 212},
+	{ID: "MC", Alpha2: "MC", Name: "Monaco", DialCode: 377},
+	{ID: "MD", Alpha2: "MD", Name: "Moldova", DialCode: 373},
+	{ID: "ME", Alpha2: "ME", Name: "Montenegro", DialCode: 382},
+	{ID: "MF", Alpha2: "MF", Name: "St. Martin", DialCode: 590},
+	{ID: "MG", Alpha2: "MG", Name: "Madagascar", DialCode: 261},
+	{ID: "MH", Alpha2: "MH", Name: "Marshall Islands", DialCode: 692},
+	{ID: "MK", Alpha2: "MK", Name: "Macedonia", DialCode: 389},
+	{ID: "ML", Alpha2: "ML", Name: "Mali", DialCode: 223},
+	{ID: "MM", Alpha2: "MM", Name: "Myanmar (Burma)", DialCode: 95},
+	{ID: "MN", Alpha2: "MN", Name: "Mongolia", DialCode: 976},
+	{ID: "MO", Alpha2: "MO", Name: "Macau SAR China", DialCode: 853},
+	{ID: "MP", Alpha2: "MP", Name: "Northern Mariana Islands", DialCode: 1},
+	{ID: "MQ", Alpha2: "MQ", Name: "Martinique", DialCode: 596},
+	{ID: "MR", Alpha2: "MR", Name: "Mauritania", DialCode: 222},
+	{ID: "MS", Alpha2: "MS", Name: "Montserrat", DialCode: 1},
+	{ID: "MT", Alpha2: "MT", Name: "Malta", DialCode: 356},
+	{ID: "MU", Alpha2: "MU", Name: "Mauritius", DialCode: 230},
+	{ID: "MV", Alpha2: "MV", Name: "Maldives", DialCode: 960},
+	{ID: "MW", Alpha2: "MW", Name: "Malawi", DialCode: 265},
+	{ID: "MX", Alpha2: "MX", Name: "Mexico", DialCode: 52},
+	{ID: "MY", Alpha2: "MY", Name: "Malaysia", DialCode: 60},
+	{ID: "MZ", Alpha2: "MZ", Name: "Mozambique", DialCode: 258},
+	{ID: "NA", Alpha2: "NA", Name: "Namibia", DialCode: 264},
+	{ID: "NC", Alpha2: "NC", Name: "New Caledonia", DialCode: 687},
+	{ID: "NE", Alpha2: "NE", Name: "Niger", DialCode: 227},
+	{ID: "NF", Alpha2: "NF", Name: "Norfolk Island", DialCode: 672},
+	{ID: "NG", Alpha2: "NG", Name: "Nigeria", DialCode: 234},
+	{ID: "NI", Alpha2: "NI", Name: "Nicaragua", DialCode: 505},
+	{ID: "NL", Alpha2: "NL", Name: "Netherlands", DialCode: 31},
+	{ID: "NO", Alpha2: "NO", Name: "Norway", DialCode: 47},
+	{ID: "NP", Alpha2: "NP", Name: "Nepal", DialCode: 977},
+	{ID: "NR", Alpha2: "NR", Name: "Nauru", DialCode: 674},
+	{ID: "NU", Alpha2: "NU", Name: "Niue", DialCode: 683},
+	{ID: "NZ", Alpha2: "NZ", Name: "New Zealand", DialCode: 64},
+	{ID: "OM", Alpha2: "OM", Name: "Oman", DialCode: 968},
+	{ID: "PA", Alpha2: "PA", Name: "Panama", DialCode: 507},
+	{ID: "PE", Alpha2: "PE", Name: "Peru", DialCode: 51},
+	{ID: "PF", Alpha2: "PF", Name: "French Polynesia", DialCode: 689},
+	{ID: "PG", Alpha2: "PG", Name: "Papua New Guinea", DialCode: 675},
+	{ID: "PH", Alpha2: "PH", Name: "Philippines", DialCode: 63},
+	{ID: "PK", Alpha2: "PK", Name: "Pakistan", DialCode: 92},
+	{ID: "PL", Alpha2: "PL", Name: "Poland", DialCode: 48},
+	{ID: "PM", Alpha2: "PM", Name: "St. Pierre & Miquelon", DialCode: 508},
+	{ID: "PR", Alpha2: "PR", Name: "Puerto Rico", DialCode: 1},
+	{ID: "PS", Alpha2: "PS", Name: "Palestinian Territories", DialCode: 970},
+	{ID: "PT", Alpha2: "PT", Name: "Portugal", DialCode: 351},
+	{ID: "PW", Alpha2: "PW", Name: "Palau", DialCode: 680},
+	{ID: "PY", Alpha2: "PY", Name: "Paraguay", DialCode: 595},
+	{ID: "QA", Alpha2: "QA", Name: "Qatar", DialCode: 974},
+	{ID: "RE", Alpha2: "RE", Name: "Réunion", DialCode: 262},
+	{ID: "RO", Alpha2: "RO", Name: "Romania", DialCode: 40},
+	{ID: "RS", Alpha2: "RS", Name: "Serbia", DialCode: 381},
+	{ID: "RU", Alpha2: "RU", Name: "Russia", DialCode: 7},
+	{ID: "RW", Alpha2: "RW", Name: "Rwanda", DialCode: 250},
+	{ID: "SA", Alpha2: "SA", Name: "Saudi Arabia", DialCode: 966},
+	{ID: "SB", Alpha2: "SB", Name: "Solomon Islands", DialCode: 677},
+	{ID: "SC", Alpha2: "SC", Name: "Seychelles", DialCode: 248},
+	{ID: "SD", Alpha2: "SD", Name: "Sudan", DialCode: 249},
+	{ID: "SE", Alpha2: "SE", Name: "Sweden", DialCode: 46},
+	{ID: "SG", Alpha2: "SG", Name: "Singapore", DialCode: 65},
+	{ID: "SH", Alpha2: "SH", Name: "St. Helena", DialCode: 290},
+	{ID: "SI", Alpha2: "SI", Name: "Slovenia", DialCode: 386},
+	{ID: "SJ", Alpha2: "SJ", Name: "Svalbard & Jan Mayen", DialCode: 47},
+	{ID: "SK", Alpha2: "SK", Name: "Slovakia", DialCode: 421},
+	{ID: "SL", Alpha2: "SL", Name: "Sierra Leone", DialCode: 232},
+	{ID: "SM", Alpha2: "SM", Name: "San Marino", DialCode: 378},
+	{ID: "SN", Alpha2: "SN", Name: "Senegal", DialCode: 221},
+	{ID: "SO", Alpha2: "SO", Name: "Somalia", DialCode: 252},
+	{ID: "SR", Alpha2: "SR", Name: "Suriname", DialCode: 597},
+	{ID: "SS", Alpha2: "SS", Name: "South Sudan", DialCode: 211},
+	{ID: "ST", Alpha2: "ST", Name: "São Tomé & Príncipe", DialCode: 239},
+	{ID: "SV", Alpha2: "SV", Name: "El Salvador", DialCode: 503},
+	{ID: "SX", Alpha2: "SX", Name: "Sint Maarten", DialCode: 1},
+	{ID: "SY", Alpha2: "SY", Name: "Syria", DialCode: 963},
+	{ID: "SZ", Alpha2: "SZ", Name: "Swaziland", DialCode: 268},
+	{ID: "TA", Alpha2: "TA", Name: "Tristan da Cunha", DialCode: 290},
+	{ID: "TC", Alpha2: "TC", Name: "Turks & Caicos Islands", DialCode: 1},
+	{ID: "TD", Alpha2: "TD", Name: "Chad", DialCode: 235},
+	{ID: "TG", Alpha2: "TG", Name: "Togo", DialCode: 228},
+	{ID: "TH", Alpha2: "TH", Name: "Thailand", DialCode: 66},
+	{ID: "TJ", Alpha2: "TJ", Name: "Tajikistan", DialCode: 992},
+	{ID: "TK", Alpha2: "TK", Name: "Tokelau", DialCode: 690},
+	{ID: "TL", Alpha2: "TL", Name: "Timor-Leste", DialCode: 670},
+	{ID: "TM", Alpha2: "TM", Name: "Turkmenistan", DialCode: 993},
+	{ID: "TN", Alpha2: "TN", Name: "Tunisia", DialCode: 216},
+	{ID: "TO", Alpha2: "TO", Name: "Tonga", DialCode: 676},
+	{ID: "TR", Alpha2: "TR", Name: "Turkey", DialCode: 90},
+	{ID: "TT", Alpha2: "TT", Name: "Trinidad & Tobago", DialCode: 1},
+	{ID: "TV", Alpha2: "TV", Name: "Tuvalu", DialCode: 688},
+	{ID: "TW", Alpha2: "TW", Name: "Taiwan", DialCode: 886},
+	{ID: "TZ", Alpha2: "TZ", Name: "Tanzania", DialCode: 255},
+	{ID: "UA", Alpha2: "UA", Name: "Ukraine", DialCode: 380},
+	{ID: "UG", Alpha2: "UG", Name: "Uganda", DialCode: 256},
+	{ID: "US", Alpha2: "US", Name: "United States", DialCode: 1},
+	{ID: "UY", Alpha2: "UY", Name: "Uruguay", DialCode: 598},
+	{ID: "UZ", Alpha2: "UZ", Name: "Uzbekistan", DialCode: 998},
+	{ID: "VA", Alpha2: "VA", Name: "Vatican City", DialCode: 39},
+	{ID: "VC", Alpha2: "VC", Name: "St. Vincent & Grenadines", DialCode: 1},
+	{ID: "VE", Alpha2: "VE", Name: "Venezuela", DialCode: 58},
+	{ID: "VG", Alpha2: "VG", Name: "British Virgin Islands", DialCode: 1},
+	{ID: "VI", Alpha2: "VI", Name: "U.S. Virgin Islands", DialCode: 1},
+	{ID: "VN", Alpha2: "VN", Name: "Vietnam", DialCode: 84},
+	{ID: "VU", Alpha2: "VU", Name: "Vanuatu", DialCode: 678},
+	{ID: "WF", Alpha2: "WF", Name: "Wallis & Futuna", DialCode: 681},
+	{ID: "WS", Alpha2: "WS", Name: "Samoa", DialCode: 685},
+	{ID: "XK", Alpha2: "XK", Name: "Kosovo", DialCode: 383},
+	{ID: "YE", Alpha2: "YE", Name: "Yemen", DialCode: 967},
+	{ID: "YT", Alpha2: "YT", Name: "Mayotte", DialCode: 262},
+	{ID: "ZA", Alpha2: "ZA", Name: "South Africa", DialCode: 27},
+	{ID: "ZM", Alpha2: "ZM", Name: "Zambia", DialCode: 260},
+	{ID: "ZW", Alpha2: "ZW", Name: "Zimbabwe", DialCode: 263},
+}
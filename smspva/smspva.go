@@ -1,5 +1,7 @@
 package smspva
 
+//go:generate go run ../cmd/smsgen -dir . smspva
+
 import (
 	"context"
 	"encoding/json"
@@ -7,18 +9,25 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/saucesteals/sms"
 )
 
+const provider = "smspva"
+
 type Client struct {
 	http   *http.Client
 	apiKey string
 }
 
 var (
-	_ sms.Client = &Client{}
+	_ sms.Client           = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
 )
 
 func NewClient(apiKey string) *Client {
@@ -65,6 +74,17 @@ func (c *Client) do(ctx context.Context, query url.Values, response any) error {
 	return nil
 }
 
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Cancel:           true,
+		// reporting cancels the number
+		Report:  false,
+		Balance: true,
+		Prices:  true,
+	}
+}
+
 func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
 	var data getPhoneNumberResponse
 	if err := c.do(ctx, url.Values{
@@ -84,10 +104,17 @@ func (c *Client) GetPhoneNumber(ctx context.Context, service string, country str
 		return nil, fmt.Errorf("smspva: parsing phone number (%s %s): %w", data.CountryCode, data.Number, err)
 	}
 
-	return &sms.PhoneNumber{
-		PhoneNumber: number,
-		Metadata:    metadata{id: strconv.Itoa(data.ID), service: service, country: country},
-	}, nil
+	id := strconv.Itoa(data.ID)
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       id,
+		Service:  service,
+		Country:  country,
+	}, metadata{id: id, service: service, country: country}), nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{id: rental.ID, service: rental.Service, country: rental.Country})
 }
 
 type getMessagesResponse struct {
@@ -97,7 +124,7 @@ type getMessagesResponse struct {
 }
 
 func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
@@ -126,12 +153,43 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	return []string{data.Text}, nil
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	// smspva stops answering get_sms for denied numbers, so rely on our own state for those
+	if phoneNumber.Cancelled() {
+		return sms.NewPhoneNumberStatus(sms.StatusCancelled, time.Time{}), nil
+	}
+
+	var data getMessagesResponse
+	if err := c.do(ctx, url.Values{
+		"metod":   {"get_sms"},
+		"country": {metadata.country},
+		"service": {metadata.service},
+		"id":      {metadata.id},
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	switch data.Response {
+	case "1":
+		return sms.NewPhoneNumberStatus(sms.StatusReceived, time.Time{}), nil
+	case "2":
+		return sms.NewPhoneNumberStatus(sms.StatusWaiting, time.Time{}), nil
+	default:
+		return nil, fmt.Errorf("smspva: get_sms bad response %+v", data)
+	}
+}
+
 func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	if phoneNumber.Used() || phoneNumber.Cancelled() {
 		return nil
 	}
 
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -158,3 +216,57 @@ func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	return c.CancelPhoneNumber(ctx, phoneNumber)
 }
+
+type getServicePriceResponse struct {
+	Response string      `json:"response"`
+	Price    json.Number `json:"price"`
+}
+
+// GetPrice returns what renting service's number costs in country, smspva
+// only prices one service and country at a time
+func (c *Client) GetPrice(ctx context.Context, service string, country string) (float64, error) {
+	var data getServicePriceResponse
+	if err := c.do(ctx, url.Values{
+		"metod":   {"get_service_price"},
+		"country": {country},
+		"service": {service},
+	}, &data); err != nil {
+		return 0, err
+	}
+
+	if data.Response != "1" {
+		return 0, fmt.Errorf("smspva: get_service_price bad response %+v", data)
+	}
+
+	price, err := data.Price.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("smspva: parsing price %q: %w", data.Price, err)
+	}
+
+	return price, nil
+}
+
+type getBalanceResponse struct {
+	Response string      `json:"response"`
+	Balance  json.Number `json:"balance"`
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	var data getBalanceResponse
+	if err := c.do(ctx, url.Values{
+		"metod": {"get_balance"},
+	}, &data); err != nil {
+		return 0, err
+	}
+
+	if data.Response != "1" {
+		return 0, fmt.Errorf("smspva: get_balance bad response %+v", data)
+	}
+
+	bal, err := data.Balance.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("smspva: parsing balance %q: %w", data.Balance, err)
+	}
+
+	return bal, nil
+}
@@ -0,0 +1,177 @@
+package smsstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/saucesteals/sms"
+	bolt "go.etcd.io/bbolt"
+)
+
+var bucket = []byte("rentals")
+
+// Bolt keeps records in a bbolt database keyed by sms.Record.Key. Only one
+// process may have the database open at a time
+type Bolt struct {
+	db *bolt.DB
+}
+
+var _ sms.UpdatingStore = &Bolt{}
+
+// OpenBolt opens or creates the database at path, waiting up to a second for
+// another process to close it
+func OpenBolt(path string) (*Bolt, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("smsstore: opening %s: %w", path, err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Bolt{db: db}, nil
+}
+
+func (s *Bolt) Save(_ context.Context, record sms.Record) error {
+	if record.UpdatedAt.IsZero() {
+		record.UpdatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(record.Key()), data)
+	})
+}
+
+// decode returns tx's records whose keys start with prefix, keys start with
+// the provider's name so Load and Delete only read the provider's records
+func decode(tx *bolt.Tx, prefix string) ([]sms.Record, error) {
+	var records []sms.Record
+	c := tx.Bucket(bucket).Cursor()
+	for key, value := c.Seek([]byte(prefix)); key != nil && bytes.HasPrefix(key, []byte(prefix)); key, value = c.Next() {
+		var record sms.Record
+		if err := json.Unmarshal(value, &record); err != nil {
+			return nil, fmt.Errorf("smsstore: decoding %s: %w", key, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (s *Bolt) all(prefix string) ([]sms.Record, error) {
+	var records []sms.Record
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		records, err = decode(tx, prefix)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (s *Bolt) Load(_ context.Context, provider string, id string) (sms.Record, error) {
+	records, err := s.all(provider + "/")
+	if err != nil {
+		return sms.Record{}, err
+	}
+
+	return latest(records, provider, id)
+}
+
+func (s *Bolt) List(_ context.Context) ([]sms.Record, error) {
+	records, err := s.all("")
+	if err != nil {
+		return nil, err
+	}
+
+	sortRecords(records)
+
+	return records, nil
+}
+
+func (s *Bolt) Delete(_ context.Context, provider string, id string) error {
+	records, err := s.all(provider + "/")
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		deleted := false
+		for _, record := range records {
+			if record.Is(provider, id) {
+				if err := tx.Bucket(bucket).Delete([]byte(record.Key())); err != nil {
+					return err
+				}
+				deleted = true
+			}
+		}
+
+		if !deleted {
+			return sms.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// Update runs fn in one write transaction, which bbolt serializes
+func (s *Bolt) Update(_ context.Context, fn func(records []sms.Record) ([]sms.Record, error)) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		records, err := decode(tx, "")
+		if err != nil {
+			return err
+		}
+
+		sortRecords(records)
+
+		saved, err := fn(records)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, record := range saved {
+			if record.UpdatedAt.IsZero() {
+				record.UpdatedAt = now
+			}
+
+			data, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.Bucket(bucket).Put([]byte(record.Key()), data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *Bolt) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,410 @@
+package smsstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// JSONLines appends every saved record to a file as one line of JSON, so the
+// file is a log of every change to every rental. The latest line of a
+// rental wins when reading.
+//
+// The records are kept in memory and only lines appended since, by this or
+// another process, are read on each call. Once most lines are stale the file
+// is compacted to one line per record by replacing it. Writers in every
+// process hold a lock on the file at path with ".lock" appended, so no line
+// is appended to a file being replaced
+type JSONLines struct {
+	path string
+
+	mu sync.Mutex
+	// file is the file read up to offset, lines of which were applied to
+	// records
+	file    os.FileInfo
+	offset  int64
+	lines   int
+	seq     int
+	records map[string]indexed
+}
+
+var _ sms.UpdatingStore = &JSONLines{}
+
+// line is a record or, when Deleted is set, the record's deletion
+type line struct {
+	sms.Record
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// indexed is a record and when it was first saved, relative to the others
+type indexed struct {
+	sms.Record
+	seq int
+}
+
+// compactLines is the least number of lines compacted, so small files are
+// left as written
+const compactLines = 1024
+
+// NewJSONLines stores records in the file at path, which is created on the
+// first Save
+func NewJSONLines(path string) *JSONLines {
+	return &JSONLines{path: path}
+}
+
+// lock holds off writers in other processes until unlock is called, it must be
+// called with mu held
+func (s *JSONLines) lock() (unlock func() error, err error) {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return nil, err
+	}
+
+	unlock, err = lockFile(s.path + ".lock")
+	if err != nil {
+		return nil, fmt.Errorf("smsstore: locking %s: %w", s.path, err)
+	}
+
+	return unlock, nil
+}
+
+func (s *JSONLines) append(lines ...line) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, l := range lines {
+		if err := enc.Encode(l); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func (s *JSONLines) reset(file os.FileInfo) {
+	s.file = file
+	s.offset = 0
+	s.lines = 0
+	s.seq = 0
+	s.records = map[string]indexed{}
+}
+
+// read applies the lines appended since the last read, starting over when the
+// file was replaced or truncated
+func (s *JSONLines) read() error {
+	f, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.reset(nil)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if s.file == nil || !os.SameFile(s.file, info) || info.Size() < s.offset {
+		s.reset(info)
+	}
+	s.file = info
+
+	if info.Size() == s.offset {
+		return nil
+	}
+
+	if _, err := f.Seek(s.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	for {
+		data, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		// a last line without a newline is applied but read again next
+		// time, as it may still be being written
+		complete := err == nil
+		if err := s.apply(data, complete); err != nil {
+			return err
+		}
+
+		if !complete {
+			return nil
+		}
+	}
+}
+
+// apply applies a line, which is only consumed when complete
+func (s *JSONLines) apply(data []byte, complete bool) error {
+	trimmed := bytes.TrimSpace(data)
+
+	var l line
+	if len(trimmed) > 0 {
+		if err := json.Unmarshal(trimmed, &l); err != nil {
+			if !complete {
+				return nil
+			}
+
+			return fmt.Errorf("smsstore: %s:%d: %w", s.path, s.lines+1, err)
+		}
+	}
+
+	if complete {
+		s.offset += int64(len(data))
+		s.lines++
+	}
+
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	key := l.Key()
+	if l.Deleted {
+		delete(s.records, key)
+		return nil
+	}
+
+	record, ok := s.records[key]
+	if !ok {
+		s.seq++
+		record.seq = s.seq
+	}
+	record.Record = l.Record
+	s.records[key] = record
+
+	return nil
+}
+
+// list returns the records in the order they were first saved since their
+// last deletion
+func (s *JSONLines) list() []sms.Record {
+	records := make([]indexed, 0, len(s.records))
+	for _, record := range s.records {
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].seq < records[j].seq
+	})
+
+	list := make([]sms.Record, len(records))
+	for i, record := range records {
+		list[i] = record.Record
+	}
+
+	return list
+}
+
+// compact replaces the file with one holding a line per record once most of
+// its lines are stale, it must be called with the lock held
+func (s *JSONLines) compact() error {
+	if err := s.read(); err != nil {
+		return err
+	}
+
+	if s.lines < compactLines || s.lines < 2*len(s.records) {
+		return nil
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, record := range s.list() {
+		if err := enc.Encode(line{Record: record}); err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return err
+	}
+
+	// the next read starts over from the compacted file
+	s.file = nil
+	return s.read()
+}
+
+func (s *JSONLines) Save(_ context.Context, record sms.Record) error {
+	if record.UpdatedAt.IsZero() {
+		record.UpdatedAt = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := s.append(line{Record: record}); err != nil {
+		return err
+	}
+
+	// the record is saved either way, compacting is tried again on the next
+	// save
+	_ = s.compact()
+
+	return nil
+}
+
+func (s *JSONLines) Load(_ context.Context, provider string, id string) (sms.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.read(); err != nil {
+		return sms.Record{}, err
+	}
+
+	return latest(s.list(), provider, id)
+}
+
+func (s *JSONLines) List(_ context.Context) ([]sms.Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.read(); err != nil {
+		return nil, err
+	}
+
+	records := s.list()
+	sortRecords(records)
+
+	return records, nil
+}
+
+func (s *JSONLines) Delete(_ context.Context, provider string, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := s.read(); err != nil {
+		return err
+	}
+
+	var deleted []line
+	for _, record := range s.list() {
+		if record.Is(provider, id) {
+			record.UpdatedAt = time.Now()
+			deleted = append(deleted, line{Record: record, Deleted: true})
+		}
+	}
+
+	if len(deleted) == 0 {
+		return sms.ErrNotFound
+	}
+
+	if err := s.append(deleted...); err != nil {
+		return err
+	}
+
+	_ = s.compact()
+
+	return nil
+}
+
+// Update reads the lines appended so far and appends the records fn returns
+// without another writer appending in between
+func (s *JSONLines) Update(_ context.Context, fn func(records []sms.Record) ([]sms.Record, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := s.read(); err != nil {
+		return err
+	}
+
+	records := s.list()
+	sortRecords(records)
+
+	saved, err := fn(records)
+	if err != nil {
+		return err
+	}
+	if len(saved) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	lines := make([]line, len(saved))
+	for i, record := range saved {
+		if record.UpdatedAt.IsZero() {
+			record.UpdatedAt = now
+		}
+		lines[i] = line{Record: record}
+	}
+
+	if err := s.append(lines...); err != nil {
+		return err
+	}
+
+	_ = s.compact()
+
+	return nil
+}
+
+func (s *JSONLines) Close() error {
+	return nil
+}
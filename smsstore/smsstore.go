@@ -0,0 +1,54 @@
+// Package smsstore implements sms.Store on a JSON-lines file and on an
+// embedded bbolt database.
+//
+//	store, err := smsstore.Open("rentals.jsonl")
+//	if err != nil {
+//		return err
+//	}
+//	defer store.Close()
+//
+//	err = store.Save(ctx, sms.NewRecord(phoneNumber.Rental(), nil))
+package smsstore
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/saucesteals/sms"
+)
+
+// Open opens the bbolt database at path when it ends in .db, .bolt or .bbolt
+// and the JSON-lines file at path otherwise
+func Open(path string) (sms.Store, error) {
+	switch filepath.Ext(path) {
+	case ".db", ".bolt", ".bbolt":
+		return OpenBolt(path)
+	default:
+		return NewJSONLines(path), nil
+	}
+}
+
+// sortRecords orders records by when they were rented
+func sortRecords(records []sms.Record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].RentedAt.Before(records[j].RentedAt)
+	})
+}
+
+// latest returns provider's most recently rented record that is id
+func latest(records []sms.Record, provider string, id string) (sms.Record, error) {
+	found := false
+	var record sms.Record
+	for _, r := range records {
+		if r.Is(provider, id) && (!found || !r.RentedAt.Before(record.RentedAt)) {
+			record = r
+			found = true
+		}
+	}
+
+	if !found {
+		return sms.Record{}, sms.ErrNotFound
+	}
+
+	return record, nil
+}
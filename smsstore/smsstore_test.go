@@ -0,0 +1,386 @@
+package smsstore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+var rentedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func record(provider string, id string, number string, rentedIn time.Duration) sms.Record {
+	return sms.Record{
+		Rental: sms.Rental{
+			Order:  sms.Order{Provider: provider, ID: id, RentedAt: rentedAt.Add(rentedIn)},
+			Number: number,
+		},
+		Status: sms.StatusWaiting,
+	}
+}
+
+// backends opens a fresh store of each kind
+func backends(t *testing.T) map[string]sms.Store {
+	t.Helper()
+
+	dir := t.TempDir()
+	bolt, err := OpenBolt(filepath.Join(dir, "rentals.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { bolt.Close() })
+
+	return map[string]sms.Store{
+		"jsonl": NewJSONLines(filepath.Join(dir, "rentals.jsonl")),
+		"bolt":  bolt,
+	}
+}
+
+func ids(records []sms.Record) []string {
+	var ids []string
+	for _, r := range records {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func mustList(t *testing.T, store sms.Store, want ...string) {
+	t.Helper()
+
+	records, err := store.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ids(records); !equal(got, want) {
+		t.Fatalf("List = %q, want %q", got, want)
+	}
+}
+
+func TestStore(t *testing.T) {
+	for name, store := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// saved out of order, listed by when they were rented
+			for _, r := range []sms.Record{
+				record("smspool", "2", "+12025550102", 2*time.Minute),
+				record("smspool", "1", "+12025550101", time.Minute),
+				record("fivesim", "3", "+12025550103", 3*time.Minute),
+			} {
+				if err := store.Save(ctx, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+			mustList(t, store, "1", "2", "3")
+
+			loaded, err := store.Load(ctx, "smspool", "12025550102")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loaded.ID != "2" || loaded.UpdatedAt.IsZero() {
+				t.Fatalf("Load by number = %+v, want 2 with UpdatedAt set", loaded)
+			}
+
+			if _, err := store.Load(ctx, "fivesim", "1"); !errors.Is(err, sms.ErrNotFound) {
+				t.Fatalf("Load of another provider's id: err = %v, want ErrNotFound", err)
+			}
+
+			// re-saving replaces the record in place
+			received := record("smspool", "1", "+12025550101", time.Minute)
+			received.Status = sms.StatusReceived
+			received.Messages = []string{"123456"}
+			if err := store.Save(ctx, received); err != nil {
+				t.Fatal(err)
+			}
+			mustList(t, store, "1", "2", "3")
+
+			loaded, err = store.Load(ctx, "smspool", "1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loaded.Status != sms.StatusReceived || len(loaded.Messages) != 1 {
+				t.Fatalf("Load after re-save = %+v, want the received record", loaded)
+			}
+
+			if err := store.Delete(ctx, "smspool", "1"); err != nil {
+				t.Fatal(err)
+			}
+			mustList(t, store, "2", "3")
+
+			if err := store.Delete(ctx, "smspool", "1"); !errors.Is(err, sms.ErrNotFound) {
+				t.Fatalf("second Delete: err = %v, want ErrNotFound", err)
+			}
+			if _, err := store.Load(ctx, "smspool", "1"); !errors.Is(err, sms.ErrNotFound) {
+				t.Fatalf("Load after Delete: err = %v, want ErrNotFound", err)
+			}
+
+			// saved again after the deletion, listed where it was rented
+			if err := store.Save(ctx, record("smspool", "1", "+12025550101", time.Minute)); err != nil {
+				t.Fatal(err)
+			}
+			mustList(t, store, "1", "2", "3")
+		})
+	}
+}
+
+func TestLoadLatest(t *testing.T) {
+	for name, store := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// the number was rented twice
+			for _, r := range []sms.Record{
+				record("smspool", "2", "+12025550101", 2*time.Minute),
+				record("smspool", "1", "+12025550101", time.Minute),
+			} {
+				if err := store.Save(ctx, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			loaded, err := store.Load(ctx, "smspool", "+12025550101")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loaded.ID != "2" {
+				t.Fatalf("Load = %s, want the latest rental 2", loaded.ID)
+			}
+
+			// deleting by number deletes both rentals
+			if err := store.Delete(ctx, "smspool", "+12025550101"); err != nil {
+				t.Fatal(err)
+			}
+			mustList(t, store)
+		})
+	}
+}
+
+func countLines(t *testing.T, path string) int {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	n := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		n++
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+func TestJSONLinesCompacts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rentals.jsonl")
+	store := NewJSONLines(path)
+	ctx := context.Background()
+
+	for _, r := range []sms.Record{
+		record("smspool", "1", "+12025550101", time.Minute),
+		record("smspool", "2", "+12025550102", 2*time.Minute),
+		record("smspool", "3", "+12025550103", 2*time.Minute),
+	} {
+		if err := store.Save(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Delete(ctx, "smspool", "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	polled := record("smspool", "3", "+12025550103", 2*time.Minute)
+	for i := 0; i < compactLines; i++ {
+		polled.UpdatedAt = rentedAt.Add(time.Duration(i) * time.Second)
+		if err := store.Save(ctx, polled); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := countLines(t, path); n >= compactLines {
+		t.Fatalf("%d lines after %d saves of 2 records, want the file compacted", n, compactLines+4)
+	}
+
+	// compacting keeps the records, their latest lines and their order
+	for _, s := range []sms.Store{store, NewJSONLines(path)} {
+		mustList(t, s, "1", "3")
+
+		loaded, err := s.Load(ctx, "smspool", "3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !loaded.UpdatedAt.Equal(polled.UpdatedAt) {
+			t.Fatalf("UpdatedAt = %s, want the latest %s", loaded.UpdatedAt, polled.UpdatedAt)
+		}
+	}
+}
+
+func TestJSONLinesReadsOtherWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rentals.jsonl")
+	ctx := context.Background()
+
+	reader := NewJSONLines(path)
+	mustList(t, reader)
+
+	writer := NewJSONLines(path)
+	if err := writer.Save(ctx, record("smspool", "1", "+12025550101", time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	mustList(t, reader, "1")
+
+	if err := writer.Save(ctx, record("smspool", "2", "+12025550102", 2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Delete(ctx, "smspool", "1"); err != nil {
+		t.Fatal(err)
+	}
+	mustList(t, reader, "2")
+
+	// replaced, as by another process compacting it
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewJSONLines(path).Save(ctx, record("smspool", "3", "+12025550103", 3*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	mustList(t, reader, "3")
+}
+
+func TestJSONLinesUnfinishedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rentals.jsonl")
+	ctx := context.Background()
+
+	store := NewJSONLines(path)
+	if err := store.Save(ctx, record("smspool", "1", "+12025550101", time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	// another process is halfway through appending a line
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(`{"provider":"smspool","id":"2",`); err != nil {
+		t.Fatal(err)
+	}
+	mustList(t, store, "1")
+
+	if _, err := f.WriteString(`"number":"+12025550102","rented_at":"2024-01-01T00:02:00Z"}` + "\n"); err != nil {
+		t.Fatal(err)
+	}
+	mustList(t, store, "1", "2")
+}
+
+// increment adds one to the count label of provider's record 1
+func increment(ctx context.Context, store sms.UpdatingStore) error {
+	return store.Update(ctx, func(records []sms.Record) ([]sms.Record, error) {
+		counter := record("counter", "1", "+12025550101", 0)
+		if existing, err := latest(records, "counter", "1"); err == nil {
+			counter = existing
+		}
+
+		count, _ := strconv.Atoi(counter.Labels["count"])
+		counter.Labels = map[string]string{"count": strconv.Itoa(count + 1)}
+		counter.UpdatedAt = time.Time{}
+
+		return []sms.Record{counter}, nil
+	})
+}
+
+func mustCount(t *testing.T, store sms.Store, want int) {
+	t.Helper()
+
+	counter, err := store.Load(context.Background(), "counter", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count := counter.Labels["count"]; count != strconv.Itoa(want) {
+		t.Fatalf("count = %s, want %d", count, want)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	for name, store := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			updating := store.(sms.UpdatingStore)
+			ctx := context.Background()
+
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := increment(ctx, updating); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+			mustCount(t, store, 20)
+
+			failed := errors.New("failed")
+			if err := updating.Update(ctx, func(records []sms.Record) ([]sms.Record, error) {
+				return []sms.Record{record("smspool", "1", "+12025550102", 0)}, failed
+			}); err != failed {
+				t.Fatalf("err = %v, want fn's error", err)
+			}
+			mustList(t, store, "1")
+		})
+	}
+}
+
+func TestJSONLinesUpdatesAcrossWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rentals.jsonl")
+	ctx := context.Background()
+
+	// writers in other processes hold the same file lock, updating through
+	// two stores also compacts the file while the other appends
+	const updates = compactLines
+	var wg sync.WaitGroup
+	for _, store := range []*JSONLines{NewJSONLines(path), NewJSONLines(path)} {
+		wg.Add(1)
+		go func(store *JSONLines) {
+			defer wg.Done()
+			for i := 0; i < updates; i++ {
+				if err := increment(ctx, store); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(store)
+	}
+	wg.Wait()
+
+	if n := countLines(t, path); n >= 2*updates {
+		t.Fatalf("%d lines after %d updates, want the file compacted", n, 2*updates)
+	}
+	mustCount(t, NewJSONLines(path), 2*updates)
+}
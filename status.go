@@ -0,0 +1,81 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Status int
+
+const (
+	StatusWaiting Status = iota
+	StatusReceived
+	StatusExpired
+	StatusCancelled
+	StatusReported
+	StatusFinished
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusWaiting:
+		return "waiting"
+	case StatusReceived:
+		return "received"
+	case StatusExpired:
+		return "expired"
+	case StatusCancelled:
+		return "cancelled"
+	case StatusReported:
+		return "reported"
+	case StatusFinished:
+		return "finished"
+	default:
+		return "unknown"
+	}
+}
+
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Status) UnmarshalText(text []byte) error {
+	for status := StatusWaiting; status <= StatusFinished; status++ {
+		if status.String() == string(text) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("sms: unknown status %q", text)
+}
+
+// Done reports whether no further messages can arrive for a phone number in this status
+func (s Status) Done() bool {
+	return s != StatusWaiting && s != StatusReceived
+}
+
+type PhoneNumberStatus struct {
+	Status Status
+	// ExpiresAt is zero when the provider does not report an expiry
+	ExpiresAt     time.Time
+	TimeRemaining time.Duration
+}
+
+// NewPhoneNumberStatus derives TimeRemaining from expiresAt
+func NewPhoneNumberStatus(status Status, expiresAt time.Time) *PhoneNumberStatus {
+	s := &PhoneNumberStatus{Status: status, ExpiresAt: expiresAt}
+	if !expiresAt.IsZero() {
+		if remaining := time.Until(expiresAt); remaining > 0 {
+			s.TimeRemaining = remaining
+		}
+	}
+
+	return s
+}
+
+type StatusClient interface {
+	Client
+	GetStatus(ctx context.Context, phoneNumber *PhoneNumber) (*PhoneNumberStatus, error)
+}
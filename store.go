@@ -0,0 +1,91 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrNotFound = errors.New("sms: rental not found")
+
+// Record is a rental as a Store keeps it, along with its last known status and
+// the messages received so far
+type Record struct {
+	Rental
+	Status   Status   `json:"status"`
+	Messages []string `json:"messages,omitempty"`
+	// Labels are the caller's own, such as its ID for the rental
+	Labels map[string]string `json:"labels,omitempty"`
+	// UpdatedAt is set by the store when left zero
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewRecord records rental with the status derived from it, for when the
+// provider was not asked for the status
+func NewRecord(rental Rental, messages []string) Record {
+	used := rental.Used || len(messages) > 0
+
+	// providers finish used numbers when they are cancelled
+	status := StatusWaiting
+	switch {
+	case used && rental.Cancelled:
+		status = StatusFinished
+	case rental.Cancelled:
+		status = StatusCancelled
+	case !rental.ExpiresAt.IsZero() && time.Now().After(rental.ExpiresAt):
+		status = StatusExpired
+	case used:
+		status = StatusReceived
+	}
+
+	return Record{Rental: rental, Status: status, Messages: messages}
+}
+
+// Refunded reports whether the rental was cancelled or reported before it was
+// used, which providers refund
+func (r Record) Refunded() bool {
+	if r.Used || len(r.Messages) > 0 {
+		return false
+	}
+
+	return r.Cancelled || r.Status == StatusCancelled || r.Status == StatusReported
+}
+
+// Key identifies the record's rental, rentals are told apart by provider,
+// order ID and number since not every provider has order IDs
+func (r Record) Key() string {
+	return r.Provider + "/" + r.ID + "/" + r.Number
+}
+
+// Is reports whether the record is provider's rental with the order ID or
+// E.164 number id, with or without its leading +
+func (r Record) Is(provider string, id string) bool {
+	if r.Provider != provider {
+		return false
+	}
+
+	return (r.ID != "" && r.ID == id) || r.Number == id || r.Number == "+"+id
+}
+
+// Store keeps rentals so they survive restarts and can be audited afterwards
+type Store interface {
+	// Save inserts the record, or replaces the one with the same key
+	Save(ctx context.Context, record Record) error
+	// Load returns provider's latest record that Is id, or ErrNotFound
+	Load(ctx context.Context, provider string, id string) (Record, error)
+	// List returns every record, in the order they were rented
+	List(ctx context.Context) ([]Record, error)
+	// Delete forgets provider's records that are id, or returns ErrNotFound
+	Delete(ctx context.Context, provider string, id string) error
+	Close() error
+}
+
+// UpdatingStore is implemented by stores that can read and save records in one
+// step, holding off every other writer in this and other processes
+type UpdatingStore interface {
+	Store
+	// Update calls fn with every record, in the order they were rented, and
+	// saves the records it returns. Nothing is saved when fn fails, its error
+	// is returned as is
+	Update(ctx context.Context, fn func(records []Record) ([]Record, error)) error
+}
@@ -0,0 +1,226 @@
+package textverified
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+// v2 is textverified's current API. The simple API the rest of the client
+// uses only lists pending verifications, without when they were created, so
+// past verifications are listed from v2, which authenticates with the
+// account's username along with the api key
+const v2 = "pub/v2/"
+
+var ErrNoUsername = errors.New("textverified: listing past verifications needs the account's username")
+
+// SetUsername sets the account's username, which OrderHistory needs
+func (c *Client) SetUsername(username string) {
+	c.username = username
+}
+
+type v2Token struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// token returns a v2 bearer token, authenticating again a minute before the
+// last one expires
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.v2Token != nil && time.Until(c.v2Token.ExpiresAt) > time.Minute {
+		return c.v2Token.Token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+v2+"auth", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("x-api-username", c.username)
+
+	var token v2Token
+	if err := c.doV2(req, &token); err != nil {
+		return "", err
+	}
+
+	c.v2Token = &token
+	return token.Token, nil
+}
+
+func (c *Client) doV2(req *http.Request, response any) error {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		if resp.StatusCode == http.StatusUnauthorized {
+			return ErrUnauthorized
+		}
+		return fmt.Errorf("textverified: %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("textverified: decoding response: %w", err)
+	}
+
+	return nil
+}
+
+type link struct {
+	Href string `json:"href"`
+}
+
+// verificationList is a page of verifications, newest first, linking to the
+// next page
+type verificationList struct {
+	HasNext bool `json:"hasNext"`
+	Links   struct {
+		Next *link `json:"next"`
+	} `json:"links"`
+	Data []listedVerification `json:"data"`
+}
+
+type listedVerification struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Number      string    `json:"number"`
+	ServiceName string    `json:"serviceName"`
+	State       string    `json:"state"`
+	TotalCost   float64   `json:"totalCost"`
+}
+
+// listPage lists a page of verifications. Pages are only linked from the page
+// before them, so the links are kept to list pages in any order, and forgotten
+// when listing starts over from the first page
+func (c *Client) listPage(ctx context.Context, page int) (*verificationList, error) {
+	c.listMu.Lock()
+	defer c.listMu.Unlock()
+
+	if page == 0 || len(c.pages) == 0 {
+		c.pages = []string{c.baseURL + v2 + "verifications"}
+	}
+
+	// follow the links from the last page known, up to page
+	i := page
+	if i >= len(c.pages) {
+		i = len(c.pages) - 1
+	}
+	for ; ; i++ {
+		list, err := c.fetchPage(ctx, c.pages[i])
+		if err != nil {
+			return nil, err
+		}
+
+		hasNext := list.HasNext && list.Links.Next != nil
+		if hasNext && len(c.pages) == i+1 {
+			c.pages = append(c.pages, list.Links.Next.Href)
+		}
+
+		if i == page {
+			return list, nil
+		}
+		if !hasNext {
+			return &verificationList{}, nil
+		}
+	}
+}
+
+func (c *Client) fetchPage(ctx context.Context, href string) (*verificationList, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, href, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("authorization", "Bearer "+token)
+
+	var list verificationList
+	if err := c.doV2(req, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// OrderHistory lists a page of past verifications, newest first. Pages are
+// textverified's own size, query.Limit is not used
+func (c *Client) OrderHistory(ctx context.Context, query sms.HistoryQuery) (*sms.HistoryPage, error) {
+	if c.username == "" {
+		return nil, ErrNoUsername
+	}
+
+	list, err := c.listPage(ctx, query.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []sms.HistoryRecord{}
+	more := list.HasNext
+	for _, v := range list.Data {
+		// verifications are listed newest first, so once one is older than
+		// From the rest are too
+		if !query.From.IsZero() && v.CreatedAt.Before(query.From) {
+			more = false
+			break
+		}
+		if !query.Contains(v.CreatedAt) {
+			continue
+		}
+
+		number, err := phonenumbers.Parse(v.Number, "US")
+		if err != nil {
+			return nil, fmt.Errorf("textverified: parsing phone number (%s): %w", v.Number, err)
+		}
+
+		service := v.ServiceName
+		if s, ok := Services.ByName(service); ok {
+			service = s.ID
+		}
+
+		records = append(records, sms.HistoryRecord{
+			Order: sms.Order{
+				Provider: provider,
+				ID:       v.ID,
+				Service:  service,
+				Country:  "US",
+				Cost:     v.TotalCost,
+				RentedAt: v.CreatedAt,
+			},
+			Number: phonenumbers.Format(number, phonenumbers.E164),
+			Status: historyStatus(v.State),
+		})
+	}
+
+	return &sms.HistoryPage{Records: records, More: more}, nil
+}
+
+// historyStatus maps a v2 verification state, the way GetStatus maps the
+// simple API's statuses
+func historyStatus(state string) sms.Status {
+	switch state {
+	case "verificationCompleted", "verificationReused", "verificationReactivated":
+		return sms.StatusReceived
+	case "verificationTimedOut":
+		return sms.StatusExpired
+	case "verificationReported":
+		return sms.StatusReported
+	case "verificationCanceled", "verificationRefunded":
+		return sms.StatusCancelled
+	default:
+		return sms.StatusWaiting
+	}
+}
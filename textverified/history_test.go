@@ -0,0 +1,182 @@
+package textverified
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms"
+)
+
+// standIn lists count verifications, one an hour and the latest created at
+// now, two to a page
+type standIn struct {
+	now   time.Time
+	count int
+
+	mu    sync.Mutex
+	auths int
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/pub/v2/auth":
+		if r.Header.Get("x-api-key") != "key" || r.Header.Get("x-api-username") != "user" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		s.mu.Lock()
+		s.auths++
+		s.mu.Unlock()
+
+		json.NewEncoder(w).Encode(v2Token{Token: "token", ExpiresAt: time.Now().Add(time.Hour)})
+	case "/api/pub/v2/verifications":
+		if r.Header.Get("authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		start, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+
+		var list verificationList
+		for i := start; i < start+2 && i < s.count; i++ {
+			list.Data = append(list.Data, listedVerification{
+				ID:          fmt.Sprintf("v%d", i),
+				CreatedAt:   s.now.Add(-time.Duration(i) * time.Hour),
+				Number:      fmt.Sprintf("20255501%02d", i),
+				ServiceName: "Example",
+				State:       "verificationCompleted",
+				TotalCost:   0.5,
+			})
+		}
+		if start+2 < s.count {
+			list.HasNext = true
+			list.Links.Next = &link{Href: fmt.Sprintf("http://%s/api/%sverifications?cursor=%d", r.Host, v2, start+2)}
+		}
+
+		json.NewEncoder(w).Encode(list)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newTestClient(t *testing.T, handler http.Handler, username string) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := NewClient("key")
+	c.baseURL = server.URL + "/api/"
+	c.SetUsername(username)
+
+	return c
+}
+
+func TestOrderHistoryNeedsUsername(t *testing.T) {
+	c := newTestClient(t, &standIn{}, "")
+
+	if _, err := c.OrderHistory(context.Background(), sms.HistoryQuery{}); !errors.Is(err, ErrNoUsername) {
+		t.Fatalf("err = %v, want ErrNoUsername", err)
+	}
+	if sms.CapabilitiesOf(c).History {
+		t.Fatal("History capability without a username")
+	}
+}
+
+func TestAllHistory(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	s := &standIn{now: now, count: 7}
+	c := newTestClient(t, s, "user")
+
+	records, err := sms.AllHistory(context.Background(), c, now.Add(-4*time.Hour-time.Minute), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 5 {
+		t.Fatalf("%d records, want the 5 created in the last 4 hours", len(records))
+	}
+
+	first := records[0]
+	if first.ID != "v0" || first.Number != "+12025550100" || first.Status != sms.StatusReceived || !first.RentedAt.Equal(now) || first.Cost != 0.5 {
+		t.Fatalf("first record = %+v", first)
+	}
+
+	if s.auths != 1 {
+		t.Fatalf("authenticated %d times, want the token reused", s.auths)
+	}
+}
+
+func TestOrderHistoryPages(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, &standIn{now: now, count: 5}, "user")
+	ctx := context.Background()
+
+	// later pages are reached through the links of the pages before them
+	for _, tt := range []struct {
+		page  int
+		first string
+		more  bool
+	}{
+		{2, "v4", false},
+		{1, "v2", true},
+		{0, "v0", true},
+		{3, "", false},
+	} {
+		page, err := c.OrderHistory(ctx, sms.HistoryQuery{Page: tt.page})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := ""
+		if len(page.Records) > 0 {
+			first = page.Records[0].ID
+		}
+		if first != tt.first || page.More != tt.more {
+			t.Fatalf("page %d starts at %q, More = %t, want %q, More = %t", tt.page, first, page.More, tt.first, tt.more)
+		}
+	}
+}
+
+func TestOrderHistoryPagesConcurrently(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, &standIn{now: now, count: 9}, "user")
+	ctx := context.Background()
+
+	// listing the first page starts over, which mustn't cut short the pages
+	// another listing is following
+	var wg sync.WaitGroup
+	errs := make(chan error, 40)
+	for i := 0; i < 20; i++ {
+		for _, page := range []int{0, 4} {
+			wg.Add(1)
+			go func(page int) {
+				defer wg.Done()
+
+				list, err := c.OrderHistory(ctx, sms.HistoryQuery{Page: page})
+				if err != nil {
+					errs <- err
+					return
+				}
+				if want := fmt.Sprintf("v%d", page*2); len(list.Records) == 0 || list.Records[0].ID != want {
+					errs <- fmt.Errorf("page %d = %+v, want it to start at %s", page, list.Records, want)
+				}
+			}(page)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
@@ -1,685 +1,2740 @@
 // Code generated by saucesteals/sms; DO NOT EDIT.
+
 package textverified
 
-var (
-	ServiceNotListed = "0"
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceID101Sweets                   ServiceID = "10613"
+	ServiceID1688                        ServiceID = "488"
+	ServiceID1StopMove                   ServiceID = "268"
+	ServiceID3Fun                        ServiceID = "196"
+	ServiceID5miles                      ServiceID = "158"
+	ServiceIDAARP                        ServiceID = "564"
+	ServiceIDAbra                        ServiceID = "192"
+	ServiceIDAccountPatrolMoneyPatrol    ServiceID = "288"
+	ServiceIDAcorns                      ServiceID = "551"
+	ServiceIDAddItUp                     ServiceID = "430"
+	ServiceIDAdGate                      ServiceID = "11191"
+	ServiceIDAdidas                      ServiceID = "1"
+	ServiceIDAdWallet                    ServiceID = "2"
+	ServiceIDAeldra                      ServiceID = "577"
+	ServiceIDAffirm                      ServiceID = "245"
+	ServiceIDAfterpay                    ServiceID = "547"
+	ServiceIDAH4RAMH                     ServiceID = "11869"
+	ServiceIDAirbnb                      ServiceID = "3"
+	ServiceIDAirtm                       ServiceID = "449"
+	ServiceIDAlbert                      ServiceID = "514"
+	ServiceIDAlibaba                     ServiceID = "4"
+	ServiceIDAllset                      ServiceID = "284"
+	ServiceIDAmasia                      ServiceID = "169"
+	ServiceIDAmazon                      ServiceID = "5"
+	ServiceIDAmazonWebs                  ServiceID = "227"
+	ServiceIDAmericaVoice                ServiceID = "373"
+	ServiceIDAndo                        ServiceID = "445"
+	ServiceIDAngi                        ServiceID = "11931"
+	ServiceIDAnkama                      ServiceID = "286"
+	ServiceIDAol                         ServiceID = "6"
+	ServiceIDAppFlame                    ServiceID = "336"
+	ServiceIDApple                       ServiceID = "216"
+	ServiceIDAppleWallet                 ServiceID = "320"
+	ServiceIDAppStation                  ServiceID = "340"
+	ServiceIDARMSLIST                    ServiceID = "10610"
+	ServiceIDAspiration                  ServiceID = "11810"
+	ServiceIDAtom                        ServiceID = "97"
+	ServiceIDAtomy                       ServiceID = "494"
+	ServiceIDAttaPoll                    ServiceID = "435"
+	ServiceIDAuthy                       ServiceID = "7"
+	ServiceIDAvail                       ServiceID = "477"
+	ServiceIDBackblaze                   ServiceID = "406"
+	ServiceIDBadoo                       ServiceID = "139"
+	ServiceIDBaidu                       ServiceID = "9"
+	ServiceIDBakkt                       ServiceID = "10582"
+	ServiceIDBanxa                       ServiceID = "407"
+	ServiceIDBaselane                    ServiceID = "12011"
+	ServiceIDBeat                        ServiceID = "11917"
+	ServiceIDBestOfOurValley             ServiceID = "282"
+	ServiceIDBetMGM                      ServiceID = "11903"
+	ServiceIDBetnowEU                    ServiceID = "11976"
+	ServiceIDBetterment                  ServiceID = "580"
+	ServiceIDBigoLive                    ServiceID = "490"
+	ServiceIDBiltRewards                 ServiceID = "11819"
+	ServiceIDBitClout                    ServiceID = "438"
+	ServiceIDBitcoinATM                  ServiceID = "330"
+	ServiceIDBitcoinIRA                  ServiceID = "12053"
+	ServiceIDbitFlyer                    ServiceID = "251"
+	ServiceIDBitfront                    ServiceID = "329"
+	ServiceIDBitly                       ServiceID = "12043"
+	ServiceIDBitmo                       ServiceID = "10"
+	ServiceIDBitstamp                    ServiceID = "276"
+	ServiceIDBitwage                     ServiceID = "258"
+	ServiceIDBlackPeopleMeet             ServiceID = "536"
+	ServiceIDBlizzard                    ServiceID = "213"
+	ServiceIDBLK                         ServiceID = "10682"
+	ServiceIDBlockFi                     ServiceID = "578"
+	ServiceIDBlueAcorn                   ServiceID = "439"
+	ServiceIDBlueBird                    ServiceID = "11811"
+	ServiceIDBlueVine                    ServiceID = "368"
+	ServiceIDBoatsetter                  ServiceID = "457"
+	ServiceIDBolt                        ServiceID = "516"
+	ServiceIDBonanza                     ServiceID = "11995"
+	ServiceIDBoo                         ServiceID = "11958"
+	ServiceIDBookingcom                  ServiceID = "10686"
+	ServiceIDBOSSRevolutionMoney         ServiceID = "231"
+	ServiceIDBovada                      ServiceID = "10595"
+	ServiceIDBoxedDeal                   ServiceID = "304"
+	ServiceIDBraid                       ServiceID = "466"
+	ServiceIDBranch                      ServiceID = "12038"
+	ServiceIDBrandclub                   ServiceID = "562"
+	ServiceIDBrandedSurveys              ServiceID = "133"
+	ServiceIDBrex                        ServiceID = "495"
+	ServiceIDBridgeCard                  ServiceID = "554"
+	ServiceIDBTCDirect                   ServiceID = "342"
+	ServiceIDBTCsurveys                  ServiceID = "322"
+	ServiceIDBumble                      ServiceID = "267"
+	ServiceIDBump                        ServiceID = "95"
+	ServiceIDBundil                      ServiceID = "461"
+	ServiceIDBurner                      ServiceID = "11"
+	ServiceIDBurstSMS                    ServiceID = "11865"
+	ServiceIDBuyOnTrust                  ServiceID = "552"
+	ServiceIDCARDcom                     ServiceID = "10591"
+	ServiceIDCareem                      ServiceID = "324"
+	ServiceIDCarepoynt                   ServiceID = "12"
+	ServiceIDCashAlarm                   ServiceID = "334"
+	ServiceIDCashApp                     ServiceID = "13"
+	ServiceIDCashew                      ServiceID = "11883"
+	ServiceIDCashShow                    ServiceID = "14"
+	ServiceIDCashWalk                    ServiceID = "487"
+	ServiceIDCaviar                      ServiceID = "401"
+	ServiceIDcdkeyscom                   ServiceID = "113"
+	ServiceIDCEXIO                       ServiceID = "10710"
+	ServiceIDChampsSports                ServiceID = "11799"
+	ServiceIDChangelly                   ServiceID = "193"
+	ServiceIDCheapVoip                   ServiceID = "236"
+	ServiceIDCheckmate                   ServiceID = "11979"
+	ServiceIDCheckPoints                 ServiceID = "130"
+	ServiceIDCheese                      ServiceID = "465"
+	ServiceIDChicksGoldInc               ServiceID = "10593"
+	ServiceIDChime                       ServiceID = "230"
+	ServiceIDChispa                      ServiceID = "458"
+	ServiceIDChowbus                     ServiceID = "379"
+	ServiceIDCinchbucks                  ServiceID = "142"
+	ServiceIDCircle                      ServiceID = "162"
+	ServiceIDCJSCDKEYSCOM                ServiceID = "167"
+	ServiceIDClassPass                   ServiceID = "159"
+	ServiceIDCleo                        ServiceID = "557"
+	ServiceIDClickadu                    ServiceID = "110"
+	ServiceIDClickDishes                 ServiceID = "168"
+	ServiceIDclickworker                 ServiceID = "327"
+	ServiceIDClover                      ServiceID = "217"
+	ServiceIDClubhouse                   ServiceID = "483"
+	ServiceIDCocaCola                    ServiceID = "11861"
+	ServiceIDCoffeeMeetsBagel            ServiceID = "425"
+	ServiceIDCoinbase                    ServiceID = "108"
+	ServiceIDCoincasper                  ServiceID = "11987"
+	ServiceIDCoinCircle                  ServiceID = "492"
+	ServiceIDCoinCloud                   ServiceID = "441"
+	ServiceIDCoinFlip                    ServiceID = "328"
+	ServiceIDCoinGate                    ServiceID = "190"
+	ServiceIDCoinhub                     ServiceID = "11943"
+	ServiceIDCoinloot                    ServiceID = "11929"
+	ServiceIDCoinme                      ServiceID = "507"
+	ServiceIDCoinomi                     ServiceID = "194"
+	ServiceIDCoinPop                     ServiceID = "337"
+	ServiceIDCoinsBaron                  ServiceID = "11816"
+	ServiceIDCoinseed                    ServiceID = "405"
+	ServiceIDCoinSwitch                  ServiceID = "264"
+	ServiceIDCointelegraph               ServiceID = "204"
+	ServiceIDCoinZoom                    ServiceID = "459"
+	ServiceIDCommunityInsightsForum      ServiceID = "493"
+	ServiceIDCopper                      ServiceID = "462"
+	ServiceIDCouponscom                  ServiceID = "15"
+	ServiceIDCourseHero                  ServiceID = "506"
+	ServiceIDCraigslist                  ServiceID = "16"
+	ServiceIDCreditKarma                 ServiceID = "209"
+	ServiceIDCreditSesame                ServiceID = "170"
+	ServiceIDCrowdTap                    ServiceID = "149"
+	ServiceIDCrypterium                  ServiceID = "454"
+	ServiceIDCryptocom                   ServiceID = "274"
+	ServiceIDCryptolocally               ServiceID = "568"
+	ServiceIDCryptoVoucher               ServiceID = "242"
+	ServiceIDCuriousCat                  ServiceID = "325"
+	ServiceIDCurrent                     ServiceID = "278"
+	ServiceIDCurrentRewards              ServiceID = "476"
+	ServiceIDCurtsy                      ServiceID = "10741"
+	ServiceIDCVS                         ServiceID = "11887"
+	ServiceIDDabbl                       ServiceID = "285"
+	ServiceIDDapper                      ServiceID = "429"
+	ServiceIDDasherDirect                ServiceID = "518"
+	ServiceIDDave                        ServiceID = "225"
+	ServiceIDDaybreakGames               ServiceID = "292"
+	ServiceIDDent                        ServiceID = "18"
+	ServiceIDDepop                       ServiceID = "530"
+	ServiceIDDewu                        ServiceID = "12049"
+	ServiceIDDHL                         ServiceID = "293"
+	ServiceIDDialpad                     ServiceID = "431"
+	ServiceIDDiceFM                      ServiceID = "12005"
+	ServiceIDDigit                       ServiceID = "310"
+	ServiceIDDing                        ServiceID = "467"
+	ServiceIDDiscord                     ServiceID = "19"
+	ServiceIDDistroKid                   ServiceID = "10756"
+	ServiceIDDoctoralia                  ServiceID = "12002"
+	ServiceIDDocuSign                    ServiceID = "479"
+	ServiceIDDollarClix                  ServiceID = "280"
+	ServiceIDDollarGeneral               ServiceID = "121"
+	ServiceIDDonately                    ServiceID = "11911"
+	ServiceIDDonut                       ServiceID = "548"
+	ServiceIDDoorDash                    ServiceID = "20"
+	ServiceIDDora                        ServiceID = "464"
+	ServiceIDDOSH                        ServiceID = "21"
+	ServiceIDDoublelist                  ServiceID = "128"
+	ServiceIDDouugh                      ServiceID = "460"
+	ServiceIDDreamSpring                 ServiceID = "10617"
+	ServiceIDDrop                        ServiceID = "154"
+	ServiceIDDunkinDonuts                ServiceID = "348"
+	ServiceIDEarlyBird                   ServiceID = "565"
+	ServiceIDEarnably                    ServiceID = "259"
+	ServiceIDEarnHoney                   ServiceID = "22"
+	ServiceIDEarningStation              ServiceID = "150"
+	ServiceIDEastbay                     ServiceID = "11805"
+	ServiceIDeBay                        ServiceID = "23"
+	ServiceIDeGifter                     ServiceID = "447"
+	ServiceIDElepreneur                  ServiceID = "208"
+	ServiceIDElevacity                   ServiceID = "163"
+	ServiceIDEllis                       ServiceID = "12006"
+	ServiceIDEmpower                     ServiceID = "171"
+	ServiceIDEneba                       ServiceID = "382"
+	ServiceIDEpicNPC                     ServiceID = "112"
+	ServiceIDEpochTimes                  ServiceID = "563"
+	ServiceIDeRewards                    ServiceID = "24"
+	ServiceIDeToro                       ServiceID = "25"
+	ServiceIDEtsy                        ServiceID = "10782"
+	ServiceIDEureka                      ServiceID = "11897"
+	ServiceIDEveryoneAPI                 ServiceID = "26"
+	ServiceIDEZTexting                   ServiceID = "531"
+	ServiceIDFacebook                    ServiceID = "27"
+	ServiceIDFacebookReset               ServiceID = "11795"
+	ServiceIDFarmersOnly                 ServiceID = "11939"
+	ServiceIDFastMail                    ServiceID = "28"
+	ServiceIDFave                        ServiceID = "10790"
+	ServiceIDFedEx                       ServiceID = "244"
+	ServiceIDFetchRewards                ServiceID = "186"
+	ServiceIDFetLife                     ServiceID = "361"
+	ServiceIDFidelityInvestments         ServiceID = "528"
+	ServiceIDFigureEight                 ServiceID = "152"
+	ServiceIDFinishLine                  ServiceID = "279"
+	ServiceIDFirehouseSubs               ServiceID = "12017"
+	ServiceIDFitplay                     ServiceID = "338"
+	ServiceIDFiverr                      ServiceID = "29"
+	ServiceIDFlare                       ServiceID = "468"
+	ServiceIDFlashRewards                ServiceID = "411"
+	ServiceIDFlippa                      ServiceID = "389"
+	ServiceIDFluz                        ServiceID = "343"
+	ServiceIDFold                        ServiceID = "10605"
+	ServiceIDFootLocker                  ServiceID = "11800"
+	ServiceIDFound                       ServiceID = "558"
+	ServiceIDFreecashcom                 ServiceID = "10579"
+	ServiceIDFreelancer                  ServiceID = "355"
+	ServiceIDFreeTaxUSA                  ServiceID = "347"
+	ServiceIDFruitlab                    ServiceID = "326"
+	ServiceIDFunko                       ServiceID = "12030"
+	ServiceIDFusionCash                  ServiceID = "165"
+	ServiceIDG2A                         ServiceID = "30"
+	ServiceIDG2G                         ServiceID = "96"
+	ServiceIDGabi                        ServiceID = "538"
+	ServiceIDGaintplay                   ServiceID = "11925"
+	ServiceIDGameflip                    ServiceID = "31"
+	ServiceIDGamekit                     ServiceID = "200"
+	ServiceIDGamercraft                  ServiceID = "11835"
+	ServiceIDGemini                      ServiceID = "141"
+	ServiceIDGemiplay                    ServiceID = "11781"
+	ServiceIDGenitrust                   ServiceID = "448"
+	ServiceIDGetPaidTo                   ServiceID = "311"
+	ServiceIDGifthulk                    ServiceID = "32"
+	ServiceIDGiftHunterClub              ServiceID = "176"
+	ServiceIDGiftPocket                  ServiceID = "11785"
+	ServiceIDGlasscom                    ServiceID = "11973"
+	ServiceIDGlassnet                    ServiceID = "523"
+	ServiceIDGlidera                     ServiceID = "185"
+	ServiceIDGmail                       ServiceID = "90"
+	ServiceIDGo2Bank                     ServiceID = "410"
+	ServiceIDGoBank                      ServiceID = "350"
+	ServiceIDGoFundMe                    ServiceID = "470"
+	ServiceIDGoldenFarmery               ServiceID = "335"
+	ServiceIDGoogle                      ServiceID = "33"
+	ServiceIDGoogleBusinessProfile       ServiceID = "11773"
+	ServiceIDGoogleMerchantCenter        ServiceID = "11777"
+	ServiceIDGoogleVoice                 ServiceID = "34"
+	ServiceIDGopuff                      ServiceID = "471"
+	ServiceIDGrab                        ServiceID = "35"
+	ServiceIDGrabPoints                  ServiceID = "202"
+	ServiceIDGreenDot                    ServiceID = "257"
+	ServiceIDGreenDotSmartHome           ServiceID = "428"
+	ServiceIDGreenlight                  ServiceID = "540"
+	ServiceIDGrindr                      ServiceID = "433"
+	ServiceIDGroupMe                     ServiceID = "306"
+	ServiceIDGuru                        ServiceID = "290"
+	ServiceIDHandyAngi                   ServiceID = "11822"
+	ServiceIDHappn                       ServiceID = "199"
+	ServiceIDHappyCo                     ServiceID = "440"
+	ServiceIDHarrisPoll                  ServiceID = "181"
+	ServiceIDHibbett                     ServiceID = "388"
+	ServiceIDHinge                       ServiceID = "161"
+	ServiceIDHomeAway                    ServiceID = "241"
+	ServiceIDHopper                      ServiceID = "10836"
+	ServiceIDHostkey                     ServiceID = "11965"
+	ServiceIDHotmail                     ServiceID = "474"
+	ServiceIDHQTrivia                    ServiceID = "36"
+	ServiceIDHumbleBundle                ServiceID = "287"
+	ServiceIDHunter                      ServiceID = "11871"
+	ServiceIDibotta                      ServiceID = "124"
+	ServiceIDICQ                         ServiceID = "38"
+	ServiceIDIDES                        ServiceID = "11779"
+	ServiceIDIdleEmpire                  ServiceID = "352"
+	ServiceIDIDme                        ServiceID = "414"
+	ServiceIDImgur                       ServiceID = "247"
+	ServiceIDiMoney                      ServiceID = "81"
+	ServiceIDInboxDollars                ServiceID = "129"
+	ServiceIDIndeed                      ServiceID = "423"
+	ServiceIDIndi                        ServiceID = "372"
+	ServiceIDInnago                      ServiceID = "515"
+	ServiceIDInspire                     ServiceID = "398"
+	ServiceIDInstacart                   ServiceID = "248"
+	ServiceIDInstaGC                     ServiceID = "39"
+	ServiceIDInstagram                   ServiceID = "40"
+	ServiceIDInstaRem                    ServiceID = "315"
+	ServiceIDIntuit                      ServiceID = "253"
+	ServiceIDiOffer                      ServiceID = "160"
+	ServiceIDIONOS                       ServiceID = "10858"
+	ServiceIDiPlum                       ServiceID = "399"
+	ServiceIDiPoll                       ServiceID = "99"
+	ServiceIDiRazoo                      ServiceID = "125"
+	ServiceIDIsay                        ServiceID = "10845"
+	ServiceIDJelli                       ServiceID = "408"
+	ServiceIDJerry                       ServiceID = "392"
+	ServiceIDJerseyMikes                 ServiceID = "11953"
+	ServiceIDJobber                      ServiceID = "553"
+	ServiceIDJuno                        ServiceID = "214"
+	ServiceIDKakaoTalk                   ServiceID = "41"
+	ServiceIDKamatera                    ServiceID = "294"
+	ServiceIDKeybase                     ServiceID = "269"
+	ServiceIDKidsFootlocker              ServiceID = "11801"
+	ServiceIDKikoff                      ServiceID = "11833"
+	ServiceIDKixify                      ServiceID = "11815"
+	ServiceIDKlarna                      ServiceID = "404"
+	ServiceIDKuCoin                      ServiceID = "482"
+	ServiceIDKudos                       ServiceID = "11988"
+	ServiceIDLBRYApp                     ServiceID = "223"
+	ServiceIDLDSPlanet                   ServiceID = "11873"
+	ServiceIDLeagueofLegends             ServiceID = "527"
+	ServiceIDLetgo                       ServiceID = "157"
+	ServiceIDLibertyX                    ServiceID = "246"
+	ServiceIDLikeCard                    ServiceID = "544"
+	ServiceIDLili                        ServiceID = "505"
+	ServiceIDLine                        ServiceID = "42"
+	ServiceIDLine2                       ServiceID = "11985"
+	ServiceIDLink                        ServiceID = "11885"
+	ServiceIDLinkedIn                    ServiceID = "43"
+	ServiceIDLinode                      ServiceID = "11966"
+	ServiceIDListia                      ServiceID = "107"
+	ServiceIDLocalBitcoins               ServiceID = "147"
+	ServiceIDLocalCoinATM                ServiceID = "198"
+	ServiceIDLocanto                     ServiceID = "271"
+	ServiceIDLoveAndSeek                 ServiceID = "11874"
+	ServiceIDLyft                        ServiceID = "44"
+	ServiceIDM1Finance                   ServiceID = "455"
+	ServiceIDMailPrincess                ServiceID = "265"
+	ServiceIDMailRu                      ServiceID = "45"
+	ServiceIDMamba                       ServiceID = "572"
+	ServiceIDMarcus                      ServiceID = "525"
+	ServiceIDMatchcom                    ServiceID = "349"
+	ServiceIDMaza                        ServiceID = "12020"
+	ServiceIDMcMoney                     ServiceID = "524"
+	ServiceIDMeetMe                      ServiceID = "371"
+	ServiceIDMercari                     ServiceID = "173"
+	ServiceIDMessageBird                 ServiceID = "10931"
+	ServiceIDMessageDesk                 ServiceID = "10609"
+	ServiceIDMetalPay                    ServiceID = "189"
+	ServiceIDMezu                        ServiceID = "105"
+	ServiceIDMicrosoft                   ServiceID = "47"
+	ServiceIDMicrosoftAzure              ServiceID = "48"
+	ServiceIDMicrosoftOffice365Business  ServiceID = "172"
+	ServiceIDMicrosoftOffice365E5        ServiceID = "281"
+	ServiceIDMicrosoftOffice365Education ServiceID = "151"
+	ServiceIDMicrosoftRewards            ServiceID = "49"
+	ServiceIDMicroworkers                ServiceID = "323"
+	ServiceIDMilesRewards                ServiceID = "10584"
+	ServiceIDMillionaireMatch            ServiceID = "539"
+	ServiceIDMillions                    ServiceID = "11843"
+	ServiceIDMint                        ServiceID = "252"
+	ServiceIDMintVine                    ServiceID = "131"
+	ServiceIDMistplay                    ServiceID = "512"
+	ServiceIDmixi                        ServiceID = "345"
+	ServiceIDMobileMoney                 ServiceID = "222"
+	ServiceIDModeEarnApp                 ServiceID = "10603"
+	ServiceIDMoneyGram                   ServiceID = "526"
+	ServiceIDMoneyLion                   ServiceID = "277"
+	ServiceIDMoneyPak                    ServiceID = "351"
+	ServiceIDMoneyRawr                   ServiceID = "333"
+	ServiceIDMoolaDays                   ServiceID = "184"
+	ServiceIDMoonPay                     ServiceID = "332"
+	ServiceIDMos                         ServiceID = "537"
+	ServiceIDMOVO                        ServiceID = "175"
+	ServiceIDMrsool                      ServiceID = "498"
+	ServiceIDMTCGamePortal               ServiceID = "210"
+	ServiceIDMudflap                     ServiceID = "11821"
+	ServiceIDMusicstream                 ServiceID = "11912"
+	ServiceIDMyBookie                    ServiceID = "224"
+	ServiceIDMyGiftCardSupply            ServiceID = "177"
+	ServiceIDMyOpinions                  ServiceID = "117"
+	ServiceIDMyPoints                    ServiceID = "94"
+	ServiceIDMyRobinhood                 ServiceID = "10580"
+	ServiceIDMyTrainerRewards            ServiceID = "50"
+	ServiceIDMyVoice                     ServiceID = "546"
+	ServiceIDmyWisely                    ServiceID = "11849"
+	ServiceIDNaturalBrainai              ServiceID = "491"
+	ServiceIDNBATopshot                  ServiceID = "473"
+	ServiceIDNerdWallet                  ServiceID = "415"
+	ServiceIDNetflix                     ServiceID = "51"
+	ServiceIDNetZero                     ServiceID = "436"
+	ServiceIDNeuron                      ServiceID = "496"
+	ServiceIDNexmoVonage                 ServiceID = "52"
+	ServiceIDNextdoor                    ServiceID = "275"
+	ServiceIDNielsen                     ServiceID = "11891"
+	ServiceIDNiftyGateway                ServiceID = "434"
+	ServiceIDNike                        ServiceID = "53"
+	ServiceIDNonoh                       ServiceID = "386"
+	ServiceIDNordstrom                   ServiceID = "263"
+	ServiceIDNotListed                   ServiceID = "0"
+	ServiceIDNTWRK                       ServiceID = "511"
+	ServiceIDOcto                        ServiceID = "11937"
+	ServiceIDOfferNation                 ServiceID = "164"
+	ServiceIDOfferToro                   ServiceID = "11957"
+	ServiceIDOfferUp                     ServiceID = "54"
+	ServiceIDOffGamers                   ServiceID = "132"
+	ServiceIDOhmConnect                  ServiceID = "499"
+	ServiceIDOkCupid                     ServiceID = "353"
+	ServiceIDOneFinance                  ServiceID = "475"
+	ServiceIDOneMainFinancial            ServiceID = "390"
+	ServiceIDOneOpinion                  ServiceID = "120"
+	ServiceIDOnJuno                      ServiceID = "561"
+	ServiceIDOnlinenet                   ServiceID = "262"
+	ServiceIDOpenAIChatGPT               ServiceID = "10982"
+	ServiceIDOpenPhone                   ServiceID = "400"
+	ServiceIDOpinionsOutpost             ServiceID = "116"
+	ServiceIDOpinionWorld                ServiceID = "344"
+	ServiceIDOportun                     ServiceID = "11797"
+	ServiceIDOracleCloud                 ServiceID = "111"
+	ServiceIDOurTime                     ServiceID = "374"
+	ServiceIDOutlook                     ServiceID = "92"
+	ServiceIDOxygen                      ServiceID = "11829"
+	ServiceIDOYO                         ServiceID = "261"
+	ServiceIDPangeaMoneyTransfer         ServiceID = "148"
+	ServiceIDParler                      ServiceID = "384"
+	ServiceIDPartyPoker                  ServiceID = "11904"
+	ServiceIDPassbook                    ServiceID = "420"
+	ServiceIDPaxful                      ServiceID = "145"
+	ServiceIDPayactiv                    ServiceID = "486"
+	ServiceIDPaybis                      ServiceID = "191"
+	ServiceIDPayCenter                   ServiceID = "359"
+	ServiceIDPaymeDollar                 ServiceID = "331"
+	ServiceIDPayoneer                    ServiceID = "357"
+	ServiceIDPayPal                      ServiceID = "55"
+	ServiceIDPaySend                     ServiceID = "174"
+	ServiceIDPaysera                     ServiceID = "238"
+	ServiceIDPCGameSupply                ServiceID = "178"
+	ServiceIDPei                         ServiceID = "182"
+	ServiceIDPerk                        ServiceID = "100"
+	ServiceIDPersonalCapital             ServiceID = "255"
+	ServiceIDPGSamsBuyGet                ServiceID = "11934"
+	ServiceIDPineconeResearch            ServiceID = "503"
+	ServiceIDPlaid                       ServiceID = "369"
+	ServiceIDPlayerAuctions              ServiceID = "126"
+	ServiceIDPlentyOfFish                ServiceID = "229"
+	ServiceIDPlivo                       ServiceID = "574"
+	ServiceIDPogo                        ServiceID = "522"
+	ServiceIDPointclub                   ServiceID = "203"
+	ServiceIDPollPass                    ServiceID = "218"
+	ServiceIDPomelo                      ServiceID = "12026"
+	ServiceIDPopads                      ServiceID = "11963"
+	ServiceIDPorte                       ServiceID = "451"
+	ServiceIDPoshmark                    ServiceID = "472"
+	ServiceIDPostmates                   ServiceID = "187"
+	ServiceIDPotatoChat                  ServiceID = "378"
+	ServiceIDPrepaid2Cash                ServiceID = "256"
+	ServiceIDPrivacy                     ServiceID = "380"
+	ServiceIDProlific                    ServiceID = "98"
+	ServiceIDPromotionPod                ServiceID = "417"
+	ServiceIDProOpinions                 ServiceID = "135"
+	ServiceIDPropy                       ServiceID = "106"
+	ServiceIDProtonMail                  ServiceID = "56"
+	ServiceIDPruvit                      ServiceID = "232"
+	ServiceIDPublic                      ServiceID = "12034"
+	ServiceIDPurseio                     ServiceID = "57"
+	ServiceIDQubeMoney                   ServiceID = "443"
+	ServiceIDQuickBooks                  ServiceID = "11024"
+	ServiceIDQuickPaySurvey              ServiceID = "509"
+	ServiceIDQuickThoughts               ServiceID = "115"
+	ServiceIDQuicrypto                   ServiceID = "11983"
+	ServiceIDRadialInsight               ServiceID = "240"
+	ServiceIDRaise                       ServiceID = "138"
+	ServiceIDRebtel                      ServiceID = "480"
+	ServiceIDRECUR                       ServiceID = "11889"
+	ServiceIDRedCircle                   ServiceID = "579"
+	ServiceIDRemitly                     ServiceID = "421"
+	ServiceIDRently                      ServiceID = "11993"
+	ServiceIDRentMe                      ServiceID = "450"
+	ServiceIDReonomy                     ServiceID = "501"
+	ServiceIDRetailMeNot                 ServiceID = "156"
+	ServiceIDRevolut                     ServiceID = "272"
+	ServiceIDRewardedPlay                ServiceID = "541"
+	ServiceIDRewardingWays               ServiceID = "144"
+	ServiceIDRI                          ServiceID = "484"
+	ServiceIDRiaFinancial                ServiceID = "409"
+	ServiceIDRingCaptcha                 ServiceID = "219"
+	ServiceIDRingCentral                 ServiceID = "309"
+	ServiceIDRitualco                    ServiceID = "59"
+	ServiceIDRobinhood                   ServiceID = "419"
+	ServiceIDRoomster                    ServiceID = "10581"
+	ServiceIDRoot                        ServiceID = "502"
+	ServiceIDRover                       ServiceID = "354"
+	ServiceIDRRF                         ServiceID = "481"
+	ServiceIDRSGoldMine                  ServiceID = "403"
+	ServiceIDRSocks                      ServiceID = "10583"
+	ServiceIDRumble                      ServiceID = "11037"
+	ServiceIDSafewayAlbertsons           ServiceID = "11841"
+	ServiceIDSamsClub                    ServiceID = "11040"
+	ServiceIDSaverLife                   ServiceID = "10607"
+	ServiceIDSaveWithSurveys             ServiceID = "134"
+	ServiceIDSayHi                       ServiceID = "233"
+	ServiceIDSBA                         ServiceID = "576"
+	ServiceIDScaleway                    ServiceID = "136"
+	ServiceIDSEAGM                       ServiceID = "60"
+	ServiceIDSeated                      ServiceID = "283"
+	ServiceIDSecretBenefits              ServiceID = "395"
+	ServiceIDSendGrid                    ServiceID = "422"
+	ServiceIDSendSprint                  ServiceID = "11944"
+	ServiceIDSendwave                    ServiceID = "485"
+	ServiceIDSentBe                      ServiceID = "12001"
+	ServiceIDSEOClerks                   ServiceID = "517"
+	ServiceIDServe                       ServiceID = "12019"
+	ServiceIDSezzle                      ServiceID = "396"
+	ServiceIDSheerID                     ServiceID = "394"
+	ServiceIDShopify                     ServiceID = "11051"
+	ServiceIDShopkick                    ServiceID = "61"
+	ServiceIDShopPay                     ServiceID = "11048"
+	ServiceIDSidelineSwap                ServiceID = "11053"
+	ServiceIDSignal                      ServiceID = "313"
+	ServiceIDSimba                       ServiceID = "463"
+	ServiceIDSimplexSimplexCC            ServiceID = "195"
+	ServiceIDSkout                       ServiceID = "127"
+	ServiceIDSkrill                      ServiceID = "62"
+	ServiceIDSkype                       ServiceID = "63"
+	ServiceIDSkyPrivate                  ServiceID = "11783"
+	ServiceIDSlide                       ServiceID = "370"
+	ServiceIDSmartyPig                   ServiceID = "12051"
+	ServiceIDSmores                      ServiceID = "166"
+	ServiceIDSnagshout                   ServiceID = "143"
+	ServiceIDSnapchat                    ServiceID = "64"
+	ServiceIDSnapFinance                 ServiceID = "413"
+	ServiceIDSneakersnstuff              ServiceID = "317"
+	ServiceIDSocieti                     ServiceID = "197"
+	ServiceIDSoFI                        ServiceID = "427"
+	ServiceIDSpend                       ServiceID = "302"
+	ServiceIDSpruce                      ServiceID = "11793"
+	ServiceIDSpruceHealth                ServiceID = "12033"
+	ServiceIDSquare                      ServiceID = "11072"
+	ServiceIDStash                       ServiceID = "11831"
+	ServiceIDSteady                      ServiceID = "535"
+	ServiceIDSteam                       ServiceID = "66"
+	ServiceIDSteemIt                     ServiceID = "67"
+	ServiceIDStep                        ServiceID = "376"
+	ServiceIDStir                        ServiceID = "567"
+	ServiceIDStreetbeat                  ServiceID = "11933"
+	ServiceIDStrike                      ServiceID = "469"
+	ServiceIDStripe                      ServiceID = "235"
+	ServiceIDSugarbook                   ServiceID = "11922"
+	ServiceIDSugarDaddyMeet              ServiceID = "11081"
+	ServiceIDSumUp                       ServiceID = "356"
+	ServiceIDSuperPay                    ServiceID = "153"
+	ServiceIDSurePayroll                 ServiceID = "555"
+	ServiceIDSurf                        ServiceID = "545"
+	ServiceIDSurveyHoney                 ServiceID = "123"
+	ServiceIDSurveyJunkie                ServiceID = "109"
+	ServiceIDSurveyMonkeyRewards         ServiceID = "103"
+	ServiceIDSurveytime                  ServiceID = "228"
+	ServiceIDSwagbucks                   ServiceID = "68"
+	ServiceIDSweatcoin                   ServiceID = "122"
+	ServiceIDSweetRing                   ServiceID = "387"
+	ServiceIDSwych                       ServiceID = "102"
+	ServiceIDTada                        ServiceID = "569"
+	ServiceIDTaimi                       ServiceID = "11996"
+	ServiceIDTaoBao                      ServiceID = "385"
+	ServiceIDTapchamps                   ServiceID = "529"
+	ServiceIDTapResearch                 ServiceID = "11991"
+	ServiceIDTaptapSend                  ServiceID = "11967"
+	ServiceIDTarget                      ServiceID = "297"
+	ServiceIDTaxAct                      ServiceID = "12025"
+	ServiceIDTaxSlayer                   ServiceID = "11789"
+	ServiceIDTCGPlayer                   ServiceID = "101"
+	ServiceIDTDAmeritrade                ServiceID = "416"
+	ServiceIDTechBubble                  ServiceID = "542"
+	ServiceIDTelegram                    ServiceID = "69"
+	ServiceIDTelnyx                      ServiceID = "11092"
+	ServiceIDTemu                        ServiceID = "12047"
+	ServiceIDTencent                     ServiceID = "298"
+	ServiceIDThinkOpinion                ServiceID = "500"
+	ServiceIDThumbtack                   ServiceID = "360"
+	ServiceIDTicketmaster                ServiceID = "179"
+	ServiceIDTikTok                      ServiceID = "381"
+	ServiceIDTinder                      ServiceID = "72"
+	ServiceIDTipNano                     ServiceID = "11975"
+	ServiceIDTiv                         ServiceID = "12037"
+	ServiceIDTMobileMoney                ServiceID = "489"
+	ServiceIDToken                       ServiceID = "220"
+	ServiceIDToTaxi                      ServiceID = "114"
+	ServiceIDTradingView                 ServiceID = "424"
+	ServiceIDTransferWise                ServiceID = "215"
+	ServiceIDTransformCredit             ServiceID = "11878"
+	ServiceIDTruthSocial                 ServiceID = "11862"
+	ServiceIDTurboTax                    ServiceID = "418"
+	ServiceIDTurgame                     ServiceID = "446"
+	ServiceIDTuro                        ServiceID = "73"
+	ServiceIDTwigcard                    ServiceID = "12021"
+	ServiceIDTwilio                      ServiceID = "74"
+	ServiceIDTwitch                      ServiceID = "180"
+	ServiceIDTwitter                     ServiceID = "75"
+	ServiceIDUber                        ServiceID = "76"
+	ServiceIDUberEats                    ServiceID = "137"
+	ServiceIDUbisoft                     ServiceID = "11118"
+	ServiceIDUKGWallet                   ServiceID = "11961"
+	ServiceIDUnivisionMobileMoney        ServiceID = "291"
+	ServiceIDUpaynet                     ServiceID = "452"
+	ServiceIDUpgrade                     ServiceID = "11892"
+	ServiceIDuphold                      ServiceID = "303"
+	ServiceIDUplift                      ServiceID = "497"
+	ServiceIDUpVoice                     ServiceID = "560"
+	ServiceIDUpward                      ServiceID = "550"
+	ServiceIDUpwork                      ServiceID = "212"
+	ServiceIDUSASurvey                   ServiceID = "188"
+	ServiceIDValuedOpinions              ServiceID = "118"
+	ServiceIDVanguard                    ServiceID = "11895"
+	ServiceIDVenmo                       ServiceID = "77"
+	ServiceIDVetri                       ServiceID = "11949"
+	ServiceIDVetsPrevail                 ServiceID = "119"
+	ServiceIDViaAppViaVan                ServiceID = "104"
+	ServiceIDViaBill                     ServiceID = "10615"
+	ServiceIDViber                       ServiceID = "78"
+	ServiceIDVidaplayer                  ServiceID = "453"
+	ServiceIDVinted                      ServiceID = "566"
+	ServiceIDVK                          ServiceID = "79"
+	ServiceIDVoicepark                   ServiceID = "11964"
+	ServiceIDVoilaNorbert                ServiceID = "508"
+	ServiceIDVoyager                     ServiceID = "383"
+	ServiceIDVrbo                        ServiceID = "11139"
+	ServiceIDVumber                      ServiceID = "341"
+	ServiceIDWagerWeb                    ServiceID = "534"
+	ServiceIDWaleteros                   ServiceID = "80"
+	ServiceIDWalletHub                   ServiceID = "366"
+	ServiceIDWalmart                     ServiceID = "296"
+	ServiceIDWalmartFamilyMobile         ServiceID = "266"
+	ServiceIDWalmartMoneyCard            ServiceID = "183"
+	ServiceIDWealthfront                 ServiceID = "456"
+	ServiceIDWebull                      ServiceID = "11877"
+	ServiceIDWeChatReceiveOnly           ServiceID = "305"
+	ServiceIDWeebly                      ServiceID = "83"
+	ServiceIDWeee                        ServiceID = "543"
+	ServiceIDWeibo                       ServiceID = "314"
+	ServiceIDWelspunBrainTrust           ServiceID = "11809"
+	ServiceIDWeverse                     ServiceID = "11827"
+	ServiceIDWhatnot                     ServiceID = "11855"
+	ServiceIDWhatsApp                    ServiceID = "84"
+	ServiceIDWindowsXboxStore            ServiceID = "85"
+	ServiceIDWingocard                   ServiceID = "444"
+	ServiceIDWink                        ServiceID = "11155"
+	ServiceIDWireBarley                  ServiceID = "11807"
+	ServiceIDWomply                      ServiceID = "437"
+	ServiceIDWooCommerce                 ServiceID = "11159"
+	ServiceIDWyre                        ServiceID = "254"
+	ServiceIDXapo                        ServiceID = "234"
+	ServiceIDXbox                        ServiceID = "93"
+	ServiceIDxcoins                      ServiceID = "556"
+	ServiceIDYahoo                       ServiceID = "86"
+	ServiceIDYandex                      ServiceID = "87"
+	ServiceIDYeezy                       ServiceID = "575"
+	ServiceIDYelp                        ServiceID = "432"
+	ServiceIDYFSResearch                 ServiceID = "289"
+	ServiceIDYodlee                      ServiceID = "318"
+	ServiceIDYotta                       ServiceID = "11999"
+	ServiceIDYoutube                     ServiceID = "91"
+	ServiceIDySense                      ServiceID = "273"
+	ServiceIDYubo                        ServiceID = "155"
+	ServiceIDYunoSurveys                 ServiceID = "270"
+	ServiceIDYuroPay                     ServiceID = "358"
+	ServiceIDZalo                        ServiceID = "11177"
+	ServiceIDZaxbys                      ServiceID = "11955"
+	ServiceIDzcom                        ServiceID = "299"
+	ServiceIDZeek                        ServiceID = "140"
+	ServiceIDZelle                       ServiceID = "88"
+	ServiceIDZillow                      ServiceID = "442"
+	ServiceIDZipQuadPay                  ServiceID = "397"
+	ServiceIDZogo                        ServiceID = "339"
+	ServiceIDZoho                        ServiceID = "89"
+	ServiceIDZoomBucks                   ServiceID = "201"
+	ServiceIDZoomInfo                    ServiceID = "346"
+	ServiceIDZoosk                       ServiceID = "243"
+	ServiceIDZumper                      ServiceID = "393"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceID101Sweets instead.
 	Service101Sweets = "10613"
+	// Deprecated: use ServiceID1688 instead.
 	Service1688 = "488"
+	// Deprecated: use ServiceID1StopMove instead.
 	Service1StopMove = "268"
+	// Deprecated: use ServiceID3Fun instead.
 	Service3Fun = "196"
+	// Deprecated: use ServiceID5miles instead.
 	Service5miles = "158"
+	// Deprecated: use ServiceIDAARP instead.
 	ServiceAARP = "564"
+	// Deprecated: use ServiceIDAbra instead.
 	ServiceAbra = "192"
+	// Deprecated: use ServiceIDAccountPatrolMoneyPatrol instead.
 	ServiceAccountPatrolMoneyPatrol = "288"
+	// Deprecated: use ServiceIDAcorns instead.
 	ServiceAcorns = "551"
+	// Deprecated: use ServiceIDAddItUp instead.
 	ServiceAddItUp = "430"
+	// Deprecated: use ServiceIDAdGate instead.
 	ServiceAdGate = "11191"
+	// Deprecated: use ServiceIDAdidas instead.
 	ServiceAdidas = "1"
+	// Deprecated: use ServiceIDAdWallet instead.
 	ServiceAdWallet = "2"
+	// Deprecated: use ServiceIDAeldra instead.
 	ServiceAeldra = "577"
+	// Deprecated: use ServiceIDAffirm instead.
 	ServiceAffirm = "245"
+	// Deprecated: use ServiceIDAfterpay instead.
 	ServiceAfterpay = "547"
+	// Deprecated: use ServiceIDAH4RAMH instead.
 	ServiceAH4RAMH = "11869"
+	// Deprecated: use ServiceIDAirbnb instead.
 	ServiceAirbnb = "3"
+	// Deprecated: use ServiceIDAirtm instead.
 	ServiceAirtm = "449"
+	// Deprecated: use ServiceIDAlbert instead.
 	ServiceAlbert = "514"
+	// Deprecated: use ServiceIDAlibaba instead.
 	ServiceAlibaba = "4"
+	// Deprecated: use ServiceIDAllset instead.
 	ServiceAllset = "284"
+	// Deprecated: use ServiceIDAmasia instead.
 	ServiceAmasia = "169"
+	// Deprecated: use ServiceIDAmazon instead.
 	ServiceAmazon = "5"
+	// Deprecated: use ServiceIDAmazonWebs instead.
 	ServiceAmazonWebs = "227"
+	// Deprecated: use ServiceIDAmericaVoice instead.
 	ServiceAmericaVoice = "373"
+	// Deprecated: use ServiceIDAndo instead.
 	ServiceAndo = "445"
+	// Deprecated: use ServiceIDAngi instead.
 	ServiceAngi = "11931"
+	// Deprecated: use ServiceIDAnkama instead.
 	ServiceAnkama = "286"
+	// Deprecated: use ServiceIDAol instead.
 	ServiceAol = "6"
+	// Deprecated: use ServiceIDAppFlame instead.
 	ServiceAppFlame = "336"
+	// Deprecated: use ServiceIDApple instead.
 	ServiceApple = "216"
+	// Deprecated: use ServiceIDAppleWallet instead.
 	ServiceAppleWallet = "320"
+	// Deprecated: use ServiceIDAppStation instead.
 	ServiceAppStation = "340"
+	// Deprecated: use ServiceIDARMSLIST instead.
 	ServiceARMSLIST = "10610"
+	// Deprecated: use ServiceIDAspiration instead.
 	ServiceAspiration = "11810"
+	// Deprecated: use ServiceIDAtom instead.
 	ServiceAtom = "97"
+	// Deprecated: use ServiceIDAtomy instead.
 	ServiceAtomy = "494"
+	// Deprecated: use ServiceIDAttaPoll instead.
 	ServiceAttaPoll = "435"
+	// Deprecated: use ServiceIDAuthy instead.
 	ServiceAuthy = "7"
+	// Deprecated: use ServiceIDAvail instead.
 	ServiceAvail = "477"
+	// Deprecated: use ServiceIDBackblaze instead.
 	ServiceBackblaze = "406"
+	// Deprecated: use ServiceIDBadoo instead.
 	ServiceBadoo = "139"
+	// Deprecated: use ServiceIDBaidu instead.
 	ServiceBaidu = "9"
+	// Deprecated: use ServiceIDBakkt instead.
 	ServiceBakkt = "10582"
+	// Deprecated: use ServiceIDBanxa instead.
 	ServiceBanxa = "407"
+	// Deprecated: use ServiceIDBaselane instead.
 	ServiceBaselane = "12011"
+	// Deprecated: use ServiceIDBeat instead.
 	ServiceBeat = "11917"
+	// Deprecated: use ServiceIDBestOfOurValley instead.
 	ServiceBestOfOurValley = "282"
+	// Deprecated: use ServiceIDBetMGM instead.
 	ServiceBetMGM = "11903"
+	// Deprecated: use ServiceIDBetnowEU instead.
 	ServiceBetnowEU = "11976"
+	// Deprecated: use ServiceIDBetterment instead.
 	ServiceBetterment = "580"
+	// Deprecated: use ServiceIDBigoLive instead.
 	ServiceBigoLive = "490"
+	// Deprecated: use ServiceIDBiltRewards instead.
 	ServiceBiltRewards = "11819"
+	// Deprecated: use ServiceIDBitClout instead.
 	ServiceBitClout = "438"
+	// Deprecated: use ServiceIDBitcoinATM instead.
 	ServiceBitcoinATM = "330"
+	// Deprecated: use ServiceIDBitcoinIRA instead.
 	ServiceBitcoinIRA = "12053"
+	// Deprecated: use ServiceIDbitFlyer instead.
 	ServicebitFlyer = "251"
+	// Deprecated: use ServiceIDBitfront instead.
 	ServiceBitfront = "329"
+	// Deprecated: use ServiceIDBitly instead.
 	ServiceBitly = "12043"
+	// Deprecated: use ServiceIDBitmo instead.
 	ServiceBitmo = "10"
+	// Deprecated: use ServiceIDBitstamp instead.
 	ServiceBitstamp = "276"
+	// Deprecated: use ServiceIDBitwage instead.
 	ServiceBitwage = "258"
+	// Deprecated: use ServiceIDBlackPeopleMeet instead.
 	ServiceBlackPeopleMeet = "536"
+	// Deprecated: use ServiceIDBlizzard instead.
 	ServiceBlizzard = "213"
+	// Deprecated: use ServiceIDBLK instead.
 	ServiceBLK = "10682"
+	// Deprecated: use ServiceIDBlockFi instead.
 	ServiceBlockFi = "578"
+	// Deprecated: use ServiceIDBlueAcorn instead.
 	ServiceBlueAcorn = "439"
+	// Deprecated: use ServiceIDBlueBird instead.
 	ServiceBlueBird = "11811"
+	// Deprecated: use ServiceIDBlueVine instead.
 	ServiceBlueVine = "368"
+	// Deprecated: use ServiceIDBoatsetter instead.
 	ServiceBoatsetter = "457"
+	// Deprecated: use ServiceIDBolt instead.
 	ServiceBolt = "516"
+	// Deprecated: use ServiceIDBonanza instead.
 	ServiceBonanza = "11995"
+	// Deprecated: use ServiceIDBoo instead.
 	ServiceBoo = "11958"
+	// Deprecated: use ServiceIDBookingcom instead.
 	ServiceBookingcom = "10686"
+	// Deprecated: use ServiceIDBOSSRevolutionMoney instead.
 	ServiceBOSSRevolutionMoney = "231"
+	// Deprecated: use ServiceIDBovada instead.
 	ServiceBovada = "10595"
+	// Deprecated: use ServiceIDBoxedDeal instead.
 	ServiceBoxedDeal = "304"
+	// Deprecated: use ServiceIDBraid instead.
 	ServiceBraid = "466"
+	// Deprecated: use ServiceIDBranch instead.
 	ServiceBranch = "12038"
+	// Deprecated: use ServiceIDBrandclub instead.
 	ServiceBrandclub = "562"
+	// Deprecated: use ServiceIDBrandedSurveys instead.
 	ServiceBrandedSurveys = "133"
+	// Deprecated: use ServiceIDBrex instead.
 	ServiceBrex = "495"
+	// Deprecated: use ServiceIDBridgeCard instead.
 	ServiceBridgeCard = "554"
+	// Deprecated: use ServiceIDBTCDirect instead.
 	ServiceBTCDirect = "342"
+	// Deprecated: use ServiceIDBTCsurveys instead.
 	ServiceBTCsurveys = "322"
+	// Deprecated: use ServiceIDBumble instead.
 	ServiceBumble = "267"
+	// Deprecated: use ServiceIDBump instead.
 	ServiceBump = "95"
+	// Deprecated: use ServiceIDBundil instead.
 	ServiceBundil = "461"
+	// Deprecated: use ServiceIDBurner instead.
 	ServiceBurner = "11"
+	// Deprecated: use ServiceIDBurstSMS instead.
 	ServiceBurstSMS = "11865"
+	// Deprecated: use ServiceIDBuyOnTrust instead.
 	ServiceBuyOnTrust = "552"
+	// Deprecated: use ServiceIDCARDcom instead.
 	ServiceCARDcom = "10591"
+	// Deprecated: use ServiceIDCareem instead.
 	ServiceCareem = "324"
+	// Deprecated: use ServiceIDCarepoynt instead.
 	ServiceCarepoynt = "12"
+	// Deprecated: use ServiceIDCashAlarm instead.
 	ServiceCashAlarm = "334"
+	// Deprecated: use ServiceIDCashApp instead.
 	ServiceCashApp = "13"
-	ServiceCashShow = "14"
+	// Deprecated: use ServiceIDCashew instead.
 	ServiceCashew = "11883"
+	// Deprecated: use ServiceIDCashShow instead.
+	ServiceCashShow = "14"
+	// Deprecated: use ServiceIDCashWalk instead.
 	ServiceCashWalk = "487"
+	// Deprecated: use ServiceIDCaviar instead.
 	ServiceCaviar = "401"
+	// Deprecated: use ServiceIDcdkeyscom instead.
 	Servicecdkeyscom = "113"
+	// Deprecated: use ServiceIDCEXIO instead.
 	ServiceCEXIO = "10710"
+	// Deprecated: use ServiceIDChampsSports instead.
 	ServiceChampsSports = "11799"
+	// Deprecated: use ServiceIDChangelly instead.
 	ServiceChangelly = "193"
+	// Deprecated: use ServiceIDCheapVoip instead.
 	ServiceCheapVoip = "236"
+	// Deprecated: use ServiceIDCheckmate instead.
 	ServiceCheckmate = "11979"
+	// Deprecated: use ServiceIDCheckPoints instead.
 	ServiceCheckPoints = "130"
+	// Deprecated: use ServiceIDCheese instead.
 	ServiceCheese = "465"
+	// Deprecated: use ServiceIDChicksGoldInc instead.
 	ServiceChicksGoldInc = "10593"
+	// Deprecated: use ServiceIDChime instead.
 	ServiceChime = "230"
+	// Deprecated: use ServiceIDChispa instead.
 	ServiceChispa = "458"
+	// Deprecated: use ServiceIDChowbus instead.
 	ServiceChowbus = "379"
+	// Deprecated: use ServiceIDCinchbucks instead.
 	ServiceCinchbucks = "142"
+	// Deprecated: use ServiceIDCircle instead.
 	ServiceCircle = "162"
+	// Deprecated: use ServiceIDCJSCDKEYSCOM instead.
 	ServiceCJSCDKEYSCOM = "167"
+	// Deprecated: use ServiceIDClassPass instead.
 	ServiceClassPass = "159"
+	// Deprecated: use ServiceIDCleo instead.
 	ServiceCleo = "557"
+	// Deprecated: use ServiceIDClickadu instead.
 	ServiceClickadu = "110"
+	// Deprecated: use ServiceIDClickDishes instead.
 	ServiceClickDishes = "168"
+	// Deprecated: use ServiceIDclickworker instead.
 	Serviceclickworker = "327"
+	// Deprecated: use ServiceIDClover instead.
 	ServiceClover = "217"
+	// Deprecated: use ServiceIDClubhouse instead.
 	ServiceClubhouse = "483"
+	// Deprecated: use ServiceIDCocaCola instead.
 	ServiceCocaCola = "11861"
+	// Deprecated: use ServiceIDCoffeeMeetsBagel instead.
 	ServiceCoffeeMeetsBagel = "425"
-	ServiceCoinPop = "337"
+	// Deprecated: use ServiceIDCoinbase instead.
 	ServiceCoinbase = "108"
+	// Deprecated: use ServiceIDCoincasper instead.
 	ServiceCoincasper = "11987"
+	// Deprecated: use ServiceIDCoinCircle instead.
 	ServiceCoinCircle = "492"
+	// Deprecated: use ServiceIDCoinCloud instead.
 	ServiceCoinCloud = "441"
+	// Deprecated: use ServiceIDCoinFlip instead.
 	ServiceCoinFlip = "328"
+	// Deprecated: use ServiceIDCoinGate instead.
 	ServiceCoinGate = "190"
+	// Deprecated: use ServiceIDCoinhub instead.
 	ServiceCoinhub = "11943"
+	// Deprecated: use ServiceIDCoinloot instead.
 	ServiceCoinloot = "11929"
+	// Deprecated: use ServiceIDCoinme instead.
 	ServiceCoinme = "507"
+	// Deprecated: use ServiceIDCoinomi instead.
 	ServiceCoinomi = "194"
+	// Deprecated: use ServiceIDCoinPop instead.
+	ServiceCoinPop = "337"
+	// Deprecated: use ServiceIDCoinsBaron instead.
 	ServiceCoinsBaron = "11816"
+	// Deprecated: use ServiceIDCoinseed instead.
 	ServiceCoinseed = "405"
+	// Deprecated: use ServiceIDCoinSwitch instead.
 	ServiceCoinSwitch = "264"
+	// Deprecated: use ServiceIDCointelegraph instead.
 	ServiceCointelegraph = "204"
+	// Deprecated: use ServiceIDCoinZoom instead.
 	ServiceCoinZoom = "459"
+	// Deprecated: use ServiceIDCommunityInsightsForum instead.
 	ServiceCommunityInsightsForum = "493"
+	// Deprecated: use ServiceIDCopper instead.
 	ServiceCopper = "462"
+	// Deprecated: use ServiceIDCouponscom instead.
 	ServiceCouponscom = "15"
+	// Deprecated: use ServiceIDCourseHero instead.
 	ServiceCourseHero = "506"
+	// Deprecated: use ServiceIDCraigslist instead.
 	ServiceCraigslist = "16"
+	// Deprecated: use ServiceIDCreditKarma instead.
 	ServiceCreditKarma = "209"
+	// Deprecated: use ServiceIDCreditSesame instead.
 	ServiceCreditSesame = "170"
+	// Deprecated: use ServiceIDCrowdTap instead.
 	ServiceCrowdTap = "149"
+	// Deprecated: use ServiceIDCrypterium instead.
 	ServiceCrypterium = "454"
+	// Deprecated: use ServiceIDCryptocom instead.
 	ServiceCryptocom = "274"
+	// Deprecated: use ServiceIDCryptolocally instead.
 	ServiceCryptolocally = "568"
+	// Deprecated: use ServiceIDCryptoVoucher instead.
 	ServiceCryptoVoucher = "242"
+	// Deprecated: use ServiceIDCuriousCat instead.
 	ServiceCuriousCat = "325"
+	// Deprecated: use ServiceIDCurrent instead.
 	ServiceCurrent = "278"
+	// Deprecated: use ServiceIDCurrentRewards instead.
 	ServiceCurrentRewards = "476"
+	// Deprecated: use ServiceIDCurtsy instead.
 	ServiceCurtsy = "10741"
+	// Deprecated: use ServiceIDCVS instead.
 	ServiceCVS = "11887"
+	// Deprecated: use ServiceIDDabbl instead.
 	ServiceDabbl = "285"
+	// Deprecated: use ServiceIDDapper instead.
 	ServiceDapper = "429"
+	// Deprecated: use ServiceIDDasherDirect instead.
 	ServiceDasherDirect = "518"
+	// Deprecated: use ServiceIDDave instead.
 	ServiceDave = "225"
+	// Deprecated: use ServiceIDDaybreakGames instead.
 	ServiceDaybreakGames = "292"
+	// Deprecated: use ServiceIDDent instead.
 	ServiceDent = "18"
+	// Deprecated: use ServiceIDDepop instead.
 	ServiceDepop = "530"
+	// Deprecated: use ServiceIDDewu instead.
 	ServiceDewu = "12049"
+	// Deprecated: use ServiceIDDHL instead.
 	ServiceDHL = "293"
+	// Deprecated: use ServiceIDDialpad instead.
 	ServiceDialpad = "431"
+	// Deprecated: use ServiceIDDiceFM instead.
 	ServiceDiceFM = "12005"
+	// Deprecated: use ServiceIDDigit instead.
 	ServiceDigit = "310"
+	// Deprecated: use ServiceIDDing instead.
 	ServiceDing = "467"
+	// Deprecated: use ServiceIDDiscord instead.
 	ServiceDiscord = "19"
+	// Deprecated: use ServiceIDDistroKid instead.
 	ServiceDistroKid = "10756"
+	// Deprecated: use ServiceIDDoctoralia instead.
 	ServiceDoctoralia = "12002"
+	// Deprecated: use ServiceIDDocuSign instead.
 	ServiceDocuSign = "479"
-	ServiceDollarGeneral = "121"
+	// Deprecated: use ServiceIDDollarClix instead.
 	ServiceDollarClix = "280"
+	// Deprecated: use ServiceIDDollarGeneral instead.
+	ServiceDollarGeneral = "121"
+	// Deprecated: use ServiceIDDonately instead.
 	ServiceDonately = "11911"
+	// Deprecated: use ServiceIDDonut instead.
 	ServiceDonut = "548"
+	// Deprecated: use ServiceIDDoorDash instead.
 	ServiceDoorDash = "20"
+	// Deprecated: use ServiceIDDora instead.
 	ServiceDora = "464"
+	// Deprecated: use ServiceIDDOSH instead.
 	ServiceDOSH = "21"
+	// Deprecated: use ServiceIDDoublelist instead.
 	ServiceDoublelist = "128"
+	// Deprecated: use ServiceIDDouugh instead.
 	ServiceDouugh = "460"
+	// Deprecated: use ServiceIDDreamSpring instead.
 	ServiceDreamSpring = "10617"
+	// Deprecated: use ServiceIDDrop instead.
 	ServiceDrop = "154"
+	// Deprecated: use ServiceIDDunkinDonuts instead.
 	ServiceDunkinDonuts = "348"
-	ServiceeRewards = "24"
+	// Deprecated: use ServiceIDEarlyBird instead.
 	ServiceEarlyBird = "565"
-	ServiceEarnHoney = "22"
+	// Deprecated: use ServiceIDEarnably instead.
 	ServiceEarnably = "259"
+	// Deprecated: use ServiceIDEarnHoney instead.
+	ServiceEarnHoney = "22"
+	// Deprecated: use ServiceIDEarningStation instead.
 	ServiceEarningStation = "150"
+	// Deprecated: use ServiceIDEastbay instead.
 	ServiceEastbay = "11805"
+	// Deprecated: use ServiceIDeBay instead.
 	ServiceeBay = "23"
+	// Deprecated: use ServiceIDeGifter instead.
 	ServiceeGifter = "447"
+	// Deprecated: use ServiceIDElepreneur instead.
 	ServiceElepreneur = "208"
+	// Deprecated: use ServiceIDElevacity instead.
 	ServiceElevacity = "163"
+	// Deprecated: use ServiceIDEllis instead.
 	ServiceEllis = "12006"
+	// Deprecated: use ServiceIDEmpower instead.
 	ServiceEmpower = "171"
+	// Deprecated: use ServiceIDEneba instead.
 	ServiceEneba = "382"
+	// Deprecated: use ServiceIDEpicNPC instead.
 	ServiceEpicNPC = "112"
+	// Deprecated: use ServiceIDEpochTimes instead.
 	ServiceEpochTimes = "563"
+	// Deprecated: use ServiceIDeRewards instead.
+	ServiceeRewards = "24"
+	// Deprecated: use ServiceIDeToro instead.
 	ServiceeToro = "25"
+	// Deprecated: use ServiceIDEtsy instead.
 	ServiceEtsy = "10782"
+	// Deprecated: use ServiceIDEureka instead.
 	ServiceEureka = "11897"
+	// Deprecated: use ServiceIDEveryoneAPI instead.
 	ServiceEveryoneAPI = "26"
+	// Deprecated: use ServiceIDEZTexting instead.
 	ServiceEZTexting = "531"
+	// Deprecated: use ServiceIDFacebook instead.
 	ServiceFacebook = "27"
+	// Deprecated: use ServiceIDFacebookReset instead.
 	ServiceFacebookReset = "11795"
+	// Deprecated: use ServiceIDFarmersOnly instead.
 	ServiceFarmersOnly = "11939"
+	// Deprecated: use ServiceIDFastMail instead.
 	ServiceFastMail = "28"
+	// Deprecated: use ServiceIDFave instead.
 	ServiceFave = "10790"
+	// Deprecated: use ServiceIDFedEx instead.
 	ServiceFedEx = "244"
+	// Deprecated: use ServiceIDFetchRewards instead.
 	ServiceFetchRewards = "186"
+	// Deprecated: use ServiceIDFetLife instead.
 	ServiceFetLife = "361"
+	// Deprecated: use ServiceIDFidelityInvestments instead.
 	ServiceFidelityInvestments = "528"
+	// Deprecated: use ServiceIDFigureEight instead.
 	ServiceFigureEight = "152"
+	// Deprecated: use ServiceIDFinishLine instead.
 	ServiceFinishLine = "279"
+	// Deprecated: use ServiceIDFirehouseSubs instead.
 	ServiceFirehouseSubs = "12017"
+	// Deprecated: use ServiceIDFitplay instead.
 	ServiceFitplay = "338"
+	// Deprecated: use ServiceIDFiverr instead.
 	ServiceFiverr = "29"
+	// Deprecated: use ServiceIDFlare instead.
 	ServiceFlare = "468"
+	// Deprecated: use ServiceIDFlashRewards instead.
 	ServiceFlashRewards = "411"
+	// Deprecated: use ServiceIDFlippa instead.
 	ServiceFlippa = "389"
+	// Deprecated: use ServiceIDFluz instead.
 	ServiceFluz = "343"
+	// Deprecated: use ServiceIDFold instead.
 	ServiceFold = "10605"
+	// Deprecated: use ServiceIDFootLocker instead.
 	ServiceFootLocker = "11800"
+	// Deprecated: use ServiceIDFound instead.
 	ServiceFound = "558"
+	// Deprecated: use ServiceIDFreecashcom instead.
 	ServiceFreecashcom = "10579"
+	// Deprecated: use ServiceIDFreelancer instead.
 	ServiceFreelancer = "355"
+	// Deprecated: use ServiceIDFreeTaxUSA instead.
 	ServiceFreeTaxUSA = "347"
+	// Deprecated: use ServiceIDFruitlab instead.
 	ServiceFruitlab = "326"
+	// Deprecated: use ServiceIDFunko instead.
 	ServiceFunko = "12030"
+	// Deprecated: use ServiceIDFusionCash instead.
 	ServiceFusionCash = "165"
+	// Deprecated: use ServiceIDG2A instead.
 	ServiceG2A = "30"
+	// Deprecated: use ServiceIDG2G instead.
 	ServiceG2G = "96"
+	// Deprecated: use ServiceIDGabi instead.
 	ServiceGabi = "538"
+	// Deprecated: use ServiceIDGaintplay instead.
 	ServiceGaintplay = "11925"
+	// Deprecated: use ServiceIDGameflip instead.
 	ServiceGameflip = "31"
+	// Deprecated: use ServiceIDGamekit instead.
 	ServiceGamekit = "200"
+	// Deprecated: use ServiceIDGamercraft instead.
 	ServiceGamercraft = "11835"
+	// Deprecated: use ServiceIDGemini instead.
 	ServiceGemini = "141"
+	// Deprecated: use ServiceIDGemiplay instead.
 	ServiceGemiplay = "11781"
+	// Deprecated: use ServiceIDGenitrust instead.
 	ServiceGenitrust = "448"
+	// Deprecated: use ServiceIDGetPaidTo instead.
 	ServiceGetPaidTo = "311"
+	// Deprecated: use ServiceIDGifthulk instead.
 	ServiceGifthulk = "32"
+	// Deprecated: use ServiceIDGiftHunterClub instead.
 	ServiceGiftHunterClub = "176"
+	// Deprecated: use ServiceIDGiftPocket instead.
 	ServiceGiftPocket = "11785"
+	// Deprecated: use ServiceIDGlasscom instead.
 	ServiceGlasscom = "11973"
+	// Deprecated: use ServiceIDGlassnet instead.
 	ServiceGlassnet = "523"
+	// Deprecated: use ServiceIDGlidera instead.
 	ServiceGlidera = "185"
+	// Deprecated: use ServiceIDGmail instead.
 	ServiceGmail = "90"
+	// Deprecated: use ServiceIDGo2Bank instead.
 	ServiceGo2Bank = "410"
+	// Deprecated: use ServiceIDGoBank instead.
 	ServiceGoBank = "350"
+	// Deprecated: use ServiceIDGoFundMe instead.
 	ServiceGoFundMe = "470"
+	// Deprecated: use ServiceIDGoldenFarmery instead.
 	ServiceGoldenFarmery = "335"
+	// Deprecated: use ServiceIDGoogle instead.
 	ServiceGoogle = "33"
+	// Deprecated: use ServiceIDGoogleBusinessProfile instead.
 	ServiceGoogleBusinessProfile = "11773"
+	// Deprecated: use ServiceIDGoogleMerchantCenter instead.
 	ServiceGoogleMerchantCenter = "11777"
+	// Deprecated: use ServiceIDGoogleVoice instead.
 	ServiceGoogleVoice = "34"
+	// Deprecated: use ServiceIDGopuff instead.
 	ServiceGopuff = "471"
+	// Deprecated: use ServiceIDGrab instead.
 	ServiceGrab = "35"
+	// Deprecated: use ServiceIDGrabPoints instead.
 	ServiceGrabPoints = "202"
+	// Deprecated: use ServiceIDGreenDot instead.
 	ServiceGreenDot = "257"
+	// Deprecated: use ServiceIDGreenDotSmartHome instead.
 	ServiceGreenDotSmartHome = "428"
+	// Deprecated: use ServiceIDGreenlight instead.
 	ServiceGreenlight = "540"
+	// Deprecated: use ServiceIDGrindr instead.
 	ServiceGrindr = "433"
+	// Deprecated: use ServiceIDGroupMe instead.
 	ServiceGroupMe = "306"
+	// Deprecated: use ServiceIDGuru instead.
 	ServiceGuru = "290"
+	// Deprecated: use ServiceIDHandyAngi instead.
 	ServiceHandyAngi = "11822"
+	// Deprecated: use ServiceIDHappn instead.
 	ServiceHappn = "199"
+	// Deprecated: use ServiceIDHappyCo instead.
 	ServiceHappyCo = "440"
+	// Deprecated: use ServiceIDHarrisPoll instead.
 	ServiceHarrisPoll = "181"
+	// Deprecated: use ServiceIDHibbett instead.
 	ServiceHibbett = "388"
+	// Deprecated: use ServiceIDHinge instead.
 	ServiceHinge = "161"
+	// Deprecated: use ServiceIDHomeAway instead.
 	ServiceHomeAway = "241"
+	// Deprecated: use ServiceIDHopper instead.
 	ServiceHopper = "10836"
+	// Deprecated: use ServiceIDHostkey instead.
 	ServiceHostkey = "11965"
+	// Deprecated: use ServiceIDHotmail instead.
 	ServiceHotmail = "474"
+	// Deprecated: use ServiceIDHQTrivia instead.
 	ServiceHQTrivia = "36"
+	// Deprecated: use ServiceIDHumbleBundle instead.
 	ServiceHumbleBundle = "287"
+	// Deprecated: use ServiceIDHunter instead.
 	ServiceHunter = "11871"
-	ServiceIsay = "10845"
+	// Deprecated: use ServiceIDibotta instead.
 	Serviceibotta = "124"
+	// Deprecated: use ServiceIDICQ instead.
 	ServiceICQ = "38"
-	ServiceIDme = "414"
+	// Deprecated: use ServiceIDIDES instead.
 	ServiceIDES = "11779"
+	// Deprecated: use ServiceIDIdleEmpire instead.
 	ServiceIdleEmpire = "352"
+	// Deprecated: use ServiceIDIDme instead.
+	ServiceIDme = "414"
+	// Deprecated: use ServiceIDImgur instead.
 	ServiceImgur = "247"
+	// Deprecated: use ServiceIDiMoney instead.
 	ServiceiMoney = "81"
+	// Deprecated: use ServiceIDInboxDollars instead.
 	ServiceInboxDollars = "129"
+	// Deprecated: use ServiceIDIndeed instead.
 	ServiceIndeed = "423"
+	// Deprecated: use ServiceIDIndi instead.
 	ServiceIndi = "372"
+	// Deprecated: use ServiceIDInnago instead.
 	ServiceInnago = "515"
+	// Deprecated: use ServiceIDInspire instead.
 	ServiceInspire = "398"
+	// Deprecated: use ServiceIDInstacart instead.
 	ServiceInstacart = "248"
+	// Deprecated: use ServiceIDInstaGC instead.
 	ServiceInstaGC = "39"
+	// Deprecated: use ServiceIDInstagram instead.
 	ServiceInstagram = "40"
+	// Deprecated: use ServiceIDInstaRem instead.
 	ServiceInstaRem = "315"
+	// Deprecated: use ServiceIDIntuit instead.
 	ServiceIntuit = "253"
+	// Deprecated: use ServiceIDiOffer instead.
 	ServiceiOffer = "160"
+	// Deprecated: use ServiceIDIONOS instead.
 	ServiceIONOS = "10858"
+	// Deprecated: use ServiceIDiPlum instead.
 	ServiceiPlum = "399"
+	// Deprecated: use ServiceIDiPoll instead.
 	ServiceiPoll = "99"
+	// Deprecated: use ServiceIDiRazoo instead.
 	ServiceiRazoo = "125"
+	// Deprecated: use ServiceIDIsay instead.
+	ServiceIsay = "10845"
+	// Deprecated: use ServiceIDJelli instead.
 	ServiceJelli = "408"
+	// Deprecated: use ServiceIDJerry instead.
 	ServiceJerry = "392"
+	// Deprecated: use ServiceIDJerseyMikes instead.
 	ServiceJerseyMikes = "11953"
+	// Deprecated: use ServiceIDJobber instead.
 	ServiceJobber = "553"
+	// Deprecated: use ServiceIDJuno instead.
 	ServiceJuno = "214"
+	// Deprecated: use ServiceIDKakaoTalk instead.
 	ServiceKakaoTalk = "41"
+	// Deprecated: use ServiceIDKamatera instead.
 	ServiceKamatera = "294"
+	// Deprecated: use ServiceIDKeybase instead.
 	ServiceKeybase = "269"
+	// Deprecated: use ServiceIDKidsFootlocker instead.
 	ServiceKidsFootlocker = "11801"
+	// Deprecated: use ServiceIDKikoff instead.
 	ServiceKikoff = "11833"
+	// Deprecated: use ServiceIDKixify instead.
 	ServiceKixify = "11815"
+	// Deprecated: use ServiceIDKlarna instead.
 	ServiceKlarna = "404"
+	// Deprecated: use ServiceIDKuCoin instead.
 	ServiceKuCoin = "482"
+	// Deprecated: use ServiceIDKudos instead.
 	ServiceKudos = "11988"
+	// Deprecated: use ServiceIDLBRYApp instead.
 	ServiceLBRYApp = "223"
+	// Deprecated: use ServiceIDLDSPlanet instead.
 	ServiceLDSPlanet = "11873"
+	// Deprecated: use ServiceIDLeagueofLegends instead.
 	ServiceLeagueofLegends = "527"
+	// Deprecated: use ServiceIDLetgo instead.
 	ServiceLetgo = "157"
+	// Deprecated: use ServiceIDLibertyX instead.
 	ServiceLibertyX = "246"
+	// Deprecated: use ServiceIDLikeCard instead.
 	ServiceLikeCard = "544"
+	// Deprecated: use ServiceIDLili instead.
 	ServiceLili = "505"
+	// Deprecated: use ServiceIDLine instead.
 	ServiceLine = "42"
+	// Deprecated: use ServiceIDLine2 instead.
 	ServiceLine2 = "11985"
+	// Deprecated: use ServiceIDLink instead.
 	ServiceLink = "11885"
+	// Deprecated: use ServiceIDLinkedIn instead.
 	ServiceLinkedIn = "43"
+	// Deprecated: use ServiceIDLinode instead.
 	ServiceLinode = "11966"
+	// Deprecated: use ServiceIDListia instead.
 	ServiceListia = "107"
+	// Deprecated: use ServiceIDLocalBitcoins instead.
 	ServiceLocalBitcoins = "147"
+	// Deprecated: use ServiceIDLocalCoinATM instead.
 	ServiceLocalCoinATM = "198"
+	// Deprecated: use ServiceIDLocanto instead.
 	ServiceLocanto = "271"
+	// Deprecated: use ServiceIDLoveAndSeek instead.
 	ServiceLoveAndSeek = "11874"
+	// Deprecated: use ServiceIDLyft instead.
 	ServiceLyft = "44"
+	// Deprecated: use ServiceIDM1Finance instead.
 	ServiceM1Finance = "455"
+	// Deprecated: use ServiceIDMailPrincess instead.
 	ServiceMailPrincess = "265"
+	// Deprecated: use ServiceIDMailRu instead.
 	ServiceMailRu = "45"
+	// Deprecated: use ServiceIDMamba instead.
 	ServiceMamba = "572"
+	// Deprecated: use ServiceIDMarcus instead.
 	ServiceMarcus = "525"
+	// Deprecated: use ServiceIDMatchcom instead.
 	ServiceMatchcom = "349"
+	// Deprecated: use ServiceIDMaza instead.
 	ServiceMaza = "12020"
+	// Deprecated: use ServiceIDMcMoney instead.
 	ServiceMcMoney = "524"
+	// Deprecated: use ServiceIDMeetMe instead.
 	ServiceMeetMe = "371"
+	// Deprecated: use ServiceIDMercari instead.
 	ServiceMercari = "173"
+	// Deprecated: use ServiceIDMessageBird instead.
 	ServiceMessageBird = "10931"
+	// Deprecated: use ServiceIDMessageDesk instead.
 	ServiceMessageDesk = "10609"
+	// Deprecated: use ServiceIDMetalPay instead.
 	ServiceMetalPay = "189"
+	// Deprecated: use ServiceIDMezu instead.
 	ServiceMezu = "105"
+	// Deprecated: use ServiceIDMicrosoft instead.
 	ServiceMicrosoft = "47"
+	// Deprecated: use ServiceIDMicrosoftAzure instead.
 	ServiceMicrosoftAzure = "48"
+	// Deprecated: use ServiceIDMicrosoftOffice365Business instead.
 	ServiceMicrosoftOffice365Business = "172"
+	// Deprecated: use ServiceIDMicrosoftOffice365E5 instead.
 	ServiceMicrosoftOffice365E5 = "281"
+	// Deprecated: use ServiceIDMicrosoftOffice365Education instead.
 	ServiceMicrosoftOffice365Education = "151"
+	// Deprecated: use ServiceIDMicrosoftRewards instead.
 	ServiceMicrosoftRewards = "49"
+	// Deprecated: use ServiceIDMicroworkers instead.
 	ServiceMicroworkers = "323"
+	// Deprecated: use ServiceIDMilesRewards instead.
 	ServiceMilesRewards = "10584"
+	// Deprecated: use ServiceIDMillionaireMatch instead.
 	ServiceMillionaireMatch = "539"
+	// Deprecated: use ServiceIDMillions instead.
 	ServiceMillions = "11843"
+	// Deprecated: use ServiceIDMint instead.
 	ServiceMint = "252"
+	// Deprecated: use ServiceIDMintVine instead.
 	ServiceMintVine = "131"
+	// Deprecated: use ServiceIDMistplay instead.
 	ServiceMistplay = "512"
+	// Deprecated: use ServiceIDmixi instead.
 	Servicemixi = "345"
+	// Deprecated: use ServiceIDMobileMoney instead.
 	ServiceMobileMoney = "222"
+	// Deprecated: use ServiceIDModeEarnApp instead.
 	ServiceModeEarnApp = "10603"
+	// Deprecated: use ServiceIDMoneyGram instead.
 	ServiceMoneyGram = "526"
+	// Deprecated: use ServiceIDMoneyLion instead.
 	ServiceMoneyLion = "277"
+	// Deprecated: use ServiceIDMoneyPak instead.
 	ServiceMoneyPak = "351"
+	// Deprecated: use ServiceIDMoneyRawr instead.
 	ServiceMoneyRawr = "333"
+	// Deprecated: use ServiceIDMoolaDays instead.
 	ServiceMoolaDays = "184"
+	// Deprecated: use ServiceIDMoonPay instead.
 	ServiceMoonPay = "332"
+	// Deprecated: use ServiceIDMos instead.
 	ServiceMos = "537"
+	// Deprecated: use ServiceIDMOVO instead.
 	ServiceMOVO = "175"
+	// Deprecated: use ServiceIDMrsool instead.
 	ServiceMrsool = "498"
+	// Deprecated: use ServiceIDMTCGamePortal instead.
 	ServiceMTCGamePortal = "210"
+	// Deprecated: use ServiceIDMudflap instead.
 	ServiceMudflap = "11821"
+	// Deprecated: use ServiceIDMusicstream instead.
 	ServiceMusicstream = "11912"
-	ServiceMyOpinions = "117"
-	ServiceMyTrainerRewards = "50"
-	ServiceMyVoice = "546"
+	// Deprecated: use ServiceIDMyBookie instead.
 	ServiceMyBookie = "224"
+	// Deprecated: use ServiceIDMyGiftCardSupply instead.
 	ServiceMyGiftCardSupply = "177"
+	// Deprecated: use ServiceIDMyOpinions instead.
+	ServiceMyOpinions = "117"
+	// Deprecated: use ServiceIDMyPoints instead.
 	ServiceMyPoints = "94"
+	// Deprecated: use ServiceIDMyRobinhood instead.
 	ServiceMyRobinhood = "10580"
+	// Deprecated: use ServiceIDMyTrainerRewards instead.
+	ServiceMyTrainerRewards = "50"
+	// Deprecated: use ServiceIDMyVoice instead.
+	ServiceMyVoice = "546"
+	// Deprecated: use ServiceIDmyWisely instead.
 	ServicemyWisely = "11849"
+	// Deprecated: use ServiceIDNaturalBrainai instead.
 	ServiceNaturalBrainai = "491"
+	// Deprecated: use ServiceIDNBATopshot instead.
 	ServiceNBATopshot = "473"
+	// Deprecated: use ServiceIDNerdWallet instead.
 	ServiceNerdWallet = "415"
+	// Deprecated: use ServiceIDNetflix instead.
 	ServiceNetflix = "51"
+	// Deprecated: use ServiceIDNetZero instead.
 	ServiceNetZero = "436"
+	// Deprecated: use ServiceIDNeuron instead.
 	ServiceNeuron = "496"
+	// Deprecated: use ServiceIDNexmoVonage instead.
 	ServiceNexmoVonage = "52"
+	// Deprecated: use ServiceIDNextdoor instead.
 	ServiceNextdoor = "275"
+	// Deprecated: use ServiceIDNielsen instead.
 	ServiceNielsen = "11891"
+	// Deprecated: use ServiceIDNiftyGateway instead.
 	ServiceNiftyGateway = "434"
+	// Deprecated: use ServiceIDNike instead.
 	ServiceNike = "53"
+	// Deprecated: use ServiceIDNonoh instead.
 	ServiceNonoh = "386"
+	// Deprecated: use ServiceIDNordstrom instead.
 	ServiceNordstrom = "263"
+	// Deprecated: use ServiceIDNotListed instead.
+	ServiceNotListed = "0"
+	// Deprecated: use ServiceIDNTWRK instead.
 	ServiceNTWRK = "511"
+	// Deprecated: use ServiceIDOcto instead.
 	ServiceOcto = "11937"
+	// Deprecated: use ServiceIDOfferNation instead.
 	ServiceOfferNation = "164"
+	// Deprecated: use ServiceIDOfferToro instead.
 	ServiceOfferToro = "11957"
+	// Deprecated: use ServiceIDOfferUp instead.
 	ServiceOfferUp = "54"
+	// Deprecated: use ServiceIDOffGamers instead.
 	ServiceOffGamers = "132"
+	// Deprecated: use ServiceIDOhmConnect instead.
 	ServiceOhmConnect = "499"
+	// Deprecated: use ServiceIDOkCupid instead.
 	ServiceOkCupid = "353"
+	// Deprecated: use ServiceIDOneFinance instead.
 	ServiceOneFinance = "475"
-	ServiceOneOpinion = "120"
+	// Deprecated: use ServiceIDOneMainFinancial instead.
 	ServiceOneMainFinancial = "390"
+	// Deprecated: use ServiceIDOneOpinion instead.
+	ServiceOneOpinion = "120"
+	// Deprecated: use ServiceIDOnJuno instead.
 	ServiceOnJuno = "561"
+	// Deprecated: use ServiceIDOnlinenet instead.
 	ServiceOnlinenet = "262"
+	// Deprecated: use ServiceIDOpenAIChatGPT instead.
 	ServiceOpenAIChatGPT = "10982"
+	// Deprecated: use ServiceIDOpenPhone instead.
 	ServiceOpenPhone = "400"
-	ServiceOpinionWorld = "344"
+	// Deprecated: use ServiceIDOpinionsOutpost instead.
 	ServiceOpinionsOutpost = "116"
+	// Deprecated: use ServiceIDOpinionWorld instead.
+	ServiceOpinionWorld = "344"
+	// Deprecated: use ServiceIDOportun instead.
 	ServiceOportun = "11797"
+	// Deprecated: use ServiceIDOracleCloud instead.
 	ServiceOracleCloud = "111"
+	// Deprecated: use ServiceIDOurTime instead.
 	ServiceOurTime = "374"
+	// Deprecated: use ServiceIDOutlook instead.
 	ServiceOutlook = "92"
+	// Deprecated: use ServiceIDOxygen instead.
 	ServiceOxygen = "11829"
+	// Deprecated: use ServiceIDOYO instead.
 	ServiceOYO = "261"
+	// Deprecated: use ServiceIDPangeaMoneyTransfer instead.
 	ServicePangeaMoneyTransfer = "148"
+	// Deprecated: use ServiceIDParler instead.
 	ServiceParler = "384"
+	// Deprecated: use ServiceIDPartyPoker instead.
 	ServicePartyPoker = "11904"
+	// Deprecated: use ServiceIDPassbook instead.
 	ServicePassbook = "420"
+	// Deprecated: use ServiceIDPaxful instead.
 	ServicePaxful = "145"
+	// Deprecated: use ServiceIDPayactiv instead.
 	ServicePayactiv = "486"
+	// Deprecated: use ServiceIDPaybis instead.
 	ServicePaybis = "191"
+	// Deprecated: use ServiceIDPayCenter instead.
 	ServicePayCenter = "359"
+	// Deprecated: use ServiceIDPaymeDollar instead.
 	ServicePaymeDollar = "331"
+	// Deprecated: use ServiceIDPayoneer instead.
 	ServicePayoneer = "357"
+	// Deprecated: use ServiceIDPayPal instead.
 	ServicePayPal = "55"
+	// Deprecated: use ServiceIDPaySend instead.
 	ServicePaySend = "174"
+	// Deprecated: use ServiceIDPaysera instead.
 	ServicePaysera = "238"
+	// Deprecated: use ServiceIDPCGameSupply instead.
 	ServicePCGameSupply = "178"
+	// Deprecated: use ServiceIDPei instead.
 	ServicePei = "182"
+	// Deprecated: use ServiceIDPerk instead.
 	ServicePerk = "100"
+	// Deprecated: use ServiceIDPersonalCapital instead.
 	ServicePersonalCapital = "255"
+	// Deprecated: use ServiceIDPGSamsBuyGet instead.
 	ServicePGSamsBuyGet = "11934"
+	// Deprecated: use ServiceIDPineconeResearch instead.
 	ServicePineconeResearch = "503"
+	// Deprecated: use ServiceIDPlaid instead.
 	ServicePlaid = "369"
+	// Deprecated: use ServiceIDPlayerAuctions instead.
 	ServicePlayerAuctions = "126"
+	// Deprecated: use ServiceIDPlentyOfFish instead.
 	ServicePlentyOfFish = "229"
+	// Deprecated: use ServiceIDPlivo instead.
 	ServicePlivo = "574"
+	// Deprecated: use ServiceIDPogo instead.
 	ServicePogo = "522"
+	// Deprecated: use ServiceIDPointclub instead.
 	ServicePointclub = "203"
+	// Deprecated: use ServiceIDPollPass instead.
 	ServicePollPass = "218"
+	// Deprecated: use ServiceIDPomelo instead.
 	ServicePomelo = "12026"
+	// Deprecated: use ServiceIDPopads instead.
 	ServicePopads = "11963"
+	// Deprecated: use ServiceIDPorte instead.
 	ServicePorte = "451"
+	// Deprecated: use ServiceIDPoshmark instead.
 	ServicePoshmark = "472"
+	// Deprecated: use ServiceIDPostmates instead.
 	ServicePostmates = "187"
+	// Deprecated: use ServiceIDPotatoChat instead.
 	ServicePotatoChat = "378"
+	// Deprecated: use ServiceIDPrepaid2Cash instead.
 	ServicePrepaid2Cash = "256"
+	// Deprecated: use ServiceIDPrivacy instead.
 	ServicePrivacy = "380"
+	// Deprecated: use ServiceIDProlific instead.
 	ServiceProlific = "98"
+	// Deprecated: use ServiceIDPromotionPod instead.
 	ServicePromotionPod = "417"
+	// Deprecated: use ServiceIDProOpinions instead.
 	ServiceProOpinions = "135"
+	// Deprecated: use ServiceIDPropy instead.
 	ServicePropy = "106"
+	// Deprecated: use ServiceIDProtonMail instead.
 	ServiceProtonMail = "56"
+	// Deprecated: use ServiceIDPruvit instead.
 	ServicePruvit = "232"
+	// Deprecated: use ServiceIDPublic instead.
 	ServicePublic = "12034"
+	// Deprecated: use ServiceIDPurseio instead.
 	ServicePurseio = "57"
+	// Deprecated: use ServiceIDQubeMoney instead.
 	ServiceQubeMoney = "443"
+	// Deprecated: use ServiceIDQuickBooks instead.
+	ServiceQuickBooks = "11024"
+	// Deprecated: use ServiceIDQuickPaySurvey instead.
 	ServiceQuickPaySurvey = "509"
+	// Deprecated: use ServiceIDQuickThoughts instead.
 	ServiceQuickThoughts = "115"
-	ServiceQuickBooks = "11024"
+	// Deprecated: use ServiceIDQuicrypto instead.
 	ServiceQuicrypto = "11983"
+	// Deprecated: use ServiceIDRadialInsight instead.
 	ServiceRadialInsight = "240"
+	// Deprecated: use ServiceIDRaise instead.
 	ServiceRaise = "138"
+	// Deprecated: use ServiceIDRebtel instead.
 	ServiceRebtel = "480"
+	// Deprecated: use ServiceIDRECUR instead.
 	ServiceRECUR = "11889"
+	// Deprecated: use ServiceIDRedCircle instead.
 	ServiceRedCircle = "579"
+	// Deprecated: use ServiceIDRemitly instead.
 	ServiceRemitly = "421"
+	// Deprecated: use ServiceIDRently instead.
 	ServiceRently = "11993"
+	// Deprecated: use ServiceIDRentMe instead.
 	ServiceRentMe = "450"
+	// Deprecated: use ServiceIDReonomy instead.
 	ServiceReonomy = "501"
+	// Deprecated: use ServiceIDRetailMeNot instead.
 	ServiceRetailMeNot = "156"
+	// Deprecated: use ServiceIDRevolut instead.
 	ServiceRevolut = "272"
+	// Deprecated: use ServiceIDRewardedPlay instead.
 	ServiceRewardedPlay = "541"
+	// Deprecated: use ServiceIDRewardingWays instead.
 	ServiceRewardingWays = "144"
+	// Deprecated: use ServiceIDRI instead.
 	ServiceRI = "484"
+	// Deprecated: use ServiceIDRiaFinancial instead.
 	ServiceRiaFinancial = "409"
+	// Deprecated: use ServiceIDRingCaptcha instead.
 	ServiceRingCaptcha = "219"
+	// Deprecated: use ServiceIDRingCentral instead.
 	ServiceRingCentral = "309"
+	// Deprecated: use ServiceIDRitualco instead.
 	ServiceRitualco = "59"
+	// Deprecated: use ServiceIDRobinhood instead.
 	ServiceRobinhood = "419"
+	// Deprecated: use ServiceIDRoomster instead.
 	ServiceRoomster = "10581"
+	// Deprecated: use ServiceIDRoot instead.
 	ServiceRoot = "502"
+	// Deprecated: use ServiceIDRover instead.
 	ServiceRover = "354"
+	// Deprecated: use ServiceIDRRF instead.
 	ServiceRRF = "481"
+	// Deprecated: use ServiceIDRSGoldMine instead.
 	ServiceRSGoldMine = "403"
+	// Deprecated: use ServiceIDRSocks instead.
 	ServiceRSocks = "10583"
+	// Deprecated: use ServiceIDRumble instead.
 	ServiceRumble = "11037"
-	ServiceSmores = "166"
+	// Deprecated: use ServiceIDSafewayAlbertsons instead.
 	ServiceSafewayAlbertsons = "11841"
+	// Deprecated: use ServiceIDSamsClub instead.
 	ServiceSamsClub = "11040"
-	ServiceSaveWithSurveys = "134"
+	// Deprecated: use ServiceIDSaverLife instead.
 	ServiceSaverLife = "10607"
+	// Deprecated: use ServiceIDSaveWithSurveys instead.
+	ServiceSaveWithSurveys = "134"
+	// Deprecated: use ServiceIDSayHi instead.
 	ServiceSayHi = "233"
+	// Deprecated: use ServiceIDSBA instead.
 	ServiceSBA = "576"
+	// Deprecated: use ServiceIDScaleway instead.
 	ServiceScaleway = "136"
+	// Deprecated: use ServiceIDSEAGM instead.
 	ServiceSEAGM = "60"
+	// Deprecated: use ServiceIDSeated instead.
 	ServiceSeated = "283"
+	// Deprecated: use ServiceIDSecretBenefits instead.
 	ServiceSecretBenefits = "395"
+	// Deprecated: use ServiceIDSendGrid instead.
 	ServiceSendGrid = "422"
+	// Deprecated: use ServiceIDSendSprint instead.
 	ServiceSendSprint = "11944"
+	// Deprecated: use ServiceIDSendwave instead.
 	ServiceSendwave = "485"
+	// Deprecated: use ServiceIDSentBe instead.
 	ServiceSentBe = "12001"
+	// Deprecated: use ServiceIDSEOClerks instead.
 	ServiceSEOClerks = "517"
+	// Deprecated: use ServiceIDServe instead.
 	ServiceServe = "12019"
+	// Deprecated: use ServiceIDSezzle instead.
 	ServiceSezzle = "396"
+	// Deprecated: use ServiceIDSheerID instead.
 	ServiceSheerID = "394"
+	// Deprecated: use ServiceIDShopify instead.
 	ServiceShopify = "11051"
+	// Deprecated: use ServiceIDShopkick instead.
 	ServiceShopkick = "61"
+	// Deprecated: use ServiceIDShopPay instead.
 	ServiceShopPay = "11048"
+	// Deprecated: use ServiceIDSidelineSwap instead.
 	ServiceSidelineSwap = "11053"
+	// Deprecated: use ServiceIDSignal instead.
 	ServiceSignal = "313"
+	// Deprecated: use ServiceIDSimba instead.
 	ServiceSimba = "463"
+	// Deprecated: use ServiceIDSimplexSimplexCC instead.
 	ServiceSimplexSimplexCC = "195"
+	// Deprecated: use ServiceIDSkout instead.
 	ServiceSkout = "127"
+	// Deprecated: use ServiceIDSkrill instead.
 	ServiceSkrill = "62"
+	// Deprecated: use ServiceIDSkype instead.
 	ServiceSkype = "63"
+	// Deprecated: use ServiceIDSkyPrivate instead.
 	ServiceSkyPrivate = "11783"
+	// Deprecated: use ServiceIDSlide instead.
 	ServiceSlide = "370"
+	// Deprecated: use ServiceIDSmartyPig instead.
 	ServiceSmartyPig = "12051"
+	// Deprecated: use ServiceIDSmores instead.
+	ServiceSmores = "166"
+	// Deprecated: use ServiceIDSnagshout instead.
 	ServiceSnagshout = "143"
-	ServiceSnapFinance = "413"
+	// Deprecated: use ServiceIDSnapchat instead.
 	ServiceSnapchat = "64"
+	// Deprecated: use ServiceIDSnapFinance instead.
+	ServiceSnapFinance = "413"
+	// Deprecated: use ServiceIDSneakersnstuff instead.
 	ServiceSneakersnstuff = "317"
+	// Deprecated: use ServiceIDSocieti instead.
 	ServiceSocieti = "197"
+	// Deprecated: use ServiceIDSoFI instead.
 	ServiceSoFI = "427"
+	// Deprecated: use ServiceIDSpend instead.
 	ServiceSpend = "302"
+	// Deprecated: use ServiceIDSpruce instead.
 	ServiceSpruce = "11793"
+	// Deprecated: use ServiceIDSpruceHealth instead.
 	ServiceSpruceHealth = "12033"
+	// Deprecated: use ServiceIDSquare instead.
 	ServiceSquare = "11072"
+	// Deprecated: use ServiceIDStash instead.
 	ServiceStash = "11831"
+	// Deprecated: use ServiceIDSteady instead.
 	ServiceSteady = "535"
+	// Deprecated: use ServiceIDSteam instead.
 	ServiceSteam = "66"
+	// Deprecated: use ServiceIDSteemIt instead.
 	ServiceSteemIt = "67"
+	// Deprecated: use ServiceIDStep instead.
 	ServiceStep = "376"
+	// Deprecated: use ServiceIDStir instead.
 	ServiceStir = "567"
+	// Deprecated: use ServiceIDStreetbeat instead.
 	ServiceStreetbeat = "11933"
+	// Deprecated: use ServiceIDStrike instead.
 	ServiceStrike = "469"
+	// Deprecated: use ServiceIDStripe instead.
 	ServiceStripe = "235"
+	// Deprecated: use ServiceIDSugarbook instead.
 	ServiceSugarbook = "11922"
+	// Deprecated: use ServiceIDSugarDaddyMeet instead.
 	ServiceSugarDaddyMeet = "11081"
+	// Deprecated: use ServiceIDSumUp instead.
 	ServiceSumUp = "356"
+	// Deprecated: use ServiceIDSuperPay instead.
 	ServiceSuperPay = "153"
+	// Deprecated: use ServiceIDSurePayroll instead.
 	ServiceSurePayroll = "555"
+	// Deprecated: use ServiceIDSurf instead.
 	ServiceSurf = "545"
+	// Deprecated: use ServiceIDSurveyHoney instead.
 	ServiceSurveyHoney = "123"
+	// Deprecated: use ServiceIDSurveyJunkie instead.
 	ServiceSurveyJunkie = "109"
+	// Deprecated: use ServiceIDSurveyMonkeyRewards instead.
 	ServiceSurveyMonkeyRewards = "103"
+	// Deprecated: use ServiceIDSurveytime instead.
 	ServiceSurveytime = "228"
+	// Deprecated: use ServiceIDSwagbucks instead.
 	ServiceSwagbucks = "68"
+	// Deprecated: use ServiceIDSweatcoin instead.
 	ServiceSweatcoin = "122"
+	// Deprecated: use ServiceIDSweetRing instead.
 	ServiceSweetRing = "387"
+	// Deprecated: use ServiceIDSwych instead.
 	ServiceSwych = "102"
-	ServiceTMobileMoney = "489"
+	// Deprecated: use ServiceIDTada instead.
 	ServiceTada = "569"
+	// Deprecated: use ServiceIDTaimi instead.
 	ServiceTaimi = "11996"
+	// Deprecated: use ServiceIDTaoBao instead.
 	ServiceTaoBao = "385"
+	// Deprecated: use ServiceIDTapchamps instead.
 	ServiceTapchamps = "529"
+	// Deprecated: use ServiceIDTapResearch instead.
 	ServiceTapResearch = "11991"
+	// Deprecated: use ServiceIDTaptapSend instead.
 	ServiceTaptapSend = "11967"
+	// Deprecated: use ServiceIDTarget instead.
 	ServiceTarget = "297"
+	// Deprecated: use ServiceIDTaxAct instead.
 	ServiceTaxAct = "12025"
+	// Deprecated: use ServiceIDTaxSlayer instead.
 	ServiceTaxSlayer = "11789"
+	// Deprecated: use ServiceIDTCGPlayer instead.
 	ServiceTCGPlayer = "101"
+	// Deprecated: use ServiceIDTDAmeritrade instead.
 	ServiceTDAmeritrade = "416"
+	// Deprecated: use ServiceIDTechBubble instead.
 	ServiceTechBubble = "542"
+	// Deprecated: use ServiceIDTelegram instead.
 	ServiceTelegram = "69"
+	// Deprecated: use ServiceIDTelnyx instead.
 	ServiceTelnyx = "11092"
+	// Deprecated: use ServiceIDTemu instead.
 	ServiceTemu = "12047"
+	// Deprecated: use ServiceIDTencent instead.
 	ServiceTencent = "298"
-	ServiceToTaxi = "114"
+	// Deprecated: use ServiceIDThinkOpinion instead.
 	ServiceThinkOpinion = "500"
+	// Deprecated: use ServiceIDThumbtack instead.
 	ServiceThumbtack = "360"
+	// Deprecated: use ServiceIDTicketmaster instead.
 	ServiceTicketmaster = "179"
+	// Deprecated: use ServiceIDTikTok instead.
 	ServiceTikTok = "381"
+	// Deprecated: use ServiceIDTinder instead.
 	ServiceTinder = "72"
+	// Deprecated: use ServiceIDTipNano instead.
 	ServiceTipNano = "11975"
+	// Deprecated: use ServiceIDTiv instead.
 	ServiceTiv = "12037"
+	// Deprecated: use ServiceIDTMobileMoney instead.
+	ServiceTMobileMoney = "489"
+	// Deprecated: use ServiceIDToken instead.
 	ServiceToken = "220"
+	// Deprecated: use ServiceIDToTaxi instead.
+	ServiceToTaxi = "114"
+	// Deprecated: use ServiceIDTradingView instead.
 	ServiceTradingView = "424"
+	// Deprecated: use ServiceIDTransferWise instead.
 	ServiceTransferWise = "215"
+	// Deprecated: use ServiceIDTransformCredit instead.
 	ServiceTransformCredit = "11878"
+	// Deprecated: use ServiceIDTruthSocial instead.
 	ServiceTruthSocial = "11862"
+	// Deprecated: use ServiceIDTurboTax instead.
 	ServiceTurboTax = "418"
+	// Deprecated: use ServiceIDTurgame instead.
 	ServiceTurgame = "446"
+	// Deprecated: use ServiceIDTuro instead.
 	ServiceTuro = "73"
+	// Deprecated: use ServiceIDTwigcard instead.
 	ServiceTwigcard = "12021"
+	// Deprecated: use ServiceIDTwilio instead.
 	ServiceTwilio = "74"
+	// Deprecated: use ServiceIDTwitch instead.
 	ServiceTwitch = "180"
+	// Deprecated: use ServiceIDTwitter instead.
 	ServiceTwitter = "75"
+	// Deprecated: use ServiceIDUber instead.
 	ServiceUber = "76"
+	// Deprecated: use ServiceIDUberEats instead.
 	ServiceUberEats = "137"
+	// Deprecated: use ServiceIDUbisoft instead.
 	ServiceUbisoft = "11118"
+	// Deprecated: use ServiceIDUKGWallet instead.
 	ServiceUKGWallet = "11961"
+	// Deprecated: use ServiceIDUnivisionMobileMoney instead.
 	ServiceUnivisionMobileMoney = "291"
+	// Deprecated: use ServiceIDUpaynet instead.
 	ServiceUpaynet = "452"
+	// Deprecated: use ServiceIDUpgrade instead.
 	ServiceUpgrade = "11892"
+	// Deprecated: use ServiceIDuphold instead.
 	Serviceuphold = "303"
+	// Deprecated: use ServiceIDUplift instead.
 	ServiceUplift = "497"
+	// Deprecated: use ServiceIDUpVoice instead.
 	ServiceUpVoice = "560"
+	// Deprecated: use ServiceIDUpward instead.
 	ServiceUpward = "550"
+	// Deprecated: use ServiceIDUpwork instead.
 	ServiceUpwork = "212"
+	// Deprecated: use ServiceIDUSASurvey instead.
 	ServiceUSASurvey = "188"
+	// Deprecated: use ServiceIDValuedOpinions instead.
 	ServiceValuedOpinions = "118"
+	// Deprecated: use ServiceIDVanguard instead.
 	ServiceVanguard = "11895"
+	// Deprecated: use ServiceIDVenmo instead.
 	ServiceVenmo = "77"
+	// Deprecated: use ServiceIDVetri instead.
 	ServiceVetri = "11949"
+	// Deprecated: use ServiceIDVetsPrevail instead.
 	ServiceVetsPrevail = "119"
+	// Deprecated: use ServiceIDViaAppViaVan instead.
 	ServiceViaAppViaVan = "104"
+	// Deprecated: use ServiceIDViaBill instead.
 	ServiceViaBill = "10615"
+	// Deprecated: use ServiceIDViber instead.
 	ServiceViber = "78"
+	// Deprecated: use ServiceIDVidaplayer instead.
 	ServiceVidaplayer = "453"
+	// Deprecated: use ServiceIDVinted instead.
 	ServiceVinted = "566"
+	// Deprecated: use ServiceIDVK instead.
 	ServiceVK = "79"
+	// Deprecated: use ServiceIDVoicepark instead.
 	ServiceVoicepark = "11964"
+	// Deprecated: use ServiceIDVoilaNorbert instead.
 	ServiceVoilaNorbert = "508"
+	// Deprecated: use ServiceIDVoyager instead.
 	ServiceVoyager = "383"
+	// Deprecated: use ServiceIDVrbo instead.
 	ServiceVrbo = "11139"
+	// Deprecated: use ServiceIDVumber instead.
 	ServiceVumber = "341"
+	// Deprecated: use ServiceIDWagerWeb instead.
 	ServiceWagerWeb = "534"
+	// Deprecated: use ServiceIDWaleteros instead.
 	ServiceWaleteros = "80"
+	// Deprecated: use ServiceIDWalletHub instead.
 	ServiceWalletHub = "366"
+	// Deprecated: use ServiceIDWalmart instead.
 	ServiceWalmart = "296"
+	// Deprecated: use ServiceIDWalmartFamilyMobile instead.
 	ServiceWalmartFamilyMobile = "266"
+	// Deprecated: use ServiceIDWalmartMoneyCard instead.
 	ServiceWalmartMoneyCard = "183"
+	// Deprecated: use ServiceIDWealthfront instead.
 	ServiceWealthfront = "456"
+	// Deprecated: use ServiceIDWebull instead.
 	ServiceWebull = "11877"
+	// Deprecated: use ServiceIDWeChatReceiveOnly instead.
 	ServiceWeChatReceiveOnly = "305"
+	// Deprecated: use ServiceIDWeebly instead.
 	ServiceWeebly = "83"
+	// Deprecated: use ServiceIDWeee instead.
 	ServiceWeee = "543"
+	// Deprecated: use ServiceIDWeibo instead.
 	ServiceWeibo = "314"
+	// Deprecated: use ServiceIDWelspunBrainTrust instead.
 	ServiceWelspunBrainTrust = "11809"
+	// Deprecated: use ServiceIDWeverse instead.
 	ServiceWeverse = "11827"
+	// Deprecated: use ServiceIDWhatnot instead.
 	ServiceWhatnot = "11855"
+	// Deprecated: use ServiceIDWhatsApp instead.
 	ServiceWhatsApp = "84"
+	// Deprecated: use ServiceIDWindowsXboxStore instead.
 	ServiceWindowsXboxStore = "85"
+	// Deprecated: use ServiceIDWingocard instead.
 	ServiceWingocard = "444"
+	// Deprecated: use ServiceIDWink instead.
 	ServiceWink = "11155"
+	// Deprecated: use ServiceIDWireBarley instead.
 	ServiceWireBarley = "11807"
+	// Deprecated: use ServiceIDWomply instead.
 	ServiceWomply = "437"
+	// Deprecated: use ServiceIDWooCommerce instead.
 	ServiceWooCommerce = "11159"
+	// Deprecated: use ServiceIDWyre instead.
 	ServiceWyre = "254"
+	// Deprecated: use ServiceIDXapo instead.
 	ServiceXapo = "234"
+	// Deprecated: use ServiceIDXbox instead.
 	ServiceXbox = "93"
+	// Deprecated: use ServiceIDxcoins instead.
 	Servicexcoins = "556"
+	// Deprecated: use ServiceIDYahoo instead.
 	ServiceYahoo = "86"
+	// Deprecated: use ServiceIDYandex instead.
 	ServiceYandex = "87"
+	// Deprecated: use ServiceIDYeezy instead.
 	ServiceYeezy = "575"
+	// Deprecated: use ServiceIDYelp instead.
 	ServiceYelp = "432"
+	// Deprecated: use ServiceIDYFSResearch instead.
 	ServiceYFSResearch = "289"
+	// Deprecated: use ServiceIDYodlee instead.
 	ServiceYodlee = "318"
+	// Deprecated: use ServiceIDYotta instead.
 	ServiceYotta = "11999"
+	// Deprecated: use ServiceIDYoutube instead.
 	ServiceYoutube = "91"
+	// Deprecated: use ServiceIDySense instead.
 	ServiceySense = "273"
+	// Deprecated: use ServiceIDYubo instead.
 	ServiceYubo = "155"
+	// Deprecated: use ServiceIDYunoSurveys instead.
 	ServiceYunoSurveys = "270"
+	// Deprecated: use ServiceIDYuroPay instead.
 	ServiceYuroPay = "358"
-	Servicezcom = "299"
+	// Deprecated: use ServiceIDZalo instead.
 	ServiceZalo = "11177"
+	// Deprecated: use ServiceIDZaxbys instead.
 	ServiceZaxbys = "11955"
+	// Deprecated: use ServiceIDzcom instead.
+	Servicezcom = "299"
+	// Deprecated: use ServiceIDZeek instead.
 	ServiceZeek = "140"
+	// Deprecated: use ServiceIDZelle instead.
 	ServiceZelle = "88"
+	// Deprecated: use ServiceIDZillow instead.
 	ServiceZillow = "442"
+	// Deprecated: use ServiceIDZipQuadPay instead.
 	ServiceZipQuadPay = "397"
+	// Deprecated: use ServiceIDZogo instead.
 	ServiceZogo = "339"
+	// Deprecated: use ServiceIDZoho instead.
 	ServiceZoho = "89"
+	// Deprecated: use ServiceIDZoomBucks instead.
 	ServiceZoomBucks = "201"
+	// Deprecated: use ServiceIDZoomInfo instead.
 	ServiceZoomInfo = "346"
+	// Deprecated: use ServiceIDZoosk instead.
 	ServiceZoosk = "243"
+	// Deprecated: use ServiceIDZumper instead.
 	ServiceZumper = "393"
-	
-)
\ No newline at end of file
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "0", Name: "NotListed", NormalizedName: "notlisted"},
+	{ID: "1", Name: "Adidas", NormalizedName: "adidas"},
+	{ID: "2", Name: "AdWallet", NormalizedName: "adwallet"},
+	{ID: "3", Name: "Airbnb", NormalizedName: "airbnb"},
+	{ID: "4", Name: "Alibaba", NormalizedName: "alibaba"},
+	{ID: "5", Name: "Amazon", NormalizedName: "amazon"},
+	{ID: "6", Name: "Aol", NormalizedName: "aol"},
+	{ID: "7", Name: "Authy", NormalizedName: "authy"},
+	{ID: "9", Name: "Baidu", NormalizedName: "baidu"},
+	{ID: "10", Name: "Bitmo", NormalizedName: "bitmo"},
+	{ID: "11", Name: "Burner", NormalizedName: "burner"},
+	{ID: "12", Name: "Carepoynt", NormalizedName: "carepoynt"},
+	{ID: "13", Name: "CashApp", NormalizedName: "cashapp"},
+	{ID: "14", Name: "CashShow", NormalizedName: "cashshow"},
+	{ID: "15", Name: "Couponscom", NormalizedName: "couponscom"},
+	{ID: "16", Name: "Craigslist", NormalizedName: "craigslist"},
+	{ID: "18", Name: "Dent", NormalizedName: "dent"},
+	{ID: "19", Name: "Discord", NormalizedName: "discord"},
+	{ID: "20", Name: "DoorDash", NormalizedName: "doordash"},
+	{ID: "21", Name: "DOSH", NormalizedName: "dosh"},
+	{ID: "22", Name: "EarnHoney", NormalizedName: "earnhoney"},
+	{ID: "23", Name: "eBay", NormalizedName: "ebay"},
+	{ID: "24", Name: "eRewards", NormalizedName: "erewards"},
+	{ID: "25", Name: "eToro", NormalizedName: "etoro"},
+	{ID: "26", Name: "EveryoneAPI", NormalizedName: "everyoneapi"},
+	{ID: "27", Name: "Facebook", NormalizedName: "facebook"},
+	{ID: "28", Name: "FastMail", NormalizedName: "fastmail"},
+	{ID: "29", Name: "Fiverr", NormalizedName: "fiverr"},
+	{ID: "30", Name: "G2A", NormalizedName: "g2a"},
+	{ID: "31", Name: "Gameflip", NormalizedName: "gameflip"},
+	{ID: "32", Name: "Gifthulk", NormalizedName: "gifthulk"},
+	{ID: "33", Name: "Google", NormalizedName: "google"},
+	{ID: "34", Name: "GoogleVoice", NormalizedName: "googlevoice"},
+	{ID: "35", Name: "Grab", NormalizedName: "grab"},
+	{ID: "36", Name: "HQTrivia", NormalizedName: "hqtrivia"},
+	{ID: "38", Name: "ICQ", NormalizedName: "icq"},
+	{ID: "39", Name: "InstaGC", NormalizedName: "instagc"},
+	{ID: "40", Name: "Instagram", NormalizedName: "instagram"},
+	{ID: "41", Name: "KakaoTalk", NormalizedName: "kakaotalk"},
+	{ID: "42", Name: "Line", NormalizedName: "line"},
+	{ID: "43", Name: "LinkedIn", NormalizedName: "linkedin"},
+	{ID: "44", Name: "Lyft", NormalizedName: "lyft"},
+	{ID: "45", Name: "MailRu", NormalizedName: "mailru"},
+	{ID: "47", Name: "Microsoft", NormalizedName: "microsoft"},
+	{ID: "48", Name: "MicrosoftAzure", NormalizedName: "microsoftazure"},
+	{ID: "49", Name: "MicrosoftRewards", NormalizedName: "microsoftrewards"},
+	{ID: "50", Name: "MyTrainerRewards", NormalizedName: "mytrainerrewards"},
+	{ID: "51", Name: "Netflix", NormalizedName: "netflix"},
+	{ID: "52", Name: "NexmoVonage", NormalizedName: "nexmovonage"},
+	{ID: "53", Name: "Nike", NormalizedName: "nike"},
+	{ID: "54", Name: "OfferUp", NormalizedName: "offerup"},
+	{ID: "55", Name: "PayPal", NormalizedName: "paypal"},
+	{ID: "56", Name: "ProtonMail", NormalizedName: "protonmail"},
+	{ID: "57", Name: "Purseio", NormalizedName: "purseio"},
+	{ID: "59", Name: "Ritualco", NormalizedName: "ritualco"},
+	{ID: "60", Name: "SEAGM", NormalizedName: "seagm"},
+	{ID: "61", Name: "Shopkick", NormalizedName: "shopkick"},
+	{ID: "62", Name: "Skrill", NormalizedName: "skrill"},
+	{ID: "63", Name: "Skype", NormalizedName: "skype"},
+	{ID: "64", Name: "Snapchat", NormalizedName: "snapchat"},
+	{ID: "66", Name: "Steam", NormalizedName: "steam"},
+	{ID: "67", Name: "SteemIt", NormalizedName: "steemit"},
+	{ID: "68", Name: "Swagbucks", NormalizedName: "swagbucks"},
+	{ID: "69", Name: "Telegram", NormalizedName: "telegram"},
+	{ID: "72", Name: "Tinder", NormalizedName: "tinder"},
+	{ID: "73", Name: "Turo", NormalizedName: "turo"},
+	{ID: "74", Name: "Twilio", NormalizedName: "twilio"},
+	{ID: "75", Name: "Twitter", NormalizedName: "twitter"},
+	{ID: "76", Name: "Uber", NormalizedName: "uber"},
+	{ID: "77", Name: "Venmo", NormalizedName: "venmo"},
+	{ID: "78", Name: "Viber", NormalizedName: "viber"},
+	{ID: "79", Name: "VK", NormalizedName: "vk"},
+	{ID: "80", Name: "Waleteros", NormalizedName: "waleteros"},
+	{ID: "81", Name: "iMoney", NormalizedName: "imoney"},
+	{ID: "83", Name: "Weebly", NormalizedName: "weebly"},
+	{ID: "84", Name: "WhatsApp", NormalizedName: "whatsapp"},
+	{ID: "85", Name: "WindowsXboxStore", NormalizedName: "windowsxboxstore"},
+	{ID: "86", Name: "Yahoo", NormalizedName: "yahoo"},
+	{ID: "87", Name: "Yandex", NormalizedName: "yandex"},
+	{ID: "88", Name: "Zelle", NormalizedName: "zelle"},
+	{ID: "89", Name: "Zoho", NormalizedName: "zoho"},
+	{ID: "90", Name: "Gmail", NormalizedName: "gmail"},
+	{ID: "91", Name: "Youtube", NormalizedName: "youtube"},
+	{ID: "92", Name: "Outlook", NormalizedName: "outlook"},
+	{ID: "93", Name: "Xbox", NormalizedName: "xbox"},
+	{ID: "94", Name: "MyPoints", NormalizedName: "mypoints"},
+	{ID: "95", Name: "Bump", NormalizedName: "bump"},
+	{ID: "96", Name: "G2G", NormalizedName: "g2g"},
+	{ID: "97", Name: "Atom", NormalizedName: "atom"},
+	{ID: "98", Name: "Prolific", NormalizedName: "prolific"},
+	{ID: "99", Name: "iPoll", NormalizedName: "ipoll"},
+	{ID: "100", Name: "Perk", NormalizedName: "perk"},
+	{ID: "101", Name: "TCGPlayer", NormalizedName: "tcgplayer"},
+	{ID: "102", Name: "Swych", NormalizedName: "swych"},
+	{ID: "103", Name: "SurveyMonkeyRewards", NormalizedName: "surveymonkeyrewards"},
+	{ID: "104", Name: "ViaAppViaVan", NormalizedName: "viaappviavan"},
+	{ID: "105", Name: "Mezu", NormalizedName: "mezu"},
+	{ID: "106", Name: "Propy", NormalizedName: "propy"},
+	{ID: "107", Name: "Listia", NormalizedName: "listia"},
+	{ID: "108", Name: "Coinbase", NormalizedName: "coinbase"},
+	{ID: "109", Name: "SurveyJunkie", NormalizedName: "surveyjunkie"},
+	{ID: "110", Name: "Clickadu", NormalizedName: "clickadu"},
+	{ID: "111", Name: "OracleCloud", NormalizedName: "oraclecloud"},
+	{ID: "112", Name: "EpicNPC", NormalizedName: "epicnpc"},
+	{ID: "113", Name: "cdkeyscom", NormalizedName: "cdkeyscom"},
+	{ID: "114", Name: "ToTaxi", NormalizedName: "totaxi"},
+	{ID: "115", Name: "QuickThoughts", NormalizedName: "quickthoughts"},
+	{ID: "116", Name: "OpinionsOutpost", NormalizedName: "opinionsoutpost"},
+	{ID: "117", Name: "MyOpinions", NormalizedName: "myopinions"},
+	{ID: "118", Name: "ValuedOpinions", NormalizedName: "valuedopinions"},
+	{ID: "119", Name: "VetsPrevail", NormalizedName: "vetsprevail"},
+	{ID: "120", Name: "OneOpinion", NormalizedName: "oneopinion"},
+	{ID: "121", Name: "DollarGeneral", NormalizedName: "dollargeneral"},
+	{ID: "122", Name: "Sweatcoin", NormalizedName: "sweatcoin"},
+	{ID: "123", Name: "SurveyHoney", NormalizedName: "surveyhoney"},
+	{ID: "124", Name: "ibotta", NormalizedName: "ibotta"},
+	{ID: "125", Name: "iRazoo", NormalizedName: "irazoo"},
+	{ID: "126", Name: "PlayerAuctions", NormalizedName: "playerauctions"},
+	{ID: "127", Name: "Skout", NormalizedName: "skout"},
+	{ID: "128", Name: "Doublelist", NormalizedName: "doublelist"},
+	{ID: "129", Name: "InboxDollars", NormalizedName: "inboxdollars"},
+	{ID: "130", Name: "CheckPoints", NormalizedName: "checkpoints"},
+	{ID: "131", Name: "MintVine", NormalizedName: "mintvine"},
+	{ID: "132", Name: "OffGamers", NormalizedName: "offgamers"},
+	{ID: "133", Name: "BrandedSurveys", NormalizedName: "brandedsurveys"},
+	{ID: "134", Name: "SaveWithSurveys", NormalizedName: "savewithsurveys"},
+	{ID: "135", Name: "ProOpinions", NormalizedName: "proopinions"},
+	{ID: "136", Name: "Scaleway", NormalizedName: "scaleway"},
+	{ID: "137", Name: "UberEats", NormalizedName: "ubereats"},
+	{ID: "138", Name: "Raise", NormalizedName: "raise"},
+	{ID: "139", Name: "Badoo", NormalizedName: "badoo"},
+	{ID: "140", Name: "Zeek", NormalizedName: "zeek"},
+	{ID: "141", Name: "Gemini", NormalizedName: "gemini"},
+	{ID: "142", Name: "Cinchbucks", NormalizedName: "cinchbucks"},
+	{ID: "143", Name: "Snagshout", NormalizedName: "snagshout"},
+	{ID: "144", Name: "RewardingWays", NormalizedName: "rewardingways"},
+	{ID: "145", Name: "Paxful", NormalizedName: "paxful"},
+	{ID: "147", Name: "LocalBitcoins", NormalizedName: "localbitcoins"},
+	{ID: "148", Name: "PangeaMoneyTransfer", NormalizedName: "pangeamoneytransfer"},
+	{ID: "149", Name: "CrowdTap", NormalizedName: "crowdtap"},
+	{ID: "150", Name: "EarningStation", NormalizedName: "earningstation"},
+	{ID: "151", Name: "MicrosoftOffice365Education", NormalizedName: "microsoftoffice365education"},
+	{ID: "152", Name: "FigureEight", NormalizedName: "figureeight"},
+	{ID: "153", Name: "SuperPay", NormalizedName: "superpay"},
+	{ID: "154", Name: "Drop", NormalizedName: "drop"},
+	{ID: "155", Name: "Yubo", NormalizedName: "yubo"},
+	{ID: "156", Name: "RetailMeNot", NormalizedName: "retailmenot"},
+	{ID: "157", Name: "Letgo", NormalizedName: "letgo"},
+	{ID: "158", Name: "5miles", NormalizedName: "5miles"},
+	{ID: "159", Name: "ClassPass", NormalizedName: "classpass"},
+	{ID: "160", Name: "iOffer", NormalizedName: "ioffer"},
+	{ID: "161", Name: "Hinge", NormalizedName: "hinge"},
+	{ID: "162", Name: "Circle", NormalizedName: "circle"},
+	{ID: "163", Name: "Elevacity", NormalizedName: "elevacity"},
+	{ID: "164", Name: "OfferNation", NormalizedName: "offernation"},
+	{ID: "165", Name: "FusionCash", NormalizedName: "fusioncash"},
+	{ID: "166", Name: "Smores", NormalizedName: "smores"},
+	{ID: "167", Name: "CJSCDKEYSCOM", NormalizedName: "cjscdkeyscom"},
+	{ID: "168", Name: "ClickDishes", NormalizedName: "clickdishes"},
+	{ID: "169", Name: "Amasia", NormalizedName: "amasia"},
+	{ID: "170", Name: "CreditSesame", NormalizedName: "creditsesame"},
+	{ID: "171", Name: "Empower", NormalizedName: "empower"},
+	{ID: "172", Name: "MicrosoftOffice365Business", NormalizedName: "microsoftoffice365business"},
+	{ID: "173", Name: "Mercari", NormalizedName: "mercari"},
+	{ID: "174", Name: "PaySend", NormalizedName: "paysend"},
+	{ID: "175", Name: "MOVO", NormalizedName: "movo"},
+	{ID: "176", Name: "GiftHunterClub", NormalizedName: "gifthunterclub"},
+	{ID: "177", Name: "MyGiftCardSupply", NormalizedName: "mygiftcardsupply"},
+	{ID: "178", Name: "PCGameSupply", NormalizedName: "pcgamesupply"},
+	{ID: "179", Name: "Ticketmaster", NormalizedName: "ticketmaster"},
+	{ID: "180", Name: "Twitch", NormalizedName: "twitch"},
+	{ID: "181", Name: "HarrisPoll", NormalizedName: "harrispoll"},
+	{ID: "182", Name: "Pei", NormalizedName: "pei"},
+	{ID: "183", Name: "WalmartMoneyCard", NormalizedName: "walmartmoneycard"},
+	{ID: "184", Name: "MoolaDays", NormalizedName: "mooladays"},
+	{ID: "185", Name: "Glidera", NormalizedName: "glidera"},
+	{ID: "186", Name: "FetchRewards", NormalizedName: "fetchrewards"},
+	{ID: "187", Name: "Postmates", NormalizedName: "postmates"},
+	{ID: "188", Name: "USASurvey", NormalizedName: "usasurvey"},
+	{ID: "189", Name: "MetalPay", NormalizedName: "metalpay"},
+	{ID: "190", Name: "CoinGate", NormalizedName: "coingate"},
+	{ID: "191", Name: "Paybis", NormalizedName: "paybis"},
+	{ID: "192", Name: "Abra", NormalizedName: "abra"},
+	{ID: "193", Name: "Changelly", NormalizedName: "changelly"},
+	{ID: "194", Name: "Coinomi", NormalizedName: "coinomi"},
+	{ID: "195", Name: "SimplexSimplexCC", NormalizedName: "simplexsimplexcc"},
+	{ID: "196", Name: "3Fun", NormalizedName: "3fun"},
+	{ID: "197", Name: "Societi", NormalizedName: "societi"},
+	{ID: "198", Name: "LocalCoinATM", NormalizedName: "localcoinatm"},
+	{ID: "199", Name: "Happn", NormalizedName: "happn"},
+	{ID: "200", Name: "Gamekit", NormalizedName: "gamekit"},
+	{ID: "201", Name: "ZoomBucks", NormalizedName: "zoombucks"},
+	{ID: "202", Name: "GrabPoints", NormalizedName: "grabpoints"},
+	{ID: "203", Name: "Pointclub", NormalizedName: "pointclub"},
+	{ID: "204", Name: "Cointelegraph", NormalizedName: "cointelegraph"},
+	{ID: "208", Name: "Elepreneur", NormalizedName: "elepreneur"},
+	{ID: "209", Name: "CreditKarma", NormalizedName: "creditkarma"},
+	{ID: "210", Name: "MTCGamePortal", NormalizedName: "mtcgameportal"},
+	{ID: "212", Name: "Upwork", NormalizedName: "upwork"},
+	{ID: "213", Name: "Blizzard", NormalizedName: "blizzard"},
+	{ID: "214", Name: "Juno", NormalizedName: "juno"},
+	{ID: "215", Name: "TransferWise", NormalizedName: "transferwise"},
+	{ID: "216", Name: "Apple", NormalizedName: "apple"},
+	{ID: "217", Name: "Clover", NormalizedName: "clover"},
+	{ID: "218", Name: "PollPass", NormalizedName: "pollpass"},
+	{ID: "219", Name: "RingCaptcha", NormalizedName: "ringcaptcha"},
+	{ID: "220", Name: "Token", NormalizedName: "token"},
+	{ID: "222", Name: "MobileMoney", NormalizedName: "mobilemoney"},
+	{ID: "223", Name: "LBRYApp", NormalizedName: "lbryapp"},
+	{ID: "224", Name: "MyBookie", NormalizedName: "mybookie"},
+	{ID: "225", Name: "Dave", NormalizedName: "dave"},
+	{ID: "227", Name: "AmazonWebs", NormalizedName: "amazonwebs"},
+	{ID: "228", Name: "Surveytime", NormalizedName: "surveytime"},
+	{ID: "229", Name: "PlentyOfFish", NormalizedName: "plentyoffish"},
+	{ID: "230", Name: "Chime", NormalizedName: "chime"},
+	{ID: "231", Name: "BOSSRevolutionMoney", NormalizedName: "bossrevolutionmoney"},
+	{ID: "232", Name: "Pruvit", NormalizedName: "pruvit"},
+	{ID: "233", Name: "SayHi", NormalizedName: "sayhi"},
+	{ID: "234", Name: "Xapo", NormalizedName: "xapo"},
+	{ID: "235", Name: "Stripe", NormalizedName: "stripe"},
+	{ID: "236", Name: "CheapVoip", NormalizedName: "cheapvoip"},
+	{ID: "238", Name: "Paysera", NormalizedName: "paysera"},
+	{ID: "240", Name: "RadialInsight", NormalizedName: "radialinsight"},
+	{ID: "241", Name: "HomeAway", NormalizedName: "homeaway"},
+	{ID: "242", Name: "CryptoVoucher", NormalizedName: "cryptovoucher"},
+	{ID: "243", Name: "Zoosk", NormalizedName: "zoosk"},
+	{ID: "244", Name: "FedEx", NormalizedName: "fedex"},
+	{ID: "245", Name: "Affirm", NormalizedName: "affirm"},
+	{ID: "246", Name: "LibertyX", NormalizedName: "libertyx"},
+	{ID: "247", Name: "Imgur", NormalizedName: "imgur"},
+	{ID: "248", Name: "Instacart", NormalizedName: "instacart"},
+	{ID: "251", Name: "bitFlyer", NormalizedName: "bitflyer"},
+	{ID: "252", Name: "Mint", NormalizedName: "mint"},
+	{ID: "253", Name: "Intuit", NormalizedName: "intuit"},
+	{ID: "254", Name: "Wyre", NormalizedName: "wyre"},
+	{ID: "255", Name: "PersonalCapital", NormalizedName: "personalcapital"},
+	{ID: "256", Name: "Prepaid2Cash", NormalizedName: "prepaid2cash"},
+	{ID: "257", Name: "GreenDot", NormalizedName: "greendot"},
+	{ID: "258", Name: "Bitwage", NormalizedName: "bitwage"},
+	{ID: "259", Name: "Earnably", NormalizedName: "earnably"},
+	{ID: "261", Name: "OYO", NormalizedName: "oyo"},
+	{ID: "262", Name: "Onlinenet", NormalizedName: "onlinenet"},
+	{ID: "263", Name: "Nordstrom", NormalizedName: "nordstrom"},
+	{ID: "264", Name: "CoinSwitch", NormalizedName: "coinswitch"},
+	{ID: "265", Name: "MailPrincess", NormalizedName: "mailprincess"},
+	{ID: "266", Name: "WalmartFamilyMobile", NormalizedName: "walmartfamilymobile"},
+	{ID: "267", Name: "Bumble", NormalizedName: "bumble"},
+	{ID: "268", Name: "1StopMove", NormalizedName: "1stopmove"},
+	{ID: "269", Name: "Keybase", NormalizedName: "keybase"},
+	{ID: "270", Name: "YunoSurveys", NormalizedName: "yunosurveys"},
+	{ID: "271", Name: "Locanto", NormalizedName: "locanto"},
+	{ID: "272", Name: "Revolut", NormalizedName: "revolut"},
+	{ID: "273", Name: "ySense", NormalizedName: "ysense"},
+	{ID: "274", Name: "Cryptocom", NormalizedName: "cryptocom"},
+	{ID: "275", Name: "Nextdoor", NormalizedName: "nextdoor"},
+	{ID: "276", Name: "Bitstamp", NormalizedName: "bitstamp"},
+	{ID: "277", Name: "MoneyLion", NormalizedName: "moneylion"},
+	{ID: "278", Name: "Current", NormalizedName: "current"},
+	{ID: "279", Name: "FinishLine", NormalizedName: "finishline"},
+	{ID: "280", Name: "DollarClix", NormalizedName: "dollarclix"},
+	{ID: "281", Name: "MicrosoftOffice365E5", NormalizedName: "microsoftoffice365e5"},
+	{ID: "282", Name: "BestOfOurValley", NormalizedName: "bestofourvalley"},
+	{ID: "283", Name: "Seated", NormalizedName: "seated"},
+	{ID: "284", Name: "Allset", NormalizedName: "allset"},
+	{ID: "285", Name: "Dabbl", NormalizedName: "dabbl"},
+	{ID: "286", Name: "Ankama", NormalizedName: "ankama"},
+	{ID: "287", Name: "HumbleBundle", NormalizedName: "humblebundle"},
+	{ID: "288", Name: "AccountPatrolMoneyPatrol", NormalizedName: "accountpatrolmoneypatrol"},
+	{ID: "289", Name: "YFSResearch", NormalizedName: "yfsresearch"},
+	{ID: "290", Name: "Guru", NormalizedName: "guru"},
+	{ID: "291", Name: "UnivisionMobileMoney", NormalizedName: "univisionmobilemoney"},
+	{ID: "292", Name: "DaybreakGames", NormalizedName: "daybreakgames"},
+	{ID: "293", Name: "DHL", NormalizedName: "dhl"},
+	{ID: "294", Name: "Kamatera", NormalizedName: "kamatera"},
+	{ID: "296", Name: "Walmart", NormalizedName: "walmart"},
+	{ID: "297", Name: "Target", NormalizedName: "target"},
+	{ID: "298", Name: "Tencent", NormalizedName: "tencent"},
+	{ID: "299", Name: "zcom", NormalizedName: "zcom"},
+	{ID: "302", Name: "Spend", NormalizedName: "spend"},
+	{ID: "303", Name: "uphold", NormalizedName: "uphold"},
+	{ID: "304", Name: "BoxedDeal", NormalizedName: "boxeddeal"},
+	{ID: "305", Name: "WeChatReceiveOnly", NormalizedName: "wechatreceiveonly"},
+	{ID: "306", Name: "GroupMe", NormalizedName: "groupme"},
+	{ID: "309", Name: "RingCentral", NormalizedName: "ringcentral"},
+	{ID: "310", Name: "Digit", NormalizedName: "digit"},
+	{ID: "311", Name: "GetPaidTo", NormalizedName: "getpaidto"},
+	{ID: "313", Name: "Signal", NormalizedName: "signal"},
+	{ID: "314", Name: "Weibo", NormalizedName: "weibo"},
+	{ID: "315", Name: "InstaRem", NormalizedName: "instarem"},
+	{ID: "317", Name: "Sneakersnstuff", NormalizedName: "sneakersnstuff"},
+	{ID: "318", Name: "Yodlee", NormalizedName: "yodlee"},
+	{ID: "320", Name: "AppleWallet", NormalizedName: "applewallet"},
+	{ID: "322", Name: "BTCsurveys", NormalizedName: "btcsurveys"},
+	{ID: "323", Name: "Microworkers", NormalizedName: "microworkers"},
+	{ID: "324", Name: "Careem", NormalizedName: "careem"},
+	{ID: "325", Name: "CuriousCat", NormalizedName: "curiouscat"},
+	{ID: "326", Name: "Fruitlab", NormalizedName: "fruitlab"},
+	{ID: "327", Name: "clickworker", NormalizedName: "clickworker"},
+	{ID: "328", Name: "CoinFlip", NormalizedName: "coinflip"},
+	{ID: "329", Name: "Bitfront", NormalizedName: "bitfront"},
+	{ID: "330", Name: "BitcoinATM", NormalizedName: "bitcoinatm"},
+	{ID: "331", Name: "PaymeDollar", NormalizedName: "paymedollar"},
+	{ID: "332", Name: "MoonPay", NormalizedName: "moonpay"},
+	{ID: "333", Name: "MoneyRawr", NormalizedName: "moneyrawr"},
+	{ID: "334", Name: "CashAlarm", NormalizedName: "cashalarm"},
+	{ID: "335", Name: "GoldenFarmery", NormalizedName: "goldenfarmery"},
+	{ID: "336", Name: "AppFlame", NormalizedName: "appflame"},
+	{ID: "337", Name: "CoinPop", NormalizedName: "coinpop"},
+	{ID: "338", Name: "Fitplay", NormalizedName: "fitplay"},
+	{ID: "339", Name: "Zogo", NormalizedName: "zogo"},
+	{ID: "340", Name: "AppStation", NormalizedName: "appstation"},
+	{ID: "341", Name: "Vumber", NormalizedName: "vumber"},
+	{ID: "342", Name: "BTCDirect", NormalizedName: "btcdirect"},
+	{ID: "343", Name: "Fluz", NormalizedName: "fluz"},
+	{ID: "344", Name: "OpinionWorld", NormalizedName: "opinionworld"},
+	{ID: "345", Name: "mixi", NormalizedName: "mixi"},
+	{ID: "346", Name: "ZoomInfo", NormalizedName: "zoominfo"},
+	{ID: "347", Name: "FreeTaxUSA", NormalizedName: "freetaxusa"},
+	{ID: "348", Name: "DunkinDonuts", NormalizedName: "dunkindonuts"},
+	{ID: "349", Name: "Matchcom", NormalizedName: "matchcom"},
+	{ID: "350", Name: "GoBank", NormalizedName: "gobank"},
+	{ID: "351", Name: "MoneyPak", NormalizedName: "moneypak"},
+	{ID: "352", Name: "IdleEmpire", NormalizedName: "idleempire"},
+	{ID: "353", Name: "OkCupid", NormalizedName: "okcupid"},
+	{ID: "354", Name: "Rover", NormalizedName: "rover"},
+	{ID: "355", Name: "Freelancer", NormalizedName: "freelancer"},
+	{ID: "356", Name: "SumUp", NormalizedName: "sumup"},
+	{ID: "357", Name: "Payoneer", NormalizedName: "payoneer"},
+	{ID: "358", Name: "YuroPay", NormalizedName: "yuropay"},
+	{ID: "359", Name: "PayCenter", NormalizedName: "paycenter"},
+	{ID: "360", Name: "Thumbtack", NormalizedName: "thumbtack"},
+	{ID: "361", Name: "FetLife", NormalizedName: "fetlife"},
+	{ID: "366", Name: "WalletHub", NormalizedName: "wallethub"},
+	{ID: "368", Name: "BlueVine", NormalizedName: "bluevine"},
+	{ID: "369", Name: "Plaid", NormalizedName: "plaid"},
+	{ID: "370", Name: "Slide", NormalizedName: "slide"},
+	{ID: "371", Name: "MeetMe", NormalizedName: "meetme"},
+	{ID: "372", Name: "Indi", NormalizedName: "indi"},
+	{ID: "373", Name: "AmericaVoice", NormalizedName: "americavoice"},
+	{ID: "374", Name: "OurTime", NormalizedName: "ourtime"},
+	{ID: "376", Name: "Step", NormalizedName: "step"},
+	{ID: "378", Name: "PotatoChat", NormalizedName: "potatochat"},
+	{ID: "379", Name: "Chowbus", NormalizedName: "chowbus"},
+	{ID: "380", Name: "Privacy", NormalizedName: "privacy"},
+	{ID: "381", Name: "TikTok", NormalizedName: "tiktok"},
+	{ID: "382", Name: "Eneba", NormalizedName: "eneba"},
+	{ID: "383", Name: "Voyager", NormalizedName: "voyager"},
+	{ID: "384", Name: "Parler", NormalizedName: "parler"},
+	{ID: "385", Name: "TaoBao", NormalizedName: "taobao"},
+	{ID: "386", Name: "Nonoh", NormalizedName: "nonoh"},
+	{ID: "387", Name: "SweetRing", NormalizedName: "sweetring"},
+	{ID: "388", Name: "Hibbett", NormalizedName: "hibbett"},
+	{ID: "389", Name: "Flippa", NormalizedName: "flippa"},
+	{ID: "390", Name: "OneMainFinancial", NormalizedName: "onemainfinancial"},
+	{ID: "392", Name: "Jerry", NormalizedName: "jerry"},
+	{ID: "393", Name: "Zumper", NormalizedName: "zumper"},
+	{ID: "394", Name: "SheerID", NormalizedName: "sheerid"},
+	{ID: "395", Name: "SecretBenefits", NormalizedName: "secretbenefits"},
+	{ID: "396", Name: "Sezzle", NormalizedName: "sezzle"},
+	{ID: "397", Name: "ZipQuadPay", NormalizedName: "zipquadpay"},
+	{ID: "398", Name: "Inspire", NormalizedName: "inspire"},
+	{ID: "399", Name: "iPlum", NormalizedName: "iplum"},
+	{ID: "400", Name: "OpenPhone", NormalizedName: "openphone"},
+	{ID: "401", Name: "Caviar", NormalizedName: "caviar"},
+	{ID: "403", Name: "RSGoldMine", NormalizedName: "rsgoldmine"},
+	{ID: "404", Name: "Klarna", NormalizedName: "klarna"},
+	{ID: "405", Name: "Coinseed", NormalizedName: "coinseed"},
+	{ID: "406", Name: "Backblaze", NormalizedName: "backblaze"},
+	{ID: "407", Name: "Banxa", NormalizedName: "banxa"},
+	{ID: "408", Name: "Jelli", NormalizedName: "jelli"},
+	{ID: "409", Name: "RiaFinancial", NormalizedName: "riafinancial"},
+	{ID: "410", Name: "Go2Bank", NormalizedName: "go2bank"},
+	{ID: "411", Name: "FlashRewards", NormalizedName: "flashrewards"},
+	{ID: "413", Name: "SnapFinance", NormalizedName: "snapfinance"},
+	{ID: "414", Name: "IDme", NormalizedName: "idme"},
+	{ID: "415", Name: "NerdWallet", NormalizedName: "nerdwallet"},
+	{ID: "416", Name: "TDAmeritrade", NormalizedName: "tdameritrade"},
+	{ID: "417", Name: "PromotionPod", NormalizedName: "promotionpod"},
+	{ID: "418", Name: "TurboTax", NormalizedName: "turbotax"},
+	{ID: "419", Name: "Robinhood", NormalizedName: "robinhood"},
+	{ID: "420", Name: "Passbook", NormalizedName: "passbook"},
+	{ID: "421", Name: "Remitly", NormalizedName: "remitly"},
+	{ID: "422", Name: "SendGrid", NormalizedName: "sendgrid"},
+	{ID: "423", Name: "Indeed", NormalizedName: "indeed"},
+	{ID: "424", Name: "TradingView", NormalizedName: "tradingview"},
+	{ID: "425", Name: "CoffeeMeetsBagel", NormalizedName: "coffeemeetsbagel"},
+	{ID: "427", Name: "SoFI", NormalizedName: "sofi"},
+	{ID: "428", Name: "GreenDotSmartHome", NormalizedName: "greendotsmarthome"},
+	{ID: "429", Name: "Dapper", NormalizedName: "dapper"},
+	{ID: "430", Name: "AddItUp", NormalizedName: "additup"},
+	{ID: "431", Name: "Dialpad", NormalizedName: "dialpad"},
+	{ID: "432", Name: "Yelp", NormalizedName: "yelp"},
+	{ID: "433", Name: "Grindr", NormalizedName: "grindr"},
+	{ID: "434", Name: "NiftyGateway", NormalizedName: "niftygateway"},
+	{ID: "435", Name: "AttaPoll", NormalizedName: "attapoll"},
+	{ID: "436", Name: "NetZero", NormalizedName: "netzero"},
+	{ID: "437", Name: "Womply", NormalizedName: "womply"},
+	{ID: "438", Name: "BitClout", NormalizedName: "bitclout"},
+	{ID: "439", Name: "BlueAcorn", NormalizedName: "blueacorn"},
+	{ID: "440", Name: "HappyCo", NormalizedName: "happyco"},
+	{ID: "441", Name: "CoinCloud", NormalizedName: "coincloud"},
+	{ID: "442", Name: "Zillow", NormalizedName: "zillow"},
+	{ID: "443", Name: "QubeMoney", NormalizedName: "qubemoney"},
+	{ID: "444", Name: "Wingocard", NormalizedName: "wingocard"},
+	{ID: "445", Name: "Ando", NormalizedName: "ando"},
+	{ID: "446", Name: "Turgame", NormalizedName: "turgame"},
+	{ID: "447", Name: "eGifter", NormalizedName: "egifter"},
+	{ID: "448", Name: "Genitrust", NormalizedName: "genitrust"},
+	{ID: "449", Name: "Airtm", NormalizedName: "airtm"},
+	{ID: "450", Name: "RentMe", NormalizedName: "rentme"},
+	{ID: "451", Name: "Porte", NormalizedName: "porte"},
+	{ID: "452", Name: "Upaynet", NormalizedName: "upaynet"},
+	{ID: "453", Name: "Vidaplayer", NormalizedName: "vidaplayer"},
+	{ID: "454", Name: "Crypterium", NormalizedName: "crypterium"},
+	{ID: "455", Name: "M1Finance", NormalizedName: "m1finance"},
+	{ID: "456", Name: "Wealthfront", NormalizedName: "wealthfront"},
+	{ID: "457", Name: "Boatsetter", NormalizedName: "boatsetter"},
+	{ID: "458", Name: "Chispa", NormalizedName: "chispa"},
+	{ID: "459", Name: "CoinZoom", NormalizedName: "coinzoom"},
+	{ID: "460", Name: "Douugh", NormalizedName: "douugh"},
+	{ID: "461", Name: "Bundil", NormalizedName: "bundil"},
+	{ID: "462", Name: "Copper", NormalizedName: "copper"},
+	{ID: "463", Name: "Simba", NormalizedName: "simba"},
+	{ID: "464", Name: "Dora", NormalizedName: "dora"},
+	{ID: "465", Name: "Cheese", NormalizedName: "cheese"},
+	{ID: "466", Name: "Braid", NormalizedName: "braid"},
+	{ID: "467", Name: "Ding", NormalizedName: "ding"},
+	{ID: "468", Name: "Flare", NormalizedName: "flare"},
+	{ID: "469", Name: "Strike", NormalizedName: "strike"},
+	{ID: "470", Name: "GoFundMe", NormalizedName: "gofundme"},
+	{ID: "471", Name: "Gopuff", NormalizedName: "gopuff"},
+	{ID: "472", Name: "Poshmark", NormalizedName: "poshmark"},
+	{ID: "473", Name: "NBATopshot", NormalizedName: "nbatopshot"},
+	{ID: "474", Name: "Hotmail", NormalizedName: "hotmail"},
+	{ID: "475", Name: "OneFinance", NormalizedName: "onefinance"},
+	{ID: "476", Name: "CurrentRewards", NormalizedName: "currentrewards"},
+	{ID: "477", Name: "Avail", NormalizedName: "avail"},
+	{ID: "479", Name: "DocuSign", NormalizedName: "docusign"},
+	{ID: "480", Name: "Rebtel", NormalizedName: "rebtel"},
+	{ID: "481", Name: "RRF", NormalizedName: "rrf"},
+	{ID: "482", Name: "KuCoin", NormalizedName: "kucoin"},
+	{ID: "483", Name: "Clubhouse", NormalizedName: "clubhouse"},
+	{ID: "484", Name: "RI", NormalizedName: "ri"},
+	{ID: "485", Name: "Sendwave", NormalizedName: "sendwave"},
+	{ID: "486", Name: "Payactiv", NormalizedName: "payactiv"},
+	{ID: "487", Name: "CashWalk", NormalizedName: "cashwalk"},
+	{ID: "488", Name: "1688", NormalizedName: "1688"},
+	{ID: "489", Name: "TMobileMoney", NormalizedName: "tmobilemoney"},
+	{ID: "490", Name: "BigoLive", NormalizedName: "bigolive"},
+	{ID: "491", Name: "NaturalBrainai", NormalizedName: "naturalbrainai"},
+	{ID: "492", Name: "CoinCircle", NormalizedName: "coincircle"},
+	{ID: "493", Name: "CommunityInsightsForum", NormalizedName: "communityinsightsforum"},
+	{ID: "494", Name: "Atomy", NormalizedName: "atomy"},
+	{ID: "495", Name: "Brex", NormalizedName: "brex"},
+	{ID: "496", Name: "Neuron", NormalizedName: "neuron"},
+	{ID: "497", Name: "Uplift", NormalizedName: "uplift"},
+	{ID: "498", Name: "Mrsool", NormalizedName: "mrsool"},
+	{ID: "499", Name: "OhmConnect", NormalizedName: "ohmconnect"},
+	{ID: "500", Name: "ThinkOpinion", NormalizedName: "thinkopinion"},
+	{ID: "501", Name: "Reonomy", NormalizedName: "reonomy"},
+	{ID: "502", Name: "Root", NormalizedName: "root"},
+	{ID: "503", Name: "PineconeResearch", NormalizedName: "pineconeresearch"},
+	{ID: "505", Name: "Lili", NormalizedName: "lili"},
+	{ID: "506", Name: "CourseHero", NormalizedName: "coursehero"},
+	{ID: "507", Name: "Coinme", NormalizedName: "coinme"},
+	{ID: "508", Name: "VoilaNorbert", NormalizedName: "voilanorbert"},
+	{ID: "509", Name: "QuickPaySurvey", NormalizedName: "quickpaysurvey"},
+	{ID: "511", Name: "NTWRK", NormalizedName: "ntwrk"},
+	{ID: "512", Name: "Mistplay", NormalizedName: "mistplay"},
+	{ID: "514", Name: "Albert", NormalizedName: "albert"},
+	{ID: "515", Name: "Innago", NormalizedName: "innago"},
+	{ID: "516", Name: "Bolt", NormalizedName: "bolt"},
+	{ID: "517", Name: "SEOClerks", NormalizedName: "seoclerks"},
+	{ID: "518", Name: "DasherDirect", NormalizedName: "dasherdirect"},
+	{ID: "522", Name: "Pogo", NormalizedName: "pogo"},
+	{ID: "523", Name: "Glassnet", NormalizedName: "glassnet"},
+	{ID: "524", Name: "McMoney", NormalizedName: "mcmoney"},
+	{ID: "525", Name: "Marcus", NormalizedName: "marcus"},
+	{ID: "526", Name: "MoneyGram", NormalizedName: "moneygram"},
+	{ID: "527", Name: "LeagueofLegends", NormalizedName: "leagueoflegends"},
+	{ID: "528", Name: "FidelityInvestments", NormalizedName: "fidelityinvestments"},
+	{ID: "529", Name: "Tapchamps", NormalizedName: "tapchamps"},
+	{ID: "530", Name: "Depop", NormalizedName: "depop"},
+	{ID: "531", Name: "EZTexting", NormalizedName: "eztexting"},
+	{ID: "534", Name: "WagerWeb", NormalizedName: "wagerweb"},
+	{ID: "535", Name: "Steady", NormalizedName: "steady"},
+	{ID: "536", Name: "BlackPeopleMeet", NormalizedName: "blackpeoplemeet"},
+	{ID: "537", Name: "Mos", NormalizedName: "mos"},
+	{ID: "538", Name: "Gabi", NormalizedName: "gabi"},
+	{ID: "539", Name: "MillionaireMatch", NormalizedName: "millionairematch"},
+	{ID: "540", Name: "Greenlight", NormalizedName: "greenlight"},
+	{ID: "541", Name: "RewardedPlay", NormalizedName: "rewardedplay"},
+	{ID: "542", Name: "TechBubble", NormalizedName: "techbubble"},
+	{ID: "543", Name: "Weee", NormalizedName: "weee"},
+	{ID: "544", Name: "LikeCard", NormalizedName: "likecard"},
+	{ID: "545", Name: "Surf", NormalizedName: "surf"},
+	{ID: "546", Name: "MyVoice", NormalizedName: "myvoice"},
+	{ID: "547", Name: "Afterpay", NormalizedName: "afterpay"},
+	{ID: "548", Name: "Donut", NormalizedName: "donut"},
+	{ID: "550", Name: "Upward", NormalizedName: "upward"},
+	{ID: "551", Name: "Acorns", NormalizedName: "acorns"},
+	{ID: "552", Name: "BuyOnTrust", NormalizedName: "buyontrust"},
+	{ID: "553", Name: "Jobber", NormalizedName: "jobber"},
+	{ID: "554", Name: "BridgeCard", NormalizedName: "bridgecard"},
+	{ID: "555", Name: "SurePayroll", NormalizedName: "surepayroll"},
+	{ID: "556", Name: "xcoins", NormalizedName: "xcoins"},
+	{ID: "557", Name: "Cleo", NormalizedName: "cleo"},
+	{ID: "558", Name: "Found", NormalizedName: "found"},
+	{ID: "560", Name: "UpVoice", NormalizedName: "upvoice"},
+	{ID: "561", Name: "OnJuno", NormalizedName: "onjuno"},
+	{ID: "562", Name: "Brandclub", NormalizedName: "brandclub"},
+	{ID: "563", Name: "EpochTimes", NormalizedName: "epochtimes"},
+	{ID: "564", Name: "AARP", NormalizedName: "aarp"},
+	{ID: "565", Name: "EarlyBird", NormalizedName: "earlybird"},
+	{ID: "566", Name: "Vinted", NormalizedName: "vinted"},
+	{ID: "567", Name: "Stir", NormalizedName: "stir"},
+	{ID: "568", Name: "Cryptolocally", NormalizedName: "cryptolocally"},
+	{ID: "569", Name: "Tada", NormalizedName: "tada"},
+	{ID: "572", Name: "Mamba", NormalizedName: "mamba"},
+	{ID: "574", Name: "Plivo", NormalizedName: "plivo"},
+	{ID: "575", Name: "Yeezy", NormalizedName: "yeezy"},
+	{ID: "576", Name: "SBA", NormalizedName: "sba"},
+	{ID: "577", Name: "Aeldra", NormalizedName: "aeldra"},
+	{ID: "578", Name: "BlockFi", NormalizedName: "blockfi"},
+	{ID: "579", Name: "RedCircle", NormalizedName: "redcircle"},
+	{ID: "580", Name: "Betterment", NormalizedName: "betterment"},
+	{ID: "10579", Name: "Freecashcom", NormalizedName: "freecashcom"},
+	{ID: "10580", Name: "MyRobinhood", NormalizedName: "myrobinhood"},
+	{ID: "10581", Name: "Roomster", NormalizedName: "roomster"},
+	{ID: "10582", Name: "Bakkt", NormalizedName: "bakkt"},
+	{ID: "10583", Name: "RSocks", NormalizedName: "rsocks"},
+	{ID: "10584", Name: "MilesRewards", NormalizedName: "milesrewards"},
+	{ID: "10591", Name: "CARDcom", NormalizedName: "cardcom"},
+	{ID: "10593", Name: "ChicksGoldInc", NormalizedName: "chicksgoldinc"},
+	{ID: "10595", Name: "Bovada", NormalizedName: "bovada"},
+	{ID: "10603", Name: "ModeEarnApp", NormalizedName: "modeearnapp"},
+	{ID: "10605", Name: "Fold", NormalizedName: "fold"},
+	{ID: "10607", Name: "SaverLife", NormalizedName: "saverlife"},
+	{ID: "10609", Name: "MessageDesk", NormalizedName: "messagedesk"},
+	{ID: "10610", Name: "ARMSLIST", NormalizedName: "armslist"},
+	{ID: "10613", Name: "101Sweets", NormalizedName: "101sweets"},
+	{ID: "10615", Name: "ViaBill", NormalizedName: "viabill"},
+	{ID: "10617", Name: "DreamSpring", NormalizedName: "dreamspring"},
+	{ID: "10682", Name: "BLK", NormalizedName: "blk"},
+	{ID: "10686", Name: "Bookingcom", NormalizedName: "bookingcom"},
+	{ID: "10710", Name: "CEXIO", NormalizedName: "cexio"},
+	{ID: "10741", Name: "Curtsy", NormalizedName: "curtsy"},
+	{ID: "10756", Name: "DistroKid", NormalizedName: "distrokid"},
+	{ID: "10782", Name: "Etsy", NormalizedName: "etsy"},
+	{ID: "10790", Name: "Fave", NormalizedName: "fave"},
+	{ID: "10836", Name: "Hopper", NormalizedName: "hopper"},
+	{ID: "10845", Name: "Isay", NormalizedName: "isay"},
+	{ID: "10858", Name: "IONOS", NormalizedName: "ionos"},
+	{ID: "10931", Name: "MessageBird", NormalizedName: "messagebird"},
+	{ID: "10982", Name: "OpenAIChatGPT", NormalizedName: "openaichatgpt"},
+	{ID: "11024", Name: "QuickBooks", NormalizedName: "quickbooks"},
+	{ID: "11037", Name: "Rumble", NormalizedName: "rumble"},
+	{ID: "11040", Name: "SamsClub", NormalizedName: "samsclub"},
+	{ID: "11048", Name: "ShopPay", NormalizedName: "shoppay"},
+	{ID: "11051", Name: "Shopify", NormalizedName: "shopify"},
+	{ID: "11053", Name: "SidelineSwap", NormalizedName: "sidelineswap"},
+	{ID: "11072", Name: "Square", NormalizedName: "square"},
+	{ID: "11081", Name: "SugarDaddyMeet", NormalizedName: "sugardaddymeet"},
+	{ID: "11092", Name: "Telnyx", NormalizedName: "telnyx"},
+	{ID: "11118", Name: "Ubisoft", NormalizedName: "ubisoft"},
+	{ID: "11139", Name: "Vrbo", NormalizedName: "vrbo"},
+	{ID: "11155", Name: "Wink", NormalizedName: "wink"},
+	{ID: "11159", Name: "WooCommerce", NormalizedName: "woocommerce"},
+	{ID: "11177", Name: "Zalo", NormalizedName: "zalo"},
+	{ID: "11191", Name: "AdGate", NormalizedName: "adgate"},
+	{ID: "11773", Name: "GoogleBusinessProfile", NormalizedName: "googlebusinessprofile"},
+	{ID: "11777", Name: "GoogleMerchantCenter", NormalizedName: "googlemerchantcenter"},
+	{ID: "11779", Name: "IDES", NormalizedName: "ides"},
+	{ID: "11781", Name: "Gemiplay", NormalizedName: "gemiplay"},
+	{ID: "11783", Name: "SkyPrivate", NormalizedName: "skyprivate"},
+	{ID: "11785", Name: "GiftPocket", NormalizedName: "giftpocket"},
+	{ID: "11789", Name: "TaxSlayer", NormalizedName: "taxslayer"},
+	{ID: "11793", Name: "Spruce", NormalizedName: "spruce"},
+	{ID: "11795", Name: "FacebookReset", NormalizedName: "facebookreset"},
+	{ID: "11797", Name: "Oportun", NormalizedName: "oportun"},
+	{ID: "11799", Name: "ChampsSports", NormalizedName: "champssports"},
+	{ID: "11800", Name: "FootLocker", NormalizedName: "footlocker"},
+	{ID: "11801", Name: "KidsFootlocker", NormalizedName: "kidsfootlocker"},
+	{ID: "11805", Name: "Eastbay", NormalizedName: "eastbay"},
+	{ID: "11807", Name: "WireBarley", NormalizedName: "wirebarley"},
+	{ID: "11809", Name: "WelspunBrainTrust", NormalizedName: "welspunbraintrust"},
+	{ID: "11810", Name: "Aspiration", NormalizedName: "aspiration"},
+	{ID: "11811", Name: "BlueBird", NormalizedName: "bluebird"},
+	{ID: "11815", Name: "Kixify", NormalizedName: "kixify"},
+	{ID: "11816", Name: "CoinsBaron", NormalizedName: "coinsbaron"},
+	{ID: "11819", Name: "BiltRewards", NormalizedName: "biltrewards"},
+	{ID: "11821", Name: "Mudflap", NormalizedName: "mudflap"},
+	{ID: "11822", Name: "HandyAngi", NormalizedName: "handyangi"},
+	{ID: "11827", Name: "Weverse", NormalizedName: "weverse"},
+	{ID: "11829", Name: "Oxygen", NormalizedName: "oxygen"},
+	{ID: "11831", Name: "Stash", NormalizedName: "stash"},
+	{ID: "11833", Name: "Kikoff", NormalizedName: "kikoff"},
+	{ID: "11835", Name: "Gamercraft", NormalizedName: "gamercraft"},
+	{ID: "11841", Name: "SafewayAlbertsons", NormalizedName: "safewayalbertsons"},
+	{ID: "11843", Name: "Millions", NormalizedName: "millions"},
+	{ID: "11849", Name: "myWisely", NormalizedName: "mywisely"},
+	{ID: "11855", Name: "Whatnot", NormalizedName: "whatnot"},
+	{ID: "11861", Name: "CocaCola", NormalizedName: "cocacola"},
+	{ID: "11862", Name: "TruthSocial", NormalizedName: "truthsocial"},
+	{ID: "11865", Name: "BurstSMS", NormalizedName: "burstsms"},
+	{ID: "11869", Name: "AH4RAMH", NormalizedName: "ah4ramh"},
+	{ID: "11871", Name: "Hunter", NormalizedName: "hunter"},
+	{ID: "11873", Name: "LDSPlanet", NormalizedName: "ldsplanet"},
+	{ID: "11874", Name: "LoveAndSeek", NormalizedName: "loveandseek"},
+	{ID: "11877", Name: "Webull", NormalizedName: "webull"},
+	{ID: "11878", Name: "TransformCredit", NormalizedName: "transformcredit"},
+	{ID: "11883", Name: "Cashew", NormalizedName: "cashew"},
+	{ID: "11885", Name: "Link", NormalizedName: "link"},
+	{ID: "11887", Name: "CVS", NormalizedName: "cvs"},
+	{ID: "11889", Name: "RECUR", NormalizedName: "recur"},
+	{ID: "11891", Name: "Nielsen", NormalizedName: "nielsen"},
+	{ID: "11892", Name: "Upgrade", NormalizedName: "upgrade"},
+	{ID: "11895", Name: "Vanguard", NormalizedName: "vanguard"},
+	{ID: "11897", Name: "Eureka", NormalizedName: "eureka"},
+	{ID: "11903", Name: "BetMGM", NormalizedName: "betmgm"},
+	{ID: "11904", Name: "PartyPoker", NormalizedName: "partypoker"},
+	{ID: "11911", Name: "Donately", NormalizedName: "donately"},
+	{ID: "11912", Name: "Musicstream", NormalizedName: "musicstream"},
+	{ID: "11917", Name: "Beat", NormalizedName: "beat"},
+	{ID: "11922", Name: "Sugarbook", NormalizedName: "sugarbook"},
+	{ID: "11925", Name: "Gaintplay", NormalizedName: "gaintplay"},
+	{ID: "11929", Name: "Coinloot", NormalizedName: "coinloot"},
+	{ID: "11931", Name: "Angi", NormalizedName: "angi"},
+	{ID: "11933", Name: "Streetbeat", NormalizedName: "streetbeat"},
+	{ID: "11934", Name: "PGSamsBuyGet", NormalizedName: "pgsamsbuyget"},
+	{ID: "11937", Name: "Octo", NormalizedName: "octo"},
+	{ID: "11939", Name: "FarmersOnly", NormalizedName: "farmersonly"},
+	{ID: "11943", Name: "Coinhub", NormalizedName: "coinhub"},
+	{ID: "11944", Name: "SendSprint", NormalizedName: "sendsprint"},
+	{ID: "11949", Name: "Vetri", NormalizedName: "vetri"},
+	{ID: "11953", Name: "JerseyMikes", NormalizedName: "jerseymikes"},
+	{ID: "11955", Name: "Zaxbys", NormalizedName: "zaxbys"},
+	{ID: "11957", Name: "OfferToro", NormalizedName: "offertoro"},
+	{ID: "11958", Name: "Boo", NormalizedName: "boo"},
+	{ID: "11961", Name: "UKGWallet", NormalizedName: "ukgwallet"},
+	{ID: "11963", Name: "Popads", NormalizedName: "popads"},
+	{ID: "11964", Name: "Voicepark", NormalizedName: "voicepark"},
+	{ID: "11965", Name: "Hostkey", NormalizedName: "hostkey"},
+	{ID: "11966", Name: "Linode", NormalizedName: "linode"},
+	{ID: "11967", Name: "TaptapSend", NormalizedName: "taptapsend"},
+	{ID: "11973", Name: "Glasscom", NormalizedName: "glasscom"},
+	{ID: "11975", Name: "TipNano", NormalizedName: "tipnano"},
+	{ID: "11976", Name: "BetnowEU", NormalizedName: "betnoweu"},
+	{ID: "11979", Name: "Checkmate", NormalizedName: "checkmate"},
+	{ID: "11983", Name: "Quicrypto", NormalizedName: "quicrypto"},
+	{ID: "11985", Name: "Line2", NormalizedName: "line2"},
+	{ID: "11987", Name: "Coincasper", NormalizedName: "coincasper"},
+	{ID: "11988", Name: "Kudos", NormalizedName: "kudos"},
+	{ID: "11991", Name: "TapResearch", NormalizedName: "tapresearch"},
+	{ID: "11993", Name: "Rently", NormalizedName: "rently"},
+	{ID: "11995", Name: "Bonanza", NormalizedName: "bonanza"},
+	{ID: "11996", Name: "Taimi", NormalizedName: "taimi"},
+	{ID: "11999", Name: "Yotta", NormalizedName: "yotta"},
+	{ID: "12001", Name: "SentBe", NormalizedName: "sentbe"},
+	{ID: "12002", Name: "Doctoralia", NormalizedName: "doctoralia"},
+	{ID: "12005", Name: "DiceFM", NormalizedName: "dicefm"},
+	{ID: "12006", Name: "Ellis", NormalizedName: "ellis"},
+	{ID: "12011", Name: "Baselane", NormalizedName: "baselane"},
+	{ID: "12017", Name: "FirehouseSubs", NormalizedName: "firehousesubs"},
+	{ID: "12019", Name: "Serve", NormalizedName: "serve"},
+	{ID: "12020", Name: "Maza", NormalizedName: "maza"},
+	{ID: "12021", Name: "Twigcard", NormalizedName: "twigcard"},
+	{ID: "12025", Name: "TaxAct", NormalizedName: "taxact"},
+	{ID: "12026", Name: "Pomelo", NormalizedName: "pomelo"},
+	{ID: "12030", Name: "Funko", NormalizedName: "funko"},
+	{ID: "12033", Name: "SpruceHealth", NormalizedName: "sprucehealth"},
+	{ID: "12034", Name: "Public", NormalizedName: "public"},
+	{ID: "12037", Name: "Tiv", NormalizedName: "tiv"},
+	{ID: "12038", Name: "Branch", NormalizedName: "branch"},
+	{ID: "12043", Name: "Bitly", NormalizedName: "bitly"},
+	{ID: "12047", Name: "Temu", NormalizedName: "temu"},
+	{ID: "12049", Name: "Dewu", NormalizedName: "dewu"},
+	{ID: "12051", Name: "SmartyPig", NormalizedName: "smartypig"},
+	{ID: "12053", Name: "BitcoinIRA", NormalizedName: "bitcoinira"},
+}
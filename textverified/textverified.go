@@ -1,5 +1,7 @@
 package textverified
 
+//go:generate go run ../cmd/smsgen -dir . textverified
+
 import (
 	"bytes"
 	"context"
@@ -9,6 +11,8 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/nyaruka/phonenumbers"
@@ -16,22 +20,44 @@ import (
 )
 
 var (
-	ErrVerificationExpired = errors.New("textverified: verification expired")
+	ErrVerificationExpired = fmt.Errorf("textverified: verification expired: %w", sms.ErrExpired)
 	ErrReported            = errors.New("textverified: verification reported")
 	ErrCancelled           = errors.New("textverified: verification was cancelled by user or system")
 
 	ErrUnauthorized = errors.New("textverified: unauthorized")
 )
 
+const (
+	provider = "textverified"
+	baseURL  = "https://www.textverified.com/api/"
+)
+
 type Client struct {
-	http   *http.Client
-	apiKey string
+	http    *http.Client
+	apiKey  string
+	baseURL string
 
 	authDetails *AuthDetails
+
+	// username, the v2 token and the links to the pages of verifications
+	// listed so far are only used by OrderHistory. listing holds listMu
+	// throughout, so a listing starting over can't cut pages short under
+	// another
+	username string
+	mu       sync.Mutex
+	v2Token  *v2Token
+	listMu   sync.Mutex
+	pages    []string
 }
 
 var (
-	_ sms.ReusableClient = &Client{}
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+	_ sms.BalanceClient    = &Client{}
+	_ sms.ActiveClient     = &Client{}
+	_ sms.HistoryClient    = &Client{}
 )
 
 type metadata struct {
@@ -40,8 +66,9 @@ type metadata struct {
 
 func NewClient(apiKey string) *Client {
 	return &Client{
-		http:   http.DefaultClient,
-		apiKey: apiKey,
+		http:    http.DefaultClient,
+		apiKey:  apiKey,
+		baseURL: baseURL,
 	}
 }
 
@@ -69,7 +96,7 @@ func (c *Client) do(ctx context.Context, method string, path string, payload any
 		bodyReader = bytes.NewReader(b)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, "https://www.textverified.com/api/"+path, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
 	if err != nil {
 		return err
 	}
@@ -177,6 +204,21 @@ type verification struct {
 	ReuseURI        string  `json:"reuse_uri"`
 }
 
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		// textverified only rents US numbers
+		CountrySelection: false,
+		Reuse:            true,
+		Cancel:           true,
+		Report:           true,
+		Balance:          true,
+		Prices:           true,
+		MultipleMessages: true,
+		ListActive:       true,
+		History:          c.username != "",
+	}
+}
+
 func (c *Client) GetPhoneNumber(ctx context.Context, serviceId string, _ string) (*sms.PhoneNumber, error) {
 	id, err := strconv.ParseInt(serviceId, 10, 64)
 	if err != nil {
@@ -189,36 +231,87 @@ func (c *Client) GetPhoneNumber(ctx context.Context, serviceId string, _ string)
 		return nil, err
 	}
 
+	return newPhoneNumber(serviceId, &resp)
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(metadata{id: rental.ID})
+}
+
+func newPhoneNumber(service string, resp *verification) (*sms.PhoneNumber, error) {
 	number, err := phonenumbers.Parse(resp.Number, "US")
 	if err != nil {
 		return nil, fmt.Errorf("textverified: parsing phone number (%s): %w", resp.Number, err)
 	}
 
-	return &sms.PhoneNumber{PhoneNumber: number, Metadata: metadata{id: resp.ID}}, nil
+	phoneNumber := sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		ID:       resp.ID,
+		Service:  service,
+		Country:  "US",
+		Cost:     resp.Cost,
+	}, metadata{id: resp.ID})
+	// the number is already paid for, so an unparseable time_remaining only loses the expiry
+	setTimeRemaining(phoneNumber, resp.TimeRemaining)
+	return phoneNumber, nil
+}
+
+// ListActive lists the pending verifications, the service of each is its ID
+// in Services when listed there and its target name otherwise
+func (c *Client) ListActive(ctx context.Context) ([]*sms.PhoneNumber, error) {
+	var resp []verification
+	if err := c.do(ctx, http.MethodGet, "Verifications/Pending", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	phoneNumbers := make([]*sms.PhoneNumber, 0, len(resp))
+	for i := range resp {
+		service := resp[i].TargetName
+		if s, ok := Services.ByName(service); ok {
+			service = s.ID
+		}
+
+		phoneNumber, err := newPhoneNumber(service, &resp[i])
+		if err != nil {
+			return nil, err
+		}
+		if resp[i].Code != "" || resp[i].Sms != "" {
+			phoneNumber.MarkUsed()
+		}
+
+		phoneNumbers = append(phoneNumbers, phoneNumber)
+	}
+
+	return phoneNumbers, nil
+}
+
+func setTimeRemaining(phoneNumber *sms.PhoneNumber, timeRemaining string) {
+	if remaining, err := parseTimeRemaining(timeRemaining); err == nil && timeRemaining != "" {
+		phoneNumber.SetExpiresAt(time.Now().Add(remaining))
+	}
 }
 
 func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
-	meta, ok := phoneNumber.Metadata.(metadata)
+	meta, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
 
-	var resp verification
-	err := c.do(ctx, http.MethodPut, fmt.Sprintf("Verifications/%s/Reuse", meta.id), nil, &resp)
-	if err != nil {
+	if err := phoneNumber.CanReuse(); err != nil {
 		return nil, err
 	}
 
-	number, err := phonenumbers.Parse(resp.Number, "US")
+	var resp verification
+	err := c.do(ctx, http.MethodPut, fmt.Sprintf("Verifications/%s/Reuse", meta.id), nil, &resp)
 	if err != nil {
-		return nil, fmt.Errorf("textverified: parsing phone number (%s): %w", resp.Number, err)
+		return nil, err
 	}
 
-	return &sms.PhoneNumber{PhoneNumber: number, Metadata: metadata{id: resp.ID}}, nil
+	return newPhoneNumber(phoneNumber.Service(), &resp)
 }
 
 func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return nil, sms.ErrInvalidMetadata
 	}
@@ -228,6 +321,8 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 		return nil, err
 	}
 
+	setTimeRemaining(phoneNumber, resp.TimeRemaining)
+
 	switch resp.Status {
 	case "Pending":
 		// sms: null (no messages yet)
@@ -245,12 +340,68 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	return []string{resp.Sms}, nil
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	resp := &verification{}
+	if err := c.do(ctx, http.MethodGet, "Verifications/"+metadata.id, nil, resp); err != nil {
+		return nil, err
+	}
+
+	var status sms.Status
+	switch resp.Status {
+	case "Pending":
+		status = sms.StatusWaiting
+	case "Completed":
+		status = sms.StatusReceived
+	case "Timed Out":
+		status = sms.StatusExpired
+	case "Reported":
+		status = sms.StatusReported
+	case "Cancelled":
+		status = sms.StatusCancelled
+	default:
+		return nil, fmt.Errorf("textverified: unknown status %q", resp.Status)
+	}
+
+	// an unparseable time_remaining leaves the expiry as it was known
+	setTimeRemaining(phoneNumber, resp.TimeRemaining)
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
+// parseTimeRemaining parses textverified's "mm:ss" or "hh:mm:ss" durations
+func parseTimeRemaining(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	var remaining time.Duration
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		remaining = remaining*60 + time.Duration(n)
+	}
+
+	return remaining * time.Second, nil
+}
+
 func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	if phoneNumber.Used() || phoneNumber.Cancelled() {
 		return nil
 	}
 
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -269,7 +420,7 @@ func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNu
 		return nil
 	}
 
-	metadata, ok := phoneNumber.Metadata.(metadata)
+	metadata, ok := phoneNumber.Metadata().(metadata)
 	if !ok {
 		return sms.ErrInvalidMetadata
 	}
@@ -301,3 +452,26 @@ func (c *Client) GetTargets(ctx context.Context) ([]Target, error) {
 
 	return targets, nil
 }
+
+type User struct {
+	Username      string  `json:"username"`
+	CreditBalance float64 `json:"credit_balance"`
+}
+
+func (c *Client) GetUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, "Users", nil, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (c *Client) GetBalance(ctx context.Context) (float64, error) {
+	user, err := c.GetUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return user.CreditBalance, nil
+}
@@ -1,608 +1,2432 @@
 // Code generated by saucesteals/sms; DO NOT EDIT.
+
 package truverifi
 
-var (
+import "github.com/saucesteals/sms"
+
+// ServiceID is one of the provider's service IDs
+type ServiceID string
+
+func (s ServiceID) String() string {
+	return string(s)
+}
+
+const (
+	ServiceID1688                                     ServiceID = "1688"
+	ServiceID1Q                                       ServiceID = "1Q"
+	ServiceID2Redbeans                                ServiceID = "2REDBEANS"
+	ServiceID53Bank                                   ServiceID = "5_3_BANK"
+	ServiceID7Eleven                                  ServiceID = "7_ELEVEN"
+	ServiceIDAcorns                                   ServiceID = "ACORNS"
+	ServiceIDAdgate                                   ServiceID = "ADGATE"
+	ServiceIDAdidas                                   ServiceID = "ADIDAS"
+	ServiceIDAditup                                   ServiceID = "AD_IT_UP"
+	ServiceIDAdp                                      ServiceID = "ADP"
+	ServiceIDAdwallet                                 ServiceID = "ADWALLET"
+	ServiceIDAeldracom                                ServiceID = "AELDRA_COM"
+	ServiceIDAffirm                                   ServiceID = "AFFIRM"
+	ServiceIDAfterpay                                 ServiceID = "AFTERPAY"
+	ServiceIDAirbnb                                   ServiceID = "AIRBNB"
+	ServiceIDAirtm                                    ServiceID = "AIRTM"
+	ServiceIDAlbertbank                               ServiceID = "ALBERT_BANK"
+	ServiceIDAlibaba                                  ServiceID = "ALIBABA"
+	ServiceIDAlly                                     ServiceID = "ALLY"
+	ServiceIDAmasia                                   ServiceID = "AMASIA"
+	ServiceIDAmazon                                   ServiceID = "AMAZON"
+	ServiceIDAmber                                    ServiceID = "AMBER"
+	ServiceIDAmericanexpress                          ServiceID = "AMERICAN_EXPRESS"
+	ServiceIDAmh                                      ServiceID = "AMH"
+	ServiceIDAmway                                    ServiceID = "AMWAY"
+	ServiceIDAndafinance                              ServiceID = "ANDA_FINANCE"
+	ServiceIDAndo                                     ServiceID = "ANDO"
+	ServiceIDAol                                      ServiceID = "AOL"
+	ServiceIDApple                                    ServiceID = "APPLE"
+	ServiceIDArmslist                                 ServiceID = "ARMSLIST"
+	ServiceIDAsbhawaii                                ServiceID = "ASB_HAWAII"
+	ServiceIDAspiration                               ServiceID = "ASPIRATION"
+	ServiceIDAssociatedbank                           ServiceID = "ASSOCIATED_BANK"
+	ServiceIDAstra                                    ServiceID = "ASTRA"
+	ServiceIDAtomy                                    ServiceID = "ATOMY"
+	ServiceIDAttapoll                                 ServiceID = "ATTAPOLL"
+	ServiceIDAuthy                                    ServiceID = "AUTHY"
+	ServiceIDAvail                                    ServiceID = "AVAIL"
+	ServiceIDAvalon                                   ServiceID = "AVALON"
+	ServiceIDAvant                                    ServiceID = "AVANT"
+	ServiceIDAxosbank                                 ServiceID = "AXOS_BANK"
+	ServiceIDBankofamerica                            ServiceID = "BANK_OF_AMERICA"
+	ServiceIDBankofthewest                            ServiceID = "BANK_OF_THE_WEST"
+	ServiceIDBarstoolsportsbook                       ServiceID = "BARSTOOL_SPORTSBOOK"
+	ServiceIDBaskbank                                 ServiceID = "BASK_BANK"
+	ServiceIDBbpeoplemeet                             ServiceID = "BBPEOPLEMEET"
+	ServiceIDBeboo                                    ServiceID = "BEBOO"
+	ServiceIDBeforthright                             ServiceID = "BEFORTHRIGHT"
+	ServiceIDBentoforbusiness                         ServiceID = "BENTO_FOR_BUSINESS"
+	ServiceIDBestbuy                                  ServiceID = "BESTBUY"
+	ServiceIDBestegg                                  ServiceID = "BESTEGG"
+	ServiceIDBet105eu                                 ServiceID = "BET105_EU"
+	ServiceIDBet365                                   ServiceID = "BET365"
+	ServiceIDBetnoweu                                 ServiceID = "BETNOW_EU"
+	ServiceIDBetterment                               ServiceID = "BETTERMENT"
+	ServiceIDBigtoken                                 ServiceID = "BIGTOKEN"
+	ServiceIDBillpaysite                              ServiceID = "BILLPAYSITE"
+	ServiceIDBiltrewards                              ServiceID = "BILT_REWARDS"
+	ServiceIDBinance                                  ServiceID = "BINANCE"
+	ServiceIDBitclout                                 ServiceID = "BITCLOUT"
+	ServiceIDBitcoinofamerica                         ServiceID = "BITCOIN_OF_AMERICA"
+	ServiceIDBitflyer                                 ServiceID = "BITFLYER"
+	ServiceIDBitgamesio                               ServiceID = "BITGAMES_IO"
+	ServiceIDBitmo                                    ServiceID = "BITMO"
+	ServiceIDBitstamp                                 ServiceID = "BITSTAMP"
+	ServiceIDBitwage                                  ServiceID = "BITWAGE"
+	ServiceIDBlackpeoplemeet                          ServiceID = "BLACKPEOPLEMEET"
+	ServiceIDBlizzardentertainment                    ServiceID = "BLIZZARD_ENTERTAINMENT"
+	ServiceIDBlk                                      ServiceID = "BLK"
+	ServiceIDBlockchain                               ServiceID = "BLOCKCHAIN"
+	ServiceIDBlueacorn                                ServiceID = "BLUEACORN"
+	ServiceIDBluebird                                 ServiceID = "BLUEBIRD"
+	ServiceIDBluevinecoinseed                         ServiceID = "BLUEVINE_COINSEED"
+	ServiceIDBmoharris                                ServiceID = "BMO_HARRIS"
+	ServiceIDBoodle                                   ServiceID = "BOODLE"
+	ServiceIDBovadalv                                 ServiceID = "BOVADA_LV"
+	ServiceIDBraid                                    ServiceID = "BRAID"
+	ServiceIDBrandclub                                ServiceID = "BRANDCLUB"
+	ServiceIDBrandedsurvey                            ServiceID = "BRANDEDSURVEY"
+	ServiceIDBreadfinancial                           ServiceID = "BREAD_FINANCIAL"
+	ServiceIDBriq                                     ServiceID = "BRIQ"
+	ServiceIDBtcsurveys                               ServiceID = "BTCSURVEYS"
+	ServiceIDBumble                                   ServiceID = "BUMBLE"
+	ServiceIDBurnerapp                                ServiceID = "BURNER_APP"
+	ServiceIDBylinebank                               ServiceID = "BYLINE_BANK"
+	ServiceIDCanadacomputers                          ServiceID = "CANADA_COMPUTERS"
+	ServiceIDCapitalone                               ServiceID = "CAPITAL_ONE"
+	ServiceIDCapway                                   ServiceID = "CAPWAY"
+	ServiceIDCardaccountnet                           ServiceID = "CARDACCOUNT_NET"
+	ServiceIDCardcom                                  ServiceID = "CARD_COM"
+	ServiceIDCashapp                                  ServiceID = "CASH_APP"
+	ServiceIDCashout                                  ServiceID = "CASHOUT"
+	ServiceIDCashwalk                                 ServiceID = "CASHWALK"
+	ServiceIDCathay                                   ServiceID = "CATHAY"
+	ServiceIDCbna                                     ServiceID = "CBNA"
+	ServiceIDCdkeyscom                                ServiceID = "CDKEYS_COM"
+	ServiceIDChangelly                                ServiceID = "CHANGELLY"
+	ServiceIDChasebank                                ServiceID = "CHASE_BANK"
+	ServiceIDCheckpoints                              ServiceID = "CHECKPOINTS"
+	ServiceIDChemistry                                ServiceID = "CHEMISTRY"
+	ServiceIDChevron                                  ServiceID = "CHEVRON"
+	ServiceIDChime                                    ServiceID = "CHIME"
+	ServiceIDChipotle                                 ServiceID = "CHIPOTLE"
+	ServiceIDChispa                                   ServiceID = "CHISPA"
+	ServiceIDChivo                                    ServiceID = "CHIVO"
+	ServiceIDChowbus                                  ServiceID = "CHOWBUS"
+	ServiceIDChumbacasino                             ServiceID = "CHUMBA_CASINO"
+	ServiceIDCinchbucks                               ServiceID = "CINCHBUCKS"
+	ServiceIDCitibank                                 ServiceID = "CITIBANK"
+	ServiceIDClasspass                                ServiceID = "CLASSPASS"
+	ServiceIDClearbit                                 ServiceID = "CLEARBIT"
+	ServiceIDClearvoice                               ServiceID = "CLEARVOICE"
+	ServiceIDClover                                   ServiceID = "CLOVER"
+	ServiceIDCocacola                                 ServiceID = "COCA_COLA"
+	ServiceIDCoffeemeetsbagel                         ServiceID = "COFFEEMEETSBAGEL"
+	ServiceIDCogni                                    ServiceID = "COGNI"
+	ServiceIDCoinbase                                 ServiceID = "COINBASE"
+	ServiceIDCoinberry                                ServiceID = "COINBERRY"
+	ServiceIDCoinchangeio                             ServiceID = "COINCHANGE_IO"
+	ServiceIDCoinflip                                 ServiceID = "COINFLIP"
+	ServiceIDCoinloot                                 ServiceID = "COINLOOT"
+	ServiceIDCoinzoom                                 ServiceID = "COINZOOM"
+	ServiceIDCopperbank                               ServiceID = "COPPER_BANK"
+	ServiceIDCouponscom                               ServiceID = "COUPONS_COM"
+	ServiceIDCox                                      ServiceID = "COX"
+	ServiceIDCraigslist                               ServiceID = "CRAIGSLIST"
+	ServiceIDCraypay                                  ServiceID = "CRAYPAY"
+	ServiceIDCredai                                   ServiceID = "CREDAI"
+	ServiceIDCreditkarma                              ServiceID = "CREDITKARMA"
+	ServiceIDCreditsesame                             ServiceID = "CREDIT_SESAME"
+	ServiceIDCrowdtap                                 ServiceID = "CROWDTAP"
+	ServiceIDCrypterium                               ServiceID = "CRYPTERIUM"
+	ServiceIDCryptocom                                ServiceID = "CRYPTO_COM"
+	ServiceIDCryptopay                                ServiceID = "CRYPTOPAY"
+	ServiceIDCsgforte                                 ServiceID = "CSG_FORTE"
+	ServiceIDCurb                                     ServiceID = "CURB"
+	ServiceIDCuriouscat                               ServiceID = "CURIOUSCAT"
+	ServiceIDCurrentbank                              ServiceID = "CURRENT_BANK"
+	ServiceIDCurrentmusic                             ServiceID = "CURRENT_MUSIC"
+	ServiceIDCurtsy                                   ServiceID = "CURTSY"
+	ServiceIDCvs                                      ServiceID = "CVS"
+	ServiceIDCybermetals                              ServiceID = "CYBERMETALS"
+	ServiceIDDabbl                                    ServiceID = "DABBL"
+	ServiceIDDailypay                                 ServiceID = "DAILYPAY"
+	ServiceIDDasherdirect                             ServiceID = "DASHERDIRECT"
+	ServiceIDDavecom                                  ServiceID = "DAVE_COM"
+	ServiceIDDcubank                                  ServiceID = "DCU_BANK"
+	ServiceIDDeliveroo                                ServiceID = "DELIVEROO"
+	ServiceIDDidi                                     ServiceID = "DIDI"
+	ServiceIDDigit                                    ServiceID = "DIGIT"
+	ServiceIDDignifi                                  ServiceID = "DIGNIFI"
+	ServiceIDDinnerballs                              ServiceID = "DINNER_BALLS"
+	ServiceIDDiscord                                  ServiceID = "DISCORD"
+	ServiceIDDiscover                                 ServiceID = "DISCOVER"
+	ServiceIDDistrokid                                ServiceID = "DISTROKID"
+	ServiceIDDocusign                                 ServiceID = "DOCUSIGN"
+	ServiceIDDollarclix                               ServiceID = "DOLLARCLIX"
+	ServiceIDDoordash                                 ServiceID = "DOORDASH"
+	ServiceIDDoritos                                  ServiceID = "DORITOS"
+	ServiceIDDosh                                     ServiceID = "DOSH"
+	ServiceIDDoublelist                               ServiceID = "DOUBLELIST"
+	ServiceIDDouughcom                                ServiceID = "DOUUGH_COM"
+	ServiceIDDrumo                                    ServiceID = "DRUMO"
+	ServiceIDDunkindonuts                             ServiceID = "DUNKIN_DONUTS"
+	ServiceIDDust                                     ServiceID = "DUST"
+	ServiceIDEarn99                                   ServiceID = "EARN99"
+	ServiceIDEarnably                                 ServiceID = "EARNABLY"
+	ServiceIDEarnapp                                  ServiceID = "EARN_APP"
+	ServiceIDEarnhoney                                ServiceID = "EARNHONEY"
+	ServiceIDEarnly                                   ServiceID = "EARNLY"
+	ServiceIDEasi                                     ServiceID = "EASI"
+	ServiceIDEbay                                     ServiceID = "EBAY"
+	ServiceIDEgifter                                  ServiceID = "EGIFTER"
+	ServiceIDElevacity                                ServiceID = "ELEVACITY"
+	ServiceIDEllevest                                 ServiceID = "ELLEVEST"
+	ServiceIDElootgg                                  ServiceID = "ELOOT_GG"
+	ServiceIDEmpower                                  ServiceID = "EMPOWER"
+	ServiceIDEneba                                    ServiceID = "ENEBA"
+	ServiceIDEnzo                                     ServiceID = "ENZO"
+	ServiceIDErewards                                 ServiceID = "E_REWARDS"
+	ServiceIDEtoro                                    ServiceID = "ETORO"
+	ServiceIDEtrade                                   ServiceID = "ETRADE"
+	ServiceIDEureka                                   ServiceID = "EUREKA"
+	ServiceIDExmo                                     ServiceID = "EXMO"
+	ServiceIDExperian                                 ServiceID = "EXPERIAN"
+	ServiceIDExtra                                    ServiceID = "EXTRA"
+	ServiceIDFacebook                                 ServiceID = "FACEBOOK"
+	ServiceIDFastmail                                 ServiceID = "FASTMAIL"
+	ServiceIDFedex                                    ServiceID = "FEDEX"
+	ServiceIDFetchrewards                             ServiceID = "FETCH_REWARDS"
+	ServiceIDFetlife                                  ServiceID = "FETLIFE"
+	ServiceIDFidelity                                 ServiceID = "FIDELITY"
+	ServiceIDFigureeight                              ServiceID = "FIGURE_EIGHT"
+	ServiceIDFinishline                               ServiceID = "FINISH_LINE"
+	ServiceIDFiverr                                   ServiceID = "FIVERR"
+	ServiceIDFlashrewards                             ServiceID = "FLASH_REWARDS"
+	ServiceIDFlippa                                   ServiceID = "FLIPPA"
+	ServiceIDFluxrewards                              ServiceID = "FLUXREWARDS"
+	ServiceIDFluz                                     ServiceID = "FLUZ"
+	ServiceIDFold                                     ServiceID = "FOLD"
+	ServiceIDFoundcom                                 ServiceID = "FOUND_COM"
+	ServiceIDFreecashcom                              ServiceID = "FREECASH_COM"
+	ServiceIDFreecryptorewards                        ServiceID = "FREECRYPTOREWARDS"
+	ServiceIDFreelancer                               ServiceID = "FREELANCER"
+	ServiceIDFreetaxusacom                            ServiceID = "FREETAXUSA_COM"
+	ServiceIDFreewalletorg                            ServiceID = "FREEWALLET_ORG"
+	ServiceIDFtx                                      ServiceID = "FTX"
+	ServiceIDFursure                                  ServiceID = "FURSURE"
+	ServiceIDFusioncash                               ServiceID = "FUSIONCASH"
+	ServiceIDG2acom                                   ServiceID = "G2A_COM"
+	ServiceIDG2gcomoffgamers                          ServiceID = "G2G_COM_OFFGAMERS"
+	ServiceIDGameflip                                 ServiceID = "GAMEFLIP"
+	ServiceIDGameminerclub                            ServiceID = "GAMEMINER_CLUB"
+	ServiceIDGemini                                   ServiceID = "GEMINI"
+	ServiceIDGerald                                   ServiceID = "GERALD"
+	ServiceIDGetjerry                                 ServiceID = "GETJERRY"
+	ServiceIDGetjobber                                ServiceID = "GETJOBBER"
+	ServiceIDGetpaidto                                ServiceID = "GETPAIDTO"
+	ServiceIDGetslide                                 ServiceID = "GETSLIDE"
+	ServiceIDGiftya                                   ServiceID = "GIFTYA"
+	ServiceIDGoalsetter                               ServiceID = "GOALSETTER"
+	ServiceIDGobankgo2bankgreendot                    ServiceID = "GOBANK_GO2BANK_GREEN_DOT"
+	ServiceIDGobranded                                ServiceID = "GOBRANDED"
+	ServiceIDGofundme                                 ServiceID = "GOFUNDME"
+	ServiceIDGoldenlakeeatery                         ServiceID = "GOLDEN_LAKE_EATERY"
+	ServiceIDGooglebipa                               ServiceID = "GOOGLE_BIPA"
+	ServiceIDGooglecloud                              ServiceID = "GOOGLE_CLOUD"
+	ServiceIDGooglegmail                              ServiceID = "GOOGLE_GMAIL"
+	ServiceIDGooglemerchantcenter                     ServiceID = "GOOGLE_MERCHANT_CENTER"
+	ServiceIDGooglepay                                ServiceID = "GOOGLE_PAY"
+	ServiceIDGoogleplayconsole                        ServiceID = "GOOGLE_PLAY_CONSOLE"
+	ServiceIDGopuff                                   ServiceID = "GOPUFF"
+	ServiceIDGrabpoint                                ServiceID = "GRABPOINT"
+	ServiceIDGrailedcom                               ServiceID = "GRAILED_COM"
+	ServiceIDGrasshopper                              ServiceID = "GRASSHOPPER"
+	ServiceIDGreenlight                               ServiceID = "GREENLIGHT"
+	ServiceIDGrind24                                  ServiceID = "GRIND24"
+	ServiceIDGrubhub                                  ServiceID = "GRUBHUB"
+	ServiceIDGtbets                                   ServiceID = "GTBETS"
+	ServiceIDGusto                                    ServiceID = "GUSTO"
+	ServiceIDHandy                                    ServiceID = "HANDY"
+	ServiceIDHappyco                                  ServiceID = "HAPPYCO"
+	ServiceIDHatch                                    ServiceID = "HATCH"
+	ServiceIDHibbett                                  ServiceID = "HIBBETT"
+	ServiceIDHily                                     ServiceID = "HILY"
+	ServiceIDHinge                                    ServiceID = "HINGE"
+	ServiceIDHopper                                   ServiceID = "HOPPER"
+	ServiceIDHotelengineregistration                  ServiceID = "HOTEL_ENGINE_REGISTRATION"
+	ServiceIDHotmail                                  ServiceID = "HOTMAIL"
+	ServiceIDHotvoip                                  ServiceID = "HOTVOIP"
+	ServiceIDHsbc                                     ServiceID = "HSBC"
+	ServiceIDHungrypanda                              ServiceID = "HUNGRYPANDA"
+	ServiceIDIbotta                                   ServiceID = "IBOTTA"
+	ServiceIDIccu                                     ServiceID = "ICCU"
+	ServiceIDIcq                                      ServiceID = "ICQ"
+	ServiceIDIdleempire                               ServiceID = "IDLE_EMPIRE"
+	ServiceIDIdme                                     ServiceID = "ID_ME"
+	ServiceIDIeadbit                                  ServiceID = "IEADBIT"
+	ServiceIDIherb                                    ServiceID = "IHERB"
+	ServiceIDImoney                                   ServiceID = "IMONEY"
+	ServiceIDImprintco                                ServiceID = "IMPRINT_CO"
+	ServiceIDInboxdollars                             ServiceID = "INBOXDOLLARS"
+	ServiceIDInboxpounds                              ServiceID = "INBOXPOUNDS"
+	ServiceIDIndeed                                   ServiceID = "INDEED"
+	ServiceIDIndi                                     ServiceID = "INDI"
+	ServiceIDInnago                                   ServiceID = "INNAGO"
+	ServiceIDInstagc                                  ServiceID = "INSTAGC"
+	ServiceIDInstagram                                ServiceID = "INSTAGRAM"
+	ServiceIDInstarem                                 ServiceID = "INSTAREM"
+	ServiceIDInterviews                               ServiceID = "INTERVIEWS"
+	ServiceIDIplum                                    ServiceID = "IPLUM"
+	ServiceIDIpoll                                    ServiceID = "IPOLL"
+	ServiceIDIpsos                                    ServiceID = "IPSOS"
+	ServiceIDIrazoocom                                ServiceID = "IRAZOO_COM"
+	ServiceIDIsay                                     ServiceID = "I_SAY"
+	ServiceIDJelli                                    ServiceID = "JELLI"
+	ServiceIDJiayuancom                               ServiceID = "JIAYUAN_COM"
+	ServiceIDJiko                                     ServiceID = "JIKO"
+	ServiceIDJuno                                     ServiceID = "JUNO"
+	ServiceIDKabbage                                  ServiceID = "KABBAGE"
+	ServiceIDKacn                                     ServiceID = "KACN"
+	ServiceIDKakaotalk                                ServiceID = "KAKAOTALK"
+	ServiceIDKansas                                   ServiceID = "KANSAS"
+	ServiceIDKeeprewardingcom                         ServiceID = "KEEPREWARDING_COM"
+	ServiceIDKeybank                                  ServiceID = "KEYBANK"
+	ServiceIDKeybase                                  ServiceID = "KEYBASE"
+	ServiceIDKinectaorg                               ServiceID = "KINECTA_ORG"
+	ServiceIDKlarna                                   ServiceID = "KLARNA"
+	ServiceIDKohls                                    ServiceID = "KOHLS"
+	ServiceIDKoramoney                                ServiceID = "KORAMONEY"
+	ServiceIDKucoin                                   ServiceID = "KUCOIN"
+	ServiceIDLanceapp                                 ServiceID = "LANCE_APP"
+	ServiceIDLdsplanet                                ServiceID = "LDSPLANET"
+	ServiceIDLendio                                   ServiceID = "LENDIO"
+	ServiceIDLetgo                                    ServiceID = "LETGO"
+	ServiceIDLilibank                                 ServiceID = "LILI_BANK"
+	ServiceIDLine2                                    ServiceID = "LINE2"
+	ServiceIDLinkedin                                 ServiceID = "LINKEDIN"
+	ServiceIDLittleredbook                            ServiceID = "LITTLE_RED_BOOK"
+	ServiceIDLocalbitcoins                            ServiceID = "LOCALBITCOINS"
+	ServiceIDLootup                                   ServiceID = "LOOTUP"
+	ServiceIDLoveandseek                              ServiceID = "LOVEANDSEEK"
+	ServiceIDLuckyland                                ServiceID = "LUCKYLAND"
+	ServiceIDLycos                                    ServiceID = "LYCOS"
+	ServiceIDLyft                                     ServiceID = "LYFT"
+	ServiceIDM1finance                                ServiceID = "M1_FINANCE"
+	ServiceIDMacu                                     ServiceID = "MACU"
+	ServiceIDMailcom                                  ServiceID = "MAIL_COM"
+	ServiceIDMailgun                                  ServiceID = "MAILGUN"
+	ServiceIDMarcus                                   ServiceID = "MARCUS"
+	ServiceIDMatchcom                                 ServiceID = "MATCH_COM"
+	ServiceIDMaza                                     ServiceID = "MAZA"
+	ServiceIDMealpal                                  ServiceID = "MEALPAL"
+	ServiceIDMeetme                                   ServiceID = "MEETME"
+	ServiceIDMercari                                  ServiceID = "MERCARI"
+	ServiceIDMetalpay                                 ServiceID = "METALPAY"
+	ServiceIDMezu                                     ServiceID = "MEZU"
+	ServiceIDMfc                                      ServiceID = "MFC"
+	ServiceIDMicrosoftads                             ServiceID = "MICROSOFT_ADS"
+	ServiceIDMicrosoftazure                           ServiceID = "MICROSOFT_AZURE"
+	ServiceIDMicrosoftoffice365                       ServiceID = "MICROSOFT_OFFICE365"
+	ServiceIDMicrosoftpartnercenter                   ServiceID = "MICROSOFT_PARTNER_CENTER"
+	ServiceIDMicrosoftrewards                         ServiceID = "MICROSOFT_REWARDS"
+	ServiceIDMilesreward                              ServiceID = "MILES_REWARD"
+	ServiceIDMillionairesmatch                        ServiceID = "MILLIONAIRES_MATCH"
+	ServiceIDMillions                                 ServiceID = "MILLIONS"
+	ServiceIDMobileman                                ServiceID = "MOBILE_MAN"
+	ServiceIDMocafi                                   ServiceID = "MOCAFI"
+	ServiceIDModernapp                                ServiceID = "MODERN_APP"
+	ServiceIDMoneygram                                ServiceID = "MONEYGRAM"
+	ServiceIDMoneylion                                ServiceID = "MONEYLION"
+	ServiceIDMoomoo                                   ServiceID = "MOOMOO"
+	ServiceIDMoonpay                                  ServiceID = "MOONPAY"
+	ServiceIDMos                                      ServiceID = "MOS"
+	ServiceIDMovo                                     ServiceID = "MOVO"
+	ServiceIDMudflap                                  ServiceID = "MUDFLAP"
+	ServiceIDMypoints                                 ServiceID = "MYPOINTS"
+	ServiceIDMysoapbox                                ServiceID = "MYSOAPBOX"
+	ServiceIDMysynchrony                              ServiceID = "MYSYNCHRONY"
+	ServiceIDMytime                                   ServiceID = "MYTIME"
+	ServiceIDN26                                      ServiceID = "N26"
+	ServiceIDNerdwallet                               ServiceID = "NERDWALLET"
+	ServiceIDNervepro                                 ServiceID = "NERVE_PRO"
+	ServiceIDNetflix                                  ServiceID = "NETFLIX"
+	ServiceIDNewton                                   ServiceID = "NEWTON"
+	ServiceIDNexmo                                    ServiceID = "NEXMO"
+	ServiceIDNexo                                     ServiceID = "NEXO"
+	ServiceIDNextdoor                                 ServiceID = "NEXT_DOOR"
+	ServiceIDNfcu                                     ServiceID = "NFCU"
+	ServiceIDNiftygateway                             ServiceID = "NIFTY_GATEWAY"
+	ServiceIDNike                                     ServiceID = "NIKE"
+	ServiceIDNorthernskies                            ServiceID = "NORTHERNSKIES"
+	ServiceIDNorthone                                 ServiceID = "NORTHONE"
+	ServiceIDNotik                                    ServiceID = "NOTIK"
+	ServiceIDNotlisted                                ServiceID = "SERVICE_NOT_LISTED"
+	ServiceIDNovo                                     ServiceID = "NOVO"
+	ServiceIDOffernation                              ServiceID = "OFFERNATION"
+	ServiceIDOfferup                                  ServiceID = "OFFERUP"
+	ServiceIDOffgamers                                ServiceID = "OFFGAMERS"
+	ServiceIDOgpay                                    ServiceID = "OGPAY"
+	ServiceIDOkcoin                                   ServiceID = "OKCOIN"
+	ServiceIDOkcupid                                  ServiceID = "OKCUPID"
+	ServiceIDOkx                                      ServiceID = "OKX"
+	ServiceIDOnce                                     ServiceID = "ONCE"
+	ServiceIDOneblinc                                 ServiceID = "ONEBLINC"
+	ServiceIDOnefinance                               ServiceID = "ONEFINANCE"
+	ServiceIDOnemainfinancial                         ServiceID = "ONEMAINFINANCIAL"
+	ServiceIDOneopinion                               ServiceID = "ONEOPINION"
+	ServiceIDOnjuno                                   ServiceID = "ONJUNO"
+	ServiceIDOpenai                                   ServiceID = "OPENAI"
+	ServiceIDOpennode                                 ServiceID = "OPENNODE"
+	ServiceIDOpenphone                                ServiceID = "OPENPHONE"
+	ServiceIDOurtime                                  ServiceID = "OURTIME"
+	ServiceIDOutlook                                  ServiceID = "OUTLOOK"
+	ServiceIDOutsmarthpv                              ServiceID = "OUTSMART_HPV"
+	ServiceIDPaidtoreademailcom                       ServiceID = "PAIDTOREADEMAIL_COM"
+	ServiceIDPaidviewpoint                            ServiceID = "PAIDVIEWPOINT"
+	ServiceIDPangea                                   ServiceID = "PANGEA"
+	ServiceIDPassbook                                 ServiceID = "PASSBOOK"
+	ServiceIDPaxful                                   ServiceID = "PAXFUL"
+	ServiceIDPayactiv                                 ServiceID = "PAYACTIV"
+	ServiceIDPaybis                                   ServiceID = "PAYBIS"
+	ServiceIDPayoneer                                 ServiceID = "PAYONEER"
+	ServiceIDPaypal                                   ServiceID = "PAYPAL"
+	ServiceIDPaysend                                  ServiceID = "PAYSEND"
+	ServiceIDPaytomorrow                              ServiceID = "PAYTOMORROW"
+	ServiceIDPcgamesupply                             ServiceID = "PCGAMESUPPLY"
+	ServiceIDPerkcom                                  ServiceID = "PERK_COM"
+	ServiceIDPettalk                                  ServiceID = "PETTALK"
+	ServiceIDPhound                                   ServiceID = "PHOUND"
+	ServiceIDPigeonloans                              ServiceID = "PIGEONLOANS"
+	ServiceIDPineconeresearch                         ServiceID = "PINECONE_RESEARCH"
+	ServiceIDPingme                                   ServiceID = "PINGME"
+	ServiceIDPingone                                  ServiceID = "PINGONE"
+	ServiceIDPlacid                                   ServiceID = "PLACID"
+	ServiceIDPlayerauctions                           ServiceID = "PLAYERAUCTIONS"
+	ServiceIDPlentyoffishpof                          ServiceID = "PLENTY_OF_FISH_POF"
+	ServiceIDPncbank                                  ServiceID = "PNC_BANK"
+	ServiceIDPogoverify                               ServiceID = "POGOVERIFY"
+	ServiceIDPoint                                    ServiceID = "POINT"
+	ServiceIDPollpay                                  ServiceID = "POLL_PAY"
+	ServiceIDPorte                                    ServiceID = "PORTE"
+	ServiceIDPoshmark                                 ServiceID = "POSHMARK"
+	ServiceIDPostmates                                ServiceID = "POSTMATES"
+	ServiceIDPrivacy                                  ServiceID = "PRIVACY"
+	ServiceIDProlific                                 ServiceID = "PROLIFIC"
+	ServiceIDProtonmail                               ServiceID = "PROTONMAIL"
+	ServiceIDPruvit                                   ServiceID = "PRUVIT"
+	ServiceIDPubliccom                                ServiceID = "PUBLIC_COM"
+	ServiceIDPurse                                    ServiceID = "PURSE"
+	ServiceIDQmeecom                                  ServiceID = "QMEE_COM"
+	ServiceIDQqcom                                    ServiceID = "QQ_COM"
+	ServiceIDQtp                                      ServiceID = "QTP"
+	ServiceIDQuadpay                                  ServiceID = "QUADPAY"
+	ServiceIDQubemoney                                ServiceID = "QUBE_MONEY"
+	ServiceIDQuickbooks                               ServiceID = "QUICKBOOKS"
+	ServiceIDQuontic                                  ServiceID = "QUONTIC"
+	ServiceIDRaise                                    ServiceID = "RAISE"
+	ServiceIDRazer                                    ServiceID = "RAZER"
+	ServiceIDRbfcuorg                                 ServiceID = "RBFCU_ORG"
+	ServiceIDRegionscom                               ServiceID = "REGIONS_COM"
+	ServiceIDRemitly                                  ServiceID = "REMITLY"
+	ServiceIDRently                                   ServiceID = "RENTLY"
+	ServiceIDRetailmenot                              ServiceID = "RETAILMENOT"
+	ServiceIDRevolut                                  ServiceID = "REVOLUT"
+	ServiceIDRevolvefinance                           ServiceID = "REVOLVE_FINANCE"
+	ServiceIDRewardedplay                             ServiceID = "REWARDED_PLAY"
+	ServiceIDRia                                      ServiceID = "RIA"
+	ServiceIDRicepo                                   ServiceID = "RICEPO"
+	ServiceIDRidlt                                    ServiceID = "RI_DLT"
+	ServiceIDRingcentral                              ServiceID = "RINGCENTRAL"
+	ServiceIDRiotgames                                ServiceID = "RIOT_GAMES"
+	ServiceIDRiverfinancial                           ServiceID = "RIVER_FINANCIAL"
+	ServiceIDRobinhood                                ServiceID = "ROBINHOOD"
+	ServiceIDSablecom                                 ServiceID = "SABLE_COM"
+	ServiceIDSamsungsamsungpay                        ServiceID = "SAMSUNG_SAMSUNG_PAY"
+	ServiceIDSantanderbank                            ServiceID = "SANTANDERBANK"
+	ServiceIDSardineai                                ServiceID = "SARDINE_AI"
+	ServiceIDSaywee                                   ServiceID = "SAYWEE"
+	ServiceIDSba                                      ServiceID = "SBA"
+	ServiceIDSchwab                                   ServiceID = "SCHWAB"
+	ServiceIDSeagamermall                             ServiceID = "SEA_GAMER_MALL"
+	ServiceIDSeated                                   ServiceID = "SEATED"
+	ServiceIDSecretbenefits                           ServiceID = "SECRET_BENEFITS"
+	ServiceIDSeedfi                                   ServiceID = "SEEDFI"
+	ServiceIDSeis                                     ServiceID = "SEIS"
+	ServiceIDSendwave                                 ServiceID = "SENDWAVE"
+	ServiceIDServe                                    ServiceID = "SERVE"
+	ServiceIDSezzle                                   ServiceID = "SEZZLE"
+	ServiceIDShakepay                                 ServiceID = "SHAKEPAY"
+	ServiceIDShassocom                                ServiceID = "SHASSO_COM"
+	ServiceIDSheerid                                  ServiceID = "SHEERID"
+	ServiceIDShopathome                               ServiceID = "SHOP_AT_HOME"
+	ServiceIDShopkick                                 ServiceID = "SHOPKICK"
+	ServiceIDShoppay                                  ServiceID = "SHOP_PAY"
+	ServiceIDShopwithscripcom                         ServiceID = "SHOPWITHSCRIP_COM"
+	ServiceIDSignal                                   ServiceID = "SIGNAL"
+	ServiceIDSimple                                   ServiceID = "SIMPLE"
+	ServiceIDSimpletexting                            ServiceID = "SIMPLETEXTING"
+	ServiceIDSimplex                                  ServiceID = "SIMPLEX"
+	ServiceIDSkipthedishes                            ServiceID = "SKIPTHEDISHES"
+	ServiceIDSkout                                    ServiceID = "SKOUT"
+	ServiceIDSkrill                                   ServiceID = "SKRILL"
+	ServiceIDSlash                                    ServiceID = "SLASH"
+	ServiceIDSmilegeneration                          ServiceID = "SMILE_GENERATION"
+	ServiceIDSmore                                    ServiceID = "SMORE"
+	ServiceIDSmtp2go                                  ServiceID = "SMTP2GO"
+	ServiceIDSnagshout                                ServiceID = "SNAGSHOUT"
+	ServiceIDSnapchat                                 ServiceID = "SNAPCHAT"
+	ServiceIDSnapfinance                              ServiceID = "SNAP_FINANCE"
+	ServiceIDSnapkitchen                              ServiceID = "SNAP_KITCHEN"
+	ServiceIDSocietitv                                ServiceID = "SOCIETI_TV"
+	ServiceIDSofi                                     ServiceID = "SOFI"
+	ServiceIDSolo                                     ServiceID = "SOLO"
+	ServiceIDSoulapp                                  ServiceID = "SOULAPP"
+	ServiceIDSpotify                                  ServiceID = "SPOTIFY"
+	ServiceIDSpruce                                   ServiceID = "SPRUCE"
+	ServiceIDSquaresquareup                           ServiceID = "SQUARE_SQUAREUP"
+	ServiceIDSsiopinionoutpostvaluedopinionsandothers ServiceID = "SSI_OPINION_OUTPOST_VALUED_OPINIONS_AND_OTHERS"
+	ServiceIDStarbucks                                ServiceID = "STARBUCKS"
+	ServiceIDStash                                    ServiceID = "STASH"
+	ServiceIDStatefarm                                ServiceID = "STATE_FARM"
+	ServiceIDSteady                                   ServiceID = "STEADY"
+	ServiceIDSteam                                    ServiceID = "STEAM"
+	ServiceIDStep                                     ServiceID = "STEP"
+	ServiceIDStormplay                                ServiceID = "STORMPLAY"
+	ServiceIDStrike                                   ServiceID = "STRIKE"
+	ServiceIDStripe                                   ServiceID = "STRIPE"
+	ServiceIDStubhub                                  ServiceID = "STUBHUB"
+	ServiceIDSumup                                    ServiceID = "SUMUP"
+	ServiceIDSunbit                                   ServiceID = "SUNBIT"
+	ServiceIDSuperpayme                               ServiceID = "SUPERPAY_ME"
+	ServiceIDSupreme                                  ServiceID = "SUPREME"
+	ServiceIDSurveoo                                  ServiceID = "SURVEOO"
+	ServiceIDSurveyhoney                              ServiceID = "SURVEY_HONEY"
+	ServiceIDSurveyjunkie                             ServiceID = "SURVEY_JUNKIE"
+	ServiceIDSurveyrewardz                            ServiceID = "SURVEYREWARDZ"
+	ServiceIDSurveytime                               ServiceID = "SURVEYTIME"
+	ServiceIDSuvicash                                 ServiceID = "SUVICASH"
+	ServiceIDSwagbucks                                ServiceID = "SWAGBUCKS"
+	ServiceIDTapchamps                                ServiceID = "TAPCHAMPS"
+	ServiceIDTaptap                                   ServiceID = "TAPTAP"
+	ServiceIDTarget                                   ServiceID = "TARGET"
+	ServiceIDTaxslayer                                ServiceID = "TAXSLAYER"
+	ServiceIDTdbank                                   ServiceID = "TD_BANK"
+	ServiceIDTelegram                                 ServiceID = "TELEGRAM"
+	ServiceIDTelesign                                 ServiceID = "TELESIGN"
+	ServiceIDTelnyx                                   ServiceID = "TELNYX"
+	ServiceIDThankyoucom                              ServiceID = "THANKYOU_COM"
+	ServiceIDThatcard                                 ServiceID = "THATCARD"
+	ServiceIDTheoremreach                             ServiceID = "THEOREMREACH"
+	ServiceIDThinktank                                ServiceID = "THINKTANK"
+	ServiceIDThriftypig                               ServiceID = "THRIFTY_PIG"
+	ServiceIDTicketmaster                             ServiceID = "TICKETMASTER"
+	ServiceIDTicketnetwork                            ServiceID = "TICKETNETWORK"
+	ServiceIDTiktok                                   ServiceID = "TIKTOK"
+	ServiceIDTinder                                   ServiceID = "TINDER"
+	ServiceIDTitan                                    ServiceID = "TITAN"
+	ServiceIDTmobilemoney                             ServiceID = "T_MOBILEMONEY"
+	ServiceIDTomocredit                               ServiceID = "TOMOCREDIT"
+	ServiceIDTransfergo                               ServiceID = "TRANSFERGO"
+	ServiceIDTransunion                               ServiceID = "TRANSUNION"
+	ServiceIDTruist                                   ServiceID = "TRUIST"
+	ServiceIDTrustmark                                ServiceID = "TRUSTMARK"
+	ServiceIDTruthsocial                              ServiceID = "TRUTH_SOCIAL"
+	ServiceIDTurbotax                                 ServiceID = "TURBOTAX"
+	ServiceIDTurbotenant                              ServiceID = "TURBOTENANT"
+	ServiceIDTuro                                     ServiceID = "TURO"
+	ServiceIDTwilio                                   ServiceID = "TWILIO"
+	ServiceIDTwine                                    ServiceID = "TWINE"
+	ServiceIDTwitch                                   ServiceID = "TWITCH"
+	ServiceIDTwitter                                  ServiceID = "TWITTER"
+	ServiceIDUberubereats                             ServiceID = "UBER_UBER_EATS"
+	ServiceIDUfbdirect                                ServiceID = "UFBDIRECT"
+	ServiceIDUkgwallet                                ServiceID = "UKG_WALLET"
+	ServiceIDUlinkremit                               ServiceID = "ULINKREMIT"
+	ServiceIDUnionbank                                ServiceID = "UNIONBANK"
+	ServiceIDUnitedfcu                                ServiceID = "UNITEDFCU"
+	ServiceIDUnivestnet                               ServiceID = "UNIVEST_NET"
+	ServiceIDUphold                                   ServiceID = "UPHOLD"
+	ServiceIDUplift                                   ServiceID = "UPLIFT"
+	ServiceIDUps                                      ServiceID = "UPS"
+	ServiceIDUpvoice                                  ServiceID = "UPVOICE"
+	ServiceIDUpward                                   ServiceID = "UPWARD"
+	ServiceIDUpwork                                   ServiceID = "UPWORK"
+	ServiceIDUsaa                                     ServiceID = "USAA"
+	ServiceIDUsbank                                   ServiceID = "US_BANK"
+	ServiceIDVamospay                                 ServiceID = "VAMOS_PAY"
+	ServiceIDVaromoney                                ServiceID = "VARO_MONEY"
+	ServiceIDVenmo                                    ServiceID = "VENMO"
+	ServiceIDViabill                                  ServiceID = "VIABILL"
+	ServiceIDViabtc                                   ServiceID = "VIABTC"
+	ServiceIDViber                                    ServiceID = "VIBER"
+	ServiceIDVirgocx                                  ServiceID = "VIRGOCX"
+	ServiceIDVoyager                                  ServiceID = "VOYAGER"
+	ServiceIDVrbo                                     ServiceID = "VRBO"
+	ServiceIDVykecode                                 ServiceID = "VYKECODE"
+	ServiceIDWallethub                                ServiceID = "WALLETHUB"
+	ServiceIDWalmartwalmartmoneycard                  ServiceID = "WALMART_WALMART_MONEYCARD"
+	ServiceIDWealthfront                              ServiceID = "WEALTHFRONT"
+	ServiceIDWealthsimplecash                         ServiceID = "WEALTHSIMPLE_CASH"
+	ServiceIDWebull                                   ServiceID = "WEBULL"
+	ServiceIDWechat                                   ServiceID = "WECHAT"
+	ServiceIDWellsfargo                               ServiceID = "WELLS_FARGO"
+	ServiceIDWesterndigitalcredit                     ServiceID = "WESTERN_DIGITAL_CREDIT"
+	ServiceIDWesternunion                             ServiceID = "WESTERNUNION"
+	ServiceIDWethosco                                 ServiceID = "WETHOS_CO"
+	ServiceIDWeverse                                  ServiceID = "WEVERSE"
+	ServiceIDWhatnot                                  ServiceID = "WHATNOT"
+	ServiceIDWhatsapp                                 ServiceID = "WHATSAPP"
+	ServiceIDWhop                                     ServiceID = "WHOP"
+	ServiceIDWingocard                                ServiceID = "WINGOCARD"
+	ServiceIDWingspan                                 ServiceID = "WINGSPAN"
+	ServiceIDWirecash                                 ServiceID = "WIRECASH"
+	ServiceIDWirex                                    ServiceID = "WIREX"
+	ServiceIDWish                                     ServiceID = "WISH"
+	ServiceIDWithyotta                                ServiceID = "WITHYOTTA"
+	ServiceIDWomply                                   ServiceID = "WOMPLY"
+	ServiceIDWoocommerce                              ServiceID = "WOOCOMMERCE"
+	ServiceIDWoodforest                               ServiceID = "WOODFOREST"
+	ServiceIDWorldremit                               ServiceID = "WORLDREMIT"
+	ServiceIDX1card                                   ServiceID = "X1_CARD"
+	ServiceIDXcoins                                   ServiceID = "XCOINS"
+	ServiceIDXecom                                    ServiceID = "XE_COM"
+	ServiceIDYahoo                                    ServiceID = "YAHOO"
+	ServiceIDYandex                                   ServiceID = "YANDEX"
+	ServiceIDYellowsocialinteractive                  ServiceID = "YELLOW_SOCIAL_INTERACTIVE"
+	ServiceIDYieldstreet                              ServiceID = "YIELDSTREET"
+	ServiceIDYodlee                                   ServiceID = "YODLEE"
+	ServiceIDYoutube                                  ServiceID = "YOUTUBE"
+	ServiceIDYsense                                   ServiceID = "YSENSE"
+	ServiceIDYunosurvey                               ServiceID = "YUNO_SURVEY"
+	ServiceIDZackstradecom                            ServiceID = "ZACKSTRADE_COM"
+	ServiceIDZelfco                                   ServiceID = "ZELF_CO"
+	ServiceIDZelle                                    ServiceID = "ZELLE"
+	ServiceIDZillow                                   ServiceID = "ZILLOW"
+	ServiceIDZip                                      ServiceID = "ZIP"
+	ServiceIDZogo                                     ServiceID = "ZOGO"
+	ServiceIDZoombucks                                ServiceID = "ZOOMBUCKS"
+	ServiceIDZoominfocom                              ServiceID = "ZOOMINFO_COM"
+	ServiceIDZoosk                                    ServiceID = "ZOOSK"
+	ServiceIDZumper                                   ServiceID = "ZUMPER"
+)
+
+// Service constants predate ServiceID and are untyped, so code passing them
+// as strings still builds
+const (
+	// Deprecated: use ServiceID1688 instead.
 	Service1688 = "1688"
+	// Deprecated: use ServiceID1Q instead.
 	Service1Q = "1Q"
+	// Deprecated: use ServiceID2Redbeans instead.
 	Service2Redbeans = "2REDBEANS"
+	// Deprecated: use ServiceID53Bank instead.
 	Service53Bank = "5_3_BANK"
+	// Deprecated: use ServiceID7Eleven instead.
 	Service7Eleven = "7_ELEVEN"
+	// Deprecated: use ServiceIDAcorns instead.
 	ServiceAcorns = "ACORNS"
+	// Deprecated: use ServiceIDAdgate instead.
 	ServiceAdgate = "ADGATE"
+	// Deprecated: use ServiceIDAdidas instead.
 	ServiceAdidas = "ADIDAS"
+	// Deprecated: use ServiceIDAditup instead.
 	ServiceAditup = "AD_IT_UP"
+	// Deprecated: use ServiceIDAdp instead.
 	ServiceAdp = "ADP"
+	// Deprecated: use ServiceIDAdwallet instead.
 	ServiceAdwallet = "ADWALLET"
+	// Deprecated: use ServiceIDAeldracom instead.
 	ServiceAeldracom = "AELDRA_COM"
+	// Deprecated: use ServiceIDAffirm instead.
 	ServiceAffirm = "AFFIRM"
+	// Deprecated: use ServiceIDAfterpay instead.
 	ServiceAfterpay = "AFTERPAY"
+	// Deprecated: use ServiceIDAirbnb instead.
+	ServiceAirbnb = "AIRBNB"
+	// Deprecated: use ServiceIDAirtm instead.
 	ServiceAirtm = "AIRTM"
+	// Deprecated: use ServiceIDAlbertbank instead.
+	ServiceAlbertbank = "ALBERT_BANK"
+	// Deprecated: use ServiceIDAlibaba instead.
 	ServiceAlibaba = "ALIBABA"
+	// Deprecated: use ServiceIDAlly instead.
+	ServiceAlly = "ALLY"
+	// Deprecated: use ServiceIDAmasia instead.
 	ServiceAmasia = "AMASIA"
+	// Deprecated: use ServiceIDAmazon instead.
+	ServiceAmazon = "AMAZON"
+	// Deprecated: use ServiceIDAmber instead.
 	ServiceAmber = "AMBER"
+	// Deprecated: use ServiceIDAmericanexpress instead.
+	ServiceAmericanexpress = "AMERICAN_EXPRESS"
+	// Deprecated: use ServiceIDAmh instead.
+	ServiceAmh = "AMH"
+	// Deprecated: use ServiceIDAmway instead.
 	ServiceAmway = "AMWAY"
+	// Deprecated: use ServiceIDAndafinance instead.
 	ServiceAndafinance = "ANDA_FINANCE"
+	// Deprecated: use ServiceIDAndo instead.
 	ServiceAndo = "ANDO"
+	// Deprecated: use ServiceIDAol instead.
+	ServiceAol = "AOL"
+	// Deprecated: use ServiceIDApple instead.
+	ServiceApple = "APPLE"
+	// Deprecated: use ServiceIDArmslist instead.
 	ServiceArmslist = "ARMSLIST"
-	ServiceAspiration = "ASPIRATION"
+	// Deprecated: use ServiceIDAsbhawaii instead.
 	ServiceAsbhawaii = "ASB_HAWAII"
+	// Deprecated: use ServiceIDAspiration instead.
+	ServiceAspiration = "ASPIRATION"
+	// Deprecated: use ServiceIDAssociatedbank instead.
 	ServiceAssociatedbank = "ASSOCIATED_BANK"
+	// Deprecated: use ServiceIDAstra instead.
 	ServiceAstra = "ASTRA"
+	// Deprecated: use ServiceIDAtomy instead.
 	ServiceAtomy = "ATOMY"
+	// Deprecated: use ServiceIDAttapoll instead.
 	ServiceAttapoll = "ATTAPOLL"
+	// Deprecated: use ServiceIDAuthy instead.
 	ServiceAuthy = "AUTHY"
+	// Deprecated: use ServiceIDAvail instead.
 	ServiceAvail = "AVAIL"
+	// Deprecated: use ServiceIDAvalon instead.
 	ServiceAvalon = "AVALON"
+	// Deprecated: use ServiceIDAvant instead.
 	ServiceAvant = "AVANT"
+	// Deprecated: use ServiceIDAxosbank instead.
 	ServiceAxosbank = "AXOS_BANK"
+	// Deprecated: use ServiceIDBankofamerica instead.
+	ServiceBankofamerica = "BANK_OF_AMERICA"
+	// Deprecated: use ServiceIDBankofthewest instead.
 	ServiceBankofthewest = "BANK_OF_THE_WEST"
+	// Deprecated: use ServiceIDBarstoolsportsbook instead.
 	ServiceBarstoolsportsbook = "BARSTOOL_SPORTSBOOK"
+	// Deprecated: use ServiceIDBaskbank instead.
 	ServiceBaskbank = "BASK_BANK"
+	// Deprecated: use ServiceIDBbpeoplemeet instead.
 	ServiceBbpeoplemeet = "BBPEOPLEMEET"
+	// Deprecated: use ServiceIDBeboo instead.
 	ServiceBeboo = "BEBOO"
+	// Deprecated: use ServiceIDBeforthright instead.
 	ServiceBeforthright = "BEFORTHRIGHT"
+	// Deprecated: use ServiceIDBentoforbusiness instead.
 	ServiceBentoforbusiness = "BENTO_FOR_BUSINESS"
+	// Deprecated: use ServiceIDBestbuy instead.
 	ServiceBestbuy = "BESTBUY"
+	// Deprecated: use ServiceIDBestegg instead.
 	ServiceBestegg = "BESTEGG"
+	// Deprecated: use ServiceIDBet105eu instead.
 	ServiceBet105eu = "BET105_EU"
+	// Deprecated: use ServiceIDBet365 instead.
 	ServiceBet365 = "BET365"
+	// Deprecated: use ServiceIDBetnoweu instead.
 	ServiceBetnoweu = "BETNOW_EU"
+	// Deprecated: use ServiceIDBetterment instead.
 	ServiceBetterment = "BETTERMENT"
+	// Deprecated: use ServiceIDBigtoken instead.
 	ServiceBigtoken = "BIGTOKEN"
+	// Deprecated: use ServiceIDBillpaysite instead.
 	ServiceBillpaysite = "BILLPAYSITE"
+	// Deprecated: use ServiceIDBiltrewards instead.
 	ServiceBiltrewards = "BILT_REWARDS"
+	// Deprecated: use ServiceIDBinance instead.
 	ServiceBinance = "BINANCE"
+	// Deprecated: use ServiceIDBitclout instead.
 	ServiceBitclout = "BITCLOUT"
+	// Deprecated: use ServiceIDBitcoinofamerica instead.
 	ServiceBitcoinofamerica = "BITCOIN_OF_AMERICA"
+	// Deprecated: use ServiceIDBitflyer instead.
 	ServiceBitflyer = "BITFLYER"
+	// Deprecated: use ServiceIDBitgamesio instead.
 	ServiceBitgamesio = "BITGAMES_IO"
+	// Deprecated: use ServiceIDBitmo instead.
 	ServiceBitmo = "BITMO"
+	// Deprecated: use ServiceIDBitstamp instead.
 	ServiceBitstamp = "BITSTAMP"
+	// Deprecated: use ServiceIDBitwage instead.
 	ServiceBitwage = "BITWAGE"
+	// Deprecated: use ServiceIDBlackpeoplemeet instead.
 	ServiceBlackpeoplemeet = "BLACKPEOPLEMEET"
+	// Deprecated: use ServiceIDBlizzardentertainment instead.
 	ServiceBlizzardentertainment = "BLIZZARD_ENTERTAINMENT"
+	// Deprecated: use ServiceIDBlk instead.
 	ServiceBlk = "BLK"
+	// Deprecated: use ServiceIDBlockchain instead.
 	ServiceBlockchain = "BLOCKCHAIN"
+	// Deprecated: use ServiceIDBlueacorn instead.
 	ServiceBlueacorn = "BLUEACORN"
+	// Deprecated: use ServiceIDBluebird instead.
 	ServiceBluebird = "BLUEBIRD"
+	// Deprecated: use ServiceIDBluevinecoinseed instead.
 	ServiceBluevinecoinseed = "BLUEVINE_COINSEED"
+	// Deprecated: use ServiceIDBmoharris instead.
 	ServiceBmoharris = "BMO_HARRIS"
+	// Deprecated: use ServiceIDBoodle instead.
 	ServiceBoodle = "BOODLE"
+	// Deprecated: use ServiceIDBovadalv instead.
 	ServiceBovadalv = "BOVADA_LV"
+	// Deprecated: use ServiceIDBraid instead.
 	ServiceBraid = "BRAID"
+	// Deprecated: use ServiceIDBrandclub instead.
 	ServiceBrandclub = "BRANDCLUB"
+	// Deprecated: use ServiceIDBrandedsurvey instead.
 	ServiceBrandedsurvey = "BRANDEDSURVEY"
+	// Deprecated: use ServiceIDBreadfinancial instead.
 	ServiceBreadfinancial = "BREAD_FINANCIAL"
+	// Deprecated: use ServiceIDBriq instead.
 	ServiceBriq = "BRIQ"
+	// Deprecated: use ServiceIDBtcsurveys instead.
 	ServiceBtcsurveys = "BTCSURVEYS"
+	// Deprecated: use ServiceIDBumble instead.
 	ServiceBumble = "BUMBLE"
+	// Deprecated: use ServiceIDBurnerapp instead.
 	ServiceBurnerapp = "BURNER_APP"
+	// Deprecated: use ServiceIDBylinebank instead.
 	ServiceBylinebank = "BYLINE_BANK"
+	// Deprecated: use ServiceIDCanadacomputers instead.
 	ServiceCanadacomputers = "CANADA_COMPUTERS"
+	// Deprecated: use ServiceIDCapitalone instead.
+	ServiceCapitalone = "CAPITAL_ONE"
+	// Deprecated: use ServiceIDCapway instead.
 	ServiceCapway = "CAPWAY"
-	ServiceCardcom = "CARD_COM"
+	// Deprecated: use ServiceIDCardaccountnet instead.
 	ServiceCardaccountnet = "CARDACCOUNT_NET"
+	// Deprecated: use ServiceIDCardcom instead.
+	ServiceCardcom = "CARD_COM"
+	// Deprecated: use ServiceIDCashapp instead.
+	ServiceCashapp = "CASH_APP"
+	// Deprecated: use ServiceIDCashout instead.
 	ServiceCashout = "CASHOUT"
+	// Deprecated: use ServiceIDCashwalk instead.
 	ServiceCashwalk = "CASHWALK"
+	// Deprecated: use ServiceIDCathay instead.
 	ServiceCathay = "CATHAY"
+	// Deprecated: use ServiceIDCbna instead.
 	ServiceCbna = "CBNA"
+	// Deprecated: use ServiceIDCdkeyscom instead.
 	ServiceCdkeyscom = "CDKEYS_COM"
+	// Deprecated: use ServiceIDChangelly instead.
 	ServiceChangelly = "CHANGELLY"
+	// Deprecated: use ServiceIDChasebank instead.
+	ServiceChasebank = "CHASE_BANK"
+	// Deprecated: use ServiceIDCheckpoints instead.
 	ServiceCheckpoints = "CHECKPOINTS"
+	// Deprecated: use ServiceIDChemistry instead.
 	ServiceChemistry = "CHEMISTRY"
+	// Deprecated: use ServiceIDChevron instead.
 	ServiceChevron = "CHEVRON"
+	// Deprecated: use ServiceIDChime instead.
+	ServiceChime = "CHIME"
+	// Deprecated: use ServiceIDChipotle instead.
 	ServiceChipotle = "CHIPOTLE"
+	// Deprecated: use ServiceIDChispa instead.
 	ServiceChispa = "CHISPA"
+	// Deprecated: use ServiceIDChivo instead.
 	ServiceChivo = "CHIVO"
+	// Deprecated: use ServiceIDChowbus instead.
 	ServiceChowbus = "CHOWBUS"
+	// Deprecated: use ServiceIDChumbacasino instead.
 	ServiceChumbacasino = "CHUMBA_CASINO"
+	// Deprecated: use ServiceIDCinchbucks instead.
 	ServiceCinchbucks = "CINCHBUCKS"
+	// Deprecated: use ServiceIDCitibank instead.
+	ServiceCitibank = "CITIBANK"
+	// Deprecated: use ServiceIDClasspass instead.
 	ServiceClasspass = "CLASSPASS"
+	// Deprecated: use ServiceIDClearbit instead.
 	ServiceClearbit = "CLEARBIT"
+	// Deprecated: use ServiceIDClearvoice instead.
 	ServiceClearvoice = "CLEARVOICE"
+	// Deprecated: use ServiceIDClover instead.
 	ServiceClover = "CLOVER"
+	// Deprecated: use ServiceIDCocacola instead.
 	ServiceCocacola = "COCA_COLA"
+	// Deprecated: use ServiceIDCoffeemeetsbagel instead.
 	ServiceCoffeemeetsbagel = "COFFEEMEETSBAGEL"
+	// Deprecated: use ServiceIDCogni instead.
 	ServiceCogni = "COGNI"
+	// Deprecated: use ServiceIDCoinbase instead.
 	ServiceCoinbase = "COINBASE"
+	// Deprecated: use ServiceIDCoinberry instead.
 	ServiceCoinberry = "COINBERRY"
+	// Deprecated: use ServiceIDCoinchangeio instead.
 	ServiceCoinchangeio = "COINCHANGE_IO"
+	// Deprecated: use ServiceIDCoinflip instead.
+	ServiceCoinflip = "COINFLIP"
+	// Deprecated: use ServiceIDCoinloot instead.
 	ServiceCoinloot = "COINLOOT"
+	// Deprecated: use ServiceIDCoinzoom instead.
 	ServiceCoinzoom = "COINZOOM"
+	// Deprecated: use ServiceIDCopperbank instead.
 	ServiceCopperbank = "COPPER_BANK"
+	// Deprecated: use ServiceIDCouponscom instead.
+	ServiceCouponscom = "COUPONS_COM"
+	// Deprecated: use ServiceIDCox instead.
 	ServiceCox = "COX"
+	// Deprecated: use ServiceIDCraigslist instead.
+	ServiceCraigslist = "CRAIGSLIST"
+	// Deprecated: use ServiceIDCraypay instead.
 	ServiceCraypay = "CRAYPAY"
+	// Deprecated: use ServiceIDCredai instead.
 	ServiceCredai = "CREDAI"
+	// Deprecated: use ServiceIDCreditkarma instead.
+	ServiceCreditkarma = "CREDITKARMA"
+	// Deprecated: use ServiceIDCreditsesame instead.
 	ServiceCreditsesame = "CREDIT_SESAME"
+	// Deprecated: use ServiceIDCrowdtap instead.
 	ServiceCrowdtap = "CROWDTAP"
+	// Deprecated: use ServiceIDCrypterium instead.
 	ServiceCrypterium = "CRYPTERIUM"
+	// Deprecated: use ServiceIDCryptocom instead.
 	ServiceCryptocom = "CRYPTO_COM"
+	// Deprecated: use ServiceIDCryptopay instead.
 	ServiceCryptopay = "CRYPTOPAY"
+	// Deprecated: use ServiceIDCsgforte instead.
 	ServiceCsgforte = "CSG_FORTE"
+	// Deprecated: use ServiceIDCurb instead.
 	ServiceCurb = "CURB"
+	// Deprecated: use ServiceIDCuriouscat instead.
 	ServiceCuriouscat = "CURIOUSCAT"
+	// Deprecated: use ServiceIDCurrentbank instead.
 	ServiceCurrentbank = "CURRENT_BANK"
+	// Deprecated: use ServiceIDCurrentmusic instead.
 	ServiceCurrentmusic = "CURRENT_MUSIC"
+	// Deprecated: use ServiceIDCurtsy instead.
 	ServiceCurtsy = "CURTSY"
+	// Deprecated: use ServiceIDCvs instead.
 	ServiceCvs = "CVS"
+	// Deprecated: use ServiceIDCybermetals instead.
 	ServiceCybermetals = "CYBERMETALS"
+	// Deprecated: use ServiceIDDabbl instead.
 	ServiceDabbl = "DABBL"
+	// Deprecated: use ServiceIDDailypay instead.
 	ServiceDailypay = "DAILYPAY"
+	// Deprecated: use ServiceIDDasherdirect instead.
 	ServiceDasherdirect = "DASHERDIRECT"
+	// Deprecated: use ServiceIDDavecom instead.
+	ServiceDavecom = "DAVE_COM"
+	// Deprecated: use ServiceIDDcubank instead.
 	ServiceDcubank = "DCU_BANK"
+	// Deprecated: use ServiceIDDeliveroo instead.
 	ServiceDeliveroo = "DELIVEROO"
+	// Deprecated: use ServiceIDDidi instead.
 	ServiceDidi = "DIDI"
+	// Deprecated: use ServiceIDDigit instead.
 	ServiceDigit = "DIGIT"
+	// Deprecated: use ServiceIDDignifi instead.
 	ServiceDignifi = "DIGNIFI"
+	// Deprecated: use ServiceIDDinnerballs instead.
 	ServiceDinnerballs = "DINNER_BALLS"
+	// Deprecated: use ServiceIDDiscord instead.
 	ServiceDiscord = "DISCORD"
+	// Deprecated: use ServiceIDDiscover instead.
 	ServiceDiscover = "DISCOVER"
+	// Deprecated: use ServiceIDDistrokid instead.
 	ServiceDistrokid = "DISTROKID"
+	// Deprecated: use ServiceIDDocusign instead.
 	ServiceDocusign = "DOCUSIGN"
+	// Deprecated: use ServiceIDDollarclix instead.
 	ServiceDollarclix = "DOLLARCLIX"
+	// Deprecated: use ServiceIDDoordash instead.
+	ServiceDoordash = "DOORDASH"
+	// Deprecated: use ServiceIDDoritos instead.
 	ServiceDoritos = "DORITOS"
+	// Deprecated: use ServiceIDDosh instead.
 	ServiceDosh = "DOSH"
+	// Deprecated: use ServiceIDDoublelist instead.
 	ServiceDoublelist = "DOUBLELIST"
+	// Deprecated: use ServiceIDDouughcom instead.
 	ServiceDouughcom = "DOUUGH_COM"
+	// Deprecated: use ServiceIDDrumo instead.
 	ServiceDrumo = "DRUMO"
+	// Deprecated: use ServiceIDDunkindonuts instead.
 	ServiceDunkindonuts = "DUNKIN_DONUTS"
+	// Deprecated: use ServiceIDDust instead.
 	ServiceDust = "DUST"
-	ServiceEarnapp = "EARN_APP"
+	// Deprecated: use ServiceIDEarn99 instead.
 	ServiceEarn99 = "EARN99"
+	// Deprecated: use ServiceIDEarnably instead.
 	ServiceEarnably = "EARNABLY"
+	// Deprecated: use ServiceIDEarnapp instead.
+	ServiceEarnapp = "EARN_APP"
+	// Deprecated: use ServiceIDEarnhoney instead.
 	ServiceEarnhoney = "EARNHONEY"
+	// Deprecated: use ServiceIDEarnly instead.
 	ServiceEarnly = "EARNLY"
+	// Deprecated: use ServiceIDEasi instead.
 	ServiceEasi = "EASI"
+	// Deprecated: use ServiceIDEbay instead.
+	ServiceEbay = "EBAY"
+	// Deprecated: use ServiceIDEgifter instead.
+	ServiceEgifter = "EGIFTER"
+	// Deprecated: use ServiceIDElevacity instead.
 	ServiceElevacity = "ELEVACITY"
+	// Deprecated: use ServiceIDEllevest instead.
 	ServiceEllevest = "ELLEVEST"
+	// Deprecated: use ServiceIDElootgg instead.
 	ServiceElootgg = "ELOOT_GG"
+	// Deprecated: use ServiceIDEmpower instead.
 	ServiceEmpower = "EMPOWER"
-	ServiceEgifter = "EGIFTER"
+	// Deprecated: use ServiceIDEneba instead.
 	ServiceEneba = "ENEBA"
+	// Deprecated: use ServiceIDEnzo instead.
 	ServiceEnzo = "ENZO"
+	// Deprecated: use ServiceIDErewards instead.
+	ServiceErewards = "E_REWARDS"
+	// Deprecated: use ServiceIDEtoro instead.
 	ServiceEtoro = "ETORO"
+	// Deprecated: use ServiceIDEtrade instead.
 	ServiceEtrade = "ETRADE"
+	// Deprecated: use ServiceIDEureka instead.
 	ServiceEureka = "EUREKA"
+	// Deprecated: use ServiceIDExmo instead.
 	ServiceExmo = "EXMO"
+	// Deprecated: use ServiceIDExperian instead.
 	ServiceExperian = "EXPERIAN"
+	// Deprecated: use ServiceIDExtra instead.
 	ServiceExtra = "EXTRA"
+	// Deprecated: use ServiceIDFacebook instead.
+	ServiceFacebook = "FACEBOOK"
+	// Deprecated: use ServiceIDFastmail instead.
 	ServiceFastmail = "FASTMAIL"
+	// Deprecated: use ServiceIDFedex instead.
 	ServiceFedex = "FEDEX"
+	// Deprecated: use ServiceIDFetchrewards instead.
 	ServiceFetchrewards = "FETCH_REWARDS"
+	// Deprecated: use ServiceIDFetlife instead.
 	ServiceFetlife = "FETLIFE"
+	// Deprecated: use ServiceIDFidelity instead.
 	ServiceFidelity = "FIDELITY"
+	// Deprecated: use ServiceIDFigureeight instead.
 	ServiceFigureeight = "FIGURE_EIGHT"
+	// Deprecated: use ServiceIDFinishline instead.
 	ServiceFinishline = "FINISH_LINE"
+	// Deprecated: use ServiceIDFiverr instead.
 	ServiceFiverr = "FIVERR"
+	// Deprecated: use ServiceIDFlashrewards instead.
 	ServiceFlashrewards = "FLASH_REWARDS"
+	// Deprecated: use ServiceIDFlippa instead.
 	ServiceFlippa = "FLIPPA"
+	// Deprecated: use ServiceIDFluxrewards instead.
 	ServiceFluxrewards = "FLUXREWARDS"
+	// Deprecated: use ServiceIDFluz instead.
 	ServiceFluz = "FLUZ"
+	// Deprecated: use ServiceIDFold instead.
 	ServiceFold = "FOLD"
+	// Deprecated: use ServiceIDFoundcom instead.
+	ServiceFoundcom = "FOUND_COM"
+	// Deprecated: use ServiceIDFreecashcom instead.
+	ServiceFreecashcom = "FREECASH_COM"
+	// Deprecated: use ServiceIDFreecryptorewards instead.
 	ServiceFreecryptorewards = "FREECRYPTOREWARDS"
+	// Deprecated: use ServiceIDFreelancer instead.
 	ServiceFreelancer = "FREELANCER"
+	// Deprecated: use ServiceIDFreetaxusacom instead.
 	ServiceFreetaxusacom = "FREETAXUSA_COM"
+	// Deprecated: use ServiceIDFreewalletorg instead.
 	ServiceFreewalletorg = "FREEWALLET_ORG"
+	// Deprecated: use ServiceIDFtx instead.
 	ServiceFtx = "FTX"
+	// Deprecated: use ServiceIDFursure instead.
 	ServiceFursure = "FURSURE"
+	// Deprecated: use ServiceIDFusioncash instead.
 	ServiceFusioncash = "FUSIONCASH"
+	// Deprecated: use ServiceIDG2acom instead.
 	ServiceG2acom = "G2A_COM"
+	// Deprecated: use ServiceIDG2gcomoffgamers instead.
 	ServiceG2gcomoffgamers = "G2G_COM_OFFGAMERS"
-	ServiceGameminerclub = "GAMEMINER_CLUB"
+	// Deprecated: use ServiceIDGameflip instead.
 	ServiceGameflip = "GAMEFLIP"
+	// Deprecated: use ServiceIDGameminerclub instead.
+	ServiceGameminerclub = "GAMEMINER_CLUB"
+	// Deprecated: use ServiceIDGemini instead.
 	ServiceGemini = "GEMINI"
+	// Deprecated: use ServiceIDGerald instead.
 	ServiceGerald = "GERALD"
+	// Deprecated: use ServiceIDGetjerry instead.
 	ServiceGetjerry = "GETJERRY"
+	// Deprecated: use ServiceIDGetjobber instead.
 	ServiceGetjobber = "GETJOBBER"
+	// Deprecated: use ServiceIDGetpaidto instead.
 	ServiceGetpaidto = "GETPAIDTO"
+	// Deprecated: use ServiceIDGetslide instead.
 	ServiceGetslide = "GETSLIDE"
+	// Deprecated: use ServiceIDGiftya instead.
 	ServiceGiftya = "GIFTYA"
+	// Deprecated: use ServiceIDGoalsetter instead.
 	ServiceGoalsetter = "GOALSETTER"
+	// Deprecated: use ServiceIDGobankgo2bankgreendot instead.
+	ServiceGobankgo2bankgreendot = "GOBANK_GO2BANK_GREEN_DOT"
+	// Deprecated: use ServiceIDGobranded instead.
 	ServiceGobranded = "GOBRANDED"
+	// Deprecated: use ServiceIDGofundme instead.
 	ServiceGofundme = "GOFUNDME"
+	// Deprecated: use ServiceIDGoldenlakeeatery instead.
 	ServiceGoldenlakeeatery = "GOLDEN_LAKE_EATERY"
+	// Deprecated: use ServiceIDGooglebipa instead.
+	ServiceGooglebipa = "GOOGLE_BIPA"
+	// Deprecated: use ServiceIDGooglecloud instead.
 	ServiceGooglecloud = "GOOGLE_CLOUD"
+	// Deprecated: use ServiceIDGooglegmail instead.
+	ServiceGooglegmail = "GOOGLE_GMAIL"
+	// Deprecated: use ServiceIDGooglemerchantcenter instead.
 	ServiceGooglemerchantcenter = "GOOGLE_MERCHANT_CENTER"
+	// Deprecated: use ServiceIDGooglepay instead.
 	ServiceGooglepay = "GOOGLE_PAY"
+	// Deprecated: use ServiceIDGoogleplayconsole instead.
 	ServiceGoogleplayconsole = "GOOGLE_PLAY_CONSOLE"
+	// Deprecated: use ServiceIDGopuff instead.
 	ServiceGopuff = "GOPUFF"
+	// Deprecated: use ServiceIDGrabpoint instead.
 	ServiceGrabpoint = "GRABPOINT"
+	// Deprecated: use ServiceIDGrailedcom instead.
 	ServiceGrailedcom = "GRAILED_COM"
+	// Deprecated: use ServiceIDGrasshopper instead.
 	ServiceGrasshopper = "GRASSHOPPER"
+	// Deprecated: use ServiceIDGreenlight instead.
 	ServiceGreenlight = "GREENLIGHT"
+	// Deprecated: use ServiceIDGrind24 instead.
 	ServiceGrind24 = "GRIND24"
+	// Deprecated: use ServiceIDGrubhub instead.
 	ServiceGrubhub = "GRUBHUB"
+	// Deprecated: use ServiceIDGtbets instead.
 	ServiceGtbets = "GTBETS"
+	// Deprecated: use ServiceIDGusto instead.
 	ServiceGusto = "GUSTO"
+	// Deprecated: use ServiceIDHandy instead.
 	ServiceHandy = "HANDY"
+	// Deprecated: use ServiceIDHappyco instead.
 	ServiceHappyco = "HAPPYCO"
+	// Deprecated: use ServiceIDHatch instead.
 	ServiceHatch = "HATCH"
+	// Deprecated: use ServiceIDHibbett instead.
 	ServiceHibbett = "HIBBETT"
+	// Deprecated: use ServiceIDHily instead.
 	ServiceHily = "HILY"
+	// Deprecated: use ServiceIDHinge instead.
 	ServiceHinge = "HINGE"
+	// Deprecated: use ServiceIDHopper instead.
 	ServiceHopper = "HOPPER"
+	// Deprecated: use ServiceIDHotelengineregistration instead.
 	ServiceHotelengineregistration = "HOTEL_ENGINE_REGISTRATION"
+	// Deprecated: use ServiceIDHotmail instead.
+	ServiceHotmail = "HOTMAIL"
+	// Deprecated: use ServiceIDHotvoip instead.
 	ServiceHotvoip = "HOTVOIP"
+	// Deprecated: use ServiceIDHsbc instead.
 	ServiceHsbc = "HSBC"
+	// Deprecated: use ServiceIDHungrypanda instead.
 	ServiceHungrypanda = "HUNGRYPANDA"
+	// Deprecated: use ServiceIDIbotta instead.
 	ServiceIbotta = "IBOTTA"
+	// Deprecated: use ServiceIDIccu instead.
 	ServiceIccu = "ICCU"
+	// Deprecated: use ServiceIDIcq instead.
 	ServiceIcq = "ICQ"
+	// Deprecated: use ServiceIDIdleempire instead.
 	ServiceIdleempire = "IDLE_EMPIRE"
+	// Deprecated: use ServiceIDIdme instead.
 	ServiceIdme = "ID_ME"
+	// Deprecated: use ServiceIDIeadbit instead.
 	ServiceIeadbit = "IEADBIT"
+	// Deprecated: use ServiceIDIherb instead.
 	ServiceIherb = "IHERB"
+	// Deprecated: use ServiceIDImoney instead.
 	ServiceImoney = "IMONEY"
+	// Deprecated: use ServiceIDImprintco instead.
 	ServiceImprintco = "IMPRINT_CO"
+	// Deprecated: use ServiceIDInboxdollars instead.
 	ServiceInboxdollars = "INBOXDOLLARS"
+	// Deprecated: use ServiceIDInboxpounds instead.
 	ServiceInboxpounds = "INBOXPOUNDS"
+	// Deprecated: use ServiceIDIndeed instead.
 	ServiceIndeed = "INDEED"
+	// Deprecated: use ServiceIDIndi instead.
 	ServiceIndi = "INDI"
+	// Deprecated: use ServiceIDInnago instead.
 	ServiceInnago = "INNAGO"
+	// Deprecated: use ServiceIDInstagc instead.
 	ServiceInstagc = "INSTAGC"
+	// Deprecated: use ServiceIDInstagram instead.
 	ServiceInstagram = "INSTAGRAM"
+	// Deprecated: use ServiceIDInstarem instead.
 	ServiceInstarem = "INSTAREM"
+	// Deprecated: use ServiceIDInterviews instead.
 	ServiceInterviews = "INTERVIEWS"
+	// Deprecated: use ServiceIDIplum instead.
 	ServiceIplum = "IPLUM"
+	// Deprecated: use ServiceIDIpoll instead.
 	ServiceIpoll = "IPOLL"
+	// Deprecated: use ServiceIDIpsos instead.
 	ServiceIpsos = "IPSOS"
+	// Deprecated: use ServiceIDIrazoocom instead.
 	ServiceIrazoocom = "IRAZOO_COM"
+	// Deprecated: use ServiceIDIsay instead.
 	ServiceIsay = "I_SAY"
+	// Deprecated: use ServiceIDJelli instead.
 	ServiceJelli = "JELLI"
+	// Deprecated: use ServiceIDJiayuancom instead.
 	ServiceJiayuancom = "JIAYUAN_COM"
+	// Deprecated: use ServiceIDJiko instead.
 	ServiceJiko = "JIKO"
+	// Deprecated: use ServiceIDJuno instead.
 	ServiceJuno = "JUNO"
+	// Deprecated: use ServiceIDKabbage instead.
 	ServiceKabbage = "KABBAGE"
+	// Deprecated: use ServiceIDKacn instead.
 	ServiceKacn = "KACN"
+	// Deprecated: use ServiceIDKakaotalk instead.
 	ServiceKakaotalk = "KAKAOTALK"
+	// Deprecated: use ServiceIDKansas instead.
 	ServiceKansas = "KANSAS"
+	// Deprecated: use ServiceIDKeeprewardingcom instead.
 	ServiceKeeprewardingcom = "KEEPREWARDING_COM"
+	// Deprecated: use ServiceIDKeybank instead.
 	ServiceKeybank = "KEYBANK"
+	// Deprecated: use ServiceIDKeybase instead.
 	ServiceKeybase = "KEYBASE"
+	// Deprecated: use ServiceIDKinectaorg instead.
 	ServiceKinectaorg = "KINECTA_ORG"
+	// Deprecated: use ServiceIDKlarna instead.
 	ServiceKlarna = "KLARNA"
+	// Deprecated: use ServiceIDKohls instead.
 	ServiceKohls = "KOHLS"
+	// Deprecated: use ServiceIDKoramoney instead.
 	ServiceKoramoney = "KORAMONEY"
+	// Deprecated: use ServiceIDKucoin instead.
 	ServiceKucoin = "KUCOIN"
+	// Deprecated: use ServiceIDLanceapp instead.
 	ServiceLanceapp = "LANCE_APP"
+	// Deprecated: use ServiceIDLdsplanet instead.
 	ServiceLdsplanet = "LDSPLANET"
+	// Deprecated: use ServiceIDLendio instead.
 	ServiceLendio = "LENDIO"
+	// Deprecated: use ServiceIDLetgo instead.
 	ServiceLetgo = "LETGO"
+	// Deprecated: use ServiceIDLilibank instead.
+	ServiceLilibank = "LILI_BANK"
+	// Deprecated: use ServiceIDLine2 instead.
 	ServiceLine2 = "LINE2"
+	// Deprecated: use ServiceIDLinkedin instead.
 	ServiceLinkedin = "LINKEDIN"
+	// Deprecated: use ServiceIDLittleredbook instead.
 	ServiceLittleredbook = "LITTLE_RED_BOOK"
+	// Deprecated: use ServiceIDLocalbitcoins instead.
 	ServiceLocalbitcoins = "LOCALBITCOINS"
+	// Deprecated: use ServiceIDLootup instead.
 	ServiceLootup = "LOOTUP"
+	// Deprecated: use ServiceIDLoveandseek instead.
 	ServiceLoveandseek = "LOVEANDSEEK"
+	// Deprecated: use ServiceIDLuckyland instead.
 	ServiceLuckyland = "LUCKYLAND"
+	// Deprecated: use ServiceIDLycos instead.
 	ServiceLycos = "LYCOS"
+	// Deprecated: use ServiceIDLyft instead.
 	ServiceLyft = "LYFT"
+	// Deprecated: use ServiceIDM1finance instead.
 	ServiceM1finance = "M1_FINANCE"
+	// Deprecated: use ServiceIDMacu instead.
 	ServiceMacu = "MACU"
+	// Deprecated: use ServiceIDMailcom instead.
 	ServiceMailcom = "MAIL_COM"
+	// Deprecated: use ServiceIDMailgun instead.
 	ServiceMailgun = "MAILGUN"
+	// Deprecated: use ServiceIDMarcus instead.
 	ServiceMarcus = "MARCUS"
+	// Deprecated: use ServiceIDMatchcom instead.
+	ServiceMatchcom = "MATCH_COM"
+	// Deprecated: use ServiceIDMaza instead.
 	ServiceMaza = "MAZA"
+	// Deprecated: use ServiceIDMealpal instead.
 	ServiceMealpal = "MEALPAL"
+	// Deprecated: use ServiceIDMeetme instead.
 	ServiceMeetme = "MEETME"
+	// Deprecated: use ServiceIDMercari instead.
 	ServiceMercari = "MERCARI"
+	// Deprecated: use ServiceIDMetalpay instead.
 	ServiceMetalpay = "METALPAY"
+	// Deprecated: use ServiceIDMezu instead.
 	ServiceMezu = "MEZU"
+	// Deprecated: use ServiceIDMfc instead.
 	ServiceMfc = "MFC"
+	// Deprecated: use ServiceIDMicrosoftads instead.
 	ServiceMicrosoftads = "MICROSOFT_ADS"
+	// Deprecated: use ServiceIDMicrosoftazure instead.
 	ServiceMicrosoftazure = "MICROSOFT_AZURE"
+	// Deprecated: use ServiceIDMicrosoftoffice365 instead.
 	ServiceMicrosoftoffice365 = "MICROSOFT_OFFICE365"
+	// Deprecated: use ServiceIDMicrosoftpartnercenter instead.
 	ServiceMicrosoftpartnercenter = "MICROSOFT_PARTNER_CENTER"
+	// Deprecated: use ServiceIDMicrosoftrewards instead.
+	ServiceMicrosoftrewards = "MICROSOFT_REWARDS"
+	// Deprecated: use ServiceIDMilesreward instead.
 	ServiceMilesreward = "MILES_REWARD"
+	// Deprecated: use ServiceIDMillionairesmatch instead.
 	ServiceMillionairesmatch = "MILLIONAIRES_MATCH"
+	// Deprecated: use ServiceIDMillions instead.
 	ServiceMillions = "MILLIONS"
+	// Deprecated: use ServiceIDMobileman instead.
 	ServiceMobileman = "MOBILE_MAN"
+	// Deprecated: use ServiceIDMocafi instead.
 	ServiceMocafi = "MOCAFI"
+	// Deprecated: use ServiceIDModernapp instead.
 	ServiceModernapp = "MODERN_APP"
+	// Deprecated: use ServiceIDMoneygram instead.
 	ServiceMoneygram = "MONEYGRAM"
+	// Deprecated: use ServiceIDMoneylion instead.
+	ServiceMoneylion = "MONEYLION"
+	// Deprecated: use ServiceIDMoomoo instead.
 	ServiceMoomoo = "MOOMOO"
+	// Deprecated: use ServiceIDMoonpay instead.
 	ServiceMoonpay = "MOONPAY"
+	// Deprecated: use ServiceIDMos instead.
 	ServiceMos = "MOS"
+	// Deprecated: use ServiceIDMovo instead.
 	ServiceMovo = "MOVO"
+	// Deprecated: use ServiceIDMudflap instead.
 	ServiceMudflap = "MUDFLAP"
+	// Deprecated: use ServiceIDMypoints instead.
 	ServiceMypoints = "MYPOINTS"
+	// Deprecated: use ServiceIDMysoapbox instead.
 	ServiceMysoapbox = "MYSOAPBOX"
+	// Deprecated: use ServiceIDMysynchrony instead.
 	ServiceMysynchrony = "MYSYNCHRONY"
+	// Deprecated: use ServiceIDMytime instead.
 	ServiceMytime = "MYTIME"
+	// Deprecated: use ServiceIDN26 instead.
 	ServiceN26 = "N26"
+	// Deprecated: use ServiceIDNerdwallet instead.
+	ServiceNerdwallet = "NERDWALLET"
+	// Deprecated: use ServiceIDNervepro instead.
 	ServiceNervepro = "NERVE_PRO"
+	// Deprecated: use ServiceIDNetflix instead.
 	ServiceNetflix = "NETFLIX"
+	// Deprecated: use ServiceIDNewton instead.
 	ServiceNewton = "NEWTON"
+	// Deprecated: use ServiceIDNexmo instead.
 	ServiceNexmo = "NEXMO"
+	// Deprecated: use ServiceIDNexo instead.
 	ServiceNexo = "NEXO"
+	// Deprecated: use ServiceIDNextdoor instead.
 	ServiceNextdoor = "NEXT_DOOR"
+	// Deprecated: use ServiceIDNfcu instead.
 	ServiceNfcu = "NFCU"
+	// Deprecated: use ServiceIDNiftygateway instead.
 	ServiceNiftygateway = "NIFTY_GATEWAY"
-	ServiceNorthone = "NORTHONE"
+	// Deprecated: use ServiceIDNike instead.
+	ServiceNike = "NIKE"
+	// Deprecated: use ServiceIDNorthernskies instead.
 	ServiceNorthernskies = "NORTHERNSKIES"
+	// Deprecated: use ServiceIDNorthone instead.
+	ServiceNorthone = "NORTHONE"
+	// Deprecated: use ServiceIDNotik instead.
 	ServiceNotik = "NOTIK"
+	// Deprecated: use ServiceIDNotlisted instead.
+	ServiceNotlisted = "SERVICE_NOT_LISTED"
+	// Deprecated: use ServiceIDNovo instead.
 	ServiceNovo = "NOVO"
+	// Deprecated: use ServiceIDOffernation instead.
 	ServiceOffernation = "OFFERNATION"
+	// Deprecated: use ServiceIDOfferup instead.
+	ServiceOfferup = "OFFERUP"
+	// Deprecated: use ServiceIDOffgamers instead.
 	ServiceOffgamers = "OFFGAMERS"
+	// Deprecated: use ServiceIDOgpay instead.
 	ServiceOgpay = "OGPAY"
+	// Deprecated: use ServiceIDOkcoin instead.
 	ServiceOkcoin = "OKCOIN"
+	// Deprecated: use ServiceIDOkcupid instead.
 	ServiceOkcupid = "OKCUPID"
+	// Deprecated: use ServiceIDOkx instead.
 	ServiceOkx = "OKX"
+	// Deprecated: use ServiceIDOnce instead.
 	ServiceOnce = "ONCE"
-	ServiceOnefinance = "ONEFINANCE"
+	// Deprecated: use ServiceIDOneblinc instead.
 	ServiceOneblinc = "ONEBLINC"
+	// Deprecated: use ServiceIDOnefinance instead.
+	ServiceOnefinance = "ONEFINANCE"
+	// Deprecated: use ServiceIDOnemainfinancial instead.
 	ServiceOnemainfinancial = "ONEMAINFINANCIAL"
+	// Deprecated: use ServiceIDOneopinion instead.
 	ServiceOneopinion = "ONEOPINION"
+	// Deprecated: use ServiceIDOnjuno instead.
 	ServiceOnjuno = "ONJUNO"
+	// Deprecated: use ServiceIDOpenai instead.
 	ServiceOpenai = "OPENAI"
+	// Deprecated: use ServiceIDOpennode instead.
 	ServiceOpennode = "OPENNODE"
+	// Deprecated: use ServiceIDOpenphone instead.
 	ServiceOpenphone = "OPENPHONE"
+	// Deprecated: use ServiceIDOurtime instead.
+	ServiceOurtime = "OURTIME"
+	// Deprecated: use ServiceIDOutlook instead.
+	ServiceOutlook = "OUTLOOK"
+	// Deprecated: use ServiceIDOutsmarthpv instead.
 	ServiceOutsmarthpv = "OUTSMART_HPV"
+	// Deprecated: use ServiceIDPaidtoreademailcom instead.
 	ServicePaidtoreademailcom = "PAIDTOREADEMAIL_COM"
+	// Deprecated: use ServiceIDPaidviewpoint instead.
 	ServicePaidviewpoint = "PAIDVIEWPOINT"
+	// Deprecated: use ServiceIDPangea instead.
 	ServicePangea = "PANGEA"
+	// Deprecated: use ServiceIDPassbook instead.
 	ServicePassbook = "PASSBOOK"
+	// Deprecated: use ServiceIDPaxful instead.
 	ServicePaxful = "PAXFUL"
+	// Deprecated: use ServiceIDPayactiv instead.
 	ServicePayactiv = "PAYACTIV"
+	// Deprecated: use ServiceIDPaybis instead.
 	ServicePaybis = "PAYBIS"
+	// Deprecated: use ServiceIDPayoneer instead.
 	ServicePayoneer = "PAYONEER"
+	// Deprecated: use ServiceIDPaypal instead.
+	ServicePaypal = "PAYPAL"
+	// Deprecated: use ServiceIDPaysend instead.
 	ServicePaysend = "PAYSEND"
+	// Deprecated: use ServiceIDPaytomorrow instead.
 	ServicePaytomorrow = "PAYTOMORROW"
+	// Deprecated: use ServiceIDPcgamesupply instead.
 	ServicePcgamesupply = "PCGAMESUPPLY"
+	// Deprecated: use ServiceIDPerkcom instead.
 	ServicePerkcom = "PERK_COM"
+	// Deprecated: use ServiceIDPettalk instead.
 	ServicePettalk = "PETTALK"
+	// Deprecated: use ServiceIDPhound instead.
 	ServicePhound = "PHOUND"
+	// Deprecated: use ServiceIDPigeonloans instead.
 	ServicePigeonloans = "PIGEONLOANS"
+	// Deprecated: use ServiceIDPineconeresearch instead.
 	ServicePineconeresearch = "PINECONE_RESEARCH"
+	// Deprecated: use ServiceIDPingme instead.
 	ServicePingme = "PINGME"
+	// Deprecated: use ServiceIDPingone instead.
 	ServicePingone = "PINGONE"
+	// Deprecated: use ServiceIDPlacid instead.
 	ServicePlacid = "PLACID"
+	// Deprecated: use ServiceIDPlayerauctions instead.
 	ServicePlayerauctions = "PLAYERAUCTIONS"
+	// Deprecated: use ServiceIDPlentyoffishpof instead.
+	ServicePlentyoffishpof = "PLENTY_OF_FISH_POF"
+	// Deprecated: use ServiceIDPncbank instead.
+	ServicePncbank = "PNC_BANK"
+	// Deprecated: use ServiceIDPogoverify instead.
 	ServicePogoverify = "POGOVERIFY"
+	// Deprecated: use ServiceIDPoint instead.
 	ServicePoint = "POINT"
+	// Deprecated: use ServiceIDPollpay instead.
 	ServicePollpay = "POLL_PAY"
+	// Deprecated: use ServiceIDPorte instead.
 	ServicePorte = "PORTE"
+	// Deprecated: use ServiceIDPoshmark instead.
+	ServicePoshmark = "POSHMARK"
+	// Deprecated: use ServiceIDPostmates instead.
 	ServicePostmates = "POSTMATES"
+	// Deprecated: use ServiceIDPrivacy instead.
 	ServicePrivacy = "PRIVACY"
+	// Deprecated: use ServiceIDProlific instead.
 	ServiceProlific = "PROLIFIC"
+	// Deprecated: use ServiceIDProtonmail instead.
 	ServiceProtonmail = "PROTONMAIL"
+	// Deprecated: use ServiceIDPruvit instead.
 	ServicePruvit = "PRUVIT"
+	// Deprecated: use ServiceIDPubliccom instead.
 	ServicePubliccom = "PUBLIC_COM"
+	// Deprecated: use ServiceIDPurse instead.
 	ServicePurse = "PURSE"
+	// Deprecated: use ServiceIDQmeecom instead.
 	ServiceQmeecom = "QMEE_COM"
+	// Deprecated: use ServiceIDQqcom instead.
 	ServiceQqcom = "QQ_COM"
+	// Deprecated: use ServiceIDQtp instead.
 	ServiceQtp = "QTP"
+	// Deprecated: use ServiceIDQuadpay instead.
 	ServiceQuadpay = "QUADPAY"
+	// Deprecated: use ServiceIDQubemoney instead.
 	ServiceQubemoney = "QUBE_MONEY"
+	// Deprecated: use ServiceIDQuickbooks instead.
+	ServiceQuickbooks = "QUICKBOOKS"
+	// Deprecated: use ServiceIDQuontic instead.
 	ServiceQuontic = "QUONTIC"
+	// Deprecated: use ServiceIDRaise instead.
 	ServiceRaise = "RAISE"
+	// Deprecated: use ServiceIDRazer instead.
 	ServiceRazer = "RAZER"
+	// Deprecated: use ServiceIDRbfcuorg instead.
 	ServiceRbfcuorg = "RBFCU_ORG"
+	// Deprecated: use ServiceIDRegionscom instead.
 	ServiceRegionscom = "REGIONS_COM"
+	// Deprecated: use ServiceIDRemitly instead.
 	ServiceRemitly = "REMITLY"
+	// Deprecated: use ServiceIDRently instead.
 	ServiceRently = "RENTLY"
+	// Deprecated: use ServiceIDRetailmenot instead.
 	ServiceRetailmenot = "RETAILMENOT"
+	// Deprecated: use ServiceIDRevolut instead.
 	ServiceRevolut = "REVOLUT"
+	// Deprecated: use ServiceIDRevolvefinance instead.
 	ServiceRevolvefinance = "REVOLVE_FINANCE"
+	// Deprecated: use ServiceIDRewardedplay instead.
 	ServiceRewardedplay = "REWARDED_PLAY"
+	// Deprecated: use ServiceIDRia instead.
 	ServiceRia = "RIA"
+	// Deprecated: use ServiceIDRicepo instead.
 	ServiceRicepo = "RICEPO"
+	// Deprecated: use ServiceIDRidlt instead.
 	ServiceRidlt = "RI_DLT"
+	// Deprecated: use ServiceIDRingcentral instead.
 	ServiceRingcentral = "RINGCENTRAL"
+	// Deprecated: use ServiceIDRiotgames instead.
 	ServiceRiotgames = "RIOT_GAMES"
+	// Deprecated: use ServiceIDRiverfinancial instead.
 	ServiceRiverfinancial = "RIVER_FINANCIAL"
+	// Deprecated: use ServiceIDRobinhood instead.
 	ServiceRobinhood = "ROBINHOOD"
+	// Deprecated: use ServiceIDSablecom instead.
 	ServiceSablecom = "SABLE_COM"
+	// Deprecated: use ServiceIDSamsungsamsungpay instead.
 	ServiceSamsungsamsungpay = "SAMSUNG_SAMSUNG_PAY"
+	// Deprecated: use ServiceIDSantanderbank instead.
 	ServiceSantanderbank = "SANTANDERBANK"
+	// Deprecated: use ServiceIDSardineai instead.
 	ServiceSardineai = "SARDINE_AI"
+	// Deprecated: use ServiceIDSaywee instead.
 	ServiceSaywee = "SAYWEE"
+	// Deprecated: use ServiceIDSba instead.
 	ServiceSba = "SBA"
+	// Deprecated: use ServiceIDSchwab instead.
 	ServiceSchwab = "SCHWAB"
+	// Deprecated: use ServiceIDSeagamermall instead.
 	ServiceSeagamermall = "SEA_GAMER_MALL"
+	// Deprecated: use ServiceIDSeated instead.
 	ServiceSeated = "SEATED"
+	// Deprecated: use ServiceIDSecretbenefits instead.
 	ServiceSecretbenefits = "SECRET_BENEFITS"
+	// Deprecated: use ServiceIDSeedfi instead.
 	ServiceSeedfi = "SEEDFI"
+	// Deprecated: use ServiceIDSeis instead.
 	ServiceSeis = "SEIS"
+	// Deprecated: use ServiceIDSendwave instead.
 	ServiceSendwave = "SENDWAVE"
+	// Deprecated: use ServiceIDServe instead.
 	ServiceServe = "SERVE"
+	// Deprecated: use ServiceIDSezzle instead.
 	ServiceSezzle = "SEZZLE"
+	// Deprecated: use ServiceIDShakepay instead.
 	ServiceShakepay = "SHAKEPAY"
+	// Deprecated: use ServiceIDShassocom instead.
+	ServiceShassocom = "SHASSO_COM"
+	// Deprecated: use ServiceIDSheerid instead.
 	ServiceSheerid = "SHEERID"
+	// Deprecated: use ServiceIDShopathome instead.
+	ServiceShopathome = "SHOP_AT_HOME"
+	// Deprecated: use ServiceIDShopkick instead.
+	ServiceShopkick = "SHOPKICK"
+	// Deprecated: use ServiceIDShoppay instead.
+	ServiceShoppay = "SHOP_PAY"
+	// Deprecated: use ServiceIDShopwithscripcom instead.
+	ServiceShopwithscripcom = "SHOPWITHSCRIP_COM"
+	// Deprecated: use ServiceIDSignal instead.
 	ServiceSignal = "SIGNAL"
+	// Deprecated: use ServiceIDSimple instead.
 	ServiceSimple = "SIMPLE"
+	// Deprecated: use ServiceIDSimpletexting instead.
 	ServiceSimpletexting = "SIMPLETEXTING"
+	// Deprecated: use ServiceIDSimplex instead.
 	ServiceSimplex = "SIMPLEX"
-	ServiceShassocom = "SHASSO_COM"
-	ServiceShopathome = "SHOP_AT_HOME"
-	ServiceShoppay = "SHOP_PAY"
-	ServiceShopwithscripcom = "SHOPWITHSCRIP_COM"
+	// Deprecated: use ServiceIDSkipthedishes instead.
 	ServiceSkipthedishes = "SKIPTHEDISHES"
+	// Deprecated: use ServiceIDSkout instead.
 	ServiceSkout = "SKOUT"
+	// Deprecated: use ServiceIDSkrill instead.
 	ServiceSkrill = "SKRILL"
+	// Deprecated: use ServiceIDSlash instead.
 	ServiceSlash = "SLASH"
+	// Deprecated: use ServiceIDSmilegeneration instead.
 	ServiceSmilegeneration = "SMILE_GENERATION"
+	// Deprecated: use ServiceIDSmore instead.
 	ServiceSmore = "SMORE"
+	// Deprecated: use ServiceIDSmtp2go instead.
 	ServiceSmtp2go = "SMTP2GO"
+	// Deprecated: use ServiceIDSnagshout instead.
 	ServiceSnagshout = "SNAGSHOUT"
+	// Deprecated: use ServiceIDSnapchat instead.
 	ServiceSnapchat = "SNAPCHAT"
+	// Deprecated: use ServiceIDSnapfinance instead.
+	ServiceSnapfinance = "SNAP_FINANCE"
+	// Deprecated: use ServiceIDSnapkitchen instead.
 	ServiceSnapkitchen = "SNAP_KITCHEN"
+	// Deprecated: use ServiceIDSocietitv instead.
 	ServiceSocietitv = "SOCIETI_TV"
+	// Deprecated: use ServiceIDSofi instead.
+	ServiceSofi = "SOFI"
+	// Deprecated: use ServiceIDSolo instead.
 	ServiceSolo = "SOLO"
+	// Deprecated: use ServiceIDSoulapp instead.
 	ServiceSoulapp = "SOULAPP"
+	// Deprecated: use ServiceIDSpotify instead.
 	ServiceSpotify = "SPOTIFY"
+	// Deprecated: use ServiceIDSpruce instead.
 	ServiceSpruce = "SPRUCE"
+	// Deprecated: use ServiceIDSquaresquareup instead.
+	ServiceSquaresquareup = "SQUARE_SQUAREUP"
+	// Deprecated: use ServiceIDSsiopinionoutpostvaluedopinionsandothers instead.
 	ServiceSsiopinionoutpostvaluedopinionsandothers = "SSI_OPINION_OUTPOST_VALUED_OPINIONS_AND_OTHERS"
+	// Deprecated: use ServiceIDStarbucks instead.
 	ServiceStarbucks = "STARBUCKS"
+	// Deprecated: use ServiceIDStash instead.
 	ServiceStash = "STASH"
+	// Deprecated: use ServiceIDStatefarm instead.
 	ServiceStatefarm = "STATE_FARM"
+	// Deprecated: use ServiceIDSteady instead.
 	ServiceSteady = "STEADY"
+	// Deprecated: use ServiceIDSteam instead.
 	ServiceSteam = "STEAM"
+	// Deprecated: use ServiceIDStep instead.
 	ServiceStep = "STEP"
+	// Deprecated: use ServiceIDStormplay instead.
 	ServiceStormplay = "STORMPLAY"
-	ServiceStubhub = "STUBHUB"
+	// Deprecated: use ServiceIDStrike instead.
 	ServiceStrike = "STRIKE"
+	// Deprecated: use ServiceIDStripe instead.
 	ServiceStripe = "STRIPE"
+	// Deprecated: use ServiceIDStubhub instead.
+	ServiceStubhub = "STUBHUB"
+	// Deprecated: use ServiceIDSumup instead.
 	ServiceSumup = "SUMUP"
+	// Deprecated: use ServiceIDSunbit instead.
 	ServiceSunbit = "SUNBIT"
+	// Deprecated: use ServiceIDSuperpayme instead.
 	ServiceSuperpayme = "SUPERPAY_ME"
+	// Deprecated: use ServiceIDSupreme instead.
 	ServiceSupreme = "SUPREME"
+	// Deprecated: use ServiceIDSurveoo instead.
 	ServiceSurveoo = "SURVEOO"
+	// Deprecated: use ServiceIDSurveyhoney instead.
 	ServiceSurveyhoney = "SURVEY_HONEY"
+	// Deprecated: use ServiceIDSurveyjunkie instead.
 	ServiceSurveyjunkie = "SURVEY_JUNKIE"
+	// Deprecated: use ServiceIDSurveyrewardz instead.
 	ServiceSurveyrewardz = "SURVEYREWARDZ"
+	// Deprecated: use ServiceIDSurveytime instead.
 	ServiceSurveytime = "SURVEYTIME"
+	// Deprecated: use ServiceIDSuvicash instead.
 	ServiceSuvicash = "SUVICASH"
+	// Deprecated: use ServiceIDSwagbucks instead.
 	ServiceSwagbucks = "SWAGBUCKS"
+	// Deprecated: use ServiceIDTapchamps instead.
 	ServiceTapchamps = "TAPCHAMPS"
+	// Deprecated: use ServiceIDTaptap instead.
 	ServiceTaptap = "TAPTAP"
+	// Deprecated: use ServiceIDTarget instead.
 	ServiceTarget = "TARGET"
+	// Deprecated: use ServiceIDTaxslayer instead.
 	ServiceTaxslayer = "TAXSLAYER"
+	// Deprecated: use ServiceIDTdbank instead.
+	ServiceTdbank = "TD_BANK"
+	// Deprecated: use ServiceIDTelegram instead.
+	ServiceTelegram = "TELEGRAM"
+	// Deprecated: use ServiceIDTelesign instead.
 	ServiceTelesign = "TELESIGN"
+	// Deprecated: use ServiceIDTelnyx instead.
 	ServiceTelnyx = "TELNYX"
+	// Deprecated: use ServiceIDThankyoucom instead.
 	ServiceThankyoucom = "THANKYOU_COM"
+	// Deprecated: use ServiceIDThatcard instead.
 	ServiceThatcard = "THATCARD"
+	// Deprecated: use ServiceIDTheoremreach instead.
 	ServiceTheoremreach = "THEOREMREACH"
+	// Deprecated: use ServiceIDThinktank instead.
 	ServiceThinktank = "THINKTANK"
+	// Deprecated: use ServiceIDThriftypig instead.
 	ServiceThriftypig = "THRIFTY_PIG"
+	// Deprecated: use ServiceIDTicketmaster instead.
+	ServiceTicketmaster = "TICKETMASTER"
+	// Deprecated: use ServiceIDTicketnetwork instead.
 	ServiceTicketnetwork = "TICKETNETWORK"
+	// Deprecated: use ServiceIDTiktok instead.
 	ServiceTiktok = "TIKTOK"
+	// Deprecated: use ServiceIDTinder instead.
+	ServiceTinder = "TINDER"
+	// Deprecated: use ServiceIDTitan instead.
 	ServiceTitan = "TITAN"
+	// Deprecated: use ServiceIDTmobilemoney instead.
 	ServiceTmobilemoney = "T_MOBILEMONEY"
+	// Deprecated: use ServiceIDTomocredit instead.
 	ServiceTomocredit = "TOMOCREDIT"
+	// Deprecated: use ServiceIDTransfergo instead.
 	ServiceTransfergo = "TRANSFERGO"
+	// Deprecated: use ServiceIDTransunion instead.
 	ServiceTransunion = "TRANSUNION"
+	// Deprecated: use ServiceIDTruist instead.
 	ServiceTruist = "TRUIST"
+	// Deprecated: use ServiceIDTrustmark instead.
 	ServiceTrustmark = "TRUSTMARK"
+	// Deprecated: use ServiceIDTruthsocial instead.
 	ServiceTruthsocial = "TRUTH_SOCIAL"
+	// Deprecated: use ServiceIDTurbotax instead.
+	ServiceTurbotax = "TURBOTAX"
+	// Deprecated: use ServiceIDTurbotenant instead.
 	ServiceTurbotenant = "TURBOTENANT"
+	// Deprecated: use ServiceIDTuro instead.
 	ServiceTuro = "TURO"
+	// Deprecated: use ServiceIDTwilio instead.
 	ServiceTwilio = "TWILIO"
+	// Deprecated: use ServiceIDTwine instead.
 	ServiceTwine = "TWINE"
+	// Deprecated: use ServiceIDTwitch instead.
 	ServiceTwitch = "TWITCH"
+	// Deprecated: use ServiceIDTwitter instead.
 	ServiceTwitter = "TWITTER"
+	// Deprecated: use ServiceIDUberubereats instead.
+	ServiceUberubereats = "UBER_UBER_EATS"
+	// Deprecated: use ServiceIDUfbdirect instead.
 	ServiceUfbdirect = "UFBDIRECT"
+	// Deprecated: use ServiceIDUkgwallet instead.
 	ServiceUkgwallet = "UKG_WALLET"
+	// Deprecated: use ServiceIDUlinkremit instead.
 	ServiceUlinkremit = "ULINKREMIT"
+	// Deprecated: use ServiceIDUnionbank instead.
 	ServiceUnionbank = "UNIONBANK"
+	// Deprecated: use ServiceIDUnitedfcu instead.
 	ServiceUnitedfcu = "UNITEDFCU"
+	// Deprecated: use ServiceIDUnivestnet instead.
 	ServiceUnivestnet = "UNIVEST_NET"
+	// Deprecated: use ServiceIDUphold instead.
 	ServiceUphold = "UPHOLD"
+	// Deprecated: use ServiceIDUplift instead.
 	ServiceUplift = "UPLIFT"
+	// Deprecated: use ServiceIDUps instead.
 	ServiceUps = "UPS"
+	// Deprecated: use ServiceIDUpvoice instead.
 	ServiceUpvoice = "UPVOICE"
+	// Deprecated: use ServiceIDUpward instead.
 	ServiceUpward = "UPWARD"
+	// Deprecated: use ServiceIDUpwork instead.
 	ServiceUpwork = "UPWORK"
+	// Deprecated: use ServiceIDUsaa instead.
 	ServiceUsaa = "USAA"
+	// Deprecated: use ServiceIDUsbank instead.
+	ServiceUsbank = "US_BANK"
+	// Deprecated: use ServiceIDVamospay instead.
 	ServiceVamospay = "VAMOS_PAY"
+	// Deprecated: use ServiceIDVaromoney instead.
+	ServiceVaromoney = "VARO_MONEY"
+	// Deprecated: use ServiceIDVenmo instead.
+	ServiceVenmo = "VENMO"
+	// Deprecated: use ServiceIDViabill instead.
 	ServiceViabill = "VIABILL"
+	// Deprecated: use ServiceIDViabtc instead.
 	ServiceViabtc = "VIABTC"
+	// Deprecated: use ServiceIDViber instead.
 	ServiceViber = "VIBER"
+	// Deprecated: use ServiceIDVirgocx instead.
 	ServiceVirgocx = "VIRGOCX"
+	// Deprecated: use ServiceIDVoyager instead.
 	ServiceVoyager = "VOYAGER"
+	// Deprecated: use ServiceIDVrbo instead.
 	ServiceVrbo = "VRBO"
+	// Deprecated: use ServiceIDVykecode instead.
 	ServiceVykecode = "VYKECODE"
+	// Deprecated: use ServiceIDWallethub instead.
+	ServiceWallethub = "WALLETHUB"
+	// Deprecated: use ServiceIDWalmartwalmartmoneycard instead.
+	ServiceWalmartwalmartmoneycard = "WALMART_WALMART_MONEYCARD"
+	// Deprecated: use ServiceIDWealthfront instead.
 	ServiceWealthfront = "WEALTHFRONT"
+	// Deprecated: use ServiceIDWealthsimplecash instead.
 	ServiceWealthsimplecash = "WEALTHSIMPLE_CASH"
+	// Deprecated: use ServiceIDWebull instead.
 	ServiceWebull = "WEBULL"
+	// Deprecated: use ServiceIDWechat instead.
 	ServiceWechat = "WECHAT"
+	// Deprecated: use ServiceIDWellsfargo instead.
 	ServiceWellsfargo = "WELLS_FARGO"
+	// Deprecated: use ServiceIDWesterndigitalcredit instead.
 	ServiceWesterndigitalcredit = "WESTERN_DIGITAL_CREDIT"
+	// Deprecated: use ServiceIDWesternunion instead.
 	ServiceWesternunion = "WESTERNUNION"
+	// Deprecated: use ServiceIDWethosco instead.
 	ServiceWethosco = "WETHOS_CO"
+	// Deprecated: use ServiceIDWeverse instead.
 	ServiceWeverse = "WEVERSE"
+	// Deprecated: use ServiceIDWhatnot instead.
 	ServiceWhatnot = "WHATNOT"
+	// Deprecated: use ServiceIDWhatsapp instead.
+	ServiceWhatsapp = "WHATSAPP"
+	// Deprecated: use ServiceIDWhop instead.
 	ServiceWhop = "WHOP"
+	// Deprecated: use ServiceIDWingocard instead.
 	ServiceWingocard = "WINGOCARD"
+	// Deprecated: use ServiceIDWingspan instead.
 	ServiceWingspan = "WINGSPAN"
+	// Deprecated: use ServiceIDWirecash instead.
 	ServiceWirecash = "WIRECASH"
+	// Deprecated: use ServiceIDWirex instead.
 	ServiceWirex = "WIREX"
+	// Deprecated: use ServiceIDWish instead.
 	ServiceWish = "WISH"
+	// Deprecated: use ServiceIDWithyotta instead.
 	ServiceWithyotta = "WITHYOTTA"
+	// Deprecated: use ServiceIDWomply instead.
 	ServiceWomply = "WOMPLY"
+	// Deprecated: use ServiceIDWoocommerce instead.
 	ServiceWoocommerce = "WOOCOMMERCE"
+	// Deprecated: use ServiceIDWoodforest instead.
 	ServiceWoodforest = "WOODFOREST"
+	// Deprecated: use ServiceIDWorldremit instead.
 	ServiceWorldremit = "WORLDREMIT"
+	// Deprecated: use ServiceIDX1card instead.
 	ServiceX1card = "X1_CARD"
+	// Deprecated: use ServiceIDXcoins instead.
 	ServiceXcoins = "XCOINS"
+	// Deprecated: use ServiceIDXecom instead.
 	ServiceXecom = "XE_COM"
+	// Deprecated: use ServiceIDYahoo instead.
+	ServiceYahoo = "YAHOO"
+	// Deprecated: use ServiceIDYandex instead.
 	ServiceYandex = "YANDEX"
+	// Deprecated: use ServiceIDYellowsocialinteractive instead.
 	ServiceYellowsocialinteractive = "YELLOW_SOCIAL_INTERACTIVE"
+	// Deprecated: use ServiceIDYieldstreet instead.
 	ServiceYieldstreet = "YIELDSTREET"
+	// Deprecated: use ServiceIDYodlee instead.
 	ServiceYodlee = "YODLEE"
+	// Deprecated: use ServiceIDYoutube instead.
 	ServiceYoutube = "YOUTUBE"
+	// Deprecated: use ServiceIDYsense instead.
 	ServiceYsense = "YSENSE"
+	// Deprecated: use ServiceIDYunosurvey instead.
 	ServiceYunosurvey = "YUNO_SURVEY"
+	// Deprecated: use ServiceIDZackstradecom instead.
 	ServiceZackstradecom = "ZACKSTRADE_COM"
+	// Deprecated: use ServiceIDZelfco instead.
 	ServiceZelfco = "ZELF_CO"
+	// Deprecated: use ServiceIDZelle instead.
 	ServiceZelle = "ZELLE"
+	// Deprecated: use ServiceIDZillow instead.
 	ServiceZillow = "ZILLOW"
+	// Deprecated: use ServiceIDZip instead.
 	ServiceZip = "ZIP"
+	// Deprecated: use ServiceIDZogo instead.
 	ServiceZogo = "ZOGO"
+	// Deprecated: use ServiceIDZoombucks instead.
 	ServiceZoombucks = "ZOOMBUCKS"
+	// Deprecated: use ServiceIDZoominfocom instead.
 	ServiceZoominfocom = "ZOOMINFO_COM"
-	ServiceZumper = "ZUMPER"
-	ServiceTurbotax = "TURBOTAX"
-	ServiceCoinflip = "COINFLIP"
-	ServiceCouponscom = "COUPONS_COM"
-	ServiceFoundcom = "FOUND_COM"
-	ServicePoshmark = "POSHMARK"
-	ServiceAirbnb = "AIRBNB"
-	ServiceNerdwallet = "NERDWALLET"
-	ServiceSnapfinance = "SNAP_FINANCE"
-	ServiceApple = "APPLE"
-	ServiceSquaresquareup = "SQUARE_SQUAREUP"
+	// Deprecated: use ServiceIDZoosk instead.
 	ServiceZoosk = "ZOOSK"
-	ServiceOurtime = "OURTIME"
-	ServiceCashapp = "CASH_APP"
-	ServiceCreditkarma = "CREDITKARMA"
-	ServiceFreecashcom = "FREECASH_COM"
-	ServiceMoneylion = "MONEYLION"
-	ServiceNike = "NIKE"
-	ServiceOutlook = "OUTLOOK"
-	ServiceShopkick = "SHOPKICK"
-	ServiceSofi = "SOFI"
-	ServiceVaromoney = "VARO_MONEY"
-	ServiceWalmartwalmartmoneycard = "WALMART_WALMART_MONEYCARD"
-	ServiceAol = "AOL"
-	ServiceTicketmaster = "TICKETMASTER"
-	ServiceVenmo = "VENMO"
-	ServiceAmazon = "AMAZON"
-	ServiceAmh = "AMH"
-	ServiceCapitalone = "CAPITAL_ONE"
-	ServiceCraigslist = "CRAIGSLIST"
-	ServiceDavecom = "DAVE_COM"
-	ServiceDoordash = "DOORDASH"
-	ServiceEbay = "EBAY"
-	ServiceFacebook = "FACEBOOK"
-	ServiceGooglebipa = "GOOGLE_BIPA"
-	ServiceHotmail = "HOTMAIL"
-	ServiceMatchcom = "MATCH_COM"
-	ServiceMicrosoftrewards = "MICROSOFT_REWARDS"
-	ServiceOfferup = "OFFERUP"
-	ServicePlentyoffishpof = "PLENTY_OF_FISH_POF"
-	ServiceTelegram = "TELEGRAM"
-	ServiceTinder = "TINDER"
-	ServiceUberubereats = "UBER_UBER_EATS"
-	ServiceWallethub = "WALLETHUB"
-	ServiceWhatsapp = "WHATSAPP"
-	ServiceYahoo = "YAHOO"
-	ServiceNotlisted = "SERVICE_NOT_LISTED"
-	ServiceAlbertbank = "ALBERT_BANK"
-	ServiceAlly = "ALLY"
-	ServiceAmericanexpress = "AMERICAN_EXPRESS"
-	ServiceBankofamerica = "BANK_OF_AMERICA"
-	ServiceChasebank = "CHASE_BANK"
-	ServiceChime = "CHIME"
-	ServiceCitibank = "CITIBANK"
-	ServiceErewards = "E_REWARDS"
-	ServiceGobankgo2bankgreendot = "GOBANK_GO2BANK_GREEN_DOT"
-	ServiceGooglegmail = "GOOGLE_GMAIL"
-	ServiceLilibank = "LILI_BANK"
-	ServicePaypal = "PAYPAL"
-	ServicePncbank = "PNC_BANK"
-	ServiceQuickbooks = "QUICKBOOKS"
-	ServiceTdbank = "TD_BANK"
-	ServiceUsbank = "US_BANK"
-	
-)
\ No newline at end of file
+	// Deprecated: use ServiceIDZumper instead.
+	ServiceZumper = "ZUMPER"
+)
+
+// Services lists every service, sorted by ID
+var Services = sms.Services{
+	{ID: "1688", Name: "1688", NormalizedName: "1688"},
+	{ID: "1Q", Name: "1Q", NormalizedName: "1q"},
+	{ID: "2REDBEANS", Name: "2REDBEANS", NormalizedName: "2redbeans"},
+	{ID: "5_3_BANK", Name: "5_3_BANK", NormalizedName: "53bank"},
+	{ID: "7_ELEVEN", Name: "7_ELEVEN", NormalizedName: "7eleven"},
+	{ID: "ACORNS", Name: "ACORNS", NormalizedName: "acorns"},
+	{ID: "ADGATE", Name: "ADGATE", NormalizedName: "adgate"},
+	{ID: "ADIDAS", Name: "ADIDAS", NormalizedName: "adidas"},
+	{ID: "ADP", Name: "ADP", NormalizedName: "adp"},
+	{ID: "ADWALLET", Name: "ADWALLET", NormalizedName: "adwallet"},
+	{ID: "AD_IT_UP", Name: "AD_IT_UP", NormalizedName: "aditup"},
+	{ID: "AELDRA_COM", Name: "AELDRA_COM", NormalizedName: "aeldracom"},
+	{ID: "AFFIRM", Name: "AFFIRM", NormalizedName: "affirm"},
+	{ID: "AFTERPAY", Name: "AFTERPAY", NormalizedName: "afterpay"},
+	{ID: "AIRBNB", Name: "AIRBNB", NormalizedName: "airbnb"},
+	{ID: "AIRTM", Name: "AIRTM", NormalizedName: "airtm"},
+	{ID: "ALBERT_BANK", Name: "ALBERT_BANK", NormalizedName: "albertbank"},
+	{ID: "ALIBABA", Name: "ALIBABA", NormalizedName: "alibaba"},
+	{ID: "ALLY", Name: "ALLY", NormalizedName: "ally"},
+	{ID: "AMASIA", Name: "AMASIA", NormalizedName: "amasia"},
+	{ID: "AMAZON", Name: "AMAZON", NormalizedName: "amazon"},
+	{ID: "AMBER", Name: "AMBER", NormalizedName: "amber"},
+	{ID: "AMERICAN_EXPRESS", Name: "AMERICAN_EXPRESS", NormalizedName: "americanexpress"},
+	{ID: "AMH", Name: "AMH", NormalizedName: "amh"},
+	{ID: "AMWAY", Name: "AMWAY", NormalizedName: "amway"},
+	{ID: "ANDA_FINANCE", Name: "ANDA_FINANCE", NormalizedName: "andafinance"},
+	{ID: "ANDO", Name: "ANDO", NormalizedName: "ando"},
+	{ID: "AOL", Name: "AOL", NormalizedName: "aol"},
+	{ID: "APPLE", Name: "APPLE", NormalizedName: "apple"},
+	{ID: "ARMSLIST", Name: "ARMSLIST", NormalizedName: "armslist"},
+	{ID: "ASB_HAWAII", Name: "ASB_HAWAII", NormalizedName: "asbhawaii"},
+	{ID: "ASPIRATION", Name: "ASPIRATION", NormalizedName: "aspiration"},
+	{ID: "ASSOCIATED_BANK", Name: "ASSOCIATED_BANK", NormalizedName: "associatedbank"},
+	{ID: "ASTRA", Name: "ASTRA", NormalizedName: "astra"},
+	{ID: "ATOMY", Name: "ATOMY", NormalizedName: "atomy"},
+	{ID: "ATTAPOLL", Name: "ATTAPOLL", NormalizedName: "attapoll"},
+	{ID: "AUTHY", Name: "AUTHY", NormalizedName: "authy"},
+	{ID: "AVAIL", Name: "AVAIL", NormalizedName: "avail"},
+	{ID: "AVALON", Name: "AVALON", NormalizedName: "avalon"},
+	{ID: "AVANT", Name: "AVANT", NormalizedName: "avant"},
+	{ID: "AXOS_BANK", Name: "AXOS_BANK", NormalizedName: "axosbank"},
+	{ID: "BANK_OF_AMERICA", Name: "BANK_OF_AMERICA", NormalizedName: "bankofamerica"},
+	{ID: "BANK_OF_THE_WEST", Name: "BANK_OF_THE_WEST", NormalizedName: "bankofthewest"},
+	{ID: "BARSTOOL_SPORTSBOOK", Name: "BARSTOOL_SPORTSBOOK", NormalizedName: "barstoolsportsbook"},
+	{ID: "BASK_BANK", Name: "BASK_BANK", NormalizedName: "baskbank"},
+	{ID: "BBPEOPLEMEET", Name: "BBPEOPLEMEET", NormalizedName: "bbpeoplemeet"},
+	{ID: "BEBOO", Name: "BEBOO", NormalizedName: "beboo"},
+	{ID: "BEFORTHRIGHT", Name: "BEFORTHRIGHT", NormalizedName: "beforthright"},
+	{ID: "BENTO_FOR_BUSINESS", Name: "BENTO_FOR_BUSINESS", NormalizedName: "bentoforbusiness"},
+	{ID: "BESTBUY", Name: "BESTBUY", NormalizedName: "bestbuy"},
+	{ID: "BESTEGG", Name: "BESTEGG", NormalizedName: "bestegg"},
+	{ID: "BET105_EU", Name: "BET105_EU", NormalizedName: "bet105eu"},
+	{ID: "BET365", Name: "BET365", NormalizedName: "bet365"},
+	{ID: "BETNOW_EU", Name: "BETNOW_EU", NormalizedName: "betnoweu"},
+	{ID: "BETTERMENT", Name: "BETTERMENT", NormalizedName: "betterment"},
+	{ID: "BIGTOKEN", Name: "BIGTOKEN", NormalizedName: "bigtoken"},
+	{ID: "BILLPAYSITE", Name: "BILLPAYSITE", NormalizedName: "billpaysite"},
+	{ID: "BILT_REWARDS", Name: "BILT_REWARDS", NormalizedName: "biltrewards"},
+	{ID: "BINANCE", Name: "BINANCE", NormalizedName: "binance"},
+	{ID: "BITCLOUT", Name: "BITCLOUT", NormalizedName: "bitclout"},
+	{ID: "BITCOIN_OF_AMERICA", Name: "BITCOIN_OF_AMERICA", NormalizedName: "bitcoinofamerica"},
+	{ID: "BITFLYER", Name: "BITFLYER", NormalizedName: "bitflyer"},
+	{ID: "BITGAMES_IO", Name: "BITGAMES_IO", NormalizedName: "bitgamesio"},
+	{ID: "BITMO", Name: "BITMO", NormalizedName: "bitmo"},
+	{ID: "BITSTAMP", Name: "BITSTAMP", NormalizedName: "bitstamp"},
+	{ID: "BITWAGE", Name: "BITWAGE", NormalizedName: "bitwage"},
+	{ID: "BLACKPEOPLEMEET", Name: "BLACKPEOPLEMEET", NormalizedName: "blackpeoplemeet"},
+	{ID: "BLIZZARD_ENTERTAINMENT", Name: "BLIZZARD_ENTERTAINMENT", NormalizedName: "blizzardentertainment"},
+	{ID: "BLK", Name: "BLK", NormalizedName: "blk"},
+	{ID: "BLOCKCHAIN", Name: "BLOCKCHAIN", NormalizedName: "blockchain"},
+	{ID: "BLUEACORN", Name: "BLUEACORN", NormalizedName: "blueacorn"},
+	{ID: "BLUEBIRD", Name: "BLUEBIRD", NormalizedName: "bluebird"},
+	{ID: "BLUEVINE_COINSEED", Name: "BLUEVINE_COINSEED", NormalizedName: "bluevinecoinseed"},
+	{ID: "BMO_HARRIS", Name: "BMO_HARRIS", NormalizedName: "bmoharris"},
+	{ID: "BOODLE", Name: "BOODLE", NormalizedName: "boodle"},
+	{ID: "BOVADA_LV", Name: "BOVADA_LV", NormalizedName: "bovadalv"},
+	{ID: "BRAID", Name: "BRAID", NormalizedName: "braid"},
+	{ID: "BRANDCLUB", Name: "BRANDCLUB", NormalizedName: "brandclub"},
+	{ID: "BRANDEDSURVEY", Name: "BRANDEDSURVEY", NormalizedName: "brandedsurvey"},
+	{ID: "BREAD_FINANCIAL", Name: "BREAD_FINANCIAL", NormalizedName: "breadfinancial"},
+	{ID: "BRIQ", Name: "BRIQ", NormalizedName: "briq"},
+	{ID: "BTCSURVEYS", Name: "BTCSURVEYS", NormalizedName: "btcsurveys"},
+	{ID: "BUMBLE", Name: "BUMBLE", NormalizedName: "bumble"},
+	{ID: "BURNER_APP", Name: "BURNER_APP", NormalizedName: "burnerapp"},
+	{ID: "BYLINE_BANK", Name: "BYLINE_BANK", NormalizedName: "bylinebank"},
+	{ID: "CANADA_COMPUTERS", Name: "CANADA_COMPUTERS", NormalizedName: "canadacomputers"},
+	{ID: "CAPITAL_ONE", Name: "CAPITAL_ONE", NormalizedName: "capitalone"},
+	{ID: "CAPWAY", Name: "CAPWAY", NormalizedName: "capway"},
+	{ID: "CARDACCOUNT_NET", Name: "CARDACCOUNT_NET", NormalizedName: "cardaccountnet"},
+	{ID: "CARD_COM", Name: "CARD_COM", NormalizedName: "cardcom"},
+	{ID: "CASHOUT", Name: "CASHOUT", NormalizedName: "cashout"},
+	{ID: "CASHWALK", Name: "CASHWALK", NormalizedName: "cashwalk"},
+	{ID: "CASH_APP", Name: "CASH_APP", NormalizedName: "cashapp"},
+	{ID: "CATHAY", Name: "CATHAY", NormalizedName: "cathay"},
+	{ID: "CBNA", Name: "CBNA", NormalizedName: "cbna"},
+	{ID: "CDKEYS_COM", Name: "CDKEYS_COM", NormalizedName: "cdkeyscom"},
+	{ID: "CHANGELLY", Name: "CHANGELLY", NormalizedName: "changelly"},
+	{ID: "CHASE_BANK", Name: "CHASE_BANK", NormalizedName: "chasebank"},
+	{ID: "CHECKPOINTS", Name: "CHECKPOINTS", NormalizedName: "checkpoints"},
+	{ID: "CHEMISTRY", Name: "CHEMISTRY", NormalizedName: "chemistry"},
+	{ID: "CHEVRON", Name: "CHEVRON", NormalizedName: "chevron"},
+	{ID: "CHIME", Name: "CHIME", NormalizedName: "chime"},
+	{ID: "CHIPOTLE", Name: "CHIPOTLE", NormalizedName: "chipotle"},
+	{ID: "CHISPA", Name: "CHISPA", NormalizedName: "chispa"},
+	{ID: "CHIVO", Name: "CHIVO", NormalizedName: "chivo"},
+	{ID: "CHOWBUS", Name: "CHOWBUS", NormalizedName: "chowbus"},
+	{ID: "CHUMBA_CASINO", Name: "CHUMBA_CASINO", NormalizedName: "chumbacasino"},
+	{ID: "CINCHBUCKS", Name: "CINCHBUCKS", NormalizedName: "cinchbucks"},
+	{ID: "CITIBANK", Name: "CITIBANK", NormalizedName: "citibank"},
+	{ID: "CLASSPASS", Name: "CLASSPASS", NormalizedName: "classpass"},
+	{ID: "CLEARBIT", Name: "CLEARBIT", NormalizedName: "clearbit"},
+	{ID: "CLEARVOICE", Name: "CLEARVOICE", NormalizedName: "clearvoice"},
+	{ID: "CLOVER", Name: "CLOVER", NormalizedName: "clover"},
+	{ID: "COCA_COLA", Name: "COCA_COLA", NormalizedName: "cocacola"},
+	{ID: "COFFEEMEETSBAGEL", Name: "COFFEEMEETSBAGEL", NormalizedName: "coffeemeetsbagel"},
+	{ID: "COGNI", Name: "COGNI", NormalizedName: "cogni"},
+	{ID: "COINBASE", Name: "COINBASE", NormalizedName: "coinbase"},
+	{ID: "COINBERRY", Name: "COINBERRY", NormalizedName: "coinberry"},
+	{ID: "COINCHANGE_IO", Name: "COINCHANGE_IO", NormalizedName: "coinchangeio"},
+	{ID: "COINFLIP", Name: "COINFLIP", NormalizedName: "coinflip"},
+	{ID: "COINLOOT", Name: "COINLOOT", NormalizedName: "coinloot"},
+	{ID: "COINZOOM", Name: "COINZOOM", NormalizedName: "coinzoom"},
+	{ID: "COPPER_BANK", Name: "COPPER_BANK", NormalizedName: "copperbank"},
+	{ID: "COUPONS_COM", Name: "COUPONS_COM", NormalizedName: "couponscom"},
+	{ID: "COX", Name: "COX", NormalizedName: "cox"},
+	{ID: "CRAIGSLIST", Name: "CRAIGSLIST", NormalizedName: "craigslist"},
+	{ID: "CRAYPAY", Name: "CRAYPAY", NormalizedName: "craypay"},
+	{ID: "CREDAI", Name: "CREDAI", NormalizedName: "credai"},
+	{ID: "CREDITKARMA", Name: "CREDITKARMA", NormalizedName: "creditkarma"},
+	{ID: "CREDIT_SESAME", Name: "CREDIT_SESAME", NormalizedName: "creditsesame"},
+	{ID: "CROWDTAP", Name: "CROWDTAP", NormalizedName: "crowdtap"},
+	{ID: "CRYPTERIUM", Name: "CRYPTERIUM", NormalizedName: "crypterium"},
+	{ID: "CRYPTOPAY", Name: "CRYPTOPAY", NormalizedName: "cryptopay"},
+	{ID: "CRYPTO_COM", Name: "CRYPTO_COM", NormalizedName: "cryptocom"},
+	{ID: "CSG_FORTE", Name: "CSG_FORTE", NormalizedName: "csgforte"},
+	{ID: "CURB", Name: "CURB", NormalizedName: "curb"},
+	{ID: "CURIOUSCAT", Name: "CURIOUSCAT", NormalizedName: "curiouscat"},
+	{ID: "CURRENT_BANK", Name: "CURRENT_BANK", NormalizedName: "currentbank"},
+	{ID: "CURRENT_MUSIC", Name: "CURRENT_MUSIC", NormalizedName: "currentmusic"},
+	{ID: "CURTSY", Name: "CURTSY", NormalizedName: "curtsy"},
+	{ID: "CVS", Name: "CVS", NormalizedName: "cvs"},
+	{ID: "CYBERMETALS", Name: "CYBERMETALS", NormalizedName: "cybermetals"},
+	{ID: "DABBL", Name: "DABBL", NormalizedName: "dabbl"},
+	{ID: "DAILYPAY", Name: "DAILYPAY", NormalizedName: "dailypay"},
+	{ID: "DASHERDIRECT", Name: "DASHERDIRECT", NormalizedName: "dasherdirect"},
+	{ID: "DAVE_COM", Name: "DAVE_COM", NormalizedName: "davecom"},
+	{ID: "DCU_BANK", Name: "DCU_BANK", NormalizedName: "dcubank"},
+	{ID: "DELIVEROO", Name: "DELIVEROO", NormalizedName: "deliveroo"},
+	{ID: "DIDI", Name: "DIDI", NormalizedName: "didi"},
+	{ID: "DIGIT", Name: "DIGIT", NormalizedName: "digit"},
+	{ID: "DIGNIFI", Name: "DIGNIFI", NormalizedName: "dignifi"},
+	{ID: "DINNER_BALLS", Name: "DINNER_BALLS", NormalizedName: "dinnerballs"},
+	{ID: "DISCORD", Name: "DISCORD", NormalizedName: "discord"},
+	{ID: "DISCOVER", Name: "DISCOVER", NormalizedName: "discover"},
+	{ID: "DISTROKID", Name: "DISTROKID", NormalizedName: "distrokid"},
+	{ID: "DOCUSIGN", Name: "DOCUSIGN", NormalizedName: "docusign"},
+	{ID: "DOLLARCLIX", Name: "DOLLARCLIX", NormalizedName: "dollarclix"},
+	{ID: "DOORDASH", Name: "DOORDASH", NormalizedName: "doordash"},
+	{ID: "DORITOS", Name: "DORITOS", NormalizedName: "doritos"},
+	{ID: "DOSH", Name: "DOSH", NormalizedName: "dosh"},
+	{ID: "DOUBLELIST", Name: "DOUBLELIST", NormalizedName: "doublelist"},
+	{ID: "DOUUGH_COM", Name: "DOUUGH_COM", NormalizedName: "douughcom"},
+	{ID: "DRUMO", Name: "DRUMO", NormalizedName: "drumo"},
+	{ID: "DUNKIN_DONUTS", Name: "DUNKIN_DONUTS", NormalizedName: "dunkindonuts"},
+	{ID: "DUST", Name: "DUST", NormalizedName: "dust"},
+	{ID: "EARN99", Name: "EARN99", NormalizedName: "earn99"},
+	{ID: "EARNABLY", Name: "EARNABLY", NormalizedName: "earnably"},
+	{ID: "EARNHONEY", Name: "EARNHONEY", NormalizedName: "earnhoney"},
+	{ID: "EARNLY", Name: "EARNLY", NormalizedName: "earnly"},
+	{ID: "EARN_APP", Name: "EARN_APP", NormalizedName: "earnapp"},
+	{ID: "EASI", Name: "EASI", NormalizedName: "easi"},
+	{ID: "EBAY", Name: "EBAY", NormalizedName: "ebay"},
+	{ID: "EGIFTER", Name: "EGIFTER", NormalizedName: "egifter"},
+	{ID: "ELEVACITY", Name: "ELEVACITY", NormalizedName: "elevacity"},
+	{ID: "ELLEVEST", Name: "ELLEVEST", NormalizedName: "ellevest"},
+	{ID: "ELOOT_GG", Name: "ELOOT_GG", NormalizedName: "elootgg"},
+	{ID: "EMPOWER", Name: "EMPOWER", NormalizedName: "empower"},
+	{ID: "ENEBA", Name: "ENEBA", NormalizedName: "eneba"},
+	{ID: "ENZO", Name: "ENZO", NormalizedName: "enzo"},
+	{ID: "ETORO", Name: "ETORO", NormalizedName: "etoro"},
+	{ID: "ETRADE", Name: "ETRADE", NormalizedName: "etrade"},
+	{ID: "EUREKA", Name: "EUREKA", NormalizedName: "eureka"},
+	{ID: "EXMO", Name: "EXMO", NormalizedName: "exmo"},
+	{ID: "EXPERIAN", Name: "EXPERIAN", NormalizedName: "experian"},
+	{ID: "EXTRA", Name: "EXTRA", NormalizedName: "extra"},
+	{ID: "E_REWARDS", Name: "E_REWARDS", NormalizedName: "erewards"},
+	{ID: "FACEBOOK", Name: "FACEBOOK", NormalizedName: "facebook"},
+	{ID: "FASTMAIL", Name: "FASTMAIL", NormalizedName: "fastmail"},
+	{ID: "FEDEX", Name: "FEDEX", NormalizedName: "fedex"},
+	{ID: "FETCH_REWARDS", Name: "FETCH_REWARDS", NormalizedName: "fetchrewards"},
+	{ID: "FETLIFE", Name: "FETLIFE", NormalizedName: "fetlife"},
+	{ID: "FIDELITY", Name: "FIDELITY", NormalizedName: "fidelity"},
+	{ID: "FIGURE_EIGHT", Name: "FIGURE_EIGHT", NormalizedName: "figureeight"},
+	{ID: "FINISH_LINE", Name: "FINISH_LINE", NormalizedName: "finishline"},
+	{ID: "FIVERR", Name: "FIVERR", NormalizedName: "fiverr"},
+	{ID: "FLASH_REWARDS", Name: "FLASH_REWARDS", NormalizedName: "flashrewards"},
+	{ID: "FLIPPA", Name: "FLIPPA", NormalizedName: "flippa"},
+	{ID: "FLUXREWARDS", Name: "FLUXREWARDS", NormalizedName: "fluxrewards"},
+	{ID: "FLUZ", Name: "FLUZ", NormalizedName: "fluz"},
+	{ID: "FOLD", Name: "FOLD", NormalizedName: "fold"},
+	{ID: "FOUND_COM", Name: "FOUND_COM", NormalizedName: "foundcom"},
+	{ID: "FREECASH_COM", Name: "FREECASH_COM", NormalizedName: "freecashcom"},
+	{ID: "FREECRYPTOREWARDS", Name: "FREECRYPTOREWARDS", NormalizedName: "freecryptorewards"},
+	{ID: "FREELANCER", Name: "FREELANCER", NormalizedName: "freelancer"},
+	{ID: "FREETAXUSA_COM", Name: "FREETAXUSA_COM", NormalizedName: "freetaxusacom"},
+	{ID: "FREEWALLET_ORG", Name: "FREEWALLET_ORG", NormalizedName: "freewalletorg"},
+	{ID: "FTX", Name: "FTX", NormalizedName: "ftx"},
+	{ID: "FURSURE", Name: "FURSURE", NormalizedName: "fursure"},
+	{ID: "FUSIONCASH", Name: "FUSIONCASH", NormalizedName: "fusioncash"},
+	{ID: "G2A_COM", Name: "G2A_COM", NormalizedName: "g2acom"},
+	{ID: "G2G_COM_OFFGAMERS", Name: "G2G_COM_OFFGAMERS", NormalizedName: "g2gcomoffgamers"},
+	{ID: "GAMEFLIP", Name: "GAMEFLIP", NormalizedName: "gameflip"},
+	{ID: "GAMEMINER_CLUB", Name: "GAMEMINER_CLUB", NormalizedName: "gameminerclub"},
+	{ID: "GEMINI", Name: "GEMINI", NormalizedName: "gemini"},
+	{ID: "GERALD", Name: "GERALD", NormalizedName: "gerald"},
+	{ID: "GETJERRY", Name: "GETJERRY", NormalizedName: "getjerry"},
+	{ID: "GETJOBBER", Name: "GETJOBBER", NormalizedName: "getjobber"},
+	{ID: "GETPAIDTO", Name: "GETPAIDTO", NormalizedName: "getpaidto"},
+	{ID: "GETSLIDE", Name: "GETSLIDE", NormalizedName: "getslide"},
+	{ID: "GIFTYA", Name: "GIFTYA", NormalizedName: "giftya"},
+	{ID: "GOALSETTER", Name: "GOALSETTER", NormalizedName: "goalsetter"},
+	{ID: "GOBANK_GO2BANK_GREEN_DOT", Name: "GOBANK_GO2BANK_GREEN_DOT", NormalizedName: "gobankgo2bankgreendot"},
+	{ID: "GOBRANDED", Name: "GOBRANDED", NormalizedName: "gobranded"},
+	{ID: "GOFUNDME", Name: "GOFUNDME", NormalizedName: "gofundme"},
+	{ID: "GOLDEN_LAKE_EATERY", Name: "GOLDEN_LAKE_EATERY", NormalizedName: "goldenlakeeatery"},
+	{ID: "GOOGLE_BIPA", Name: "GOOGLE_BIPA", NormalizedName: "googlebipa"},
+	{ID: "GOOGLE_CLOUD", Name: "GOOGLE_CLOUD", NormalizedName: "googlecloud"},
+	{ID: "GOOGLE_GMAIL", Name: "GOOGLE_GMAIL", NormalizedName: "googlegmail"},
+	{ID: "GOOGLE_MERCHANT_CENTER", Name: "GOOGLE_MERCHANT_CENTER", NormalizedName: "googlemerchantcenter"},
+	{ID: "GOOGLE_PAY", Name: "GOOGLE_PAY", NormalizedName: "googlepay"},
+	{ID: "GOOGLE_PLAY_CONSOLE", Name: "GOOGLE_PLAY_CONSOLE", NormalizedName: "googleplayconsole"},
+	{ID: "GOPUFF", Name: "GOPUFF", NormalizedName: "gopuff"},
+	{ID: "GRABPOINT", Name: "GRABPOINT", NormalizedName: "grabpoint"},
+	{ID: "GRAILED_COM", Name: "GRAILED_COM", NormalizedName: "grailedcom"},
+	{ID: "GRASSHOPPER", Name: "GRASSHOPPER", NormalizedName: "grasshopper"},
+	{ID: "GREENLIGHT", Name: "GREENLIGHT", NormalizedName: "greenlight"},
+	{ID: "GRIND24", Name: "GRIND24", NormalizedName: "grind24"},
+	{ID: "GRUBHUB", Name: "GRUBHUB", NormalizedName: "grubhub"},
+	{ID: "GTBETS", Name: "GTBETS", NormalizedName: "gtbets"},
+	{ID: "GUSTO", Name: "GUSTO", NormalizedName: "gusto"},
+	{ID: "HANDY", Name: "HANDY", NormalizedName: "handy"},
+	{ID: "HAPPYCO", Name: "HAPPYCO", NormalizedName: "happyco"},
+	{ID: "HATCH", Name: "HATCH", NormalizedName: "hatch"},
+	{ID: "HIBBETT", Name: "HIBBETT", NormalizedName: "hibbett"},
+	{ID: "HILY", Name: "HILY", NormalizedName: "hily"},
+	{ID: "HINGE", Name: "HINGE", NormalizedName: "hinge"},
+	{ID: "HOPPER", Name: "HOPPER", NormalizedName: "hopper"},
+	{ID: "HOTEL_ENGINE_REGISTRATION", Name: "HOTEL_ENGINE_REGISTRATION", NormalizedName: "hotelengineregistration"},
+	{ID: "HOTMAIL", Name: "HOTMAIL", NormalizedName: "hotmail"},
+	{ID: "HOTVOIP", Name: "HOTVOIP", NormalizedName: "hotvoip"},
+	{ID: "HSBC", Name: "HSBC", NormalizedName: "hsbc"},
+	{ID: "HUNGRYPANDA", Name: "HUNGRYPANDA", NormalizedName: "hungrypanda"},
+	{ID: "IBOTTA", Name: "IBOTTA", NormalizedName: "ibotta"},
+	{ID: "ICCU", Name: "ICCU", NormalizedName: "iccu"},
+	{ID: "ICQ", Name: "ICQ", NormalizedName: "icq"},
+	{ID: "IDLE_EMPIRE", Name: "IDLE_EMPIRE", NormalizedName: "idleempire"},
+	{ID: "ID_ME", Name: "ID_ME", NormalizedName: "idme"},
+	{ID: "IEADBIT", Name: "IEADBIT", NormalizedName: "ieadbit"},
+	{ID: "IHERB", Name: "IHERB", NormalizedName: "iherb"},
+	{ID: "IMONEY", Name: "IMONEY", NormalizedName: "imoney"},
+	{ID: "IMPRINT_CO", Name: "IMPRINT_CO", NormalizedName: "imprintco"},
+	{ID: "INBOXDOLLARS", Name: "INBOXDOLLARS", NormalizedName: "inboxdollars"},
+	{ID: "INBOXPOUNDS", Name: "INBOXPOUNDS", NormalizedName: "inboxpounds"},
+	{ID: "INDEED", Name: "INDEED", NormalizedName: "indeed"},
+	{ID: "INDI", Name: "INDI", NormalizedName: "indi"},
+	{ID: "INNAGO", Name: "INNAGO", NormalizedName: "innago"},
+	{ID: "INSTAGC", Name: "INSTAGC", NormalizedName: "instagc"},
+	{ID: "INSTAGRAM", Name: "INSTAGRAM", NormalizedName: "instagram"},
+	{ID: "INSTAREM", Name: "INSTAREM", NormalizedName: "instarem"},
+	{ID: "INTERVIEWS", Name: "INTERVIEWS", NormalizedName: "interviews"},
+	{ID: "IPLUM", Name: "IPLUM", NormalizedName: "iplum"},
+	{ID: "IPOLL", Name: "IPOLL", NormalizedName: "ipoll"},
+	{ID: "IPSOS", Name: "IPSOS", NormalizedName: "ipsos"},
+	{ID: "IRAZOO_COM", Name: "IRAZOO_COM", NormalizedName: "irazoocom"},
+	{ID: "I_SAY", Name: "I_SAY", NormalizedName: "isay"},
+	{ID: "JELLI", Name: "JELLI", NormalizedName: "jelli"},
+	{ID: "JIAYUAN_COM", Name: "JIAYUAN_COM", NormalizedName: "jiayuancom"},
+	{ID: "JIKO", Name: "JIKO", NormalizedName: "jiko"},
+	{ID: "JUNO", Name: "JUNO", NormalizedName: "juno"},
+	{ID: "KABBAGE", Name: "KABBAGE", NormalizedName: "kabbage"},
+	{ID: "KACN", Name: "KACN", NormalizedName: "kacn"},
+	{ID: "KAKAOTALK", Name: "KAKAOTALK", NormalizedName: "kakaotalk"},
+	{ID: "KANSAS", Name: "KANSAS", NormalizedName: "kansas"},
+	{ID: "KEEPREWARDING_COM", Name: "KEEPREWARDING_COM", NormalizedName: "keeprewardingcom"},
+	{ID: "KEYBANK", Name: "KEYBANK", NormalizedName: "keybank"},
+	{ID: "KEYBASE", Name: "KEYBASE", NormalizedName: "keybase"},
+	{ID: "KINECTA_ORG", Name: "KINECTA_ORG", NormalizedName: "kinectaorg"},
+	{ID: "KLARNA", Name: "KLARNA", NormalizedName: "klarna"},
+	{ID: "KOHLS", Name: "KOHLS", NormalizedName: "kohls"},
+	{ID: "KORAMONEY", Name: "KORAMONEY", NormalizedName: "koramoney"},
+	{ID: "KUCOIN", Name: "KUCOIN", NormalizedName: "kucoin"},
+	{ID: "LANCE_APP", Name: "LANCE_APP", NormalizedName: "lanceapp"},
+	{ID: "LDSPLANET", Name: "LDSPLANET", NormalizedName: "ldsplanet"},
+	{ID: "LENDIO", Name: "LENDIO", NormalizedName: "lendio"},
+	{ID: "LETGO", Name: "LETGO", NormalizedName: "letgo"},
+	{ID: "LILI_BANK", Name: "LILI_BANK", NormalizedName: "lilibank"},
+	{ID: "LINE2", Name: "LINE2", NormalizedName: "line2"},
+	{ID: "LINKEDIN", Name: "LINKEDIN", NormalizedName: "linkedin"},
+	{ID: "LITTLE_RED_BOOK", Name: "LITTLE_RED_BOOK", NormalizedName: "littleredbook"},
+	{ID: "LOCALBITCOINS", Name: "LOCALBITCOINS", NormalizedName: "localbitcoins"},
+	{ID: "LOOTUP", Name: "LOOTUP", NormalizedName: "lootup"},
+	{ID: "LOVEANDSEEK", Name: "LOVEANDSEEK", NormalizedName: "loveandseek"},
+	{ID: "LUCKYLAND", Name: "LUCKYLAND", NormalizedName: "luckyland"},
+	{ID: "LYCOS", Name: "LYCOS", NormalizedName: "lycos"},
+	{ID: "LYFT", Name: "LYFT", NormalizedName: "lyft"},
+	{ID: "M1_FINANCE", Name: "M1_FINANCE", NormalizedName: "m1finance"},
+	{ID: "MACU", Name: "MACU", NormalizedName: "macu"},
+	{ID: "MAILGUN", Name: "MAILGUN", NormalizedName: "mailgun"},
+	{ID: "MAIL_COM", Name: "MAIL_COM", NormalizedName: "mailcom"},
+	{ID: "MARCUS", Name: "MARCUS", NormalizedName: "marcus"},
+	{ID: "MATCH_COM", Name: "MATCH_COM", NormalizedName: "matchcom"},
+	{ID: "MAZA", Name: "MAZA", NormalizedName: "maza"},
+	{ID: "MEALPAL", Name: "MEALPAL", NormalizedName: "mealpal"},
+	{ID: "MEETME", Name: "MEETME", NormalizedName: "meetme"},
+	{ID: "MERCARI", Name: "MERCARI", NormalizedName: "mercari"},
+	{ID: "METALPAY", Name: "METALPAY", NormalizedName: "metalpay"},
+	{ID: "MEZU", Name: "MEZU", NormalizedName: "mezu"},
+	{ID: "MFC", Name: "MFC", NormalizedName: "mfc"},
+	{ID: "MICROSOFT_ADS", Name: "MICROSOFT_ADS", NormalizedName: "microsoftads"},
+	{ID: "MICROSOFT_AZURE", Name: "MICROSOFT_AZURE", NormalizedName: "microsoftazure"},
+	{ID: "MICROSOFT_OFFICE365", Name: "MICROSOFT_OFFICE365", NormalizedName: "microsoftoffice365"},
+	{ID: "MICROSOFT_PARTNER_CENTER", Name: "MICROSOFT_PARTNER_CENTER", NormalizedName: "microsoftpartnercenter"},
+	{ID: "MICROSOFT_REWARDS", Name: "MICROSOFT_REWARDS", NormalizedName: "microsoftrewards"},
+	{ID: "MILES_REWARD", Name: "MILES_REWARD", NormalizedName: "milesreward"},
+	{ID: "MILLIONAIRES_MATCH", Name: "MILLIONAIRES_MATCH", NormalizedName: "millionairesmatch"},
+	{ID: "MILLIONS", Name: "MILLIONS", NormalizedName: "millions"},
+	{ID: "MOBILE_MAN", Name: "MOBILE_MAN", NormalizedName: "mobileman"},
+	{ID: "MOCAFI", Name: "MOCAFI", NormalizedName: "mocafi"},
+	{ID: "MODERN_APP", Name: "MODERN_APP", NormalizedName: "modernapp"},
+	{ID: "MONEYGRAM", Name: "MONEYGRAM", NormalizedName: "moneygram"},
+	{ID: "MONEYLION", Name: "MONEYLION", NormalizedName: "moneylion"},
+	{ID: "MOOMOO", Name: "MOOMOO", NormalizedName: "moomoo"},
+	{ID: "MOONPAY", Name: "MOONPAY", NormalizedName: "moonpay"},
+	{ID: "MOS", Name: "MOS", NormalizedName: "mos"},
+	{ID: "MOVO", Name: "MOVO", NormalizedName: "movo"},
+	{ID: "MUDFLAP", Name: "MUDFLAP", NormalizedName: "mudflap"},
+	{ID: "MYPOINTS", Name: "MYPOINTS", NormalizedName: "mypoints"},
+	{ID: "MYSOAPBOX", Name: "MYSOAPBOX", NormalizedName: "mysoapbox"},
+	{ID: "MYSYNCHRONY", Name: "MYSYNCHRONY", NormalizedName: "mysynchrony"},
+	{ID: "MYTIME", Name: "MYTIME", NormalizedName: "mytime"},
+	{ID: "N26", Name: "N26", NormalizedName: "n26"},
+	{ID: "NERDWALLET", Name: "NERDWALLET", NormalizedName: "nerdwallet"},
+	{ID: "NERVE_PRO", Name: "NERVE_PRO", NormalizedName: "nervepro"},
+	{ID: "NETFLIX", Name: "NETFLIX", NormalizedName: "netflix"},
+	{ID: "NEWTON", Name: "NEWTON", NormalizedName: "newton"},
+	{ID: "NEXMO", Name: "NEXMO", NormalizedName: "nexmo"},
+	{ID: "NEXO", Name: "NEXO", NormalizedName: "nexo"},
+	{ID: "NEXT_DOOR", Name: "NEXT_DOOR", NormalizedName: "nextdoor"},
+	{ID: "NFCU", Name: "NFCU", NormalizedName: "nfcu"},
+	{ID: "NIFTY_GATEWAY", Name: "NIFTY_GATEWAY", NormalizedName: "niftygateway"},
+	{ID: "NIKE", Name: "NIKE", NormalizedName: "nike"},
+	{ID: "NORTHERNSKIES", Name: "NORTHERNSKIES", NormalizedName: "northernskies"},
+	{ID: "NORTHONE", Name: "NORTHONE", NormalizedName: "northone"},
+	{ID: "NOTIK", Name: "NOTIK", NormalizedName: "notik"},
+	{ID: "NOVO", Name: "NOVO", NormalizedName: "novo"},
+	{ID: "OFFERNATION", Name: "OFFERNATION", NormalizedName: "offernation"},
+	{ID: "OFFERUP", Name: "OFFERUP", NormalizedName: "offerup"},
+	{ID: "OFFGAMERS", Name: "OFFGAMERS", NormalizedName: "offgamers"},
+	{ID: "OGPAY", Name: "OGPAY", NormalizedName: "ogpay"},
+	{ID: "OKCOIN", Name: "OKCOIN", NormalizedName: "okcoin"},
+	{ID: "OKCUPID", Name: "OKCUPID", NormalizedName: "okcupid"},
+	{ID: "OKX", Name: "OKX", NormalizedName: "okx"},
+	{ID: "ONCE", Name: "ONCE", NormalizedName: "once"},
+	{ID: "ONEBLINC", Name: "ONEBLINC", NormalizedName: "oneblinc"},
+	{ID: "ONEFINANCE", Name: "ONEFINANCE", NormalizedName: "onefinance"},
+	{ID: "ONEMAINFINANCIAL", Name: "ONEMAINFINANCIAL", NormalizedName: "onemainfinancial"},
+	{ID: "ONEOPINION", Name: "ONEOPINION", NormalizedName: "oneopinion"},
+	{ID: "ONJUNO", Name: "ONJUNO", NormalizedName: "onjuno"},
+	{ID: "OPENAI", Name: "OPENAI", NormalizedName: "openai"},
+	{ID: "OPENNODE", Name: "OPENNODE", NormalizedName: "opennode"},
+	{ID: "OPENPHONE", Name: "OPENPHONE", NormalizedName: "openphone"},
+	{ID: "OURTIME", Name: "OURTIME", NormalizedName: "ourtime"},
+	{ID: "OUTLOOK", Name: "OUTLOOK", NormalizedName: "outlook"},
+	{ID: "OUTSMART_HPV", Name: "OUTSMART_HPV", NormalizedName: "outsmarthpv"},
+	{ID: "PAIDTOREADEMAIL_COM", Name: "PAIDTOREADEMAIL_COM", NormalizedName: "paidtoreademailcom"},
+	{ID: "PAIDVIEWPOINT", Name: "PAIDVIEWPOINT", NormalizedName: "paidviewpoint"},
+	{ID: "PANGEA", Name: "PANGEA", NormalizedName: "pangea"},
+	{ID: "PASSBOOK", Name: "PASSBOOK", NormalizedName: "passbook"},
+	{ID: "PAXFUL", Name: "PAXFUL", NormalizedName: "paxful"},
+	{ID: "PAYACTIV", Name: "PAYACTIV", NormalizedName: "payactiv"},
+	{ID: "PAYBIS", Name: "PAYBIS", NormalizedName: "paybis"},
+	{ID: "PAYONEER", Name: "PAYONEER", NormalizedName: "payoneer"},
+	{ID: "PAYPAL", Name: "PAYPAL", NormalizedName: "paypal"},
+	{ID: "PAYSEND", Name: "PAYSEND", NormalizedName: "paysend"},
+	{ID: "PAYTOMORROW", Name: "PAYTOMORROW", NormalizedName: "paytomorrow"},
+	{ID: "PCGAMESUPPLY", Name: "PCGAMESUPPLY", NormalizedName: "pcgamesupply"},
+	{ID: "PERK_COM", Name: "PERK_COM", NormalizedName: "perkcom"},
+	{ID: "PETTALK", Name: "PETTALK", NormalizedName: "pettalk"},
+	{ID: "PHOUND", Name: "PHOUND", NormalizedName: "phound"},
+	{ID: "PIGEONLOANS", Name: "PIGEONLOANS", NormalizedName: "pigeonloans"},
+	{ID: "PINECONE_RESEARCH", Name: "PINECONE_RESEARCH", NormalizedName: "pineconeresearch"},
+	{ID: "PINGME", Name: "PINGME", NormalizedName: "pingme"},
+	{ID: "PINGONE", Name: "PINGONE", NormalizedName: "pingone"},
+	{ID: "PLACID", Name: "PLACID", NormalizedName: "placid"},
+	{ID: "PLAYERAUCTIONS", Name: "PLAYERAUCTIONS", NormalizedName: "playerauctions"},
+	{ID: "PLENTY_OF_FISH_POF", Name: "PLENTY_OF_FISH_POF", NormalizedName: "plentyoffishpof"},
+	{ID: "PNC_BANK", Name: "PNC_BANK", NormalizedName: "pncbank"},
+	{ID: "POGOVERIFY", Name: "POGOVERIFY", NormalizedName: "pogoverify"},
+	{ID: "POINT", Name: "POINT", NormalizedName: "point"},
+	{ID: "POLL_PAY", Name: "POLL_PAY", NormalizedName: "pollpay"},
+	{ID: "PORTE", Name: "PORTE", NormalizedName: "porte"},
+	{ID: "POSHMARK", Name: "POSHMARK", NormalizedName: "poshmark"},
+	{ID: "POSTMATES", Name: "POSTMATES", NormalizedName: "postmates"},
+	{ID: "PRIVACY", Name: "PRIVACY", NormalizedName: "privacy"},
+	{ID: "PROLIFIC", Name: "PROLIFIC", NormalizedName: "prolific"},
+	{ID: "PROTONMAIL", Name: "PROTONMAIL", NormalizedName: "protonmail"},
+	{ID: "PRUVIT", Name: "PRUVIT", NormalizedName: "pruvit"},
+	{ID: "PUBLIC_COM", Name: "PUBLIC_COM", NormalizedName: "publiccom"},
+	{ID: "PURSE", Name: "PURSE", NormalizedName: "purse"},
+	{ID: "QMEE_COM", Name: "QMEE_COM", NormalizedName: "qmeecom"},
+	{ID: "QQ_COM", Name: "QQ_COM", NormalizedName: "qqcom"},
+	{ID: "QTP", Name: "QTP", NormalizedName: "qtp"},
+	{ID: "QUADPAY", Name: "QUADPAY", NormalizedName: "quadpay"},
+	{ID: "QUBE_MONEY", Name: "QUBE_MONEY", NormalizedName: "qubemoney"},
+	{ID: "QUICKBOOKS", Name: "QUICKBOOKS", NormalizedName: "quickbooks"},
+	{ID: "QUONTIC", Name: "QUONTIC", NormalizedName: "quontic"},
+	{ID: "RAISE", Name: "RAISE", NormalizedName: "raise"},
+	{ID: "RAZER", Name: "RAZER", NormalizedName: "razer"},
+	{ID: "RBFCU_ORG", Name: "RBFCU_ORG", NormalizedName: "rbfcuorg"},
+	{ID: "REGIONS_COM", Name: "REGIONS_COM", NormalizedName: "regionscom"},
+	{ID: "REMITLY", Name: "REMITLY", NormalizedName: "remitly"},
+	{ID: "RENTLY", Name: "RENTLY", NormalizedName: "rently"},
+	{ID: "RETAILMENOT", Name: "RETAILMENOT", NormalizedName: "retailmenot"},
+	{ID: "REVOLUT", Name: "REVOLUT", NormalizedName: "revolut"},
+	{ID: "REVOLVE_FINANCE", Name: "REVOLVE_FINANCE", NormalizedName: "revolvefinance"},
+	{ID: "REWARDED_PLAY", Name: "REWARDED_PLAY", NormalizedName: "rewardedplay"},
+	{ID: "RIA", Name: "RIA", NormalizedName: "ria"},
+	{ID: "RICEPO", Name: "RICEPO", NormalizedName: "ricepo"},
+	{ID: "RINGCENTRAL", Name: "RINGCENTRAL", NormalizedName: "ringcentral"},
+	{ID: "RIOT_GAMES", Name: "RIOT_GAMES", NormalizedName: "riotgames"},
+	{ID: "RIVER_FINANCIAL", Name: "RIVER_FINANCIAL", NormalizedName: "riverfinancial"},
+	{ID: "RI_DLT", Name: "RI_DLT", NormalizedName: "ridlt"},
+	{ID: "ROBINHOOD", Name: "ROBINHOOD", NormalizedName: "robinhood"},
+	{ID: "SABLE_COM", Name: "SABLE_COM", NormalizedName: "sablecom"},
+	{ID: "SAMSUNG_SAMSUNG_PAY", Name: "SAMSUNG_SAMSUNG_PAY", NormalizedName: "samsungsamsungpay"},
+	{ID: "SANTANDERBANK", Name: "SANTANDERBANK", NormalizedName: "santanderbank"},
+	{ID: "SARDINE_AI", Name: "SARDINE_AI", NormalizedName: "sardineai"},
+	{ID: "SAYWEE", Name: "SAYWEE", NormalizedName: "saywee"},
+	{ID: "SBA", Name: "SBA", NormalizedName: "sba"},
+	{ID: "SCHWAB", Name: "SCHWAB", NormalizedName: "schwab"},
+	{ID: "SEATED", Name: "SEATED", NormalizedName: "seated"},
+	{ID: "SEA_GAMER_MALL", Name: "SEA_GAMER_MALL", NormalizedName: "seagamermall"},
+	{ID: "SECRET_BENEFITS", Name: "SECRET_BENEFITS", NormalizedName: "secretbenefits"},
+	{ID: "SEEDFI", Name: "SEEDFI", NormalizedName: "seedfi"},
+	{ID: "SEIS", Name: "SEIS", NormalizedName: "seis"},
+	{ID: "SENDWAVE", Name: "SENDWAVE", NormalizedName: "sendwave"},
+	{ID: "SERVE", Name: "SERVE", NormalizedName: "serve"},
+	{ID: "SERVICE_NOT_LISTED", Name: "SERVICE_NOT_LISTED", NormalizedName: "servicenotlisted"},
+	{ID: "SEZZLE", Name: "SEZZLE", NormalizedName: "sezzle"},
+	{ID: "SHAKEPAY", Name: "SHAKEPAY", NormalizedName: "shakepay"},
+	{ID: "SHASSO_COM", Name: "SHASSO_COM", NormalizedName: "shassocom"},
+	{ID: "SHEERID", Name: "SHEERID", NormalizedName: "sheerid"},
+	{ID: "SHOPKICK", Name: "SHOPKICK", NormalizedName: "shopkick"},
+	{ID: "SHOPWITHSCRIP_COM", Name: "SHOPWITHSCRIP_COM", NormalizedName: "shopwithscripcom"},
+	{ID: "SHOP_AT_HOME", Name: "SHOP_AT_HOME", NormalizedName: "shopathome"},
+	{ID: "SHOP_PAY", Name: "SHOP_PAY", NormalizedName: "shoppay"},
+	{ID: "SIGNAL", Name: "SIGNAL", NormalizedName: "signal"},
+	{ID: "SIMPLE", Name: "SIMPLE", NormalizedName: "simple"},
+	{ID: "SIMPLETEXTING", Name: "SIMPLETEXTING", NormalizedName: "simpletexting"},
+	{ID: "SIMPLEX", Name: "SIMPLEX", NormalizedName: "simplex"},
+	{ID: "SKIPTHEDISHES", Name: "SKIPTHEDISHES", NormalizedName: "skipthedishes"},
+	{ID: "SKOUT", Name: "SKOUT", NormalizedName: "skout"},
+	{ID: "SKRILL", Name: "SKRILL", NormalizedName: "skrill"},
+	{ID: "SLASH", Name: "SLASH", NormalizedName: "slash"},
+	{ID: "SMILE_GENERATION", Name: "SMILE_GENERATION", NormalizedName: "smilegeneration"},
+	{ID: "SMORE", Name: "SMORE", NormalizedName: "smore"},
+	{ID: "SMTP2GO", Name: "SMTP2GO", NormalizedName: "smtp2go"},
+	{ID: "SNAGSHOUT", Name: "SNAGSHOUT", NormalizedName: "snagshout"},
+	{ID: "SNAPCHAT", Name: "SNAPCHAT", NormalizedName: "snapchat"},
+	{ID: "SNAP_FINANCE", Name: "SNAP_FINANCE", NormalizedName: "snapfinance"},
+	{ID: "SNAP_KITCHEN", Name: "SNAP_KITCHEN", NormalizedName: "snapkitchen"},
+	{ID: "SOCIETI_TV", Name: "SOCIETI_TV", NormalizedName: "societitv"},
+	{ID: "SOFI", Name: "SOFI", NormalizedName: "sofi"},
+	{ID: "SOLO", Name: "SOLO", NormalizedName: "solo"},
+	{ID: "SOULAPP", Name: "SOULAPP", NormalizedName: "soulapp"},
+	{ID: "SPOTIFY", Name: "SPOTIFY", NormalizedName: "spotify"},
+	{ID: "SPRUCE", Name: "SPRUCE", NormalizedName: "spruce"},
+	{ID: "SQUARE_SQUAREUP", Name: "SQUARE_SQUAREUP", NormalizedName: "squaresquareup"},
+	{ID: "SSI_OPINION_OUTPOST_VALUED_OPINIONS_AND_OTHERS", Name: "SSI_OPINION_OUTPOST_VALUED_OPINIONS_AND_OTHERS", NormalizedName: "ssiopinionoutpostvaluedopinionsandothers"},
+	{ID: "STARBUCKS", Name: "STARBUCKS", NormalizedName: "starbucks"},
+	{ID: "STASH", Name: "STASH", NormalizedName: "stash"},
+	{ID: "STATE_FARM", Name: "STATE_FARM", NormalizedName: "statefarm"},
+	{ID: "STEADY", Name: "STEADY", NormalizedName: "steady"},
+	{ID: "STEAM", Name: "STEAM", NormalizedName: "steam"},
+	{ID: "STEP", Name: "STEP", NormalizedName: "step"},
+	{ID: "STORMPLAY", Name: "STORMPLAY", NormalizedName: "stormplay"},
+	{ID: "STRIKE", Name: "STRIKE", NormalizedName: "strike"},
+	{ID: "STRIPE", Name: "STRIPE", NormalizedName: "stripe"},
+	{ID: "STUBHUB", Name: "STUBHUB", NormalizedName: "stubhub"},
+	{ID: "SUMUP", Name: "SUMUP", NormalizedName: "sumup"},
+	{ID: "SUNBIT", Name: "SUNBIT", NormalizedName: "sunbit"},
+	{ID: "SUPERPAY_ME", Name: "SUPERPAY_ME", NormalizedName: "superpayme"},
+	{ID: "SUPREME", Name: "SUPREME", NormalizedName: "supreme"},
+	{ID: "SURVEOO", Name: "SURVEOO", NormalizedName: "surveoo"},
+	{ID: "SURVEYREWARDZ", Name: "SURVEYREWARDZ", NormalizedName: "surveyrewardz"},
+	{ID: "SURVEYTIME", Name: "SURVEYTIME", NormalizedName: "surveytime"},
+	{ID: "SURVEY_HONEY", Name: "SURVEY_HONEY", NormalizedName: "surveyhoney"},
+	{ID: "SURVEY_JUNKIE", Name: "SURVEY_JUNKIE", NormalizedName: "surveyjunkie"},
+	{ID: "SUVICASH", Name: "SUVICASH", NormalizedName: "suvicash"},
+	{ID: "SWAGBUCKS", Name: "SWAGBUCKS", NormalizedName: "swagbucks"},
+	{ID: "TAPCHAMPS", Name: "TAPCHAMPS", NormalizedName: "tapchamps"},
+	{ID: "TAPTAP", Name: "TAPTAP", NormalizedName: "taptap"},
+	{ID: "TARGET", Name: "TARGET", NormalizedName: "target"},
+	{ID: "TAXSLAYER", Name: "TAXSLAYER", NormalizedName: "taxslayer"},
+	{ID: "TD_BANK", Name: "TD_BANK", NormalizedName: "tdbank"},
+	{ID: "TELEGRAM", Name: "TELEGRAM", NormalizedName: "telegram"},
+	{ID: "TELESIGN", Name: "TELESIGN", NormalizedName: "telesign"},
+	{ID: "TELNYX", Name: "TELNYX", NormalizedName: "telnyx"},
+	{ID: "THANKYOU_COM", Name: "THANKYOU_COM", NormalizedName: "thankyoucom"},
+	{ID: "THATCARD", Name: "THATCARD", NormalizedName: "thatcard"},
+	{ID: "THEOREMREACH", Name: "THEOREMREACH", NormalizedName: "theoremreach"},
+	{ID: "THINKTANK", Name: "THINKTANK", NormalizedName: "thinktank"},
+	{ID: "THRIFTY_PIG", Name: "THRIFTY_PIG", NormalizedName: "thriftypig"},
+	{ID: "TICKETMASTER", Name: "TICKETMASTER", NormalizedName: "ticketmaster"},
+	{ID: "TICKETNETWORK", Name: "TICKETNETWORK", NormalizedName: "ticketnetwork"},
+	{ID: "TIKTOK", Name: "TIKTOK", NormalizedName: "tiktok"},
+	{ID: "TINDER", Name: "TINDER", NormalizedName: "tinder"},
+	{ID: "TITAN", Name: "TITAN", NormalizedName: "titan"},
+	{ID: "TOMOCREDIT", Name: "TOMOCREDIT", NormalizedName: "tomocredit"},
+	{ID: "TRANSFERGO", Name: "TRANSFERGO", NormalizedName: "transfergo"},
+	{ID: "TRANSUNION", Name: "TRANSUNION", NormalizedName: "transunion"},
+	{ID: "TRUIST", Name: "TRUIST", NormalizedName: "truist"},
+	{ID: "TRUSTMARK", Name: "TRUSTMARK", NormalizedName: "trustmark"},
+	{ID: "TRUTH_SOCIAL", Name: "TRUTH_SOCIAL", NormalizedName: "truthsocial"},
+	{ID: "TURBOTAX", Name: "TURBOTAX", NormalizedName: "turbotax"},
+	{ID: "TURBOTENANT", Name: "TURBOTENANT", NormalizedName: "turbotenant"},
+	{ID: "TURO", Name: "TURO", NormalizedName: "turo"},
+	{ID: "TWILIO", Name: "TWILIO", NormalizedName: "twilio"},
+	{ID: "TWINE", Name: "TWINE", NormalizedName: "twine"},
+	{ID: "TWITCH", Name: "TWITCH", NormalizedName: "twitch"},
+	{ID: "TWITTER", Name: "TWITTER", NormalizedName: "twitter"},
+	{ID: "T_MOBILEMONEY", Name: "T_MOBILEMONEY", NormalizedName: "tmobilemoney"},
+	{ID: "UBER_UBER_EATS", Name: "UBER_UBER_EATS", NormalizedName: "uberubereats"},
+	{ID: "UFBDIRECT", Name: "UFBDIRECT", NormalizedName: "ufbdirect"},
+	{ID: "UKG_WALLET", Name: "UKG_WALLET", NormalizedName: "ukgwallet"},
+	{ID: "ULINKREMIT", Name: "ULINKREMIT", NormalizedName: "ulinkremit"},
+	{ID: "UNIONBANK", Name: "UNIONBANK", NormalizedName: "unionbank"},
+	{ID: "UNITEDFCU", Name: "UNITEDFCU", NormalizedName: "unitedfcu"},
+	{ID: "UNIVEST_NET", Name: "UNIVEST_NET", NormalizedName: "univestnet"},
+	{ID: "UPHOLD", Name: "UPHOLD", NormalizedName: "uphold"},
+	{ID: "UPLIFT", Name: "UPLIFT", NormalizedName: "uplift"},
+	{ID: "UPS", Name: "UPS", NormalizedName: "ups"},
+	{ID: "UPVOICE", Name: "UPVOICE", NormalizedName: "upvoice"},
+	{ID: "UPWARD", Name: "UPWARD", NormalizedName: "upward"},
+	{ID: "UPWORK", Name: "UPWORK", NormalizedName: "upwork"},
+	{ID: "USAA", Name: "USAA", NormalizedName: "usaa"},
+	{ID: "US_BANK", Name: "US_BANK", NormalizedName: "usbank"},
+	{ID: "VAMOS_PAY", Name: "VAMOS_PAY", NormalizedName: "vamospay"},
+	{ID: "VARO_MONEY", Name: "VARO_MONEY", NormalizedName: "varomoney"},
+	{ID: "VENMO", Name: "VENMO", NormalizedName: "venmo"},
+	{ID: "VIABILL", Name: "VIABILL", NormalizedName: "viabill"},
+	{ID: "VIABTC", Name: "VIABTC", NormalizedName: "viabtc"},
+	{ID: "VIBER", Name: "VIBER", NormalizedName: "viber"},
+	{ID: "VIRGOCX", Name: "VIRGOCX", NormalizedName: "virgocx"},
+	{ID: "VOYAGER", Name: "VOYAGER", NormalizedName: "voyager"},
+	{ID: "VRBO", Name: "VRBO", NormalizedName: "vrbo"},
+	{ID: "VYKECODE", Name: "VYKECODE", NormalizedName: "vykecode"},
+	{ID: "WALLETHUB", Name: "WALLETHUB", NormalizedName: "wallethub"},
+	{ID: "WALMART_WALMART_MONEYCARD", Name: "WALMART_WALMART_MONEYCARD", NormalizedName: "walmartwalmartmoneycard"},
+	{ID: "WEALTHFRONT", Name: "WEALTHFRONT", NormalizedName: "wealthfront"},
+	{ID: "WEALTHSIMPLE_CASH", Name: "WEALTHSIMPLE_CASH", NormalizedName: "wealthsimplecash"},
+	{ID: "WEBULL", Name: "WEBULL", NormalizedName: "webull"},
+	{ID: "WECHAT", Name: "WECHAT", NormalizedName: "wechat"},
+	{ID: "WELLS_FARGO", Name: "WELLS_FARGO", NormalizedName: "wellsfargo"},
+	{ID: "WESTERNUNION", Name: "WESTERNUNION", NormalizedName: "westernunion"},
+	{ID: "WESTERN_DIGITAL_CREDIT", Name: "WESTERN_DIGITAL_CREDIT", NormalizedName: "westerndigitalcredit"},
+	{ID: "WETHOS_CO", Name: "WETHOS_CO", NormalizedName: "wethosco"},
+	{ID: "WEVERSE", Name: "WEVERSE", NormalizedName: "weverse"},
+	{ID: "WHATNOT", Name: "WHATNOT", NormalizedName: "whatnot"},
+	{ID: "WHATSAPP", Name: "WHATSAPP", NormalizedName: "whatsapp"},
+	{ID: "WHOP", Name: "WHOP", NormalizedName: "whop"},
+	{ID: "WINGOCARD", Name: "WINGOCARD", NormalizedName: "wingocard"},
+	{ID: "WINGSPAN", Name: "WINGSPAN", NormalizedName: "wingspan"},
+	{ID: "WIRECASH", Name: "WIRECASH", NormalizedName: "wirecash"},
+	{ID: "WIREX", Name: "WIREX", NormalizedName: "wirex"},
+	{ID: "WISH", Name: "WISH", NormalizedName: "wish"},
+	{ID: "WITHYOTTA", Name: "WITHYOTTA", NormalizedName: "withyotta"},
+	{ID: "WOMPLY", Name: "WOMPLY", NormalizedName: "womply"},
+	{ID: "WOOCOMMERCE", Name: "WOOCOMMERCE", NormalizedName: "woocommerce"},
+	{ID: "WOODFOREST", Name: "WOODFOREST", NormalizedName: "woodforest"},
+	{ID: "WORLDREMIT", Name: "WORLDREMIT", NormalizedName: "worldremit"},
+	{ID: "X1_CARD", Name: "X1_CARD", NormalizedName: "x1card"},
+	{ID: "XCOINS", Name: "XCOINS", NormalizedName: "xcoins"},
+	{ID: "XE_COM", Name: "XE_COM", NormalizedName: "xecom"},
+	{ID: "YAHOO", Name: "YAHOO", NormalizedName: "yahoo"},
+	{ID: "YANDEX", Name: "YANDEX", NormalizedName: "yandex"},
+	{ID: "YELLOW_SOCIAL_INTERACTIVE", Name: "YELLOW_SOCIAL_INTERACTIVE", NormalizedName: "yellowsocialinteractive"},
+	{ID: "YIELDSTREET", Name: "YIELDSTREET", NormalizedName: "yieldstreet"},
+	{ID: "YODLEE", Name: "YODLEE", NormalizedName: "yodlee"},
+	{ID: "YOUTUBE", Name: "YOUTUBE", NormalizedName: "youtube"},
+	{ID: "YSENSE", Name: "YSENSE", NormalizedName: "ysense"},
+	{ID: "YUNO_SURVEY", Name: "YUNO_SURVEY", NormalizedName: "yunosurvey"},
+	{ID: "ZACKSTRADE_COM", Name: "ZACKSTRADE_COM", NormalizedName: "zackstradecom"},
+	{ID: "ZELF_CO", Name: "ZELF_CO", NormalizedName: "zelfco"},
+	{ID: "ZELLE", Name: "ZELLE", NormalizedName: "zelle"},
+	{ID: "ZILLOW", Name: "ZILLOW", NormalizedName: "zillow"},
+	{ID: "ZIP", Name: "ZIP", NormalizedName: "zip"},
+	{ID: "ZOGO", Name: "ZOGO", NormalizedName: "zogo"},
+	{ID: "ZOOMBUCKS", Name: "ZOOMBUCKS", NormalizedName: "zoombucks"},
+	{ID: "ZOOMINFO_COM", Name: "ZOOMINFO_COM", NormalizedName: "zoominfocom"},
+	{ID: "ZOOSK", Name: "ZOOSK", NormalizedName: "zoosk"},
+	{ID: "ZUMPER", Name: "ZUMPER", NormalizedName: "zumper"},
+}
@@ -1,5 +1,7 @@
 package truverifi
 
+//go:generate go run ../cmd/smsgen -dir . truverifi
+
 import (
 	"bytes"
 	"context"
@@ -7,12 +9,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/nyaruka/phonenumbers"
 	"github.com/saucesteals/sms"
 )
 
+const provider = "truverifi"
+
 type Client struct {
 	http   *http.Client
 	apiKey string
@@ -26,7 +31,10 @@ func NewClient(apiKey string) *Client {
 }
 
 var (
-	_ sms.Client = &Client{}
+	_ sms.Client           = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
 )
 
 type changeServicePayload struct {
@@ -76,6 +84,16 @@ func (c *Client) do(ctx context.Context, method string, path string, payload any
 	return nil
 }
 
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		// truverifi only rents US numbers, on a single line per account that
+		// cannot be cancelled or reported
+		CountrySelection: false,
+		Rentals:          true,
+		MultipleMessages: true,
+	}
+}
+
 func (c *Client) GetPhoneNumber(ctx context.Context, service string, _ string) (*sms.PhoneNumber, error) {
 	var resp changeServiceResponse
 	err := c.do(ctx, http.MethodPost, "line/changeService", changeServicePayload{Services: []string{service}}, &resp)
@@ -91,7 +109,16 @@ func (c *Client) GetPhoneNumber(ctx context.Context, service string, _ string) (
 		return nil, fmt.Errorf("truverifi: parsing phone number (%s): %w", resp.PhoneNumber, err)
 	}
 
-	return &sms.PhoneNumber{PhoneNumber: number, Metadata: nil}, nil
+	// truverifi rents a single line per account, so there is no order to identify
+	return sms.NewPhoneNumber(number, sms.Order{
+		Provider: provider,
+		Service:  service,
+		Country:  "US",
+	}, nil), nil
+}
+
+func (c *Client) RestorePhoneNumber(_ context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	return rental.PhoneNumber(nil)
 }
 
 type lineResponse struct {
@@ -115,6 +142,9 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	if err := c.do(ctx, http.MethodGet, "line", nil, resp); err != nil {
 		return nil, err
 	}
+
+	setExpirationTime(phoneNumber, resp.ExpirationTime)
+
 	messages := make([]string, len(resp.Sms))
 	for i, sms := range resp.Sms {
 		messages[i] = sms.Text
@@ -127,6 +157,33 @@ func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber)
 	return messages, nil
 }
 
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	resp := &lineResponse{}
+	if err := c.do(ctx, http.MethodGet, "line", nil, resp); err != nil {
+		return nil, err
+	}
+
+	setExpirationTime(phoneNumber, resp.ExpirationTime)
+
+	var status sms.Status
+	switch {
+	case strings.EqualFold(resp.Status, "expired"), phoneNumber.Expired():
+		status = sms.StatusExpired
+	case len(resp.Sms) > 0:
+		status = sms.StatusReceived
+	default:
+		status = sms.StatusWaiting
+	}
+
+	return sms.NewPhoneNumberStatus(status, phoneNumber.ExpiresAt()), nil
+}
+
+func setExpirationTime(phoneNumber *sms.PhoneNumber, expirationTime time.Time) {
+	if !expirationTime.IsZero() {
+		phoneNumber.SetExpiresAt(expirationTime)
+	}
+}
+
 func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
 	// truverifi does not support cancelling
 	return nil
@@ -0,0 +1,503 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nyaruka/phonenumbers"
+	"github.com/saucesteals/sms"
+)
+
+var (
+	ErrNoNumbers    = errors.New("twilio: no free numbers in the pool")
+	ErrUnauthorized = errors.New("twilio: unauthorized")
+)
+
+const (
+	provider = "twilio"
+
+	DefaultBaseURL = "https://api.twilio.com"
+)
+
+type Config struct {
+	AccountSID string
+	AuthToken  string
+	// Numbers are the owned numbers to lease, in E.164
+	Numbers []string
+	// BaseURL defaults to DefaultBaseURL, point it at a local stand-in of the
+	// REST API to run without Twilio
+	BaseURL    string
+	HTTPClient *http.Client
+	// Store, when set, records every lease so leases that were not cancelled
+	// are still held after a restart. Clients sharing an sms.UpdatingStore,
+	// such as smsstore's, never lease the same number, even from different
+	// processes
+	Store sms.Store
+}
+
+// Client leases numbers from a pool of owned Twilio numbers, a leased number
+// receives every inbound message sent to it from the start of its lease until
+// it is cancelled
+type Client struct {
+	http       *http.Client
+	baseURL    string
+	accountSID string
+	authToken  string
+	store      sms.Store
+
+	numbers []*phonenumbers.PhoneNumber
+
+	mu sync.Mutex
+	// leased are the numbers leased without a store, the store's records
+	// are read again before every lease otherwise
+	leased map[string]bool
+}
+
+var (
+	_ sms.ReusableClient   = &Client{}
+	_ sms.StatusClient     = &Client{}
+	_ sms.CapableClient    = &Client{}
+	_ sms.RestorableClient = &Client{}
+)
+
+type metadata struct {
+	number   string
+	leasedAt time.Time
+}
+
+// leasedAtLabel is the record label holding the lease start, which differs
+// from the rental's start once the number was reused
+const leasedAtLabel = "twilio_leased_at"
+
+func NewClient(config Config) (*Client, error) {
+	c := &Client{
+		http:       config.HTTPClient,
+		baseURL:    strings.TrimSuffix(config.BaseURL, "/"),
+		accountSID: config.AccountSID,
+		authToken:  config.AuthToken,
+		store:      config.Store,
+		leased:     map[string]bool{},
+	}
+
+	if c.http == nil {
+		c.http = http.DefaultClient
+	}
+
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+
+	for _, n := range config.Numbers {
+		number, err := phonenumbers.Parse(n, "US")
+		if err != nil {
+			return nil, fmt.Errorf("twilio: parsing phone number (%s): %w", n, err)
+		}
+
+		c.numbers = append(c.numbers, number)
+	}
+
+	return c, nil
+}
+
+func (c *Client) Capabilities() sms.Capabilities {
+	return sms.Capabilities{
+		CountrySelection: true,
+		Reuse:            true,
+		Cancel:           true,
+		MultipleMessages: true,
+	}
+}
+
+// free returns the first number in country (an ISO 3166-1 alpha-2 code, any
+// country when empty) that is not leased
+func (c *Client) free(country string, leased map[string]bool) (*phonenumbers.PhoneNumber, error) {
+	for _, number := range c.numbers {
+		if country != "" && !inCountry(number, country) {
+			continue
+		}
+
+		if !leased[phonenumbers.Format(number, phonenumbers.E164)] {
+			return number, nil
+		}
+	}
+
+	return nil, ErrNoNumbers
+}
+
+// leases returns the numbers of records' leases that were not released
+func leases(records []sms.Record) map[string]bool {
+	leased := map[string]bool{}
+	for _, record := range records {
+		if record.Provider == provider && !record.Cancelled && !record.Status.Done() {
+			leased[record.Number] = true
+		}
+	}
+
+	return leased
+}
+
+// update calls fn with the store's records and saves the records it returns,
+// without other writers in between when the store is an sms.UpdatingStore
+func (c *Client) update(ctx context.Context, fn func(records []sms.Record) ([]sms.Record, error)) error {
+	if updating, ok := c.store.(sms.UpdatingStore); ok {
+		return updating.Update(ctx, fn)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	records, err := c.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	saved, err := fn(records)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range saved {
+		if err := c.store.Save(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inCountry also matches the main region of the number's calling code, so GB
+// matches numbers phonenumbers places in Guernsey or Jersey
+func inCountry(number *phonenumbers.PhoneNumber, country string) bool {
+	return strings.EqualFold(phonenumbers.GetRegionCodeForNumber(number), country) ||
+		strings.EqualFold(phonenumbers.GetRegionCodeForCountryCode(int(number.GetCountryCode())), country)
+}
+
+func (c *Client) release(number string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.leased, number)
+}
+
+// newRecord records phoneNumber's lease, keeping the messages and labels of
+// existing when it is the same rental's record
+func newRecord(phoneNumber *sms.PhoneNumber, status sms.Status, existing *sms.Record) (sms.Record, error) {
+	m, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.Record{}, sms.ErrInvalidMetadata
+	}
+
+	record := sms.Record{Rental: phoneNumber.Rental(), Status: status, Labels: map[string]string{}}
+	if existing != nil && existing.Key() == record.Key() {
+		record.Messages = existing.Messages
+		for key, value := range existing.Labels {
+			record.Labels[key] = value
+		}
+	}
+	record.Labels[leasedAtLabel] = m.leasedAt.UTC().Format(time.RFC3339)
+
+	return record, nil
+}
+
+// record saves phoneNumber's lease in the store, if any, keeping the messages
+// and labels recorded so far
+func (c *Client) record(ctx context.Context, phoneNumber *sms.PhoneNumber, status sms.Status) error {
+	if c.store == nil {
+		return nil
+	}
+
+	var existing *sms.Record
+	if loaded, err := c.store.Load(ctx, provider, phoneNumber.Rental().ID); err == nil {
+		existing = &loaded
+	}
+
+	record, err := newRecord(phoneNumber, status, existing)
+	if err != nil {
+		return err
+	}
+
+	if err := c.store.Save(ctx, record); err != nil {
+		return fmt.Errorf("twilio: recording lease of %s: %w", record.Number, err)
+	}
+
+	return nil
+}
+
+// GetPhoneNumber leases a free number, service is only recorded on the order
+// as every owned number receives messages from any service
+func (c *Client) GetPhoneNumber(ctx context.Context, service string, country string) (*sms.PhoneNumber, error) {
+	// Twilio reports send times to the second
+	leasedAt := time.Now().Truncate(time.Second)
+	lease := func(leased map[string]bool) (*sms.PhoneNumber, error) {
+		number, err := c.free(country, leased)
+		if err != nil {
+			return nil, err
+		}
+
+		e164 := phonenumbers.Format(number, phonenumbers.E164)
+		return sms.NewPhoneNumber(number, sms.Order{
+			Provider: provider,
+			ID:       e164,
+			Service:  service,
+			Country:  country,
+			RentedAt: leasedAt,
+		}, metadata{number: e164, leasedAt: leasedAt}), nil
+	}
+
+	if c.store == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		phoneNumber, err := lease(c.leased)
+		if err != nil {
+			return nil, err
+		}
+
+		c.leased[phoneNumber.Rental().Number] = true
+		return phoneNumber, nil
+	}
+
+	// the leases are read again under the store's lock, as clients in other
+	// processes may have leased or released numbers since
+	var phoneNumber *sms.PhoneNumber
+	err := c.update(ctx, func(records []sms.Record) ([]sms.Record, error) {
+		var err error
+		phoneNumber, err = lease(leases(records))
+		if err != nil {
+			return nil, err
+		}
+
+		record, err := newRecord(phoneNumber, sms.StatusWaiting, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return []sms.Record{record}, nil
+	})
+	if errors.Is(err, ErrNoNumbers) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("twilio: recording lease: %w", err)
+	}
+
+	return phoneNumber, nil
+}
+
+// RestorePhoneNumber leases the rental's number again, keeping its lease start
+func (c *Client) RestorePhoneNumber(ctx context.Context, rental sms.Rental) (*sms.PhoneNumber, error) {
+	phoneNumber, err := rental.PhoneNumber(metadata{number: rental.Number, leasedAt: c.leasedAt(ctx, rental)})
+	if err != nil {
+		return nil, err
+	}
+
+	if !rental.Cancelled {
+		c.mu.Lock()
+		c.leased[rental.Number] = true
+		c.mu.Unlock()
+	}
+
+	return phoneNumber, nil
+}
+
+// leasedAt is when rental's lease started, as recorded when it was last
+// reused, or when it was rented
+func (c *Client) leasedAt(ctx context.Context, rental sms.Rental) time.Time {
+	if c.store == nil {
+		return rental.RentedAt
+	}
+
+	record, err := c.store.Load(ctx, provider, rental.ID)
+	if err != nil || record.Number != rental.Number || !record.RentedAt.Equal(rental.RentedAt) {
+		return rental.RentedAt
+	}
+
+	leasedAt, err := time.Parse(time.RFC3339, record.Labels[leasedAtLabel])
+	if err != nil {
+		return rental.RentedAt
+	}
+
+	return leasedAt
+}
+
+type message struct {
+	Body      string `json:"body"`
+	Direction string `json:"direction"`
+	DateSent  string `json:"date_sent"`
+	From      string `json:"from"`
+}
+
+type messagesResponse struct {
+	Messages    []message `json:"messages"`
+	NextPageURI string    `json:"next_page_uri"`
+}
+
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (c *Client) get(ctx context.Context, uri string, response any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+uri, nil)
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(c.accountSID, c.authToken)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return sms.ErrRatelimited
+	case resp.StatusCode > 299:
+		var res errorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err == nil && res.Message != "" {
+			return fmt.Errorf("twilio: %d %s", res.Code, res.Message)
+		}
+		return fmt.Errorf("twilio: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// GetMessages lists the inbound messages the number received since its lease
+// started, oldest first
+func (c *Client) GetMessages(ctx context.Context, phoneNumber *sms.PhoneNumber) ([]string, error) {
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	if phoneNumber.Cancelled() {
+		return nil, fmt.Errorf("twilio: lease released: %w", sms.ErrInvalidState)
+	}
+
+	// DateSent filters by day, the lease start is applied below
+	query := url.Values{
+		"To":        {metadata.number},
+		"DateSent>": {metadata.leasedAt.UTC().Format("2006-01-02")},
+		"PageSize":  {"100"},
+	}
+	uri := fmt.Sprintf("/2010-04-01/Accounts/%s/Messages.json?%s", url.PathEscape(c.accountSID), query.Encode())
+
+	messages := []string{}
+	for uri != "" {
+		var res messagesResponse
+		if err := c.get(ctx, uri, &res); err != nil {
+			return nil, err
+		}
+
+		for _, m := range res.Messages {
+			if m.Direction != "inbound" {
+				continue
+			}
+
+			sent, err := time.Parse(time.RFC1123Z, m.DateSent)
+			if err != nil {
+				return nil, fmt.Errorf("twilio: parsing date_sent %q: %w", m.DateSent, err)
+			}
+
+			if sent.Before(metadata.leasedAt) {
+				continue
+			}
+
+			messages = append(messages, m.Body)
+		}
+
+		uri = res.NextPageURI
+	}
+
+	// Twilio lists newest first
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	if len(messages) > 0 {
+		phoneNumber.MarkUsed()
+	}
+
+	return messages, nil
+}
+
+func (c *Client) GetStatus(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumberStatus, error) {
+	if phoneNumber.Cancelled() {
+		status := sms.StatusCancelled
+		if phoneNumber.Used() {
+			status = sms.StatusFinished
+		}
+		return sms.NewPhoneNumberStatus(status, time.Time{}), nil
+	}
+
+	messages, err := c.GetMessages(ctx, phoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	status := sms.StatusWaiting
+	if len(messages) > 0 {
+		status = sms.StatusReceived
+	}
+
+	return sms.NewPhoneNumberStatus(status, time.Time{}), nil
+}
+
+// CancelPhoneNumber releases the lease so the number can be leased again
+func (c *Client) CancelPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	if phoneNumber.Cancelled() {
+		return nil
+	}
+
+	metadata, ok := phoneNumber.Metadata().(metadata)
+	if !ok {
+		return sms.ErrInvalidMetadata
+	}
+
+	c.release(metadata.number)
+	phoneNumber.MarkCancelled()
+	return c.record(ctx, phoneNumber, sms.StatusCancelled)
+}
+
+// ReportPhoneNumber releases the lease, owned numbers cannot be reported
+func (c *Client) ReportPhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) error {
+	return c.CancelPhoneNumber(ctx, phoneNumber)
+}
+
+// ReusePhoneNumber restarts the lease so only messages received from now on
+// are listed
+func (c *Client) ReusePhoneNumber(ctx context.Context, phoneNumber *sms.PhoneNumber) (*sms.PhoneNumber, error) {
+	if _, ok := phoneNumber.Metadata().(metadata); !ok {
+		return nil, sms.ErrInvalidMetadata
+	}
+
+	if err := phoneNumber.Reuse(); err != nil {
+		return nil, err
+	}
+
+	// Twilio reports send times to the second
+	leasedAt := time.Now().Truncate(time.Second)
+	phoneNumber.UpdateMetadata(func(m any) any {
+		leased := m.(metadata)
+		leased.leasedAt = leasedAt
+		return leased
+	})
+
+	if err := c.record(ctx, phoneNumber, sms.StatusWaiting); err != nil {
+		return nil, err
+	}
+
+	return phoneNumber, nil
+}
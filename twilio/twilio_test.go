@@ -0,0 +1,207 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/saucesteals/sms/smsstore"
+)
+
+// standIn serves the inbound messages it was given from the Messages
+// resource, newest first like Twilio
+type standIn struct {
+	mu       sync.Mutex
+	messages []message
+}
+
+func (s *standIn) receive(body string, sent time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append([]message{{
+		Body:      body,
+		Direction: "inbound",
+		DateSent:  sent.Format(time.RFC1123Z),
+	}}, s.messages...)
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/2010-04-01/Accounts/AC1/Messages.json" {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	json.NewEncoder(w).Encode(messagesResponse{Messages: s.messages})
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, leases string) *Client {
+	t.Helper()
+
+	c, err := NewClient(Config{
+		AccountSID: "AC1",
+		AuthToken:  "token",
+		Numbers:    []string{"+12025550101", "+12025550102"},
+		BaseURL:    server.URL,
+		Store:      smsstore.NewJSONLines(leases),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestLeasesAreSharedThroughTheStore(t *testing.T) {
+	server := httptest.NewServer(&standIn{})
+	defer server.Close()
+
+	leases := filepath.Join(t.TempDir(), "leases.jsonl")
+	ctx := context.Background()
+
+	first, err := newTestClient(t, server, leases).GetPhoneNumber(ctx, "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// another process leasing from the same pool
+	second, err := newTestClient(t, server, leases).GetPhoneNumber(ctx, "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.OrderID() == second.OrderID() {
+		t.Fatalf("both clients leased %s", first.OrderID())
+	}
+
+	if _, err := newTestClient(t, server, leases).GetPhoneNumber(ctx, "test", "US"); err != ErrNoNumbers {
+		t.Fatalf("err = %v, want ErrNoNumbers", err)
+	}
+}
+
+func TestLeasesReadTheStoreAgain(t *testing.T) {
+	server := httptest.NewServer(&standIn{})
+	defer server.Close()
+
+	leases := filepath.Join(t.TempDir(), "leases.jsonl")
+	ctx := context.Background()
+
+	// both clients start before either leases, as processes running side by side
+	clients := []*Client{newTestClient(t, server, leases), newTestClient(t, server, leases)}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		leased = map[string]int{}
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+
+			phoneNumber, err := c.GetPhoneNumber(ctx, "test", "US")
+			if errors.Is(err, ErrNoNumbers) {
+				return
+			}
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			mu.Lock()
+			leased[phoneNumber.OrderID()]++
+			mu.Unlock()
+		}(clients[i%2])
+	}
+	wg.Wait()
+
+	if len(leased) != 2 || leased["+12025550101"] != 1 || leased["+12025550102"] != 1 {
+		t.Fatalf("leased %v, want each number once", leased)
+	}
+
+	// a number released by one client can be leased by the other
+	records, err := clients[0].store.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	phoneNumber, err := clients[0].RestorePhoneNumber(ctx, records[0].Rental)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := clients[0].CancelPhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	released, err := clients[1].GetPhoneNumber(ctx, "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released.OrderID() != phoneNumber.OrderID() {
+		t.Fatalf("leased %s, want the released %s", released.OrderID(), phoneNumber.OrderID())
+	}
+}
+
+func TestReuseSurvivesRestore(t *testing.T) {
+	messages := &standIn{}
+	server := httptest.NewServer(messages)
+	defer server.Close()
+
+	leases := filepath.Join(t.TempDir(), "leases.jsonl")
+	ctx := context.Background()
+
+	c := newTestClient(t, server, leases)
+	phoneNumber, err := c.GetPhoneNumber(ctx, "test", "US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the lease started an hour ago and already received a code
+	rental := phoneNumber.Rental()
+	rental.RentedAt = rental.RentedAt.Add(-time.Hour)
+	messages.receive("111111", rental.RentedAt.Add(time.Minute))
+
+	phoneNumber, err = c.RestorePhoneNumber(ctx, rental)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := c.GetMessages(ctx, phoneNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || received[0] != "111111" {
+		t.Fatalf("messages = %q, want [111111]", received)
+	}
+
+	if _, err := c.ReusePhoneNumber(ctx, phoneNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	leasedAt := phoneNumber.Metadata().(metadata).leasedAt
+	if !leasedAt.Equal(leasedAt.Truncate(time.Second)) {
+		t.Fatalf("reused lease starts at %s, which is not to the second", leasedAt)
+	}
+
+	// a restart restores the rental as recorded before the reuse
+	restored, err := newTestClient(t, server, leases).RestorePhoneNumber(ctx, rental)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received, err = c.GetMessages(ctx, restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 0 {
+		t.Fatalf("restored reused lease listed %q received before the reuse", received)
+	}
+}